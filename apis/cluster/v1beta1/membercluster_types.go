@@ -75,11 +75,74 @@ type MemberClusterSpec struct {
 	// +optional
 	Taints []Taint `json:"taints,omitempty"`
 
+	// MaintenanceWindows are the periods of time during which Fleet should not roll out
+	// changes to the member cluster. Updates that would otherwise land on the cluster while
+	// one of its windows is active are deferred until the window ends.
+	//
+	// Each window is either recurring, described by a cron schedule and a duration, or absolute,
+	// described by a start and an end time.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
 	// DeleteOptions for deleting the MemberCluster.
 	// +optional
 	DeleteOptions *DeleteOptions `json:"deleteOptions,omitempty"`
 }
 
+// MaintenanceWindow describes a period of time during which Fleet defers rollouts to a member cluster.
+// +kubebuilder:validation:XValidation:rule="has(self.schedule) != has(self.start)",message="exactly one of schedule or start must be specified"
+// +kubebuilder:validation:XValidation:rule="has(self.schedule) == has(self.duration)",message="duration must be specified if and only if schedule is specified"
+// +kubebuilder:validation:XValidation:rule="has(self.start) == has(self.end)",message="start and end must be specified together"
+// +kubebuilder:validation:XValidation:rule="!has(self.start) || !has(self.end) || self.end > self.start",message="end must be after start"
+// +kubebuilder:validation:XValidation:rule="!has(self.timeZone) || has(self.schedule)",message="timeZone can only be specified with schedule"
+type MaintenanceWindow struct {
+	// Name is the name of the maintenance window; it must be unique in the member cluster.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Name string `json:"name"`
+
+	// Schedule is a standard 5-field cron expression (minute, hour, day of month, month,
+	// day of week) at which each occurrence of a recurring window starts, e.g., "0 2 * * SAT".
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Duration is how long each occurrence of a recurring window lasts.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// TimeZone is the IANA time zone name (e.g., "Europe/Berlin") in which the schedule is
+	// evaluated. Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Start is the start time of an absolute window.
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End is the end time of an absolute window.
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// MaintenanceWindowOccurrence is a concrete occurrence of a maintenance window.
+type MaintenanceWindowOccurrence struct {
+	// Name is the name of the maintenance window this occurrence belongs to.
+	// +required
+	Name string `json:"name"`
+
+	// Start is the time at which the occurrence starts.
+	// +required
+	Start metav1.Time `json:"start"`
+
+	// End is the time at which the occurrence ends.
+	// +required
+	End metav1.Time `json:"end"`
+}
+
 // DeleteValidationMode identifies the type of validation when deleting a MemberCluster.
 // +enum
 type DeleteValidationMode string
@@ -144,6 +207,15 @@ type MemberClusterStatus struct {
 	// AgentStatus is an array of current observed status, each corresponding to one member agent running in the member cluster.
 	// +optional
 	AgentStatus []AgentStatus `json:"agentStatus,omitempty"`
+
+	// CurrentMaintenanceWindow is the maintenance window occurrence that is active at the time
+	// the status is computed, if any. Rollouts to the member cluster are deferred while it is set.
+	// +optional
+	CurrentMaintenanceWindow *MaintenanceWindowOccurrence `json:"currentMaintenanceWindow,omitempty"`
+
+	// NextMaintenanceWindow is the next upcoming maintenance window occurrence, if any.
+	// +optional
+	NextMaintenanceWindow *MaintenanceWindowOccurrence `json:"nextMaintenanceWindow,omitempty"`
 }
 
 // Taint attached to MemberCluster has the "effect" on
@@ -158,10 +230,20 @@ type Taint struct {
 	Value string `json:"value,omitempty"`
 
 	// The effect of the taint on ClusterResourcePlacements that do not tolerate the taint.
-	// Only NoSchedule is supported.
-	// +kubebuilder:validation:Enum=NoSchedule
+	// Only NoSchedule and NoExecute are supported.
+	//
+	// A NoSchedule taint prevents new placements from being scheduled onto the cluster; a NoExecute
+	// taint further evicts the existing placements that do not tolerate the taint from the cluster.
+	// Placements of the PickFixed type cannot be evicted; they are reported in the
+	// NoExecuteTaintsEnforced condition of the cluster instead.
+	// +kubebuilder:validation:Enum=NoSchedule;NoExecute
 	// +required
 	Effect corev1.TaintEffect `json:"effect"`
+
+	// TimeAdded is the time at which the taint was added. It is only used for NoExecute taints, and
+	// is set by Fleet if not specified.
+	// +optional
+	TimeAdded *metav1.Time `json:"timeAdded,omitempty"`
 }
 
 // MemberClusterConditionType defines a specific condition of a member cluster.
@@ -206,6 +288,15 @@ const (
 	// - "False" means the cluster property collection has failed.
 	// - "Unknown" means it is unknown whether the cluster property collection has succeeded or not.
 	ConditionTypeClusterPropertyCollectionSucceeded MemberClusterConditionType = "ClusterPropertyCollectionSucceeded"
+
+	// ConditionTypeMemberClusterNoExecuteTaintsEnforced indicates whether the placements that do not
+	// tolerate the NoExecute taints of the given member cluster can be moved off the cluster. It is
+	// only reported when the cluster has NoExecute taints.
+	// Its condition status can be one of the following:
+	// - "True" means all such placements are evicted once their toleration periods expire.
+	// - "False" means some such placements cannot be evicted, i.e., placements of the PickFixed
+	//   placement type; the cluster must be removed from their cluster names instead.
+	ConditionTypeMemberClusterNoExecuteTaintsEnforced MemberClusterConditionType = "NoExecuteTaintsEnforced"
 )
 
 //+kubebuilder:object:root=true
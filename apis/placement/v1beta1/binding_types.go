@@ -127,6 +127,16 @@ type ResourceBindingSpec struct {
 	// ClusterDecision explains why the scheduler selected this cluster.
 	ClusterDecision ClusterDecision `json:"clusterDecision"`
 
+	// Replicas is the share of replicas that the target cluster runs for the replica target, when
+	// the placement divides replicas across clusters. If not set, the selected workloads are placed
+	// with their replica counts as is.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// ReplicaTarget identifies the selected workload whose `spec.replicas` field is set to Replicas.
+	// +optional
+	ReplicaTarget *WorkloadReference `json:"replicaTarget,omitempty"`
+
 	// ApplyStrategy describes how to resolve the conflict if the resource to be placed already exists in the target cluster
 	// and is owned by other appliers.
 	// +optional
@@ -34,6 +34,10 @@ const (
 	// SchedulerCleanupFinalizer is a finalizer added by the scheduler to placement objects, to make sure
 	// that all bindings derived from a placement object can be cleaned up after the placement object is deleted.
 	SchedulerCleanupFinalizer = FleetPrefix + "scheduler-cleanup"
+
+	// DefaultSchedulerName is the name of the scheduler that schedules placements which do not
+	// specify a scheduler name.
+	DefaultSchedulerName = "default-scheduler"
 )
 
 // make sure the PlacementObj and PlacementObjList interfaces are implemented by the
@@ -116,6 +120,8 @@ type ClusterResourcePlacement struct {
 	// +kubebuilder:validation:XValidation:rule="!(has(oldSelf.policy) && !has(self.policy))",message="policy cannot be removed once set"
 	// +kubebuilder:validation:XValidation:rule="!(self.statusReportingScope == 'NamespaceAccessible' && size(self.resourceSelectors.filter(x, x.kind == 'Namespace')) != 1)",message="when statusReportingScope is NamespaceAccessible, exactly one resourceSelector with kind 'Namespace' is required"
 	// +kubebuilder:validation:XValidation:rule="!has(oldSelf.statusReportingScope) || self.statusReportingScope == oldSelf.statusReportingScope",message="statusReportingScope is immutable"
+	// +kubebuilder:validation:XValidation:rule="(has(self.schedulerName) ? self.schedulerName : '') == (has(oldSelf.schedulerName) ? oldSelf.schedulerName : '')",message="schedulerName is immutable"
+	// +kubebuilder:validation:XValidation:rule="!has(self.schedulingGates) || (has(oldSelf.schedulingGates) && self.schedulingGates.all(g, oldSelf.schedulingGates.exists(o, o.name == g.name)))",message="schedulingGates can only be removed after creation"
 	Spec PlacementSpec `json:"spec"`
 
 	// The observed status of ClusterResourcePlacement.
@@ -162,6 +168,46 @@ type PlacementSpec struct {
 	// +kubebuilder:validation:Enum=ClusterScopeOnly;NamespaceAccessible
 	// +kubebuilder:validation:Optional
 	StatusReportingScope StatusReportingScope `json:"statusReportingScope,omitempty"`
+
+	// PriorityClassName is the name of the PlacementPriorityClass of the placement, which determines
+	// the order in which the scheduler processes placements, and whether the placement may preempt
+	// lower-priority placements when it cannot find enough clusters to run on.
+	// If unspecified, or if the priority class does not exist, the placement has a priority of zero
+	// and never preempts other placements.
+	// Preemption applies to ClusterResourcePlacement objects only.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// SchedulerName is the name of the scheduler that schedules the placement, which allows
+	// custom schedulers to run alongside the default one; each scheduler only processes the
+	// placements that specify its name.
+	// If unspecified, the placement is scheduled by the default scheduler, `default-scheduler`.
+	// This field is immutable.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// SchedulingGates is a list of gates that block the scheduling of the placement; the scheduler
+	// does not schedule the placement until all the gates are removed. This allows a placement to be
+	// created before its prerequisites are ready, e.g., CRDs installed by another placement, or an
+	// external approval.
+	// Similar to Pod scheduling gates, the gates can only be specified when the placement is created,
+	// and can only be removed afterwards.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:Optional
+	SchedulingGates []PlacementSchedulingGate `json:"schedulingGates,omitempty"`
+}
+
+// PlacementSchedulingGate is a gate that blocks the scheduling of a placement.
+type PlacementSchedulingGate struct {
+	// Name of the scheduling gate; each scheduling gate must have a unique name.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=316
+	Name string `json:"name"`
 }
 
 // Tolerations returns tolerations for PlacementSpec to handle nil policy case.
@@ -172,6 +218,20 @@ func (p *PlacementSpec) Tolerations() []Toleration {
 	return nil
 }
 
+// IsSchedulingGated returns if the scheduling of the PlacementSpec is blocked by any scheduling gates.
+func (p *PlacementSpec) IsSchedulingGated() bool {
+	return len(p.SchedulingGates) > 0
+}
+
+// Scheduler returns the name of the scheduler of the PlacementSpec, with the default scheduler
+// name used if none is specified.
+func (p *PlacementSpec) Scheduler() string {
+	if len(p.SchedulerName) == 0 {
+		return DefaultSchedulerName
+	}
+	return p.SchedulerName
+}
+
 // ResourceSelectorTerm is used to select resources as the target resources to be placed.
 // All the fields are `ANDed`. In other words, a resource must match all the fields to be selected.
 type ResourceSelectorTerm struct {
@@ -242,7 +302,8 @@ type PlacementPolicy struct {
 	// +kubebuilder:validation:Optional
 	NumberOfClusters *int32 `json:"numberOfClusters,omitempty"`
 
-	// Affinity contains cluster affinity scheduling rules. Defines which member clusters to place the selected resources.
+	// Affinity contains cluster affinity and inter-placement (anti-)affinity scheduling rules. Defines which member
+	// clusters to place the selected resources.
 	// Only valid if the placement type is "PickAll" or "PickN".
 	// +kubebuilder:validation:Optional
 	Affinity *Affinity `json:"affinity,omitempty"`
@@ -263,13 +324,170 @@ type PlacementPolicy struct {
 	// +kubebuilder:validation:MaxItems=100
 	// +kubebuilder:validation:Optional
 	Tolerations []Toleration `json:"tolerations,omitempty"`
+
+	// SchedulingProfile is the name of the scheduling profile the scheduler uses when making
+	// scheduling decisions for the placement. Scheduling profiles are defined in the scheduler
+	// configuration of the hub agent; if not specified, the default profile is used.
+	//
+	// If the specified profile does not exist in the scheduler configuration, the placement
+	// will not be scheduled until the profile becomes available.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Optional
+	SchedulingProfile string `json:"schedulingProfile,omitempty"`
+
+	// ResourceRequests describes the amount of compute resources (e.g., cpu, memory, nvidia.com/gpu)
+	// the selected resources need in each cluster they are placed on. If specified, the scheduler only
+	// picks clusters that report enough available capacity for every requested resource, after
+	// deducting the capacity reserved by placements recently scheduled to the same cluster.
+	//
+	// Only valid if the placement type is "PickAll" or "PickN".
+	// +kubebuilder:validation:Optional
+	ResourceRequests corev1.ResourceList `json:"resourceRequests,omitempty"`
+
+	// ReplicaScheduling describes how the replicas of the selected workloads (e.g., Deployments and
+	// StatefulSets) are distributed across the picked clusters. If not specified, every picked cluster
+	// runs the selected workloads with their replica counts as is.
+	// +kubebuilder:validation:Optional
+	ReplicaScheduling *ReplicaScheduling `json:"replicaScheduling,omitempty"`
+}
+
+// ReplicaSchedulingType describes how the replicas of the selected workloads are scheduled.
+// +enum
+type ReplicaSchedulingType string
+
+const (
+	// ReplicaSchedulingTypeDuplicated instructs Fleet to place the selected workloads with their
+	// replica counts as is on every picked cluster.
+	ReplicaSchedulingTypeDuplicated ReplicaSchedulingType = "Duplicated"
+
+	// ReplicaSchedulingTypeDivided instructs Fleet to divide a total number of replicas across the
+	// picked clusters; each cluster runs its own share of the replicas.
+	ReplicaSchedulingTypeDivided ReplicaSchedulingType = "Divided"
+)
+
+// ReplicaDivisionPreference describes how replicas are divided across the picked clusters.
+// +enum
+type ReplicaDivisionPreference string
+
+const (
+	// ReplicaDivisionPreferenceWeighted divides replicas in proportion to the static weights
+	// assigned to the picked clusters.
+	ReplicaDivisionPreferenceWeighted ReplicaDivisionPreference = "Weighted"
+
+	// ReplicaDivisionPreferenceAvailableCapacity divides replicas in proportion to the available
+	// capacity reported by the picked clusters.
+	ReplicaDivisionPreferenceAvailableCapacity ReplicaDivisionPreference = "AvailableCapacity"
+)
+
+// ReplicaScheduling describes how the replicas of the selected workloads are distributed across
+// the picked clusters.
+// +kubebuilder:validation:XValidation:rule="!has(self.type) || self.type != 'Divided' || (has(self.replicas) && has(self.targetWorkload))",message="replicas and target workload must be specified when the replica scheduling type is Divided"
+type ReplicaScheduling struct {
+	// Type is the replica scheduling type. Can be "Duplicated" or "Divided". Default is Duplicated.
+	// +kubebuilder:validation:Enum=Duplicated;Divided
+	// +kubebuilder:default=Duplicated
+	// +kubebuilder:validation:Optional
+	Type ReplicaSchedulingType `json:"type,omitempty"`
+
+	// Replicas is the total number of replicas to divide across the picked clusters. The scheduler
+	// records the share of each cluster on its binding, and Fleet sets the `spec.replicas` field of
+	// the target workload placed on the cluster to the share.
+	//
+	// Only valid if the replica scheduling type is "Divided".
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// TargetWorkload identifies the selected workload (e.g., a Deployment) whose replicas are
+	// divided across the picked clusters; the other selected resources are placed as they are. The
+	// workload can be selected directly, or wrapped in a selected envelope.
+	//
+	// Required if the replica scheduling type is "Divided".
+	// +kubebuilder:validation:Optional
+	TargetWorkload *WorkloadReference `json:"targetWorkload,omitempty"`
+
+	// DivisionPreference describes how the replicas are divided across the picked clusters.
+	// Can be "Weighted" or "AvailableCapacity". Default is Weighted.
+	//
+	// Only valid if the replica scheduling type is "Divided".
+	// +kubebuilder:validation:Enum=Weighted;AvailableCapacity
+	// +kubebuilder:default=Weighted
+	// +kubebuilder:validation:Optional
+	DivisionPreference ReplicaDivisionPreference `json:"divisionPreference,omitempty"`
+
+	// StaticWeights assigns weights to clusters when replicas are divided by weight. Picked clusters
+	// that are not listed have a weight of 0; if none of the picked clusters has a positive weight,
+	// the replicas are divided evenly.
+	//
+	// Only valid if the division preference is "Weighted".
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=map
+	// +listMapKey=clusterName
+	// +kubebuilder:validation:Optional
+	StaticWeights []StaticClusterWeight `json:"staticWeights,omitempty"`
+
+	// CapacityResourceName is the name of the resource (e.g., cpu, memory) whose available capacity
+	// is used when replicas are divided by available capacity. Default is cpu. If none of the picked
+	// clusters reports a positive available capacity, the replicas are divided evenly.
+	//
+	// Only valid if the division preference is "AvailableCapacity".
+	// +kubebuilder:validation:Optional
+	CapacityResourceName corev1.ResourceName `json:"capacityResourceName,omitempty"`
 }
 
-// Affinity is a group of cluster affinity scheduling rules. More to be added.
+// WorkloadReference identifies a workload among the selected resources.
+type WorkloadReference struct {
+	// Group is the API group of the workload; empty for the core API group.
+	// +kubebuilder:validation:Optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the workload.
+	// +kubebuilder:validation:Required
+	Version string `json:"version"`
+
+	// Kind is the kind of the workload.
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// Name is the name of the workload.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the workload. For ResourcePlacements, it can be left empty,
+	// which stands for the namespace of the placement; for ClusterResourcePlacements, it must be
+	// specified for namespaced workloads.
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// StaticClusterWeight is the weight of a cluster when replicas are divided by weight.
+type StaticClusterWeight struct {
+	// ClusterName is the name of the member cluster.
+	// +kubebuilder:validation:Required
+	ClusterName string `json:"clusterName"`
+
+	// Weight is the weight of the cluster.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +kubebuilder:validation:Required
+	Weight int32 `json:"weight"`
+}
+
+// Affinity is a group of affinity scheduling rules.
 type Affinity struct {
 	// ClusterAffinity contains cluster affinity scheduling rules for the selected resources.
 	// +kubebuilder:validation:Optional
 	ClusterAffinity *ClusterAffinity `json:"clusterAffinity,omitempty"`
+
+	// PlacementAffinity contains inter-placement affinity scheduling rules, which help place the
+	// selected resources on the same clusters as those picked by other placements.
+	// +kubebuilder:validation:Optional
+	PlacementAffinity *PlacementAffinity `json:"placementAffinity,omitempty"`
+
+	// PlacementAntiAffinity contains inter-placement anti-affinity scheduling rules, which help keep
+	// the selected resources away from the clusters picked by other placements.
+	// +kubebuilder:validation:Optional
+	PlacementAntiAffinity *PlacementAntiAffinity `json:"placementAntiAffinity,omitempty"`
 }
 
 // ClusterAffinity contains cluster affinity scheduling rules for the selected resources.
@@ -294,6 +512,75 @@ type ClusterAffinity struct {
 	PreferredDuringSchedulingIgnoredDuringExecution []PreferredClusterSelector `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
 }
 
+// PlacementAffinity contains inter-placement affinity scheduling rules for the selected resources.
+type PlacementAffinity struct {
+	// If the affinity requirements specified by this field are not met at
+	// scheduling time, the resource will not be scheduled onto the cluster.
+	// A cluster satisfies the requirements only if it has been picked by at least one placement
+	// matching each of the terms; that is, the terms are `ANDed`.
+	// If the affinity requirements specified by this field cease to be met
+	// at some point after the placement (e.g. due to an update), the system
+	// may or may not try to eventually remove the resource from the cluster.
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	RequiredDuringSchedulingIgnoredDuringExecution []PlacementAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+
+	// The scheduler computes a score for each cluster at schedule time by iterating
+	// through the elements of this field and adding "weight" to the sum if the cluster
+	// has been picked by a placement matching the corresponding term.
+	// This field is ignored if the placement type is "PickAll".
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPlacementAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// PlacementAntiAffinity contains inter-placement anti-affinity scheduling rules for the selected resources.
+type PlacementAntiAffinity struct {
+	// If the anti-affinity requirements specified by this field are not met at
+	// scheduling time, the resource will not be scheduled onto the cluster.
+	// A cluster satisfies the requirements only if it has not been picked by any placement
+	// matching any of the terms.
+	// If the anti-affinity requirements specified by this field cease to be met
+	// at some point after the placement (e.g. due to an update), the system
+	// may or may not try to eventually remove the resource from the cluster.
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	RequiredDuringSchedulingIgnoredDuringExecution []PlacementAffinityTerm `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+
+	// The scheduler computes a score for each cluster at schedule time by iterating
+	// through the elements of this field and subtracting "weight" from the sum if the cluster
+	// has been picked by a placement matching the corresponding term.
+	// This field is ignored if the placement type is "PickAll".
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	PreferredDuringSchedulingIgnoredDuringExecution []WeightedPlacementAffinityTerm `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// PlacementAffinityTerm selects a group of placements; the clusters picked by these placements
+// are the ones the affinity (or anti-affinity) term refers to.
+//
+// For a ClusterResourcePlacement, the term selects other ClusterResourcePlacements; for a
+// ResourcePlacement, the term selects other ResourcePlacements in the same namespace. The
+// placement itself is never selected.
+type PlacementAffinityTerm struct {
+	// LabelSelector is a label query over placements.
+	// +kubebuilder:validation:Required
+	LabelSelector *metav1.LabelSelector `json:"labelSelector"`
+}
+
+// WeightedPlacementAffinityTerm is a placement affinity term with a weight.
+type WeightedPlacementAffinityTerm struct {
+	// Weight associated with matching the corresponding placement affinity term, in the range [1, 100].
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Weight int32 `json:"weight"`
+
+	// A placement affinity term, associated with the corresponding weight.
+	// +kubebuilder:validation:Required
+	PlacementAffinityTerm PlacementAffinityTerm `json:"placementAffinityTerm"`
+}
+
 type ClusterSelector struct {
 	// +kubebuilder:validation:MaxItems=10
 	// ClusterSelectorTerms is a list of cluster selector terms. The terms are `ORed`.
@@ -373,6 +660,27 @@ const (
 	// PropertySelectorLessThanOrEqualTo dictates Fleet to select cluster if its observed value of a
 	// given property is less than or equal to the value specified in the requirement.
 	PropertySelectorLessThanOrEqualTo PropertySelectorOperator = "Le"
+	// PropertySelectorIn dictates Fleet to select cluster if its observed value of a given
+	// property is one of the values specified in the requirement.
+	PropertySelectorIn PropertySelectorOperator = "In"
+	// PropertySelectorNotIn dictates Fleet to select cluster if its observed value of a given
+	// property is none of the values specified in the requirement, or if the property is not
+	// available for the cluster.
+	PropertySelectorNotIn PropertySelectorOperator = "NotIn"
+	// PropertySelectorExists dictates Fleet to select cluster if it reports a value for a
+	// given property.
+	PropertySelectorExists PropertySelectorOperator = "Exists"
+	// PropertySelectorDoesNotExist dictates Fleet to select cluster if it does not report a
+	// value for a given property.
+	PropertySelectorDoesNotExist PropertySelectorOperator = "DoesNotExist"
+	// PropertySelectorVersionGreaterThan dictates Fleet to select cluster if its observed value
+	// of a given property, as a semantic version, is greater than the version specified in the
+	// requirement.
+	PropertySelectorVersionGreaterThan PropertySelectorOperator = "VersionGt"
+	// PropertySelectorVersionLessThan dictates Fleet to select cluster if its observed value
+	// of a given property, as a semantic version, is less than the version specified in the
+	// requirement.
+	PropertySelectorVersionLessThan PropertySelectorOperator = "VersionLt"
 )
 
 // PropertySelectorRequirement is a specific property requirement when picking clusters for
@@ -391,16 +699,23 @@ type PropertySelectorRequirement struct {
 	// the observed values of individual member clusters in accordance with the given
 	// operator.
 	//
-	// At this moment, each value should be a Kubernetes quantity. For more information, see
-	// https://pkg.go.dev/k8s.io/apimachinery/pkg/api/resource#Quantity.
-	//
 	// If the operator is Gt (greater than), Ge (greater than or equal to), Lt (less than),
 	// or `Le` (less than or equal to), Eq (equal to), or Ne (ne), exactly one value must be
-	// specified in the list.
+	// specified in the list, which should be a Kubernetes quantity. For more information, see
+	// https://pkg.go.dev/k8s.io/apimachinery/pkg/api/resource#Quantity.
 	//
-	// +kubebuilder:validation:MaxItems=1
-	// +kubebuilder:validation:Required
-	Values []string `json:"values"`
+	// If the operator is VersionGt (version greater than) or VersionLt (version less than),
+	// exactly one value must be specified in the list, which should be a semantic version,
+	// e.g., `1.30` or `v1.30.2`.
+	//
+	// If the operator is In or NotIn, one or more values must be specified in the list; the
+	// observed values are compared with them as strings.
+	//
+	// If the operator is Exists or DoesNotExist, the list must be empty.
+	//
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:Optional
+	Values []string `json:"values,omitempty"`
 }
 
 // PropertySelector helps user specify property requirements when picking clusters for resource
@@ -436,8 +751,8 @@ type ClusterSelectorTerm struct {
 	//
 	// If you specify both label and property selectors in the same term, the results are AND'd.
 	//
-	// At this moment, PropertySelector can only be used with
-	// `RequiredDuringSchedulingIgnoredDuringExecution` affinity terms.
+	// PropertySelector can be used with both `RequiredDuringSchedulingIgnoredDuringExecution`
+	// and `PreferredDuringSchedulingIgnoredDuringExecution` affinity terms.
 	//
 	// This field is beta-level; it is for the property-based scheduling feature and is only
 	// functional when a property provider is enabled in the deployment.
@@ -454,9 +769,47 @@ type ClusterSelectorTerm struct {
 	// functional when a property provider is enabled in the deployment.
 	// +kubebuilder:validation:Optional
 	PropertySorter *PropertySorter `json:"propertySorter,omitempty"`
+
+	// PropertyExpression is a CEL expression over the labels, the properties, and the resource
+	// usage of a member cluster, which must evaluate to a boolean value. Clusters for which the
+	// expression evaluates to true are selected.
+	//
+	// The following variables are available in the expression:
+	// * `labels`, a map of the labels of the cluster;
+	// * `properties`, a map of the non-resource properties of the cluster, keyed by the property
+	//   names, with the values parsed as numbers; properties whose values are not valid
+	//   quantities are absent;
+	// * `stringProperties`, a map of the non-resource properties of the cluster, keyed by the
+	//   property names, with the values as they are reported; and
+	// * `resourceUsage`, a map with the keys `capacity`, `allocatable`, and `available`, each
+	//   being a map of the resource quantities of the cluster as numbers, keyed by the resource
+	//   names.
+	//
+	// The function `compareVersions(a, b)` compares two version strings (e.g., `v1.30.2`), and
+	// returns -1, 0, or 1 if version `a` is lower than, equal to, or higher than version `b`.
+	//
+	// For example, `resourceUsage.available.cpu > 0.2 * resourceUsage.capacity.cpu &&
+	// compareVersions(stringProperties["k8s.io/k8s-version"], "1.30") >= 0`. Note that all
+	// numeric property and resource values are floating-point numbers; use floating-point
+	// literals (e.g., `4.0` rather than `4`) in arithmetic and equality checks.
+	//
+	// If the expression cannot be evaluated for a cluster, e.g., it refers to a property the
+	// cluster does not report, the cluster is not selected; use the `in` operator (e.g.,
+	// `"k8s.io/k8s-version" in stringProperties`) to check if a property is present.
+	//
+	// If you specify multiple selectors in the same term, the results are AND'd. The expression
+	// can be used with both `RequiredDuringSchedulingIgnoredDuringExecution` and
+	// `PreferredDuringSchedulingIgnoredDuringExecution` affinity terms.
+	//
+	// This field is beta-level; it is for the property-based scheduling feature and is only
+	// functional when a property provider is enabled in the deployment.
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Optional
+	PropertyExpression string `json:"propertyExpression,omitempty"`
 }
 
 // TopologySpreadConstraint specifies how to spread resources among the given cluster topology.
+// +kubebuilder:validation:XValidation:rule="!has(self.minDomains) || !has(self.whenUnsatisfiable) || self.whenUnsatisfiable == 'DoNotSchedule'",message="minDomains can only be set when whenUnsatisfiable is DoNotSchedule"
 type TopologySpreadConstraint struct {
 	// MaxSkew describes the degree to which resources may be unevenly distributed.
 	// When `whenUnsatisfiable=DoNotSchedule`, it is the maximum permitted difference
@@ -486,6 +839,46 @@ type TopologySpreadConstraint struct {
 	// It's an optional field.
 	// +kubebuilder:validation:Optional
 	WhenUnsatisfiable UnsatisfiableConstraintAction `json:"whenUnsatisfiable,omitempty"`
+
+	// MinDomains indicates the minimum number of eligible domains (per TopologyKey).
+	// When the number of eligible domains with matching topology keys is less than MinDomains,
+	// the global minimum is treated as 0 when calculating the skew, i.e., the scheduler will
+	// not place more than MaxSkew resource copies in any domain until enough domains are in use.
+	// For example, with a MaxSkew of 1 and a MinDomains of 3, resources are placed in at most
+	// one cluster per domain until clusters from at least 3 domains are in use.
+	// It can only be set when WhenUnsatisfiable is DoNotSchedule.
+	// It's an optional field; the scheduler considers it to be 1 if it is not set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Optional
+	MinDomains *int32 `json:"minDomains,omitempty"`
+
+	// MatchLabelKeys is a set of cluster label keys which further narrow down the clusters
+	// that are spread against each other: a cluster is only counted towards the spread together
+	// with the clusters that have the same values for all these keys. A cluster that does not
+	// have all these keys is not part of the spread.
+	// For example, with a TopologyKey of zone and a MatchLabelKeys of [environment], resources
+	// are spread across the zones of production clusters and across the zones of staging
+	// clusters separately.
+	// The keys cannot overlap with TopologyKey or NestedTopologyKeys.
+	// It's an optional field.
+	// +kubebuilder:validation:MaxItems=8
+	// +listType=set
+	// +kubebuilder:validation:Optional
+	MatchLabelKeys []string `json:"matchLabelKeys,omitempty"`
+
+	// NestedTopologyKeys are the keys of cluster labels which describe the topology levels
+	// nested under TopologyKey, from the outermost to the innermost. Resources are spread across
+	// the domains of TopologyKey first; then, within each domain of a level, across the domains
+	// of the next level. For example, with a TopologyKey of region and a NestedTopologyKeys of
+	// [zone], resources are spread across regions, and across the zones of each region.
+	// MaxSkew and WhenUnsatisfiable apply to every level; MinDomains applies to TopologyKey only.
+	// A cluster is part of the spread at a nested level only if it has the labels of all the
+	// enclosing levels.
+	// It's an optional field.
+	// +kubebuilder:validation:MaxItems=4
+	// +listType=set
+	// +kubebuilder:validation:Optional
+	NestedTopologyKeys []string `json:"nestedTopologyKeys,omitempty"`
 }
 
 // UnsatisfiableConstraintAction defines the type of actions that can be taken if a constraint is not satisfied.
@@ -661,19 +1054,33 @@ type ApplyStrategy struct {
 	//
 	//   Use ComparisonOption setting to control how the difference is calculated.
 	//
+	// * DryRun: Fleet will submit each manifest to the member cluster API server as a server-side
+	//   apply in dry-run mode, so that it runs through schema validation, admission control
+	//   (including webhooks) and quota checks without being persisted. Manifests that the API
+	//   server rejects are reported as apply failures; no resources will be created, updated, or
+	//   deleted on the member clusters.
+	//
+	//   Manifests are submitted in the same order as they would be applied. Field manager
+	//   conflicts are reported as failures, unless ServerSideApplyConfig asks to force them.
+	//   Manifests whose parents are placed along with them and have been accepted (namespaced
+	//   resources whose namespace, or custom resources whose definition, is in the same Work) cannot
+	//   be fully validated before the parents are created; they are reported as such instead of
+	//   as failures.
+	//
 	// ClientSideApply and ServerSideApply apply strategies only work when Fleet can assume
 	// ownership of a resource (e.g., the resource is created by Fleet, or Fleet has taken over
 	// the resource). See the comments on the WhenToTakeOver field for more information.
 	// ReportDiff apply strategy, however, will function regardless of Fleet's ownership
 	// status. One may set up a CRP with the ReportDiff strategy and the Never takeover option,
 	// and this will turn Fleet into a detection tool that reports only configuration differences
-	// but do not touch any resources on the member cluster side.
+	// but do not touch any resources on the member cluster side. The same is true for the
+	// DryRun apply strategy.
 	//
 	// For a comparison between the different strategies and usage examples, refer to the
 	// Fleet documentation.
 	//
 	// +kubebuilder:default=ClientSideApply
-	// +kubebuilder:validation:Enum=ClientSideApply;ServerSideApply;ReportDiff
+	// +kubebuilder:validation:Enum=ClientSideApply;ServerSideApply;ReportDiff;DryRun
 	// +kubebuilder:validation:Optional
 	Type ApplyStrategyType `json:"type,omitempty"`
 
@@ -687,7 +1094,8 @@ type ApplyStrategy struct {
 	// with the AllowCoOwnership setting set to true.
 	AllowCoOwnership bool `json:"allowCoOwnership,omitempty"`
 
-	// ServerSideApplyConfig defines the configuration for server side apply. It is honored only when type is ServerSideApply.
+	// ServerSideApplyConfig defines the configuration for server side apply. It is honored only when type is ServerSideApply
+	// or DryRun.
 	// +kubebuilder:validation:Optional
 	ServerSideApplyConfig *ServerSideApplyConfig `json:"serverSideApplyConfig,omitempty"`
 
@@ -754,6 +1162,65 @@ type ApplyStrategy struct {
 	// +kubebuilder:validation:Enum=Always;IfNoDiff;Never
 	// +kubebuilder:validation:Optional
 	WhenToTakeOver WhenToTakeOverType `json:"whenToTakeOver,omitempty"`
+
+	// IgnoreDifferences is a list of rules that specify fields which Fleet should skip when
+	// detecting configuration drifts and calculating configuration differences.
+	//
+	// This is most useful for fields that are legitimately modified by other agents in the
+	// member clusters, such as the replica count of a Deployment scaled by a HorizontalPodAutoscaler,
+	// or fields injected by mutating admission webhooks and service meshes.
+	//
+	// Fields ignored this way will not be reported as drifts or diffs, and will not block apply ops
+	// or takeovers. Note, however, that the apply op itself still writes all the fields specified
+	// in the hub cluster manifest; to avoid overwriting a field that is managed by another agent,
+	// leave it out of the hub cluster manifest, and ignore it here if the FullComparison option
+	// is in use.
+	//
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	IgnoreDifferences []IgnoreDifference `json:"ignoreDifferences,omitempty"`
+}
+
+// IgnoreDifference specifies a group of fields on a group of resources that Fleet should skip
+// when detecting configuration drifts and calculating configuration differences.
+//
+// A resource is subject to a rule if its group, kind, (optionally) version, and name match those
+// in the rule; the fields to ignore are those at the given JSON pointers, plus those owned by the
+// given field managers (as tracked in the managed fields of the resource in the member cluster).
+// +kubebuilder:validation:XValidation:rule="(has(self.jsonPointers) && size(self.jsonPointers) > 0) || (has(self.managedFieldsManagers) && size(self.managedFieldsManagers) > 0)",message="at least one JSON pointer or managed fields manager must be specified"
+type IgnoreDifference struct {
+	// Group is the API group of the resources. Use an empty string for the core API group.
+	// +kubebuilder:validation:Optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the resources. If not specified, resources of all versions
+	// match.
+	// +kubebuilder:validation:Optional
+	Version string `json:"version,omitempty"`
+
+	// Kind is the kind of the resources.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Name is a pattern that the names of the resources should match, in the form of a
+	// shell file name pattern (e.g., `web-*`). If not specified, resources of all names match.
+	// +kubebuilder:validation:Optional
+	Name string `json:"name,omitempty"`
+
+	// JSONPointers is a list of JSON pointers (RFC 6901), such as `/spec/replicas`, to the
+	// fields to ignore. Differences found in a field, or in any of its sub-fields, are skipped.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=atomic
+	JSONPointers []string `json:"jsonPointers,omitempty"`
+
+	// ManagedFieldsManagers is a list of field manager names, such as `kube-controller-manager`.
+	// Differences found in fields owned by any of these managers in the member cluster are skipped.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=atomic
+	ManagedFieldsManagers []string `json:"managedFieldsManagers,omitempty"`
 }
 
 // ComparisonOptionType describes the compare option that Fleet uses to detect drifts and/or
@@ -807,6 +1274,11 @@ const (
 	// resource as kept in the hub cluster and its current state (if applicable) on the member
 	// cluster side. No actual apply ops would be executed.
 	ApplyStrategyTypeReportDiff ApplyStrategyType = "ReportDiff"
+
+	// ApplyStrategyTypeDryRun will submit the resources to the member cluster API server as
+	// server-side apply requests in dry-run mode, and report any rejections. No actual changes
+	// would be persisted.
+	ApplyStrategyTypeDryRun ApplyStrategyType = "DryRun"
 )
 
 // ServerSideApplyConfig defines the configuration for server side apply.
@@ -1219,10 +1691,19 @@ type Toleration struct {
 	Value string `json:"value,omitempty"`
 
 	// Effect indicates the taint effect to match. Empty means match all taint effects.
-	// When specified, only allowed value is NoSchedule.
-	// +kubebuilder:validation:Enum=NoSchedule
+	// When specified, allowed values are NoSchedule and NoExecute.
+	// +kubebuilder:validation:Enum=NoSchedule;NoExecute
 	// +kubebuilder:validation:Optional
 	Effect corev1.TaintEffect `json:"effect,omitempty"`
+
+	// TolerationSeconds is the period of time the toleration (which must be of effect NoExecute,
+	// otherwise this field is ignored) tolerates the taint. By default, it is not set, which means
+	// the taint is tolerated forever (the placement is never evicted). Zero and negative values
+	// are treated as 0 (evict immediately).
+	//
+	// The period starts when the taint is added to the cluster.
+	// +kubebuilder:validation:Optional
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
 }
 
 // ClusterResourcePlacementConditionType defines a specific condition of a cluster resource placement object.
@@ -1630,6 +2111,8 @@ type ResourcePlacement struct {
 	// The desired state of ResourcePlacement.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:XValidation:rule="!(has(oldSelf.policy) && !has(self.policy))",message="policy cannot be removed once set"
+	// +kubebuilder:validation:XValidation:rule="(has(self.schedulerName) ? self.schedulerName : '') == (has(oldSelf.schedulerName) ? oldSelf.schedulerName : '')",message="schedulerName is immutable"
+	// +kubebuilder:validation:XValidation:rule="!has(self.schedulingGates) || (has(oldSelf.schedulingGates) && self.schedulingGates.all(g, oldSelf.schedulingGates.exists(o, o.name == g.name)))",message="schedulingGates can only be removed after creation"
 	Spec PlacementSpec `json:"spec"`
 
 	// The observed status of ResourcePlacement.
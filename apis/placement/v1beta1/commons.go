@@ -55,6 +55,8 @@ const (
 	ApprovalRequestKind = "ApprovalRequest"
 	// ClusterResourcePlacementEvictionKind is the kind of the ClusterResourcePlacementEviction.
 	ClusterResourcePlacementEvictionKind = "ClusterResourcePlacementEviction"
+	// ResourcePlacementEvictionKind is the kind of the ResourcePlacementEviction.
+	ResourcePlacementEvictionKind = "ResourcePlacementEviction"
 	// ClusterResourcePlacementDisruptionBudgetKind is the kind of the ClusterResourcePlacementDisruptionBudget.
 	ClusterResourcePlacementDisruptionBudgetKind = "ClusterResourcePlacementDisruptionBudget"
 	// ResourceEnvelopeKind is the kind of the ResourceEnvelope.
@@ -63,6 +65,21 @@ const (
 	ClusterResourceEnvelopeKind = "ClusterResourceEnvelope"
 	// ClusterResourcePlacementStatusKind is the kind of the ClusterResourcePlacementStatus.
 	ClusterResourcePlacementStatusKind = "ClusterResourcePlacementStatus"
+	// PlacementSimulationKind is the kind of the PlacementSimulation.
+	PlacementSimulationKind = "PlacementSimulation"
+	// PlacementPriorityClassKind is the kind of the PlacementPriorityClass.
+	PlacementPriorityClassKind = "PlacementPriorityClass"
+	// PlacementGroupKind is the kind of the PlacementGroup.
+	PlacementGroupKind = "PlacementGroup"
+	// ClusterSchedulingExplanationKind is the kind of the ClusterSchedulingExplanation.
+	ClusterSchedulingExplanationKind = "ClusterSchedulingExplanation"
+	// SchedulingExplanationKind is the kind of the SchedulingExplanation.
+	SchedulingExplanationKind = "SchedulingExplanation"
+	// FleetResourceQuotaKind is the kind of the FleetResourceQuota.
+	FleetResourceQuotaKind = "FleetResourceQuota"
+
+	// ResourceHealthCheckKind is the kind of the ResourceHealthCheck.
+	ResourceHealthCheckKind = "ResourceHealthCheck"
 )
 
 const (
@@ -118,6 +135,12 @@ const (
 	// ParentResourceOverrideSnapshotHashAnnotation is the annotation to work that contains the hash of the parent resource override snapshot list.
 	ParentResourceOverrideSnapshotHashAnnotation = FleetPrefix + "parent-resource-override-snapshot-hash"
 
+	// ParentBindingReplicasAnnotation is the annotation to work that contains the share of replicas recorded on the parent binding.
+	ParentBindingReplicasAnnotation = FleetPrefix + "parent-binding-replicas"
+
+	// ParentBindingReplicaTargetAnnotation is the annotation to work that identifies the replica target recorded on the parent binding.
+	ParentBindingReplicaTargetAnnotation = FleetPrefix + "parent-binding-replica-target"
+
 	// ParentResourceSnapshotNameAnnotation is the annotation applied to work that contains the name of the master resource snapshot that generates the work.
 	ParentResourceSnapshotNameAnnotation = FleetPrefix + "parent-resource-snapshot-name"
 
@@ -151,6 +174,35 @@ const (
 	// This is used to remember if an "unscheduled" binding was moved from a "bound" state or a "scheduled" state.
 	PreviousBindingStateAnnotation = FleetPrefix + "previous-binding-state"
 
+	// DeschedulingAnnotation opts a ClusterResourcePlacement of the PickN placement type in for descheduling
+	// when set to "true", i.e., the descheduler may evict its resources from clusters that are no longer the
+	// best fit, so that the scheduler can pick better clusters instead.
+	//
+	// Note that this annotation is an experimental, unversioned opt-in with the following limitations:
+	//   - it is only honored on ClusterResourcePlacements; ResourcePlacements ignore it and are never
+	//     descheduled;
+	//   - its value is not validated; any value other than "true" (case-sensitive) leaves descheduling off;
+	//   - it may be replaced by a typed field in the placement policy in a future API version.
+	DeschedulingAnnotation = FleetPrefix + "descheduling"
+
+	// DeschedulerPlacementLabel is the label applied to evictions created by the descheduler; its value is
+	// the name of the placement the eviction targets.
+	DeschedulerPlacementLabel = FleetPrefix + "descheduler-placement"
+
+	// TaintEvictionClusterLabel is the label applied to evictions created to move placements off a cluster
+	// with NoExecute taints; its value is the name of the cluster.
+	TaintEvictionClusterLabel = FleetPrefix + "taint-eviction-cluster"
+
+	// PreemptorPlacementLabel is the label applied to evictions created by the scheduler to preempt
+	// lower-priority placements; its value is the name of the placement on whose behalf the preemption
+	// is performed.
+	PreemptorPlacementLabel = FleetPrefix + "preemptor-placement"
+
+	// SchedulingQueueWeightAnnotation is the annotation on a namespace which sets the weight of the namespace
+	// in the fair scheduling queue, i.e., how many of its placements the scheduler may pick up in one round
+	// before the other namespaces take their turns; it must be an integer between 1 and 100, and defaults to 1.
+	SchedulingQueueWeightAnnotation = FleetPrefix + "scheduling-queue-weight"
+
 	// UpdateRunFinalizer is used by the UpdateRun controller to make sure that the UpdateRun
 	// object is not deleted until all its dependent resources are deleted.
 	UpdateRunFinalizer = FleetPrefix + "stagedupdaterun-finalizer"
@@ -19,8 +19,19 @@ package v1beta1
 import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubefleet-dev/kubefleet/apis"
 )
 
+// A PlacementEvictionObj offers an abstract way to work with fleet placement eviction objects.
+// +kubebuilder:object:generate=false
+type PlacementEvictionObj interface {
+	apis.ConditionedObj
+
+	// GetPlacementEvictionSpec returns the spec of the eviction.
+	GetPlacementEvictionSpec() *PlacementEvictionSpec
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=crpe
 // +kubebuilder:subresource:status
@@ -148,8 +159,75 @@ func (e *ClusterResourcePlacementEviction) GetCondition(conditionType string) *m
 	return meta.FindStatusCondition(e.Status.Conditions, conditionType)
 }
 
+// GetPlacementEvictionSpec returns the spec of the ClusterResourcePlacementEviction.
+func (e *ClusterResourcePlacementEviction) GetPlacementEvictionSpec() *PlacementEvictionSpec {
+	return &e.Spec
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,categories={fleet,fleet-placement},shortName=rpe
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Valid")].status`,name="Valid",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Executed")].status`,name="Executed",type=string
+
+// ResourcePlacementEviction is an eviction attempt on a specific placement from a
+// ResourcePlacement object in the same namespace; one may use this API to force the removal of
+// specific resources from a cluster.
+//
+// ResourcePlacementEvictions work in the same way as ClusterResourcePlacementEvictions, except that
+// there is no disruption budget for ResourcePlacements; a valid eviction is executed as soon as the
+// resources have been placed on the target cluster.
+//
+// Note: Eviction of resources from a cluster propagated by a PickFixed ResourcePlacement is not
+// allowed; remove the cluster name from the cluster names field of the ResourcePlacement spec instead.
+type ResourcePlacementEviction struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the ResourcePlacementEviction.
+	//
+	// Note that all fields in the spec are immutable.
+	// +required
+	Spec PlacementEvictionSpec `json:"spec"`
+
+	// Status is the observed state of the ResourcePlacementEviction.
+	// +optional
+	Status PlacementEvictionStatus `json:"status,omitempty"`
+}
+
+// ResourcePlacementEvictionList contains a list of ResourcePlacementEviction objects.
+// +kubebuilder:resource:scope=Namespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourcePlacementEvictionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ResourcePlacementEviction objects.
+	Items []ResourcePlacementEviction `json:"items"`
+}
+
+// SetConditions set the given conditions on the ResourcePlacementEviction.
+func (e *ResourcePlacementEviction) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&e.Status.Conditions, c)
+	}
+}
+
+// GetCondition returns the condition of the given ResourcePlacementEviction.
+func (e *ResourcePlacementEviction) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(e.Status.Conditions, conditionType)
+}
+
+// GetPlacementEvictionSpec returns the spec of the ResourcePlacementEviction.
+func (e *ResourcePlacementEviction) GetPlacementEvictionSpec() *PlacementEvictionSpec {
+	return &e.Spec
+}
+
 func init() {
 	SchemeBuilder.Register(
 		&ClusterResourcePlacementEviction{},
-		&ClusterResourcePlacementEvictionList{})
+		&ClusterResourcePlacementEvictionList{},
+		&ResourcePlacementEviction{},
+		&ResourcePlacementEvictionList{})
 }
@@ -0,0 +1,100 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Namespaced",shortName=frq,categories={fleet,fleet-placement}
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.maxPlacements`,name="Max-Placements",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.spec.maxClustersPerPlacement`,name="Max-Clusters-Per-Placement",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.spec.maxBindings`,name="Max-Bindings",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FleetResourceQuota limits how the ResourcePlacements in its namespace can place resources
+// across the fleet, i.e., how many ResourcePlacements the namespace can have, how many clusters
+// each ResourcePlacement can select, how many bindings the namespace can have in total, and which
+// clusters the ResourcePlacements can target.
+//
+// If a namespace has multiple FleetResourceQuota objects, all of them apply; in other words, the
+// most restrictive limit wins.
+//
+// The limits are enforced when ResourcePlacements are created or updated, and by the scheduler
+// when it picks clusters for the ResourcePlacements. Lowering a limit does not remove existing
+// placements or bindings. If the allowed cluster selector of a quota is invalid (which the
+// webhook rejects), the quota allows no cluster, and the scheduler reports the quota as the reason
+// why clusters are filtered out.
+type FleetResourceQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of FleetResourceQuota.
+	// +kubebuilder:validation:Required
+	Spec FleetResourceQuotaSpec `json:"spec"`
+}
+
+// FleetResourceQuotaSpec defines the limits of a FleetResourceQuota.
+type FleetResourceQuotaSpec struct {
+	// MaxPlacements is the maximum number of ResourcePlacements in the namespace.
+	// If not specified, the number is not limited.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	MaxPlacements *int32 `json:"maxPlacements,omitempty"`
+
+	// MaxClustersPerPlacement is the maximum number of clusters each ResourcePlacement in the
+	// namespace can select.
+	// If not specified, the number is not limited.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	MaxClustersPerPlacement *int32 `json:"maxClustersPerPlacement,omitempty"`
+
+	// MaxBindings is the maximum number of scheduled or bound bindings, across all the
+	// ResourcePlacements in the namespace.
+	// If not specified, the number is not limited.
+	//
+	// The limit is enforced by the scheduler on a best-effort basis: as the scheduler might pick
+	// clusters for multiple ResourcePlacements in the namespace at the same time, the namespace can
+	// briefly have a few more bindings than the limit allows.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	MaxBindings *int32 `json:"maxBindings,omitempty"`
+
+	// AllowedClusterSelector selects the member clusters that the ResourcePlacements in the
+	// namespace can target, by cluster labels.
+	// If not specified, all member clusters can be targeted.
+	// +kubebuilder:validation:Optional
+	AllowedClusterSelector *metav1.LabelSelector `json:"allowedClusterSelector,omitempty"`
+}
+
+// FleetResourceQuotaList contains a list of FleetResourceQuota.
+// +kubebuilder:resource:scope="Namespaced"
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FleetResourceQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FleetResourceQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FleetResourceQuota{}, &FleetResourceQuotaList{})
+}
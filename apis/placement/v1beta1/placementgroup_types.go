@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=pg
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Scheduled")].status`,name="Scheduled",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// PlacementGroup groups a number of placements (ClusterResourcePlacements and/or ResourcePlacements)
+// together, so that the Fleet scheduler treats them as one unit (i.e., gang placement): all the
+// members are placed onto a common set of clusters, or none of them is placed at all.
+//
+// The scheduler makes one decision for the group as a whole, in which it:
+//
+//   - checks every cluster against the scheduling policies of all the members, and keeps only the
+//     clusters that satisfy all of them; and
+//   - picks one set of clusters from the common candidates for all the members, with clusters that
+//     the members have already been placed onto preferred, and the scores all the members give to
+//     a cluster summed up.
+//
+// The decision is committed in the status of the group, along with the scheduling policy snapshot
+// of each member it has been made for. When scheduling a member of the group, the scheduler creates
+// bindings for the member only from the committed decision, and only if the group can be satisfied
+// as a whole, i.e., every member is ready for scheduling and enough common clusters can be found;
+// if the decision has not been made yet for the current scheduling policy of the member, the member
+// waits.
+//
+// All the members must use the same placement type; for the PickN placement type, all the members
+// must ask for the same number of clusters. The PickFixed placement type is not supported. A
+// placement may belong to at most one PlacementGroup.
+//
+// Note that if a group that has been satisfied can no longer be satisfied (e.g., a picked cluster
+// has left the fleet), the scheduler leaves the existing bindings of the members as they are.
+type PlacementGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the PlacementGroup.
+	// +required
+	Spec PlacementGroupSpec `json:"spec"`
+
+	// Status is the observed state of the PlacementGroup.
+	// +optional
+	Status PlacementGroupStatus `json:"status,omitempty"`
+}
+
+// PlacementGroupSpec is the desired state of a PlacementGroup.
+type PlacementGroupSpec struct {
+	// Members are the placements that belong to the group.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:XValidation:rule="self.all(x, self.exists_one(y, x.name == y.name && (has(x.__namespace__) ? x.__namespace__ : '') == (has(y.__namespace__) ? y.__namespace__ : '')))",message="members must be unique"
+	// +required
+	Members []PlacementGroupMember `json:"members"`
+}
+
+// PlacementGroupMember refers to a placement that belongs to a PlacementGroup.
+type PlacementGroupMember struct {
+	// Name is the name of the placement.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the placement; it refers to a ResourcePlacement if set, or a
+	// ClusterResourcePlacement otherwise.
+	// +kubebuilder:validation:MaxLength=63
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// PlacementGroupStatus is the observed state of a PlacementGroup.
+type PlacementGroupStatus struct {
+	// SelectedClusters are the names of the clusters the scheduler has picked for all the members
+	// of the group; it is empty if the group cannot be satisfied.
+	// +listType=set
+	// +optional
+	SelectedClusters []string `json:"selectedClusters,omitempty"`
+
+	// Members are the members of the group, along with the scheduling policy snapshots the
+	// scheduler has picked the selected clusters for; it is empty if the group cannot be satisfied.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Members []PlacementGroupMemberStatus `json:"members,omitempty"`
+
+	// Conditions is an array of current observed conditions for the PlacementGroup.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// PlacementGroupMemberStatus is the observed state of a member of a PlacementGroup.
+type PlacementGroupMemberStatus struct {
+	// PlacementGroupMember refers to the member placement.
+	PlacementGroupMember `json:",inline"`
+
+	// PolicySnapshotName is the name of the scheduling policy snapshot of the member that the
+	// scheduler has picked the selected clusters for.
+	// +required
+	PolicySnapshotName string `json:"policySnapshotName"`
+}
+
+// PlacementGroupConditionType identifies a specific condition of the PlacementGroup.
+type PlacementGroupConditionType string
+
+const (
+	// PlacementGroupConditionTypeScheduled indicates whether a common set of clusters has been
+	// picked for all the members of the group.
+	//
+	// The following values are possible:
+	// * True: a common set of clusters has been picked; they are listed in the status.
+	// * False: the group cannot be satisfied, e.g., a member is not ready for scheduling, or
+	//   not enough clusters can satisfy the scheduling policies of all the members.
+	PlacementGroupConditionTypeScheduled PlacementGroupConditionType = "Scheduled"
+)
+
+// PlacementGroupList contains a list of PlacementGroup objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PlacementGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of PlacementGroup objects.
+	Items []PlacementGroup `json:"items"`
+}
+
+// SetConditions set the given conditions on the PlacementGroup.
+func (g *PlacementGroup) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&g.Status.Conditions, c)
+	}
+}
+
+// GetCondition returns the condition of the given PlacementGroup.
+func (g *PlacementGroup) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(g.Status.Conditions, conditionType)
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&PlacementGroup{},
+		&PlacementGroupList{})
+}
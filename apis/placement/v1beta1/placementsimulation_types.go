@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=psim
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.placementName`,name="Placement",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Completed")].status`,name="Completed",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// PlacementSimulation is a what-if request to the Fleet scheduler; one may use this API to
+// find out which clusters a scheduling policy would pick (and why) before actually applying
+// the policy to a ClusterResourcePlacement, or before changing the labels, taints, or
+// properties of member clusters.
+//
+// The scheduler runs a simulated scheduling cycle with the same plugins and scheduler extenders
+// it uses for actual scheduling, and reports the outcome in the status of the object. The
+// simulation never creates, updates, or deletes any binding, nor does it modify any member
+// cluster; the hypothetical cluster changes are applied to in-memory copies only.
+//
+// The simulation runs once per generation of the object; to re-run a simulation (e.g., after
+// the fleet has changed), update its spec or re-create the object.
+type PlacementSimulation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the PlacementSimulation.
+	// +required
+	Spec PlacementSimulationSpec `json:"spec"`
+
+	// Status is the observed state of the PlacementSimulation.
+	// +optional
+	Status PlacementSimulationStatus `json:"status,omitempty"`
+}
+
+// PlacementSimulationSpec is the desired state of a PlacementSimulation.
+// +kubebuilder:validation:XValidation:rule="has(self.placementName) || has(self.policy)",message="at least one of placementName and policy must be set"
+type PlacementSimulationSpec struct {
+	// PlacementName is the name of an existing ClusterResourcePlacement object.
+	//
+	// If set, the existing bindings of the placement are taken into account, as they would be
+	// if the proposed policy were to replace the current one; and, if no policy is specified in
+	// the simulation, the current policy of the placement is simulated.
+	// +kubebuilder:validation:MaxLength=255
+	// +optional
+	PlacementName string `json:"placementName,omitempty"`
+
+	// Policy is the proposed scheduling policy to simulate.
+	// +optional
+	Policy *PlacementPolicy `json:"policy,omitempty"`
+
+	// ClusterChanges is a list of hypothetical changes to member clusters in the fleet, which
+	// apply in the simulation only.
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=map
+	// +listMapKey=clusterName
+	// +optional
+	ClusterChanges []SimulatedClusterChange `json:"clusterChanges,omitempty"`
+}
+
+// SimulatedClusterChange is a hypothetical change to a member cluster.
+type SimulatedClusterChange struct {
+	// ClusterName is the name of the member cluster to change; the cluster must exist.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=255
+	ClusterName string `json:"clusterName"`
+
+	// Labels are labels to add to the cluster; existing labels with the same keys are overwritten.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints are taints to add to the cluster.
+	// +kubebuilder:validation:MaxItems=100
+	// +optional
+	Taints []clusterv1beta1.Taint `json:"taints,omitempty"`
+
+	// Properties are non-resource properties to report for the cluster; existing properties with
+	// the same names are overwritten.
+	// +optional
+	Properties map[clusterv1beta1.PropertyName]string `json:"properties,omitempty"`
+}
+
+// PlacementSimulationStatus is the observed state of a PlacementSimulation.
+type PlacementSimulationStatus struct {
+	// ObservedGeneration is the generation of the PlacementSimulation object which the status
+	// reflects.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions is the list of currently observed conditions for the PlacementSimulation object.
+	//
+	// Available condition types include:
+	// * Completed: whether the simulation has been completed.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Decisions is the list of simulated scheduling decisions; selected clusters are listed
+	// first, followed by the clusters that are scored but not picked, and the clusters that
+	// have been filtered out.
+	// +kubebuilder:validation:MaxItems=1000
+	// +optional
+	Decisions []SimulatedClusterDecision `json:"decisions,omitempty"`
+}
+
+// SimulatedClusterDecision is a simulated scheduling decision for a cluster.
+type SimulatedClusterDecision struct {
+	// ClusterName is the name of the cluster.
+	// +required
+	ClusterName string `json:"clusterName"`
+
+	// Selected is true if the cluster would have been picked.
+	// +required
+	Selected bool `json:"selected"`
+
+	// ClusterScore is the total score the cluster receives; it is absent if the cluster has not
+	// been scored.
+	// +optional
+	ClusterScore *ClusterScore `json:"clusterScore,omitempty"`
+
+	// PluginScores are the weighted scores given by each score plugin of the scheduler, and the
+	// scores given by each scheduler extender (which are added to the affinity score and the
+	// weighted score).
+	// +optional
+	PluginScores []PluginScore `json:"pluginScores,omitempty"`
+
+	// Reason explains why the cluster is or is not picked, e.g., the reasons reported by filter
+	// plugins.
+	// +required
+	Reason string `json:"reason"`
+}
+
+// PluginScore is the score a scheduler plugin or scheduler extender gives to a cluster.
+type PluginScore struct {
+	// Name is the name of the scheduler plugin or scheduler extender.
+	// +required
+	Name string `json:"name"`
+
+	// ClusterScore is the score given.
+	// +required
+	ClusterScore ClusterScore `json:"clusterScore"`
+}
+
+// PlacementSimulationConditionType identifies a specific condition of the PlacementSimulation.
+type PlacementSimulationConditionType string
+
+const (
+	// PlacementSimulationConditionTypeCompleted indicates whether the simulation has been completed.
+	//
+	// The following values are possible:
+	// * True: the simulation has been completed; the decisions in the status are the outcome.
+	// * False: the simulation cannot be completed, e.g., the simulation is invalid or it targets a
+	//   placement that does not exist.
+	PlacementSimulationConditionTypeCompleted PlacementSimulationConditionType = "Completed"
+)
+
+// PlacementSimulationList contains a list of PlacementSimulation objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PlacementSimulationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of PlacementSimulation objects.
+	Items []PlacementSimulation `json:"items"`
+}
+
+// SetConditions set the given conditions on the PlacementSimulation.
+func (s *PlacementSimulation) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&s.Status.Conditions, c)
+	}
+}
+
+// GetCondition returns the condition of the given PlacementSimulation.
+func (s *PlacementSimulation) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(s.Status.Conditions, conditionType)
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&PlacementSimulation{},
+		&PlacementSimulationList{})
+}
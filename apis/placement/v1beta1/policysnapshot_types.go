@@ -204,6 +204,12 @@ type ClusterDecision struct {
 	// +optional
 	ClusterScore *ClusterScore `json:"clusterScore"`
 
+	// PluginScores are the scores given by each score plugin of the scheduler, and the scores
+	// given by each scheduler extender, which sum up to the cluster score; they explain why the
+	// cluster is or is not selected over other clusters.
+	// +optional
+	PluginScores []PluginScore `json:"pluginScores,omitempty"`
+
 	// Reason represents the reason why the cluster is selected or not.
 	// +required
 	Reason string `json:"reason"`
@@ -222,6 +228,13 @@ type ClusterScore struct {
 	// A priority score may not present if the cluster does not meet the topology spread.
 	// +optional
 	TopologySpreadScore *int32 `json:"priorityScore,omitempty"`
+
+	// WeightedScore is the sum of the scores the score plugins of the scheduler and the scheduler
+	// extenders give to the cluster, each multiplied by the weight of its source. The scores of
+	// score plugins are used as they are, unless the scheduling profile opts in to normalize them.
+	// Clusters of higher weighted scores are preferred; the other scores break the ties.
+	// +optional
+	WeightedScore *int64 `json:"weightedScore,omitempty"`
 }
 
 // ClusterSchedulingPolicySnapshotList contains a list of ClusterSchedulingPolicySnapshot.
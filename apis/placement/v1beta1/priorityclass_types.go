@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=ppc
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.value`,name="Value",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.preemptionPolicy`,name="Preemption-Policy",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// PlacementPriorityClass defines a mapping from a priority class name to a priority value, which
+// the Fleet scheduler uses to order placements when capacity is tight.
+//
+// A ClusterResourcePlacement refers to a priority class by name via its `priorityClassName` field;
+// placements with a higher priority value are scheduled before those with a lower value, and,
+// if preemption is enabled in the scheduler, a placement of the PickN placement type that cannot
+// find enough clusters may evict the resources of lower-priority placements from clusters to make
+// room for itself. Placements that do not refer to any priority class, or that refer to a priority
+// class that does not exist, have a priority value of zero.
+type PlacementPriorityClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Value is the priority of the placements that refer to this priority class; the higher the
+	// value, the higher the priority.
+	// +kubebuilder:validation:Minimum=-1000000000
+	// +kubebuilder:validation:Maximum=1000000000
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="value is immutable"
+	// +required
+	Value int32 `json:"value"`
+
+	// PreemptionPolicy controls whether placements of this priority class may preempt placements
+	// of a lower priority.
+	//
+	// Available options are:
+	//
+	// * PreemptLowerPriority: a placement of this priority class may evict the resources of
+	//   placements of a lower priority from clusters, if it cannot otherwise find enough clusters
+	//   to run on.
+	//
+	// * Never: a placement of this priority class never preempts other placements; it is still
+	//   scheduled ahead of placements of a lower priority.
+	//
+	// Defaults to PreemptLowerPriority.
+	// +kubebuilder:default=PreemptLowerPriority
+	// +kubebuilder:validation:Enum=PreemptLowerPriority;Never
+	// +optional
+	PreemptionPolicy PreemptionPolicy `json:"preemptionPolicy,omitempty"`
+
+	// Description is an arbitrary string that describes when this priority class should be used.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// PreemptionPolicy describes a policy for if/when to preempt a placement.
+type PreemptionPolicy string
+
+const (
+	// PreemptLowerPriority means that a placement can preempt other placements of a lower priority.
+	PreemptLowerPriority PreemptionPolicy = "PreemptLowerPriority"
+
+	// PreemptNever means that a placement never preempts other placements.
+	PreemptNever PreemptionPolicy = "Never"
+)
+
+// PlacementPriorityClassList contains a list of PlacementPriorityClass objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PlacementPriorityClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of PlacementPriorityClass objects.
+	Items []PlacementPriorityClass `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&PlacementPriorityClass{},
+		&PlacementPriorityClassList{})
+}
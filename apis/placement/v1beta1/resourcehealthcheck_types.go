@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster",shortName=rhc,categories={fleet,fleet-placement}
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.target.group`,name="Group",type=string
+// +kubebuilder:printcolumn:JSONPath=`.spec.target.kind`,name="Kind",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ResourceHealthCheck defines the rules with which the Fleet member agent determines if applied
+// resources of a specific kind are available.
+//
+// Fleet tracks the availability of a few common kinds, such as Deployments and Services, out of the
+// box; resources of other kinds are considered untrackable and reported as available as soon as they
+// are applied. A ResourceHealthCheck lets users teach Fleet how to tell if resources of any kind,
+// e.g., the custom resources of an operator, are available.
+//
+// ResourceHealthChecks are read by the member agent from the member cluster. They can be created on
+// the hub cluster and placed onto member clusters with a ClusterResourcePlacement like any other
+// cluster-scoped resource.
+//
+// A resource is available if it satisfies all the rules of all the ResourceHealthChecks that target
+// its kind. ResourceHealthChecks take precedence over the built-in availability checks.
+type ResourceHealthCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The desired state of ResourceHealthCheck.
+	// +kubebuilder:validation:Required
+	Spec ResourceHealthCheckSpec `json:"spec"`
+}
+
+// ResourceHealthCheckSpec defines the target and the rules of a ResourceHealthCheck.
+type ResourceHealthCheckSpec struct {
+	// Target is the kind of resources that the health check applies to.
+	// +kubebuilder:validation:Required
+	Target ResourceHealthCheckTarget `json:"target"`
+
+	// Rules are the rules a resource must satisfy to be considered available.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Rules []ResourceHealthCheckRule `json:"rules"`
+}
+
+// ResourceHealthCheckTarget identifies a kind of resources.
+type ResourceHealthCheckTarget struct {
+	// Group is the API group of the resources; use an empty string for the core API group.
+	// +kubebuilder:validation:Optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the resources. If not specified, resources of all versions
+	// are targeted.
+	// +kubebuilder:validation:Optional
+	Version string `json:"version,omitempty"`
+
+	// Kind is the kind of the resources.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+}
+
+// ResourceHealthCheckRule is a single rule of a ResourceHealthCheck; exactly one of expression and
+// condition must be specified.
+// +kubebuilder:validation:XValidation:rule="has(self.expression) != has(self.condition)",message="exactly one of expression or condition must be specified"
+type ResourceHealthCheckRule struct {
+	// Expression is a CEL expression that evaluates to true when the resource is available. The
+	// resource is available as the `object` variable, e.g.,
+	// `object.status.readyReplicas == object.spec.replicas`.
+	//
+	// If the expression cannot be evaluated, e.g., it refers to a status field that has not been
+	// populated yet, the resource is considered not yet available.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=2048
+	Expression string `json:"expression,omitempty"`
+
+	// Condition is a condition the resource must report in its `status.conditions` field.
+	// +kubebuilder:validation:Optional
+	Condition *ResourceHealthCheckCondition `json:"condition,omitempty"`
+}
+
+// ResourceHealthCheckCondition is the expectation on a status condition of a resource.
+type ResourceHealthCheckCondition struct {
+	// Type is the type of the condition.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Type string `json:"type"`
+
+	// Status is the expected status of the condition.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status metav1.ConditionStatus `json:"status"`
+}
+
+// ResourceHealthCheckList contains a list of ResourceHealthCheck.
+// +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ResourceHealthCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceHealthCheck `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourceHealthCheck{}, &ResourceHealthCheckList{})
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// make sure the SchedulingExplanationObj interface is implemented by the
+// ClusterSchedulingExplanation and SchedulingExplanation types.
+var _ SchedulingExplanationObj = &ClusterSchedulingExplanation{}
+var _ SchedulingExplanationObj = &SchedulingExplanation{}
+
+// A SchedulingExplanationStatusGetterSetter offers methods to get and set the scheduling explanation status.
+// +kubebuilder:object:generate=false
+type SchedulingExplanationStatusGetterSetter interface {
+	GetSchedulingExplanationStatus() *SchedulingExplanationStatus
+	SetSchedulingExplanationStatus(SchedulingExplanationStatus)
+}
+
+// A SchedulingExplanationObj offers an abstract way to work with a fleet scheduling explanation object.
+// +kubebuilder:object:generate=false
+type SchedulingExplanationObj interface {
+	client.Object
+	SchedulingExplanationStatusGetterSetter
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Cluster",shortName=cse,categories={fleet,fleet-placement}
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.status.policySnapshotName`,name="Policy-Snapshot",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.lastUpdatedTime`,name="Last-Updated",type=date
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterSchedulingExplanation explains, cluster by cluster, the latest scheduling cycle the
+// scheduler has run for a ClusterResourcePlacement, i.e., which scheduler plugin has filtered out
+// a cluster and why, and the score each scheduler plugin has given to a cluster.
+//
+// Unlike the decisions in the status of a policy snapshot, which are capped in number, every
+// cluster the scheduler has considered is explained (up to the API limit).
+//
+// The scheduler manages ClusterSchedulingExplanation objects; each object has the same name as
+// its ClusterResourcePlacement and is owned by it.
+type ClusterSchedulingExplanation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The observed status of ClusterSchedulingExplanation.
+	// +optional
+	Status SchedulingExplanationStatus `json:"status,omitempty"`
+}
+
+// GetSchedulingExplanationStatus returns the scheduling explanation status.
+func (m *ClusterSchedulingExplanation) GetSchedulingExplanationStatus() *SchedulingExplanationStatus {
+	return &m.Status
+}
+
+// SetSchedulingExplanationStatus sets the scheduling explanation status.
+func (m *ClusterSchedulingExplanation) SetSchedulingExplanationStatus(status SchedulingExplanationStatus) {
+	status.DeepCopyInto(&m.Status)
+}
+
+// SchedulingExplanationStatus is the observed state of a scheduling explanation.
+type SchedulingExplanationStatus struct {
+	// PolicySnapshotName is the name of the scheduling policy snapshot the scheduling cycle has
+	// run for.
+	// +required
+	PolicySnapshotName string `json:"policySnapshotName"`
+
+	// ObservedCRPGeneration is the generation of the resource placement which the scheduler uses to
+	// perform the scheduling cycle.
+	// +required
+	ObservedCRPGeneration int64 `json:"observedCRPGeneration"`
+
+	// LastUpdatedTime is the last time the explanation has changed.
+	// +optional
+	LastUpdatedTime *metav1.Time `json:"lastUpdatedTime,omitempty"`
+
+	// Clusters explains the scheduling outcome for each cluster the scheduler has considered;
+	// selected clusters are listed first, followed by the clusters that are scored but not picked,
+	// and the clusters that have been filtered out.
+	// +kubebuilder:validation:MaxItems=1000
+	// +optional
+	Clusters []ClusterSchedulingExplanationEntry `json:"clusters,omitempty"`
+}
+
+// ClusterSchedulingExplanationEntry explains the scheduling outcome for a single cluster.
+type ClusterSchedulingExplanationEntry struct {
+	// ClusterName is the name of the cluster.
+	// +required
+	ClusterName string `json:"clusterName"`
+
+	// Selected is true if the cluster has been selected.
+	// +required
+	Selected bool `json:"selected"`
+
+	// FilteredBy is the name of the scheduler plugin or scheduler extender which has filtered out
+	// the cluster; it is empty if the cluster has not been filtered out.
+	// +optional
+	FilteredBy string `json:"filteredBy,omitempty"`
+
+	// ClusterScore is the total score the cluster receives; it is absent if the cluster has not
+	// been scored.
+	// +optional
+	ClusterScore *ClusterScore `json:"clusterScore,omitempty"`
+
+	// PluginScores are the weighted scores given by each score plugin of the scheduler, and the
+	// scores given by each scheduler extender.
+	// +optional
+	PluginScores []PluginScore `json:"pluginScores,omitempty"`
+
+	// Reason explains why the cluster is or is not selected, e.g., the message the filtering
+	// plugin has reported.
+	// +required
+	Reason string `json:"reason"`
+}
+
+// ClusterSchedulingExplanationList contains a list of ClusterSchedulingExplanation.
+// +kubebuilder:resource:scope="Cluster"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterSchedulingExplanationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSchedulingExplanation `json:"items"`
+}
+
+// +genclient
+// +genclient:Namespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope="Namespaced",shortName=se,categories={fleet,fleet-placement}
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.status.policySnapshotName`,name="Policy-Snapshot",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.lastUpdatedTime`,name="Last-Updated",type=date
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchedulingExplanation explains, cluster by cluster, the latest scheduling cycle the scheduler
+// has run for a ResourcePlacement; see ClusterSchedulingExplanation for more information.
+//
+// The scheduler manages SchedulingExplanation objects; each object has the same namespace and
+// name as its ResourcePlacement and is owned by it.
+type SchedulingExplanation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The observed status of SchedulingExplanation.
+	// +optional
+	Status SchedulingExplanationStatus `json:"status,omitempty"`
+}
+
+// GetSchedulingExplanationStatus returns the scheduling explanation status.
+func (m *SchedulingExplanation) GetSchedulingExplanationStatus() *SchedulingExplanationStatus {
+	return &m.Status
+}
+
+// SetSchedulingExplanationStatus sets the scheduling explanation status.
+func (m *SchedulingExplanation) SetSchedulingExplanationStatus(status SchedulingExplanationStatus) {
+	status.DeepCopyInto(&m.Status)
+}
+
+// SchedulingExplanationList contains a list of SchedulingExplanation.
+// +kubebuilder:resource:scope="Namespaced"
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SchedulingExplanationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SchedulingExplanation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterSchedulingExplanation{}, &ClusterSchedulingExplanationList{}, &SchedulingExplanation{}, &SchedulingExplanationList{})
+}
@@ -45,6 +45,43 @@ const (
 	// LastAppliedConfigAnnotation is to record the last applied configuration on the object.
 	LastAppliedConfigAnnotation = FleetPrefix + "last-applied-configuration"
 
+	// ApplyWaveAnnotation is the annotation that users may add to a selected resource to override
+	// the wave in which the resource is applied on a member cluster. The value must be a
+	// non-negative integer; resources in lower waves are applied before those in higher waves.
+	ApplyWaveAnnotation = FleetPrefix + "apply-wave"
+
+	// DependsOnAnnotation is the annotation that users may add to a selected resource to declare
+	// that it must be applied after other resources. The value is a comma-separated list of
+	// references, each of the format `<Kind>[.<group>]/[<namespace>/]<name>`, e.g.,
+	// `Deployment.apps/default/web` or `Namespace/app`. A resource placed in the same Work must
+	// have been applied first; any other resource must exist and be available on the member cluster.
+	DependsOnAnnotation = FleetPrefix + "depends-on"
+
+	// WaitForAvailableAnnotation is the annotation that users may add to a selected resource
+	// (with the value `true`) to have Fleet apply the resource only after all the resources in
+	// earlier waves and all of its dependencies have become available on the member cluster.
+	WaitForAvailableAnnotation = FleetPrefix + "wait-for-available"
+
+	// HookAnnotation is the annotation that users may add to a selected Job or Pod to run it as a
+	// hook on member clusters. Pre-apply hooks (PreApplyHook) must succeed before the other resources
+	// in the same Work are applied; post-apply hooks (PostApplyHook) run after all the other resources
+	// have become available. Hooks are re-run each time the other resources in the Work, or the hook
+	// itself, change.
+	HookAnnotation = FleetPrefix + "hook"
+
+	// PreApplyHook is the value of the hook annotation for hooks that run before the other resources
+	// are applied.
+	PreApplyHook = "pre-apply"
+
+	// PostApplyHook is the value of the hook annotation for hooks that run after the other resources
+	// have become available.
+	PostApplyHook = "post-apply"
+
+	// HookManifestsHashAnnotation is the annotation that Fleet adds to hook objects on member clusters
+	// to track the hash of the manifests (the other resources in the Work and the hook itself) for
+	// which the hook has run.
+	HookManifestsHashAnnotation = FleetPrefix + "hook-manifests-hash"
+
 	// WorkConditionTypeApplied represents workload in Work is applied successfully on the spoke cluster.
 	WorkConditionTypeApplied = "Applied"
 
@@ -21,6 +21,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -34,6 +36,16 @@ func (in *Affinity) DeepCopyInto(out *Affinity) {
 		*out = new(ClusterAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PlacementAffinity != nil {
+		in, out := &in.PlacementAffinity, &out.PlacementAffinity
+		*out = new(PlacementAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PlacementAntiAffinity != nil {
+		in, out := &in.PlacementAntiAffinity, &out.PlacementAntiAffinity
+		*out = new(PlacementAntiAffinity)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Affinity.
@@ -164,6 +176,13 @@ func (in *ApplyStrategy) DeepCopyInto(out *ApplyStrategy) {
 		*out = new(ServerSideApplyConfig)
 		**out = **in
 	}
+	if in.IgnoreDifferences != nil {
+		in, out := &in.IgnoreDifferences, &out.IgnoreDifferences
+		*out = make([]IgnoreDifference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyStrategy.
@@ -383,6 +402,13 @@ func (in *ClusterDecision) DeepCopyInto(out *ClusterDecision) {
 		*out = new(ClusterScore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PluginScores != nil {
+		in, out := &in.PluginScores, &out.PluginScores
+		*out = make([]PluginScore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDecision.
@@ -981,6 +1007,91 @@ func (in *ClusterResourceSnapshotList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSchedulingExplanation) DeepCopyInto(out *ClusterSchedulingExplanation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSchedulingExplanation.
+func (in *ClusterSchedulingExplanation) DeepCopy() *ClusterSchedulingExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSchedulingExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSchedulingExplanation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSchedulingExplanationEntry) DeepCopyInto(out *ClusterSchedulingExplanationEntry) {
+	*out = *in
+	if in.ClusterScore != nil {
+		in, out := &in.ClusterScore, &out.ClusterScore
+		*out = new(ClusterScore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PluginScores != nil {
+		in, out := &in.PluginScores, &out.PluginScores
+		*out = make([]PluginScore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSchedulingExplanationEntry.
+func (in *ClusterSchedulingExplanationEntry) DeepCopy() *ClusterSchedulingExplanationEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSchedulingExplanationEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSchedulingExplanationList) DeepCopyInto(out *ClusterSchedulingExplanationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterSchedulingExplanation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSchedulingExplanationList.
+func (in *ClusterSchedulingExplanationList) DeepCopy() *ClusterSchedulingExplanationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSchedulingExplanationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterSchedulingExplanationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSchedulingPolicySnapshot) DeepCopyInto(out *ClusterSchedulingPolicySnapshot) {
 	*out = *in
@@ -1053,6 +1164,11 @@ func (in *ClusterScore) DeepCopyInto(out *ClusterScore) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.WeightedScore != nil {
+		in, out := &in.WeightedScore, &out.WeightedScore
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterScore.
@@ -1413,6 +1529,124 @@ func (in *FailedResourcePlacement) DeepCopy() *FailedResourcePlacement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetResourceQuota) DeepCopyInto(out *FleetResourceQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetResourceQuota.
+func (in *FleetResourceQuota) DeepCopy() *FleetResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetResourceQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetResourceQuotaList) DeepCopyInto(out *FleetResourceQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FleetResourceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetResourceQuotaList.
+func (in *FleetResourceQuotaList) DeepCopy() *FleetResourceQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetResourceQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetResourceQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetResourceQuotaSpec) DeepCopyInto(out *FleetResourceQuotaSpec) {
+	*out = *in
+	if in.MaxPlacements != nil {
+		in, out := &in.MaxPlacements, &out.MaxPlacements
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxClustersPerPlacement != nil {
+		in, out := &in.MaxClustersPerPlacement, &out.MaxClustersPerPlacement
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBindings != nil {
+		in, out := &in.MaxBindings, &out.MaxBindings
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowedClusterSelector != nil {
+		in, out := &in.AllowedClusterSelector, &out.AllowedClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetResourceQuotaSpec.
+func (in *FleetResourceQuotaSpec) DeepCopy() *FleetResourceQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetResourceQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IgnoreDifference) DeepCopyInto(out *IgnoreDifference) {
+	*out = *in
+	if in.JSONPointers != nil {
+		in, out := &in.JSONPointers, &out.JSONPointers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedFieldsManagers != nil {
+		in, out := &in.ManagedFieldsManagers, &out.ManagedFieldsManagers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IgnoreDifference.
+func (in *IgnoreDifference) DeepCopy() *IgnoreDifference {
+	if in == nil {
+		return nil
+	}
+	out := new(IgnoreDifference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONPatchOverride) DeepCopyInto(out *JSONPatchOverride) {
 	*out = *in
@@ -1615,6 +1849,84 @@ func (in *PerClusterPlacementStatus) DeepCopy() *PerClusterPlacementStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementAffinity) DeepCopyInto(out *PlacementAffinity) {
+	*out = *in
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
+		*out = make([]PlacementAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
+		*out = make([]WeightedPlacementAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementAffinity.
+func (in *PlacementAffinity) DeepCopy() *PlacementAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementAffinityTerm) DeepCopyInto(out *PlacementAffinityTerm) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementAffinityTerm.
+func (in *PlacementAffinityTerm) DeepCopy() *PlacementAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementAntiAffinity) DeepCopyInto(out *PlacementAntiAffinity) {
+	*out = *in
+	if in.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.RequiredDuringSchedulingIgnoredDuringExecution, &out.RequiredDuringSchedulingIgnoredDuringExecution
+		*out = make([]PlacementAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PreferredDuringSchedulingIgnoredDuringExecution != nil {
+		in, out := &in.PreferredDuringSchedulingIgnoredDuringExecution, &out.PreferredDuringSchedulingIgnoredDuringExecution
+		*out = make([]WeightedPlacementAffinityTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementAntiAffinity.
+func (in *PlacementAntiAffinity) DeepCopy() *PlacementAntiAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementDisruptionBudgetSpec) DeepCopyInto(out *PlacementDisruptionBudgetSpec) {
 	*out = *in
@@ -1678,19 +1990,161 @@ func (in *PlacementEvictionStatus) DeepCopy() *PlacementEvictionStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
+func (in *PlacementGroup) DeepCopyInto(out *PlacementGroup) {
 	*out = *in
-	if in.ClusterNames != nil {
-		in, out := &in.ClusterNames, &out.ClusterNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroup.
+func (in *PlacementGroup) DeepCopy() *PlacementGroup {
+	if in == nil {
+		return nil
 	}
-	if in.NumberOfClusters != nil {
-		in, out := &in.NumberOfClusters, &out.NumberOfClusters
-		*out = new(int32)
-		**out = **in
+	out := new(PlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Affinity != nil {
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupList) DeepCopyInto(out *PlacementGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlacementGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupList.
+func (in *PlacementGroupList) DeepCopy() *PlacementGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupMember) DeepCopyInto(out *PlacementGroupMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupMember.
+func (in *PlacementGroupMember) DeepCopy() *PlacementGroupMember {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupMemberStatus) DeepCopyInto(out *PlacementGroupMemberStatus) {
+	*out = *in
+	out.PlacementGroupMember = in.PlacementGroupMember
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupMemberStatus.
+func (in *PlacementGroupMemberStatus) DeepCopy() *PlacementGroupMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupSpec) DeepCopyInto(out *PlacementGroupSpec) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]PlacementGroupMember, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupSpec.
+func (in *PlacementGroupSpec) DeepCopy() *PlacementGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementGroupStatus) DeepCopyInto(out *PlacementGroupStatus) {
+	*out = *in
+	if in.SelectedClusters != nil {
+		in, out := &in.SelectedClusters, &out.SelectedClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]PlacementGroupMemberStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementGroupStatus.
+func (in *PlacementGroupStatus) DeepCopy() *PlacementGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
+	*out = *in
+	if in.ClusterNames != nil {
+		in, out := &in.ClusterNames, &out.ClusterNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NumberOfClusters != nil {
+		in, out := &in.NumberOfClusters, &out.NumberOfClusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Affinity != nil {
 		in, out := &in.Affinity, &out.Affinity
 		*out = new(Affinity)
 		(*in).DeepCopyInto(*out)
@@ -1705,7 +2159,21 @@ func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
 		*out = make([]Toleration, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceRequests != nil {
+		in, out := &in.ResourceRequests, &out.ResourceRequests
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ReplicaScheduling != nil {
+		in, out := &in.ReplicaScheduling, &out.ReplicaScheduling
+		*out = new(ReplicaScheduling)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1719,6 +2187,63 @@ func (in *PlacementPolicy) DeepCopy() *PlacementPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPriorityClass) DeepCopyInto(out *PlacementPriorityClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPriorityClass.
+func (in *PlacementPriorityClass) DeepCopy() *PlacementPriorityClass {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPriorityClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementPriorityClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPriorityClassList) DeepCopyInto(out *PlacementPriorityClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlacementPriorityClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPriorityClassList.
+func (in *PlacementPriorityClassList) DeepCopy() *PlacementPriorityClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPriorityClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementPriorityClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementRef) DeepCopyInto(out *PlacementRef) {
 	*out = *in
@@ -1734,6 +2259,136 @@ func (in *PlacementRef) DeepCopy() *PlacementRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSchedulingGate) DeepCopyInto(out *PlacementSchedulingGate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSchedulingGate.
+func (in *PlacementSchedulingGate) DeepCopy() *PlacementSchedulingGate {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSchedulingGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSimulation) DeepCopyInto(out *PlacementSimulation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSimulation.
+func (in *PlacementSimulation) DeepCopy() *PlacementSimulation {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSimulation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementSimulation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSimulationList) DeepCopyInto(out *PlacementSimulationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlacementSimulation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSimulationList.
+func (in *PlacementSimulationList) DeepCopy() *PlacementSimulationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSimulationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementSimulationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSimulationSpec) DeepCopyInto(out *PlacementSimulationSpec) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(PlacementPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterChanges != nil {
+		in, out := &in.ClusterChanges, &out.ClusterChanges
+		*out = make([]SimulatedClusterChange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSimulationSpec.
+func (in *PlacementSimulationSpec) DeepCopy() *PlacementSimulationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSimulationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementSimulationStatus) DeepCopyInto(out *PlacementSimulationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Decisions != nil {
+		in, out := &in.Decisions, &out.Decisions
+		*out = make([]SimulatedClusterDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSimulationStatus.
+func (in *PlacementSimulationStatus) DeepCopy() *PlacementSimulationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementSimulationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementSpec) DeepCopyInto(out *PlacementSpec) {
 	*out = *in
@@ -1755,6 +2410,11 @@ func (in *PlacementSpec) DeepCopyInto(out *PlacementSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.SchedulingGates != nil {
+		in, out := &in.SchedulingGates, &out.SchedulingGates
+		*out = make([]PlacementSchedulingGate, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSpec.
@@ -1803,6 +2463,22 @@ func (in *PlacementStatus) DeepCopy() *PlacementStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginScore) DeepCopyInto(out *PluginScore) {
+	*out = *in
+	in.ClusterScore.DeepCopyInto(&out.ClusterScore)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginScore.
+func (in *PluginScore) DeepCopy() *PluginScore {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginScore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreferredClusterSelector) DeepCopyInto(out *PreferredClusterSelector) {
 	*out = *in
@@ -1876,6 +2552,36 @@ func (in *PropertySorter) DeepCopy() *PropertySorter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaScheduling) DeepCopyInto(out *ReplicaScheduling) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetWorkload != nil {
+		in, out := &in.TargetWorkload, &out.TargetWorkload
+		*out = new(WorkloadReference)
+		**out = **in
+	}
+	if in.StaticWeights != nil {
+		in, out := &in.StaticWeights, &out.StaticWeights
+		*out = make([]StaticClusterWeight, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaScheduling.
+func (in *ReplicaScheduling) DeepCopy() *ReplicaScheduling {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaScheduling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReportBackStrategy) DeepCopyInto(out *ReportBackStrategy) {
 	*out = *in
@@ -1969,6 +2675,16 @@ func (in *ResourceBindingSpec) DeepCopyInto(out *ResourceBindingSpec) {
 		copy(*out, *in)
 	}
 	in.ClusterDecision.DeepCopyInto(&out.ClusterDecision)
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReplicaTarget != nil {
+		in, out := &in.ReplicaTarget, &out.ReplicaTarget
+		*out = new(WorkloadReference)
+		**out = **in
+	}
 	if in.ApplyStrategy != nil {
 		in, out := &in.ApplyStrategy, &out.ApplyStrategy
 		*out = new(ApplyStrategy)
@@ -2030,47 +2746,152 @@ func (in *ResourceBindingStatus) DeepCopy() *ResourceBindingStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceContent) DeepCopyInto(out *ResourceContent) {
+func (in *ResourceContent) DeepCopyInto(out *ResourceContent) {
+	*out = *in
+	in.RawExtension.DeepCopyInto(&out.RawExtension)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceContent.
+func (in *ResourceContent) DeepCopy() *ResourceContent {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceEnvelope) DeepCopyInto(out *ResourceEnvelope) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceEnvelope.
+func (in *ResourceEnvelope) DeepCopy() *ResourceEnvelope {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceEnvelope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceEnvelope) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceEnvelopeList) DeepCopyInto(out *ResourceEnvelopeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceEnvelope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceEnvelopeList.
+func (in *ResourceEnvelopeList) DeepCopy() *ResourceEnvelopeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceEnvelopeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceEnvelopeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceHealthCheck) DeepCopyInto(out *ResourceHealthCheck) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealthCheck.
+func (in *ResourceHealthCheck) DeepCopy() *ResourceHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceHealthCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceHealthCheckCondition) DeepCopyInto(out *ResourceHealthCheckCondition) {
 	*out = *in
-	in.RawExtension.DeepCopyInto(&out.RawExtension)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceContent.
-func (in *ResourceContent) DeepCopy() *ResourceContent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealthCheckCondition.
+func (in *ResourceHealthCheckCondition) DeepCopy() *ResourceHealthCheckCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceContent)
+	out := new(ResourceHealthCheckCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceEnvelope) DeepCopyInto(out *ResourceEnvelope) {
+func (in *ResourceHealthCheckList) DeepCopyInto(out *ResourceHealthCheckList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
-		*out = make(map[string]runtime.RawExtension, len(*in))
-		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceHealthCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceEnvelope.
-func (in *ResourceEnvelope) DeepCopy() *ResourceEnvelope {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealthCheckList.
+func (in *ResourceHealthCheckList) DeepCopy() *ResourceHealthCheckList {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceEnvelope)
+	out := new(ResourceHealthCheckList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ResourceEnvelope) DeepCopyObject() runtime.Object {
+func (in *ResourceHealthCheckList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2078,35 +2899,61 @@ func (in *ResourceEnvelope) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceEnvelopeList) DeepCopyInto(out *ResourceEnvelopeList) {
+func (in *ResourceHealthCheckRule) DeepCopyInto(out *ResourceHealthCheckRule) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ResourceEnvelope, len(*in))
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(ResourceHealthCheckCondition)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealthCheckRule.
+func (in *ResourceHealthCheckRule) DeepCopy() *ResourceHealthCheckRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceHealthCheckRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceHealthCheckSpec) DeepCopyInto(out *ResourceHealthCheckSpec) {
+	*out = *in
+	out.Target = in.Target
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ResourceHealthCheckRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceEnvelopeList.
-func (in *ResourceEnvelopeList) DeepCopy() *ResourceEnvelopeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealthCheckSpec.
+func (in *ResourceHealthCheckSpec) DeepCopy() *ResourceHealthCheckSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceEnvelopeList)
+	out := new(ResourceHealthCheckSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ResourceEnvelopeList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceHealthCheckTarget) DeepCopyInto(out *ResourceHealthCheckTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceHealthCheckTarget.
+func (in *ResourceHealthCheckTarget) DeepCopy() *ResourceHealthCheckTarget {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ResourceHealthCheckTarget)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -2323,6 +3170,65 @@ func (in *ResourcePlacement) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePlacementEviction) DeepCopyInto(out *ResourcePlacementEviction) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePlacementEviction.
+func (in *ResourcePlacementEviction) DeepCopy() *ResourcePlacementEviction {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePlacementEviction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePlacementEviction) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePlacementEvictionList) DeepCopyInto(out *ResourcePlacementEvictionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourcePlacementEviction, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePlacementEvictionList.
+func (in *ResourcePlacementEvictionList) DeepCopy() *ResourcePlacementEvictionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePlacementEvictionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePlacementEvictionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourcePlacementList) DeepCopyInto(out *ResourcePlacementList) {
 	*out = *in
@@ -2558,6 +3464,90 @@ func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingExplanation) DeepCopyInto(out *SchedulingExplanation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingExplanation.
+func (in *SchedulingExplanation) DeepCopy() *SchedulingExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulingExplanation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingExplanationList) DeepCopyInto(out *SchedulingExplanationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SchedulingExplanation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingExplanationList.
+func (in *SchedulingExplanationList) DeepCopy() *SchedulingExplanationList {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingExplanationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulingExplanationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingExplanationStatus) DeepCopyInto(out *SchedulingExplanationStatus) {
+	*out = *in
+	if in.LastUpdatedTime != nil {
+		in, out := &in.LastUpdatedTime, &out.LastUpdatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterSchedulingExplanationEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingExplanationStatus.
+func (in *SchedulingExplanationStatus) DeepCopy() *SchedulingExplanationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingExplanationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchedulingPolicySnapshot) DeepCopyInto(out *SchedulingPolicySnapshot) {
 	*out = *in
@@ -2686,6 +3676,69 @@ func (in *ServerSideApplyConfig) DeepCopy() *ServerSideApplyConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatedClusterChange) DeepCopyInto(out *SimulatedClusterChange) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]clusterv1beta1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make(map[clusterv1beta1.PropertyName]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedClusterChange.
+func (in *SimulatedClusterChange) DeepCopy() *SimulatedClusterChange {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatedClusterChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatedClusterDecision) DeepCopyInto(out *SimulatedClusterDecision) {
+	*out = *in
+	if in.ClusterScore != nil {
+		in, out := &in.ClusterScore, &out.ClusterScore
+		*out = new(ClusterScore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PluginScores != nil {
+		in, out := &in.PluginScores, &out.PluginScores
+		*out = make([]PluginScore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatedClusterDecision.
+func (in *SimulatedClusterDecision) DeepCopy() *SimulatedClusterDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatedClusterDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageConfig) DeepCopyInto(out *StageConfig) {
 	*out = *in
@@ -2940,9 +3993,29 @@ func (in *StagedUpdateStrategyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticClusterWeight) DeepCopyInto(out *StaticClusterWeight) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticClusterWeight.
+func (in *StaticClusterWeight) DeepCopy() *StaticClusterWeight {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticClusterWeight)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Toleration) DeepCopyInto(out *Toleration) {
 	*out = *in
+	if in.TolerationSeconds != nil {
+		in, out := &in.TolerationSeconds, &out.TolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Toleration.
@@ -2963,6 +4036,21 @@ func (in *TopologySpreadConstraint) DeepCopyInto(out *TopologySpreadConstraint)
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MinDomains != nil {
+		in, out := &in.MinDomains, &out.MinDomains
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MatchLabelKeys != nil {
+		in, out := &in.MatchLabelKeys, &out.MatchLabelKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NestedTopologyKeys != nil {
+		in, out := &in.NestedTopologyKeys, &out.NestedTopologyKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologySpreadConstraint.
@@ -3056,6 +4144,22 @@ func (in *UpdateStrategySpec) DeepCopy() *UpdateStrategySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedPlacementAffinityTerm) DeepCopyInto(out *WeightedPlacementAffinityTerm) {
+	*out = *in
+	in.PlacementAffinityTerm.DeepCopyInto(&out.PlacementAffinityTerm)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedPlacementAffinityTerm.
+func (in *WeightedPlacementAffinityTerm) DeepCopy() *WeightedPlacementAffinityTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedPlacementAffinityTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Work) DeepCopyInto(out *Work) {
 	*out = *in
@@ -3185,6 +4289,21 @@ func (in *WorkStatus) DeepCopy() *WorkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadReference) DeepCopyInto(out *WorkloadReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadReference.
+func (in *WorkloadReference) DeepCopy() *WorkloadReference {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadTemplate) DeepCopyInto(out *WorkloadTemplate) {
 	*out = *in
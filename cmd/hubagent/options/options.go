@@ -24,6 +24,7 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	componentbaseconfig "k8s.io/component-base/config"
 
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 )
 
@@ -115,8 +116,54 @@ type Options struct {
 	ResourceSnapshotCreationMinimumInterval time.Duration
 	// ResourceChangesCollectionDuration is the duration for collecting resource changes into one snapshot.
 	ResourceChangesCollectionDuration time.Duration
+	// SchedulerConfigFile is the path to the scheduler configuration file, which defines the scheduling
+	// profiles in use by the scheduler. If not set, the scheduler uses the default profile only.
+	SchedulerConfigFile string
+	// SchedulerName is the name of the scheduler; the scheduler only processes the placements that
+	// specify this name. It allows custom schedulers to run alongside the scheduler in the hub agent.
+	SchedulerName string
+	// EnableDescheduler enables the descheduler, which periodically re-evaluates the bindings of the
+	// ClusterResourcePlacements that have opted in for descheduling, and evicts the ones whose clusters
+	// are no longer the best fit. It requires the eviction APIs to be enabled.
+	EnableDescheduler bool
+	// DeschedulingInterval is how often the descheduler re-evaluates a placement.
+	DeschedulingInterval time.Duration
+	// DeschedulerMinBindingAge is how long a binding must have existed before the descheduler can move it.
+	DeschedulerMinBindingAge time.Duration
+	// DeschedulerCooldown is the minimum amount of time between two evictions the descheduler issues for
+	// the same placement.
+	DeschedulerCooldown time.Duration
+	// DeschedulerMinWeightedScoreGain is the minimum weighted score gain a cluster must offer over the
+	// current one for the descheduler to move a binding.
+	DeschedulerMinWeightedScoreGain int
+	// TaintEvictionRetryInterval is how long the taint eviction controller waits before it retries an
+	// eviction, issued to move a placement off a cluster with NoExecute taints, that has not been executed.
+	TaintEvictionRetryInterval time.Duration
+	// EnablePlacementSimulation enables the agents to watch the PlacementSimulation API, which runs
+	// simulated (what-if) scheduling cycles.
+	EnablePlacementSimulation bool
+	// EnablePlacementPreemption enables the scheduler to preempt lower-priority ClusterResourcePlacements,
+	// through the eviction APIs, when a higher-priority one of the PickN placement type cannot find enough
+	// clusters. It requires the eviction APIs to be enabled.
+	EnablePlacementPreemption bool
+	// PlacementPreemptionCooldown is the minimum amount of time between two preemptions on behalf of
+	// the same placement.
+	PlacementPreemptionCooldown time.Duration
+	// SchedulingQueuePolicy is the policy of the scheduling queue, which decides the order in which the
+	// scheduler processes placements. Available options are Priority, which orders placements by their
+	// priority only (and in FIFO order if the placement priority class API is not installed), and Fair,
+	// which also shares the queue between namespaces in weighted round robin order.
+	SchedulingQueuePolicy string
 }
 
+const (
+	// PrioritySchedulingQueuePolicy orders placements in the scheduling queue by their priority only.
+	PrioritySchedulingQueuePolicy = "Priority"
+	// FairSchedulingQueuePolicy orders placements in the scheduling queue by their priority and, among
+	// placements of the same priority, shares the queue between namespaces in weighted round robin order.
+	FairSchedulingQueuePolicy = "Fair"
+)
+
 // NewOptions builds an empty options.
 func NewOptions() *Options {
 	return &Options{
@@ -188,5 +235,26 @@ func (o *Options) AddFlags(flags *flag.FlagSet) {
 	flags.DurationVar(&o.ResourceSnapshotCreationMinimumInterval, "resource-snapshot-creation-minimum-interval", 30*time.Second, "The minimum interval at which resource snapshots could be created.")
 	flags.DurationVar(&o.ResourceChangesCollectionDuration, "resource-changes-collection-duration", 15*time.Second,
 		"The duration for collecting resource changes into one snapshot. The default is 15 seconds, which means that the controller will collect resource changes for 15 seconds before creating a resource snapshot.")
+	flags.StringVar(&o.SchedulerConfigFile, "scheduler-config-file", "",
+		"The path to the scheduler configuration file, which defines the scheduling profiles in use by the scheduler. If not set, the scheduler uses the default profile only.")
+	flags.StringVar(&o.SchedulerName, "scheduler-name", placementv1beta1.DefaultSchedulerName,
+		"The name of the scheduler. The scheduler only processes the placements that specify this scheduler name; placements that do not specify one belong to the default scheduler, "+placementv1beta1.DefaultSchedulerName+".")
+	flags.BoolVar(&o.EnableDescheduler, "enable-descheduler", false,
+		"If set, the descheduler moves the resources of opted-in PickN ClusterResourcePlacements off clusters that are no longer the best fit, through the eviction APIs.")
+	flags.DurationVar(&o.DeschedulingInterval, "descheduling-interval", 5*time.Minute, "How often the descheduler re-evaluates a placement.")
+	flags.DurationVar(&o.DeschedulerMinBindingAge, "descheduler-min-binding-age", 30*time.Minute, "How long a binding must have existed before the descheduler can move it.")
+	flags.DurationVar(&o.DeschedulerCooldown, "descheduler-cooldown", 30*time.Minute, "The minimum amount of time between two evictions the descheduler issues for the same placement.")
+	flags.IntVar(&o.DeschedulerMinWeightedScoreGain, "descheduler-min-weighted-score-gain", 20,
+		"The minimum weighted score gain (the sum of the normalized plugin scores, each multiplied by the plugin weight) a cluster must offer over the current one for the descheduler to move a binding.")
+	flags.DurationVar(&o.TaintEvictionRetryInterval, "taint-eviction-retry-interval", time.Minute,
+		"How long to wait before retrying an eviction, issued to move a placement off a cluster with NoExecute taints, that has not been executed (e.g., blocked by the disruption budget).")
+	flags.BoolVar(&o.EnablePlacementSimulation, "enable-placement-simulation", false,
+		"If set, the agents will watch for the PlacementSimulation API, which runs simulated scheduling cycles without making any scheduling decision.")
+	flags.BoolVar(&o.EnablePlacementPreemption, "enable-placement-preemption", false,
+		"If set, the scheduler preempts lower-priority ClusterResourcePlacements, through the eviction APIs, when a higher-priority PickN ClusterResourcePlacement cannot find enough clusters.")
+	flags.DurationVar(&o.PlacementPreemptionCooldown, "placement-preemption-cooldown", 2*time.Minute,
+		"The minimum amount of time between two preemptions on behalf of the same placement, which allows member clusters to report the capacity released by the preemption.")
+	flags.StringVar(&o.SchedulingQueuePolicy, "scheduling-queue-policy", PrioritySchedulingQueuePolicy,
+		"The policy of the scheduling queue. Priority orders placements by their priority only; Fair also shares the queue between namespaces in weighted round robin order, with the weight of a namespace set by the "+placementv1beta1.SchedulingQueueWeightAnnotation+" annotation.")
 	o.RateLimiterOpts.AddFlags(flags)
 }
@@ -17,6 +17,9 @@ limitations under the License.
 package options
 
 import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
@@ -61,5 +64,40 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Required(newPath.Child("EnableV1Alpha1APIs"), "Either EnableV1Alpha1APIs or EnableV1Beta1APIs is required"))
 	}
 
+	if o.SchedulerName != "" {
+		if errMsgs := validation.IsDNS1123Label(o.SchedulerName); len(errMsgs) > 0 {
+			errs = append(errs, field.Invalid(newPath.Child("SchedulerName"), o.SchedulerName, strings.Join(errMsgs, "; ")))
+		}
+	}
+
+	if o.EnableEvictionAPIs && o.TaintEvictionRetryInterval <= 0 {
+		errs = append(errs, field.Invalid(newPath.Child("TaintEvictionRetryInterval"), o.TaintEvictionRetryInterval, "Must be greater than 0"))
+	}
+
+	if o.EnableDescheduler {
+		if !o.EnableEvictionAPIs {
+			errs = append(errs, field.Invalid(newPath.Child("EnableDescheduler"), o.EnableDescheduler, "The descheduler requires the eviction APIs to be enabled"))
+		}
+		if o.DeschedulingInterval <= 0 {
+			errs = append(errs, field.Invalid(newPath.Child("DeschedulingInterval"), o.DeschedulingInterval, "Must be greater than 0"))
+		}
+		if o.DeschedulerMinWeightedScoreGain < 0 {
+			errs = append(errs, field.Invalid(newPath.Child("DeschedulerMinWeightedScoreGain"), o.DeschedulerMinWeightedScoreGain, "Must be no less than 0"))
+		}
+	}
+
+	if o.EnablePlacementPreemption {
+		if !o.EnableEvictionAPIs {
+			errs = append(errs, field.Invalid(newPath.Child("EnablePlacementPreemption"), o.EnablePlacementPreemption, "Placement preemption requires the eviction APIs to be enabled"))
+		}
+		if o.PlacementPreemptionCooldown <= 0 {
+			errs = append(errs, field.Invalid(newPath.Child("PlacementPreemptionCooldown"), o.PlacementPreemptionCooldown, "Must be greater than 0"))
+		}
+	}
+
+	if o.SchedulingQueuePolicy != PrioritySchedulingQueuePolicy && o.SchedulingQueuePolicy != FairSchedulingQueuePolicy {
+		errs = append(errs, field.NotSupported(newPath.Child("SchedulingQueuePolicy"), o.SchedulingQueuePolicy, []string{PrioritySchedulingQueuePolicy, FairSchedulingQueuePolicy}))
+	}
+
 	return errs
 }
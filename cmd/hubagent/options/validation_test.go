@@ -38,6 +38,8 @@ func newTestOptions(modifyOptions ModifyOptions) Options {
 		ClusterUnhealthyThreshold:   metav1.Duration{Duration: 60 * time.Second},
 		WebhookClientConnectionType: "url",
 		EnableV1Alpha1APIs:          true,
+		SchedulingQueuePolicy:       PrioritySchedulingQueuePolicy,
+		TaintEvictionRetryInterval:  time.Minute,
 	}
 
 	if modifyOptions != nil {
@@ -93,6 +95,65 @@ func TestValidateControllerManagerConfiguration(t *testing.T) {
 			}),
 			want: field.ErrorList{field.Invalid(newPath.Child("WebhookServiceName"), "", "Webhook service name is required when webhook is enabled")},
 		},
+		"descheduler enabled without eviction APIs": {
+			opt: newTestOptions(func(option *Options) {
+				option.EnableDescheduler = true
+				option.DeschedulingInterval = 5 * time.Minute
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("EnableDescheduler"), true, "The descheduler requires the eviction APIs to be enabled")},
+		},
+		"invalid DeschedulingInterval": {
+			opt: newTestOptions(func(option *Options) {
+				option.EnableDescheduler = true
+				option.EnableEvictionAPIs = true
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("DeschedulingInterval"), time.Duration(0), "Must be greater than 0")},
+		},
+		"invalid TaintEvictionRetryInterval": {
+			opt: newTestOptions(func(option *Options) {
+				option.EnableEvictionAPIs = true
+				option.TaintEvictionRetryInterval = 0
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("TaintEvictionRetryInterval"), time.Duration(0), "Must be greater than 0")},
+		},
+		"valid SchedulerName": {
+			opt: newTestOptions(func(option *Options) {
+				option.SchedulerName = "gpu-scheduler"
+			}),
+			want: field.ErrorList{},
+		},
+		"invalid SchedulerName": {
+			opt: newTestOptions(func(option *Options) {
+				option.SchedulerName = "GPU_Scheduler"
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("SchedulerName"), "GPU_Scheduler", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')")},
+		},
+		"placement preemption enabled without eviction APIs": {
+			opt: newTestOptions(func(option *Options) {
+				option.EnablePlacementPreemption = true
+				option.PlacementPreemptionCooldown = 2 * time.Minute
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("EnablePlacementPreemption"), true, "Placement preemption requires the eviction APIs to be enabled")},
+		},
+		"invalid PlacementPreemptionCooldown": {
+			opt: newTestOptions(func(option *Options) {
+				option.EnablePlacementPreemption = true
+				option.EnableEvictionAPIs = true
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("PlacementPreemptionCooldown"), time.Duration(0), "Must be greater than 0")},
+		},
+		"valid SchedulingQueuePolicy": {
+			opt: newTestOptions(func(option *Options) {
+				option.SchedulingQueuePolicy = FairSchedulingQueuePolicy
+			}),
+			want: field.ErrorList{},
+		},
+		"invalid SchedulingQueuePolicy": {
+			opt: newTestOptions(func(option *Options) {
+				option.SchedulingQueuePolicy = "RoundRobin"
+			}),
+			want: field.ErrorList{field.NotSupported(newPath.Child("SchedulingQueuePolicy"), "RoundRobin", []string{PrioritySchedulingQueuePolicy, FairSchedulingQueuePolicy})},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -113,4 +174,6 @@ func TestAddFlags(t *testing.T) {
 	opts.AddFlags(flags)
 
 	g.Expect(opts.DenyModifyMemberClusterLabels).To(gomega.BeFalse(), "deny-modify-member-cluster-labels should be false by default")
+	g.Expect(opts.SchedulerName).To(gomega.Equal("default-scheduler"), "scheduler-name should be default-scheduler by default")
+	g.Expect(opts.SchedulingQueuePolicy).To(gomega.Equal(PrioritySchedulingQueuePolicy), "scheduling-queue-policy should be Priority by default")
 }
@@ -37,23 +37,29 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterinventory/clusterprofile"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterresourceplacementeviction"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterresourceplacementstatuswatcher"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/descheduler"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/overrider"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placement"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementgroup"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementsimulation"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementwatcher"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/resourcechange"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/rollout"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/schedulingpolicysnapshot"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/tainteviction"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/updaterun"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workgenerator"
 	"github.com/kubefleet-dev/kubefleet/pkg/resourcewatcher"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/extender"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/profile"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
 	schedulerbindingwatcher "github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/membercluster"
 	schedulerplacementwatcher "github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/placement"
+	schedulerplacementgroupwatcher "github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/placementgroup"
 	schedulerspswatcher "github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/schedulingpolicysnapshot"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
@@ -116,6 +122,22 @@ var (
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourcePlacementEvictionKind),
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourcePlacementDisruptionBudgetKind),
 	}
+
+	rpEvictionGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ResourcePlacementEvictionKind),
+	}
+
+	placementSimulationGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.PlacementSimulationKind),
+	}
+
+	placementPriorityClassGVK = placementv1beta1.GroupVersion.WithKind(placementv1beta1.PlacementPriorityClassKind)
+
+	placementGroupGVK = placementv1beta1.GroupVersion.WithKind(placementv1beta1.PlacementGroupKind)
+
+	clusterSchedulingExplanationGVK = placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterSchedulingExplanationKind)
+
+	fleetResourceQuotaGVK = placementv1beta1.GroupVersion.WithKind(placementv1beta1.FleetResourceQuotaKind)
 )
 
 // SetupControllers set up the customized controllers we developed
@@ -294,6 +316,31 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 				klog.ErrorS(err, "Unable to set up cluster resource placement eviction controller")
 				return err
 			}
+			if opts.EnableResourcePlacement {
+				for _, gvk := range rpEvictionGVKs {
+					if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+						klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+						return err
+					}
+				}
+				klog.Info("Setting up resource placement eviction controller")
+				if err := (&clusterresourceplacementeviction.Reconciler{
+					Client:         mgr.GetClient(),
+					UncachedReader: mgr.GetAPIReader(),
+				}).SetupWithManagerForResourcePlacementEviction(mgr); err != nil {
+					klog.ErrorS(err, "Unable to set up resource placement eviction controller")
+					return err
+				}
+			}
+			klog.Info("Setting up taint eviction controller")
+			if err := (&tainteviction.Reconciler{
+				Client:                  mgr.GetClient(),
+				RetryInterval:           opts.TaintEvictionRetryInterval,
+				EnableResourcePlacement: opts.EnableResourcePlacement,
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up taint eviction controller")
+				return err
+			}
 		}
 
 		// Set up a controller to do staged update run, rolling out resources to clusters in a stage by stage manner.
@@ -355,14 +402,124 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 
 		// Set up the scheduler
 		klog.Info("Setting up scheduler")
-		defaultProfile := profile.NewDefaultProfile()
-		defaultFramework := framework.NewFramework(defaultProfile, mgr)
-		defaultSchedulingQueue := queue.NewSimplePlacementSchedulingQueue(
-			schedulerQueueName, nil,
-		)
+		var schedulerConfig *profile.Configuration
+		if opts.SchedulerConfigFile != "" {
+			if schedulerConfig, err = profile.LoadConfiguration(opts.SchedulerConfigFile); err != nil {
+				klog.ErrorS(err, "Unable to load the scheduler configuration")
+				return err
+			}
+		}
+		// The default profile always comes first.
+		profiles, err := profile.NewProfilesFromConfiguration(schedulerConfig, profile.Options{})
+		if err != nil {
+			klog.ErrorS(err, "Unable to build the scheduling profiles")
+			return err
+		}
+		var extenders []framework.Extender
+		if schedulerConfig != nil {
+			if extenders, err = extender.NewHTTPExtenders(schedulerConfig.Extenders); err != nil {
+				klog.ErrorS(err, "Unable to set up the scheduler extenders")
+				return err
+			}
+		}
+		// Placement priority classes are honored only if the API is installed.
+		priorityClassErr := utils.CheckCRDInstalled(discoverClient, placementPriorityClassGVK)
+		if priorityClassErr != nil {
+			klog.InfoS("The placement priority class API is not installed; placements are scheduled in FIFO order", "GVK", placementPriorityClassGVK)
+		}
+		frameworkOpts := []framework.Option{framework.WithExtenders(extenders...)}
+		if opts.EnablePlacementPreemption {
+			if priorityClassErr != nil {
+				klog.ErrorS(priorityClassErr, "Unable to find the required CRD", "GVK", placementPriorityClassGVK)
+				return priorityClassErr
+			}
+			frameworkOpts = append(frameworkOpts, framework.WithPreemption(opts.PlacementPreemptionCooldown))
+		}
+		// Placement groups are honored only if the API is installed.
+		placementGroupErr := utils.CheckCRDInstalled(discoverClient, placementGroupGVK)
+		if placementGroupErr != nil {
+			klog.InfoS("The placement group API is not installed; placements are scheduled individually", "GVK", placementGroupGVK)
+		} else {
+			frameworkOpts = append(frameworkOpts, framework.WithPlacementGroups())
+		}
+		// Scheduling explanations are written only if the API is installed.
+		if err := utils.CheckCRDInstalled(discoverClient, clusterSchedulingExplanationGVK); err != nil {
+			klog.InfoS("The scheduling explanation API is not installed; scheduling cycles are not explained", "GVK", clusterSchedulingExplanationGVK)
+		} else {
+			frameworkOpts = append(frameworkOpts, framework.WithSchedulingExplanations())
+		}
+		// Fleet resource quotas are enforced only for resource placements and only if the API is installed.
+		if opts.EnableResourcePlacement {
+			if err := utils.CheckCRDInstalled(discoverClient, fleetResourceQuotaGVK); err != nil {
+				klog.InfoS("The fleet resource quota API is not installed; resource placements are not subject to quotas", "GVK", fleetResourceQuotaGVK)
+			} else {
+				frameworkOpts = append(frameworkOpts, framework.WithFleetResourceQuotas())
+			}
+		}
+		defaultFramework := framework.NewFramework(profiles[0], mgr, frameworkOpts...)
+		profileFrameworks := map[string]framework.Framework{profiles[0].Name(): defaultFramework}
+		schedulerOpts := make([]scheduler.Option, 0, len(profiles)+1)
+		schedulerOpts = append(schedulerOpts, scheduler.WithSchedulerName(opts.SchedulerName))
+		schedulerOpts = append(schedulerOpts, scheduler.WithProfileFramework(profiles[0].Name(), defaultFramework))
+		for _, p := range profiles[1:] {
+			profileFrameworks[p.Name()] = framework.NewFramework(p, mgr, frameworkOpts...)
+			schedulerOpts = append(schedulerOpts, scheduler.WithProfileFramework(p.Name(), profileFrameworks[p.Name()]))
+		}
+		var defaultSchedulingQueue queue.PlacementSchedulingQueue
+		switch {
+		case opts.SchedulingQueuePolicy == options.FairSchedulingQueuePolicy:
+			var priorityFunc queue.PriorityFunc
+			if priorityClassErr == nil {
+				priorityFunc = controller.NewPlacementPriorityFunc(mgr.GetClient())
+			}
+			defaultSchedulingQueue = queue.NewFairPlacementSchedulingQueue(
+				schedulerQueueName, nil, priorityFunc, controller.NewNamespaceWeightFunc(mgr.GetClient()),
+			)
+		case priorityClassErr == nil:
+			defaultSchedulingQueue = queue.NewPriorityPlacementSchedulingQueue(
+				schedulerQueueName, nil, controller.NewPlacementPriorityFunc(mgr.GetClient()),
+			)
+		default:
+			defaultSchedulingQueue = queue.NewSimplePlacementSchedulingQueue(
+				schedulerQueueName, nil,
+			)
+		}
 		// we use one scheduler for every 10 concurrent placement
 		defaultScheduler := scheduler.NewScheduler("DefaultScheduler", defaultFramework, defaultSchedulingQueue, mgr,
-			int(math.Ceil(float64(opts.MaxFleetSizeSupported)/50)*math.Ceil(float64(opts.MaxConcurrentClusterPlacement)/10)))
+			int(math.Ceil(float64(opts.MaxFleetSizeSupported)/50)*math.Ceil(float64(opts.MaxConcurrentClusterPlacement)/10)), schedulerOpts...)
+		if opts.EnableDescheduler {
+			klog.Info("Setting up the descheduler")
+			if err := (&descheduler.Reconciler{
+				Client:               mgr.GetClient(),
+				DefaultFramework:     defaultFramework,
+				ProfileFrameworks:    profileFrameworks,
+				Interval:             opts.DeschedulingInterval,
+				MinBindingAge:        opts.DeschedulerMinBindingAge,
+				Cooldown:             opts.DeschedulerCooldown,
+				MinWeightedScoreGain: int64(opts.DeschedulerMinWeightedScoreGain),
+				SchedulerName:        opts.SchedulerName,
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up the descheduler")
+				return err
+			}
+		}
+		if opts.EnablePlacementSimulation {
+			for _, gvk := range placementSimulationGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+			klog.Info("Setting up the placement simulation controller")
+			if err := (&placementsimulation.Reconciler{
+				Client:            mgr.GetClient(),
+				DefaultFramework:  defaultFramework,
+				ProfileFrameworks: profileFrameworks,
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up the placement simulation controller")
+				return err
+			}
+		}
 		klog.Info("Starting the scheduler")
 		// Scheduler must run in a separate goroutine as Run() is a blocking call.
 		wg.Add(1)
@@ -380,6 +537,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		if err := (&schedulerplacementwatcher.Reconciler{
 			Client:             mgr.GetClient(),
 			SchedulerWorkQueue: defaultSchedulingQueue,
+			SchedulerName:      opts.SchedulerName,
 		}).SetupWithManagerForClusterResourcePlacement(mgr); err != nil {
 			klog.ErrorS(err, "Unable to set up clusterResourcePlacement watcher for scheduler")
 			return err
@@ -389,6 +547,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		if err := (&schedulerspswatcher.Reconciler{
 			Client:             mgr.GetClient(),
 			SchedulerWorkQueue: defaultSchedulingQueue,
+			SchedulerName:      opts.SchedulerName,
 		}).SetupWithManagerForClusterSchedulingPolicySnapshot(mgr); err != nil {
 			klog.ErrorS(err, "Unable to set up clusterSchedulingPolicySnapshot watcher for scheduler")
 			return err
@@ -398,6 +557,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		if err := (&schedulerbindingwatcher.Reconciler{
 			Client:             mgr.GetClient(),
 			SchedulerWorkQueue: defaultSchedulingQueue,
+			SchedulerName:      opts.SchedulerName,
 		}).SetupWithManagerForClusterResourceBinding(mgr); err != nil {
 			klog.ErrorS(err, "Unable to set up clusterResourceBinding watcher for scheduler")
 			return err
@@ -408,6 +568,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			if err := (&schedulerplacementwatcher.Reconciler{
 				Client:             mgr.GetClient(),
 				SchedulerWorkQueue: defaultSchedulingQueue,
+				SchedulerName:      opts.SchedulerName,
 			}).SetupWithManagerForResourcePlacement(mgr); err != nil {
 				klog.ErrorS(err, "Unable to set up resourcePlacement watcher for scheduler")
 				return err
@@ -417,6 +578,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			if err := (&schedulerspswatcher.Reconciler{
 				Client:             mgr.GetClient(),
 				SchedulerWorkQueue: defaultSchedulingQueue,
+				SchedulerName:      opts.SchedulerName,
 			}).SetupWithManagerForSchedulingPolicySnapshot(mgr); err != nil {
 				klog.ErrorS(err, "Unable to set up schedulingPolicySnapshot watcher for scheduler")
 				return err
@@ -426,18 +588,44 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			if err := (&schedulerbindingwatcher.Reconciler{
 				Client:             mgr.GetClient(),
 				SchedulerWorkQueue: defaultSchedulingQueue,
+				SchedulerName:      opts.SchedulerName,
 			}).SetupWithManagerForResourceBinding(mgr); err != nil {
 				klog.ErrorS(err, "Unable to set up resourceBinding watcher for scheduler")
 				return err
 			}
 		}
 
+		if placementGroupErr == nil {
+			klog.Info("Setting up the placementGroup watcher for scheduler")
+			if err := (&schedulerplacementgroupwatcher.Reconciler{
+				Client:             mgr.GetClient(),
+				SchedulerWorkQueue: defaultSchedulingQueue,
+				SchedulerName:      opts.SchedulerName,
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up placementGroup watcher for scheduler")
+				return err
+			}
+
+			klog.Info("Setting up the placementGroup scheduler")
+			if err := (&placementgroup.Reconciler{
+				Client:                    mgr.GetClient(),
+				Framework:                 defaultFramework,
+				SchedulerName:             opts.SchedulerName,
+				EnableResourcePlacement:   opts.EnableResourcePlacement,
+				ClusterEligibilityChecker: clustereligibilitychecker.New(),
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up placementGroup scheduler")
+				return err
+			}
+		}
+
 		klog.Info("Setting up the memberCluster watcher for scheduler")
 		if err := (&membercluster.Reconciler{
 			Client:                    mgr.GetClient(),
 			SchedulerWorkQueue:        defaultSchedulingQueue,
 			ClusterEligibilityChecker: clustereligibilitychecker.New(),
 			EnableResourcePlacement:   opts.EnableResourcePlacement,
+			SchedulerName:             opts.SchedulerName,
 		}).SetupWithManager(mgr); err != nil {
 			klog.ErrorS(err, "Unable to set up memberCluster watcher for scheduler")
 			return err
@@ -43,7 +43,7 @@ import (
 	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
 )
 
-// Reconciler reconciles a ClusterResourcePlacementEviction object.
+// Reconciler reconciles a ClusterResourcePlacementEviction or a ResourcePlacementEviction object.
 type Reconciler struct {
 	client.Client
 	// UncachedReader is only used to read disruption budget objects directly from the API server to ensure we can enforce the disruption budget for eviction.
@@ -53,176 +53,214 @@ type Reconciler struct {
 // Reconcile triggers a single eviction reconcile round.
 func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtime.Result, error) {
 	startTime := time.Now()
-	evictionName := req.NamespacedName.Name
-	klog.V(2).InfoS("ClusterResourcePlacementEviction reconciliation starts", "clusterResourcePlacementEviction", evictionName)
+	evictionName := controller.GetObjectKeyFromNamespaceName(req.Namespace, req.Name)
+	klog.V(2).InfoS("Placement eviction reconciliation starts", "placementEviction", evictionName)
 	var internalError bool
 	defer func() {
 		if internalError {
 			hubmetrics.FleetEvictionStatus.WithLabelValues(evictionName, "false", "unknown").SetToCurrentTime()
 		}
 		latency := time.Since(startTime).Milliseconds()
-		klog.V(2).InfoS("ClusterResourcePlacementEviction reconciliation ends", "clusterResourcePlacementEviction", evictionName, "latency", latency)
+		klog.V(2).InfoS("Placement eviction reconciliation ends", "placementEviction", evictionName, "latency", latency)
 	}()
 
-	var eviction placementv1beta1.ClusterResourcePlacementEviction
-	if err := r.Client.Get(ctx, req.NamespacedName, &eviction); err != nil {
+	var eviction placementv1beta1.PlacementEvictionObj
+	if req.Namespace == "" {
+		eviction = &placementv1beta1.ClusterResourcePlacementEviction{}
+	} else {
+		eviction = &placementv1beta1.ResourcePlacementEviction{}
+	}
+	if err := r.Client.Get(ctx, req.NamespacedName, eviction); err != nil {
 		internalError = true
-		klog.ErrorS(err, "Failed to get cluster resource placement eviction", "clusterResourcePlacementEviction", evictionName)
+		klog.ErrorS(err, "Failed to get placement eviction", "placementEviction", evictionName)
 		return runtime.Result{}, client.IgnoreNotFound(err)
 	}
 
-	if evictionutils.IsEvictionInTerminalState(&eviction) {
+	if evictionutils.IsEvictionInTerminalState(eviction) {
 		return runtime.Result{}, nil
 	}
 
-	validationResult, err := r.validateEviction(ctx, &eviction)
+	validationResult, err := r.validateEviction(ctx, eviction)
 	if err != nil {
 		internalError = true
 		return runtime.Result{}, err
 	}
 	if !validationResult.isValid {
-		if err = r.updateEvictionStatus(ctx, &eviction); err != nil {
+		if err = r.updateEvictionStatus(ctx, eviction); err != nil {
 			internalError = true
 			return runtime.Result{}, err
 		}
-		emitEvictionCompleteMetric(&eviction)
+		emitEvictionCompleteMetric(eviction)
 		return runtime.Result{}, nil
 	}
 
-	markEvictionValid(&eviction)
+	markEvictionValid(eviction)
 
-	if err = r.executeEviction(ctx, validationResult, &eviction); err != nil {
+	if err = r.executeEviction(ctx, validationResult, eviction); err != nil {
 		internalError = true
 		return runtime.Result{}, err
 	}
 
-	if err = r.updateEvictionStatus(ctx, &eviction); err != nil {
+	if err = r.updateEvictionStatus(ctx, eviction); err != nil {
 		internalError = true
 		return runtime.Result{}, err
 	}
-	emitEvictionCompleteMetric(&eviction)
+	emitEvictionCompleteMetric(eviction)
 	return runtime.Result{}, nil
 }
 
 // validateEviction performs validation for eviction object's spec and returns a wrapped validation result.
-func (r *Reconciler) validateEviction(ctx context.Context, eviction *placementv1beta1.ClusterResourcePlacementEviction) (*evictionValidationResult, error) {
+//
+// A ClusterResourcePlacementEviction targets a ClusterResourcePlacement, and a
+// ResourcePlacementEviction targets a ResourcePlacement in the same namespace.
+func (r *Reconciler) validateEviction(ctx context.Context, eviction placementv1beta1.PlacementEvictionObj) (*evictionValidationResult, error) {
 	validationResult := &evictionValidationResult{isValid: false}
-	var crp placementv1beta1.ClusterResourcePlacement
-	if err := r.Client.Get(ctx, types.NamespacedName{Name: eviction.Spec.PlacementName}, &crp); err != nil {
+	evictionRef := klog.KObj(eviction)
+	spec := eviction.GetPlacementEvictionSpec()
+	isClusterScoped := eviction.GetNamespace() == ""
+	placementKey := types.NamespacedName{Namespace: eviction.GetNamespace(), Name: spec.PlacementName}
+	placementRef := klog.KRef(placementKey.Namespace, placementKey.Name)
+	placement, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, placementKey)
+	if err != nil {
 		if k8serrors.IsNotFound(err) {
-			klog.V(2).InfoS(condition.EvictionInvalidMissingCRPMessage, "clusterResourcePlacementEviction", eviction.Name, "clusterResourcePlacement", eviction.Spec.PlacementName)
-			markEvictionInvalid(eviction, condition.EvictionInvalidMissingCRPMessage)
+			message := condition.EvictionInvalidMissingCRPMessage
+			if !isClusterScoped {
+				message = condition.EvictionInvalidMissingRPMessage
+			}
+			klog.V(2).InfoS(message, "placementEviction", evictionRef, "placement", placementRef)
+			markEvictionInvalid(eviction, message)
 			return validationResult, nil
 		}
 		return nil, controller.NewAPIServerError(true, err)
 	}
 
-	// set default values for CRP.
-	defaulter.SetPlacementDefaults(&crp)
+	// set default values for the placement.
+	defaulter.SetPlacementDefaults(placement)
 
-	if crp.DeletionTimestamp != nil {
-		klog.V(2).InfoS(condition.EvictionInvalidDeletingCRPMessage, "clusterResourcePlacementEviction", eviction.Name, "clusterResourcePlacement", eviction.Spec.PlacementName)
-		markEvictionInvalid(eviction, condition.EvictionInvalidDeletingCRPMessage)
+	if placement.GetDeletionTimestamp() != nil {
+		message := condition.EvictionInvalidDeletingCRPMessage
+		if !isClusterScoped {
+			message = condition.EvictionInvalidDeletingRPMessage
+		}
+		klog.V(2).InfoS(message, "placementEviction", evictionRef, "placement", placementRef)
+		markEvictionInvalid(eviction, message)
 		return validationResult, nil
 	}
-	if crp.Spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType {
-		klog.V(2).InfoS(condition.EvictionInvalidPickFixedCRPMessage, "clusterResourcePlacementEviction", eviction.Name, "clusterResourcePlacement", eviction.Spec.PlacementName)
-		markEvictionInvalid(eviction, condition.EvictionInvalidPickFixedCRPMessage)
+	if placement.GetPlacementSpec().Policy.PlacementType == placementv1beta1.PickFixedPlacementType {
+		message := condition.EvictionInvalidPickFixedCRPMessage
+		if !isClusterScoped {
+			message = condition.EvictionInvalidPickFixedRPMessage
+		}
+		klog.V(2).InfoS(message, "placementEviction", evictionRef, "placement", placementRef)
+		markEvictionInvalid(eviction, message)
 		return validationResult, nil
 	}
-	validationResult.crp = &crp
+	validationResult.placement = placement
 
-	var crbList placementv1beta1.ClusterResourceBindingList
-	if err := r.Client.List(ctx, &crbList, client.MatchingLabels{placementv1beta1.PlacementTrackingLabel: crp.Name}); err != nil {
-		return nil, controller.NewAPIServerError(true, err)
+	bindings, err := controller.ListBindingsFromKey(ctx, r.Client, placementKey, true)
+	if err != nil {
+		return nil, err
 	}
-	validationResult.bindings = crbList.Items
+	validationResult.bindings = bindings
 
-	var evictionTargetBinding *placementv1beta1.ClusterResourceBinding
-	for i := range crbList.Items {
-		if crbList.Items[i].Spec.TargetCluster == eviction.Spec.ClusterName {
+	var evictionTargetBinding placementv1beta1.BindingObj
+	for i := range bindings {
+		if bindings[i].GetBindingSpec().TargetCluster == spec.ClusterName {
 			if evictionTargetBinding == nil {
-				evictionTargetBinding = &crbList.Items[i]
+				evictionTargetBinding = bindings[i]
 			} else {
-				klog.V(2).InfoS(condition.EvictionInvalidMultipleCRBMessage, "clusterResourcePlacementEviction", eviction.Name, "clusterResourcePlacement", eviction.Spec.PlacementName)
+				klog.V(2).InfoS(condition.EvictionInvalidMultipleCRBMessage, "placementEviction", evictionRef, "placement", placementRef)
 				markEvictionInvalid(eviction, condition.EvictionInvalidMultipleCRBMessage)
 				return validationResult, nil
 			}
 		}
 	}
 	if evictionTargetBinding == nil {
-		klog.V(2).InfoS("Failed to find cluster resource binding for cluster targeted by eviction", "clusterResourcePlacementEviction", eviction.Name, "targetCluster", eviction.Spec.ClusterName)
+		klog.V(2).InfoS("Failed to find binding for cluster targeted by eviction", "placementEviction", evictionRef, "targetCluster", spec.ClusterName)
 		markEvictionInvalid(eviction, condition.EvictionInvalidMissingCRBMessage)
 		return validationResult, nil
 	}
-	validationResult.crb = evictionTargetBinding
+	validationResult.binding = evictionTargetBinding
 
 	validationResult.isValid = true
 	return validationResult, nil
 }
 
 // updateEvictionStatus updates eviction status.
-func (r *Reconciler) updateEvictionStatus(ctx context.Context, eviction *placementv1beta1.ClusterResourcePlacementEviction) error {
+func (r *Reconciler) updateEvictionStatus(ctx context.Context, eviction placementv1beta1.PlacementEvictionObj) error {
 	evictionRef := klog.KObj(eviction)
 	if err := r.Client.Status().Update(ctx, eviction); err != nil {
-		klog.ErrorS(err, "Failed to update eviction status", "clusterResourcePlacementEviction", evictionRef)
+		klog.ErrorS(err, "Failed to update eviction status", "placementEviction", evictionRef)
 		return controller.NewUpdateIgnoreConflictError(err)
 	}
-	klog.V(2).InfoS("Updated the status of a eviction", "clusterResourcePlacementEviction", evictionRef, "status", eviction.Status)
+	klog.V(2).InfoS("Updated the status of a eviction", "placementEviction", evictionRef)
 	return nil
 }
 
-// deleteClusterResourceBinding deletes the specified cluster resource binding.
-func (r *Reconciler) deleteClusterResourceBinding(ctx context.Context, binding *placementv1beta1.ClusterResourceBinding) error {
+// deleteBinding deletes the specified binding.
+func (r *Reconciler) deleteBinding(ctx context.Context, binding placementv1beta1.BindingObj) error {
 	bindingRef := klog.KObj(binding)
 	deleteOptions := &client.DeleteOptions{
 		Preconditions: &metav1.Preconditions{
-			ResourceVersion: ptr.To(binding.ResourceVersion),
+			ResourceVersion: ptr.To(binding.GetResourceVersion()),
 		},
 	}
 	if err := r.Client.Delete(ctx, binding, deleteOptions); err != nil {
-		klog.ErrorS(err, "Failed to delete cluster resource binding", "clusterResourceBinding", bindingRef)
+		klog.ErrorS(err, "Failed to delete binding", "binding", bindingRef)
 		return controller.NewDeleteIgnoreNotFoundError(err)
 	}
-	klog.V(2).InfoS("Issued delete on cluster resource binding, eviction succeeded", "clusterResourceBinding", bindingRef)
+	klog.V(2).InfoS("Issued delete on binding, eviction succeeded", "binding", bindingRef)
 	return nil
 }
 
 // executeEviction tries to remove resources from target cluster placed by placement targeted by eviction.
-func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evictionValidationResult, eviction *placementv1beta1.ClusterResourcePlacementEviction) error {
+func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evictionValidationResult, eviction placementv1beta1.PlacementEvictionObj) error {
 	// Unwrap validation result for processing.
-	crp, evictionTargetBinding, bindingList := validationResult.crp, validationResult.crb, validationResult.bindings
+	placement, evictionTargetBinding, bindingList := validationResult.placement, validationResult.binding, validationResult.bindings
+	evictionRef := klog.KObj(eviction)
+	bindingRef := klog.KObj(evictionTargetBinding)
+	targetCluster := eviction.GetPlacementEvictionSpec().ClusterName
 
 	// Check to see if binding is being deleted.
 	if evictionTargetBinding.GetDeletionTimestamp() != nil {
-		klog.V(2).InfoS("ClusterResourceBinding targeted by eviction is being deleted",
-			"clusterResourcePlacementEviction", eviction.Name, "clusterResourceBinding", evictionTargetBinding.Name, "targetCluster", eviction.Spec.ClusterName)
+		klog.V(2).InfoS("Binding targeted by eviction is being deleted",
+			"placementEviction", evictionRef, "binding", bindingRef, "targetCluster", targetCluster)
 		markEvictionExecuted(eviction, condition.EvictionAllowedPlacementRemovedMessage)
 		return nil
 	}
 
 	if !evictionutils.IsPlacementPresent(evictionTargetBinding) {
-		klog.V(2).InfoS("No resources have been placed for ClusterResourceBinding in target cluster",
-			"clusterResourcePlacementEviction", eviction.Name, "clusterResourceBinding", evictionTargetBinding.Name, "targetCluster", eviction.Spec.ClusterName)
+		klog.V(2).InfoS("No resources have been placed for binding in target cluster",
+			"placementEviction", evictionRef, "binding", bindingRef, "targetCluster", targetCluster)
 		markEvictionNotExecuted(eviction, condition.EvictionBlockedMissingPlacementMessage)
 		return nil
 	}
 
 	// Check to see if binding has failed or just reportDiff. If so no need to check disruption budget we can evict.
 	if bindingutils.HasBindingFailed(evictionTargetBinding) || bindingutils.IsBindingDiffReported(evictionTargetBinding) {
-		klog.V(2).InfoS("ClusterResourceBinding targeted by eviction is in failed state",
-			"clusterResourcePlacementEviction", eviction.Name, "clusterResourceBinding", evictionTargetBinding.Name, "targetCluster", eviction.Spec.ClusterName)
-		if err := r.deleteClusterResourceBinding(ctx, evictionTargetBinding); err != nil {
+		klog.V(2).InfoS("Binding targeted by eviction is in failed state",
+			"placementEviction", evictionRef, "binding", bindingRef, "targetCluster", targetCluster)
+		if err := r.deleteBinding(ctx, evictionTargetBinding); err != nil {
 			return err
 		}
 		markEvictionExecuted(eviction, condition.EvictionAllowedPlacementFailedMessage)
 		return nil
 	}
 
+	crp, ok := placement.(*placementv1beta1.ClusterResourcePlacement)
+	if !ok {
+		// There is no disruption budget for ResourcePlacements.
+		if err := r.deleteBinding(ctx, evictionTargetBinding); err != nil {
+			return err
+		}
+		markEvictionExecuted(eviction, condition.EvictionAllowedNoDisruptionBudgetForRPMessage)
+		return nil
+	}
+
 	var db placementv1beta1.ClusterResourcePlacementDisruptionBudget
 	if err := r.UncachedReader.Get(ctx, types.NamespacedName{Name: crp.Name}, &db); err != nil {
 		if k8serrors.IsNotFound(err) {
-			if err = r.deleteClusterResourceBinding(ctx, evictionTargetBinding); err != nil {
+			if err = r.deleteBinding(ctx, evictionTargetBinding); err != nil {
 				return err
 			}
 			markEvictionExecuted(eviction, condition.EvictionAllowedNoPDBMessage)
@@ -242,7 +280,7 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 	totalBindings := len(bindingList)
 	allowed, availableBindings := isEvictionAllowed(bindingList, *crp, db)
 	if allowed {
-		if err := r.deleteClusterResourceBinding(ctx, evictionTargetBinding); err != nil {
+		if err := r.deleteBinding(ctx, evictionTargetBinding); err != nil {
 			return err
 		}
 		markEvictionExecuted(eviction, fmt.Sprintf(condition.EvictionAllowedPDBSpecifiedMessageFmt, availableBindings, totalBindings))
@@ -253,7 +291,7 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 }
 
 // isEvictionAllowed calculates if eviction allowed based on available bindings and spec specified in placement disruption budget.
-func isEvictionAllowed(bindings []placementv1beta1.ClusterResourceBinding, crp placementv1beta1.ClusterResourcePlacement, db placementv1beta1.ClusterResourcePlacementDisruptionBudget) (bool, int) {
+func isEvictionAllowed(bindings []placementv1beta1.BindingObj, crp placementv1beta1.ClusterResourcePlacement, db placementv1beta1.ClusterResourcePlacementDisruptionBudget) (bool, int) {
 	availableBindings := 0
 	for i := range bindings {
 		availableCondition := bindings[i].GetCondition(string(placementv1beta1.ResourceBindingAvailable))
@@ -293,78 +331,90 @@ func isEvictionAllowed(bindings []placementv1beta1.ClusterResourceBinding, crp p
 }
 
 // markEvictionValid sets the valid condition as true in eviction status.
-func markEvictionValid(eviction *placementv1beta1.ClusterResourcePlacementEviction) {
+func markEvictionValid(eviction placementv1beta1.PlacementEvictionObj) {
 	cond := metav1.Condition{
 		Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
 		Status:             metav1.ConditionTrue,
-		ObservedGeneration: eviction.Generation,
+		ObservedGeneration: eviction.GetGeneration(),
 		Reason:             condition.ClusterResourcePlacementEvictionValidReason,
 		Message:            condition.EvictionValidMessage,
 	}
 	eviction.SetConditions(cond)
 
-	klog.V(2).InfoS("Marked eviction as valid", "clusterResourcePlacementEviction", klog.KObj(eviction))
+	klog.V(2).InfoS("Marked eviction as valid", "placementEviction", klog.KObj(eviction))
 }
 
 // markEvictionInvalid sets the valid condition as false in eviction status.
-func markEvictionInvalid(eviction *placementv1beta1.ClusterResourcePlacementEviction, message string) {
+func markEvictionInvalid(eviction placementv1beta1.PlacementEvictionObj, message string) {
 	cond := metav1.Condition{
 		Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
 		Status:             metav1.ConditionFalse,
-		ObservedGeneration: eviction.Generation,
+		ObservedGeneration: eviction.GetGeneration(),
 		Reason:             condition.ClusterResourcePlacementEvictionInvalidReason,
 		Message:            message,
 	}
 	eviction.SetConditions(cond)
-	klog.V(2).InfoS("Marked eviction as invalid", "clusterResourcePlacementEviction", klog.KObj(eviction))
+	klog.V(2).InfoS("Marked eviction as invalid", "placementEviction", klog.KObj(eviction))
 }
 
 // markEvictionExecuted sets the executed condition as true in eviction status.
-func markEvictionExecuted(eviction *placementv1beta1.ClusterResourcePlacementEviction, message string) {
+func markEvictionExecuted(eviction placementv1beta1.PlacementEvictionObj, message string) {
 	cond := metav1.Condition{
 		Type:               string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
 		Status:             metav1.ConditionTrue,
-		ObservedGeneration: eviction.Generation,
+		ObservedGeneration: eviction.GetGeneration(),
 		Reason:             condition.ClusterResourcePlacementEvictionExecutedReason,
 		Message:            message,
 	}
 	eviction.SetConditions(cond)
-	klog.V(2).InfoS("Marked eviction as executed", "clusterResourcePlacementEviction", klog.KObj(eviction))
+	klog.V(2).InfoS("Marked eviction as executed", "placementEviction", klog.KObj(eviction))
 }
 
 // markEvictionNotExecuted sets the executed condition as false in eviction status.
-func markEvictionNotExecuted(eviction *placementv1beta1.ClusterResourcePlacementEviction, message string) {
+func markEvictionNotExecuted(eviction placementv1beta1.PlacementEvictionObj, message string) {
 	cond := metav1.Condition{
 		Type:               string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
 		Status:             metav1.ConditionFalse,
-		ObservedGeneration: eviction.Generation,
+		ObservedGeneration: eviction.GetGeneration(),
 		Reason:             condition.ClusterResourcePlacementEvictionNotExecutedReason,
 		Message:            message,
 	}
 	eviction.SetConditions(cond)
-	klog.V(2).InfoS("Marked eviction as not executed", "clusterResourcePlacementEviction", klog.KObj(eviction))
+	klog.V(2).InfoS("Marked eviction as not executed", "placementEviction", klog.KObj(eviction))
 }
 
-func emitEvictionCompleteMetric(eviction *placementv1beta1.ClusterResourcePlacementEviction) {
-	hubmetrics.FleetEvictionStatus.DeletePartialMatch(prometheus.Labels{"name": eviction.GetName(), "isCompleted": "false"})
+func emitEvictionCompleteMetric(eviction placementv1beta1.PlacementEvictionObj) {
+	evictionName := controller.GetObjectKeyFromNamespaceName(eviction.GetNamespace(), eviction.GetName())
+	hubmetrics.FleetEvictionStatus.DeletePartialMatch(prometheus.Labels{"name": evictionName, "isCompleted": "false"})
 	// check to see if eviction is valid.
 	if condition.IsConditionStatusTrue(eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeValid)), eviction.GetGeneration()) {
-		hubmetrics.FleetEvictionStatus.WithLabelValues(eviction.Name, "true", "true").SetToCurrentTime()
+		hubmetrics.FleetEvictionStatus.WithLabelValues(evictionName, "true", "true").SetToCurrentTime()
 	} else {
-		hubmetrics.FleetEvictionStatus.WithLabelValues(eviction.Name, "true", "false").SetToCurrentTime()
+		hubmetrics.FleetEvictionStatus.WithLabelValues(evictionName, "true", "false").SetToCurrentTime()
 	}
 }
 
-// SetupWithManager sets up the controller with the Manager.
+// SetupWithManager sets up the controller with the Manager for ClusterResourcePlacementEvictions.
 func (r *Reconciler) SetupWithManager(mgr runtime.Manager) error {
-	return runtime.NewControllerManagedBy(mgr).Named("clusterresourceplacementeviction-controller").
+	return r.setupWithManager(mgr, "clusterresourceplacementeviction-controller", &placementv1beta1.ClusterResourcePlacementEviction{})
+}
+
+// SetupWithManagerForResourcePlacementEviction sets up the controller with the Manager for
+// ResourcePlacementEvictions.
+func (r *Reconciler) SetupWithManagerForResourcePlacementEviction(mgr runtime.Manager) error {
+	return r.setupWithManager(mgr, "resourceplacementeviction-controller", &placementv1beta1.ResourcePlacementEviction{})
+}
+
+func (r *Reconciler) setupWithManager(mgr runtime.Manager, name string, eviction placementv1beta1.PlacementEvictionObj) error {
+	return runtime.NewControllerManagedBy(mgr).Named(name).
 		WithOptions(ctrl.Options{MaxConcurrentReconciles: 1}). // max concurrent reconciles is currently set to 1 for concurrency control.
-		For(&placementv1beta1.ClusterResourcePlacementEviction{}).
+		For(eviction).
 		WithEventFilter(predicate.Funcs{
 			DeleteFunc: func(e event.DeleteEvent) bool {
 				// delete complete status metric for eviction and skip reconciliation.
-				count := hubmetrics.FleetEvictionStatus.DeletePartialMatch(prometheus.Labels{"name": e.Object.GetName()})
-				klog.V(2).InfoS("ClusterResourcePlacementEviction is being deleted", "clusterResourcePlacementEviction", e.Object.GetName(), "metricCount", count)
+				evictionName := controller.GetObjectKeyFromNamespaceName(e.Object.GetNamespace(), e.Object.GetName())
+				count := hubmetrics.FleetEvictionStatus.DeletePartialMatch(prometheus.Labels{"name": evictionName})
+				klog.V(2).InfoS("Placement eviction is being deleted", "placementEviction", evictionName, "metricCount", count)
 				return false
 			},
 		}).
@@ -373,8 +423,8 @@ func (r *Reconciler) SetupWithManager(mgr runtime.Manager) error {
 }
 
 type evictionValidationResult struct {
-	crp      *placementv1beta1.ClusterResourcePlacement
-	crb      *placementv1beta1.ClusterResourceBinding
-	bindings []placementv1beta1.ClusterResourceBinding
-	isValid  bool
+	placement placementv1beta1.PlacementObj
+	binding   placementv1beta1.BindingObj
+	bindings  []placementv1beta1.BindingObj
+	isValid   bool
 }
@@ -40,6 +40,7 @@ import (
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	hubmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/hub"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
 )
 
@@ -49,6 +50,8 @@ const (
 	testCRPName              = "test-crp"
 	testDisruptionBudgetName = "test-disruption-budget"
 	testEvictionName         = "test-eviction"
+	testNamespace            = "test-ns"
+	testRPName               = "test-rp"
 )
 
 var (
@@ -185,9 +188,9 @@ func TestValidateEviction(t *testing.T) {
 				testBinding1, testBinding2,
 			},
 			wantValidationResult: &evictionValidationResult{
-				isValid:  false,
-				crp:      testCRP,
-				bindings: []placementv1beta1.ClusterResourceBinding{testBinding1, testBinding2},
+				isValid:   false,
+				placement: testCRP,
+				bindings:  []placementv1beta1.BindingObj{&testBinding1, &testBinding2},
 			},
 			wantEvictionInvalidCondition: &metav1.Condition{
 				Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
@@ -203,9 +206,9 @@ func TestValidateEviction(t *testing.T) {
 			eviction: buildTestEviction(testEvictionName, testCRPName, testClusterName),
 			crp:      testCRP,
 			wantValidationResult: &evictionValidationResult{
-				isValid:  false,
-				crp:      testCRP,
-				bindings: []placementv1beta1.ClusterResourceBinding{},
+				isValid:   false,
+				placement: testCRP,
+				bindings:  []placementv1beta1.BindingObj{},
 			},
 			wantEvictionInvalidCondition: &metav1.Condition{
 				Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
@@ -227,10 +230,10 @@ func TestValidateEviction(t *testing.T) {
 			},
 			bindings: []placementv1beta1.ClusterResourceBinding{testBinding2},
 			wantValidationResult: &evictionValidationResult{
-				isValid:  true,
-				crp:      testCRP,
-				crb:      &testBinding2,
-				bindings: []placementv1beta1.ClusterResourceBinding{testBinding2},
+				isValid:   true,
+				placement: testCRP,
+				binding:   &testBinding2,
+				bindings:  []placementv1beta1.BindingObj{&testBinding2},
 			},
 			wantErr: nil,
 		},
@@ -257,8 +260,8 @@ func TestValidateEviction(t *testing.T) {
 
 			// Since default values are applied to the affected CRP in the eviction controller; the
 			// the same must be done on the expected result as well.
-			if tc.wantValidationResult.crp != nil {
-				defaulter.SetPlacementDefaults(tc.wantValidationResult.crp)
+			if tc.wantValidationResult.placement != nil {
+				defaulter.SetPlacementDefaults(tc.wantValidationResult.placement)
 			}
 
 			if diff := cmp.Diff(tc.wantValidationResult, gotValidationResult, validationResultCmpOptions...); diff != "" {
@@ -279,7 +282,7 @@ func TestValidateEviction(t *testing.T) {
 	}
 }
 
-func TestDeleteClusterResourceBinding(t *testing.T) {
+func TestDeleteBinding(t *testing.T) {
 	tests := []struct {
 		name          string
 		inputBinding  *placementv1beta1.ClusterResourceBinding
@@ -345,7 +348,7 @@ func TestDeleteClusterResourceBinding(t *testing.T) {
 			r := Reconciler{
 				Client: fakeClient,
 			}
-			gotErr := r.deleteClusterResourceBinding(ctx, tc.inputBinding)
+			gotErr := r.deleteBinding(ctx, tc.inputBinding)
 			if tc.wantErr == nil {
 				if gotErr != nil {
 					t.Errorf("test case `%s` didn't return the expected error,  want no error, got error = %+v ", tc.name, gotErr)
@@ -386,7 +389,7 @@ func TestExecuteEviction(t *testing.T) {
 	tests := []struct {
 		name                          string
 		validationResult              *evictionValidationResult
-		eviction                      *placementv1beta1.ClusterResourcePlacementEviction
+		eviction                      placementv1beta1.PlacementEvictionObj
 		pdb                           *placementv1beta1.ClusterResourcePlacementDisruptionBudget
 		wantEvictionExecutedCondition *metav1.Condition
 		wantErr                       error
@@ -394,7 +397,7 @@ func TestExecuteEviction(t *testing.T) {
 		{
 			name: "scheduled binding - eviction not executed",
 			validationResult: &evictionValidationResult{
-				crb: &placementv1beta1.ClusterResourceBinding{
+				binding: &placementv1beta1.ClusterResourceBinding{
 					ObjectMeta: metav1.ObjectMeta{
 						Name: testBindingName,
 					},
@@ -416,7 +419,7 @@ func TestExecuteEviction(t *testing.T) {
 		{
 			name: "unscheduled binding with previous state annotation doesn't exist - eviction not executed",
 			validationResult: &evictionValidationResult{
-				crb: &placementv1beta1.ClusterResourceBinding{
+				binding: &placementv1beta1.ClusterResourceBinding{
 					ObjectMeta: metav1.ObjectMeta{
 						Name: testBindingName,
 					},
@@ -438,7 +441,7 @@ func TestExecuteEviction(t *testing.T) {
 		{
 			name: "unscheduled binding with previous state as scheduled - eviction not executed",
 			validationResult: &evictionValidationResult{
-				crb: &placementv1beta1.ClusterResourceBinding{
+				binding: &placementv1beta1.ClusterResourceBinding{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:        testBindingName,
 						Annotations: map[string]string{placementv1beta1.PreviousBindingStateAnnotation: string(placementv1beta1.BindingStateScheduled)},
@@ -461,7 +464,7 @@ func TestExecuteEviction(t *testing.T) {
 		{
 			name: "deleting binding - eviction executed",
 			validationResult: &evictionValidationResult{
-				crb: &placementv1beta1.ClusterResourceBinding{
+				binding: &placementv1beta1.ClusterResourceBinding{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:              testBindingName,
 						Annotations:       map[string]string{placementv1beta1.PreviousBindingStateAnnotation: string(placementv1beta1.BindingStateBound)},
@@ -486,7 +489,7 @@ func TestExecuteEviction(t *testing.T) {
 		{
 			name: "failed to apply binding - eviction executed",
 			validationResult: &evictionValidationResult{
-				crb: &placementv1beta1.ClusterResourceBinding{
+				binding: &placementv1beta1.ClusterResourceBinding{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:       testBindingName,
 						Generation: 1,
@@ -519,7 +522,7 @@ func TestExecuteEviction(t *testing.T) {
 		{
 			name: "failed to be available binding - eviction executed",
 			validationResult: &evictionValidationResult{
-				crb: &placementv1beta1.ClusterResourceBinding{
+				binding: &placementv1beta1.ClusterResourceBinding{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:       testBindingName,
 						Generation: 1,
@@ -558,8 +561,8 @@ func TestExecuteEviction(t *testing.T) {
 		{
 			name: "pdb not found - eviction executed",
 			validationResult: &evictionValidationResult{
-				crb: availableBinding,
-				crp: &placementv1beta1.ClusterResourcePlacement{
+				binding: availableBinding,
+				placement: &placementv1beta1.ClusterResourcePlacement{
 					ObjectMeta: metav1.ObjectMeta{
 						Name: testCRPName,
 					},
@@ -578,8 +581,8 @@ func TestExecuteEviction(t *testing.T) {
 		{
 			name: "PickAll CRP, Misconfigured PDB MaxUnavailable specified - eviction not executed",
 			validationResult: &evictionValidationResult{
-				crb: availableBinding,
-				crp: ptr.To(buildTestPickAllCRP(testCRPName)),
+				binding:   availableBinding,
+				placement: ptr.To(buildTestPickAllCRP(testCRPName)),
 			},
 			eviction: buildTestEviction(testEvictionName, testCRPName, testClusterName),
 			pdb: &placementv1beta1.ClusterResourcePlacementDisruptionBudget{
@@ -605,8 +608,8 @@ func TestExecuteEviction(t *testing.T) {
 		{
 			name: "PickAll CRP, Misconfigured PDB MinAvailable specified as percentage - eviction not executed",
 			validationResult: &evictionValidationResult{
-				crb: availableBinding,
-				crp: ptr.To(buildTestPickAllCRP(testCRPName)),
+				binding:   availableBinding,
+				placement: ptr.To(buildTestPickAllCRP(testCRPName)),
 			},
 			eviction: buildTestEviction(testEvictionName, testCRPName, testClusterName),
 			pdb: &placementv1beta1.ClusterResourcePlacementDisruptionBudget{
@@ -629,6 +632,40 @@ func TestExecuteEviction(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "available binding of RP - eviction executed",
+			validationResult: &evictionValidationResult{
+				placement: &placementv1beta1.ResourcePlacement{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      testRPName,
+						Namespace: testNamespace,
+					},
+					Spec: placementv1beta1.PlacementSpec{
+						Policy: &placementv1beta1.PlacementPolicy{
+							PlacementType: placementv1beta1.PickAllPlacementType,
+						},
+					},
+				},
+				binding: &placementv1beta1.ResourceBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       testBindingName,
+						Namespace:  testNamespace,
+						Generation: 1,
+					},
+					Spec:   availableBinding.Spec,
+					Status: availableBinding.Status,
+				},
+			},
+			eviction: buildTestResourcePlacementEviction(testEvictionName, testRPName, testClusterName),
+			wantEvictionExecutedCondition: &metav1.Condition{
+				Type:               string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 1,
+				Reason:             condition.ClusterResourcePlacementEvictionExecutedReason,
+				Message:            condition.EvictionAllowedNoDisruptionBudgetForRPMessage,
+			},
+			wantErr: nil,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -661,6 +698,123 @@ func TestExecuteEviction(t *testing.T) {
 	}
 }
 
+func TestValidateResourcePlacementEviction(t *testing.T) {
+	testRP := &placementv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testRPName,
+			Namespace: testNamespace,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+			},
+		},
+	}
+	testBinding := &placementv1beta1.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testBindingName,
+			Namespace: testNamespace,
+			Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: testRPName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: testClusterName,
+		},
+	}
+	tests := []struct {
+		name                         string
+		rp                           *placementv1beta1.ResourcePlacement
+		bindings                     []*placementv1beta1.ResourceBinding
+		wantValidationResult         *evictionValidationResult
+		wantEvictionInvalidCondition *metav1.Condition
+	}{
+		{
+			name: "invalid eviction - RP not found",
+			wantValidationResult: &evictionValidationResult{
+				isValid: false,
+			},
+			wantEvictionInvalidCondition: &metav1.Condition{
+				Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             condition.ClusterResourcePlacementEvictionInvalidReason,
+				Message:            condition.EvictionInvalidMissingRPMessage,
+			},
+		},
+		{
+			name: "invalid eviction - pickFixed RP",
+			rp: &placementv1beta1.ResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      testRPName,
+					Namespace: testNamespace,
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickFixedPlacementType,
+					},
+				},
+			},
+			wantValidationResult: &evictionValidationResult{
+				isValid: false,
+			},
+			wantEvictionInvalidCondition: &metav1.Condition{
+				Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             condition.ClusterResourcePlacementEvictionInvalidReason,
+				Message:            condition.EvictionInvalidPickFixedRPMessage,
+			},
+		},
+		{
+			name:     "valid eviction",
+			rp:       testRP,
+			bindings: []*placementv1beta1.ResourceBinding{testBinding},
+			wantValidationResult: &evictionValidationResult{
+				isValid:   true,
+				placement: testRP,
+				binding:   testBinding,
+				bindings:  []placementv1beta1.BindingObj{testBinding},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var objects []client.Object
+			if tc.rp != nil {
+				objects = append(objects, tc.rp.DeepCopy())
+			}
+			for i := range tc.bindings {
+				objects = append(objects, tc.bindings[i].DeepCopy())
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(objects...).
+				Build()
+			r := Reconciler{
+				Client: fakeClient,
+			}
+			eviction := buildTestResourcePlacementEviction(testEvictionName, testRPName, testClusterName)
+			gotValidationResult, err := r.validateEviction(ctx, eviction)
+			if err != nil {
+				t.Fatalf("validateEviction() = %v, want no error", err)
+			}
+
+			if tc.wantValidationResult.placement != nil {
+				tc.wantValidationResult.placement = tc.wantValidationResult.placement.DeepCopyObject().(placementv1beta1.PlacementObj)
+				defaulter.SetPlacementDefaults(tc.wantValidationResult.placement)
+			}
+			if diff := cmp.Diff(tc.wantValidationResult, gotValidationResult, validationResultCmpOptions...); diff != "" {
+				t.Errorf("validateEviction() validation result mismatch (-want, +got):\n%s", diff)
+			}
+			gotInvalidCondition := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeValid))
+			if diff := cmp.Diff(tc.wantEvictionInvalidCondition, gotInvalidCondition, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("validateEviction() eviction invalid condition mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIsEvictionAllowed(t *testing.T) {
 	availableCondition := metav1.Condition{
 		Type:               string(placementv1beta1.ResourceBindingAvailable),
@@ -1476,7 +1630,7 @@ func TestIsEvictionAllowed(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			gotAllowed, gotAvailableBindings := isEvictionAllowed(tc.bindings, tc.crp, tc.disruptionBudget)
+			gotAllowed, gotAvailableBindings := isEvictionAllowed(controller.ConvertCRBObjsToBindingObjs(tc.bindings), tc.crp, tc.disruptionBudget)
 			if gotAllowed != tc.wantAllowed {
 				t.Errorf("isEvictionAllowed test `%s` failed gotAllowed: %v, wantAllowed: %v", tc.name, gotAllowed, tc.wantAllowed)
 			}
@@ -1557,6 +1711,20 @@ func buildTestPickAllCRP(crpName string) placementv1beta1.ClusterResourcePlaceme
 	}
 }
 
+func buildTestResourcePlacementEviction(evictionName, placementName, clusterName string) *placementv1beta1.ResourcePlacementEviction {
+	return &placementv1beta1.ResourcePlacementEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       evictionName,
+			Namespace:  testNamespace,
+			Generation: 1,
+		},
+		Spec: placementv1beta1.PlacementEvictionSpec{
+			PlacementName: placementName,
+			ClusterName:   clusterName,
+		},
+	}
+}
+
 func serviceScheme(t *testing.T) *runtime.Scheme {
 	scheme := runtime.NewScheme()
 	if err := placementv1beta1.AddToScheme(scheme); err != nil {
@@ -0,0 +1,311 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package descheduler features a controller that periodically re-evaluates the scheduling decisions
+// of ClusterResourcePlacements of the PickN placement type, and moves their resources off clusters
+// that are no longer the best fit.
+package descheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+)
+
+// Reconciler re-evaluates the bindings of a ClusterResourcePlacement that has opted in for
+// descheduling, and evicts a binding when a much better cluster exists.
+//
+// The descheduler never moves resources by itself; instead, it creates a
+// ClusterResourcePlacementEviction object for the binding, so that the eviction is subject to
+// the disruption budget of the placement. Once the binding is evicted, the scheduler picks a
+// new cluster for the placement, which, per the evaluation, is a better one.
+//
+// To avoid flip-flopping, the descheduler only
+//   - evaluates placements that are in a steady state, i.e., all of its bindings are bound per
+//     the latest scheduling policy;
+//   - moves bindings that have existed for a while (MinBindingAge);
+//   - moves a binding when the best alternative scores higher than the current cluster by a
+//     margin (MinWeightedScoreGain), per the same weighted score the scheduler ranks clusters
+//     with; and
+//   - issues at most one eviction per placement in a period (Cooldown).
+type Reconciler struct {
+	client.Client
+
+	// DefaultFramework is the scheduler framework that runs the default scheduling profile.
+	DefaultFramework framework.Framework
+	// ProfileFrameworks are the scheduler frameworks that run the other scheduling profiles, keyed
+	// by the profile names.
+	//
+	// Note that the frameworks must be set up with the same profiles as the scheduler, so that the
+	// descheduler and the scheduler agree on which clusters are better.
+	ProfileFrameworks map[string]framework.Framework
+
+	// Interval is how often a placement is re-evaluated.
+	Interval time.Duration
+	// MinBindingAge is how long a binding must have existed before it can be moved.
+	MinBindingAge time.Duration
+	// Cooldown is the minimum amount of time between two evictions the descheduler issues for the
+	// same placement; evictions that have completed for longer than this period are cleaned up.
+	Cooldown time.Duration
+	// MinWeightedScoreGain is the minimum weighted score gain a better cluster must offer for a
+	// binding to be moved.
+	MinWeightedScoreGain int64
+	// SchedulerName is the name of the scheduler the descheduler works with; placements scheduled
+	// by other schedulers are left alone. An empty name stands for the default scheduler.
+	SchedulerName string
+}
+
+// Reconcile re-evaluates a ClusterResourcePlacement.
+func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtime.Result, error) {
+	startTime := time.Now()
+	crpName := req.Name
+	klog.V(2).InfoS("Descheduler reconciliation starts", "clusterResourcePlacement", crpName)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Descheduler reconciliation ends", "clusterResourcePlacement", crpName, "latency", latency)
+	}()
+
+	crp := &placementv1beta1.ClusterResourcePlacement{}
+	if err := r.Client.Get(ctx, req.NamespacedName, crp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return runtime.Result{}, nil
+		}
+		return runtime.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if !isDeschedulingEnabled(crp) || !controller.IsPlacementScheduledBy(crp, r.SchedulerName) {
+		return runtime.Result{}, nil
+	}
+
+	// Clean up old evictions and check if the placement is cooling down.
+	lastEvictionTime, inFlight, err := r.cleanUpEvictions(ctx, crp.Name)
+	if err != nil {
+		return runtime.Result{}, err
+	}
+	if inFlight {
+		klog.V(2).InfoS("An eviction issued by the descheduler is still in progress", "clusterResourcePlacement", crpName)
+		return runtime.Result{RequeueAfter: r.Interval}, nil
+	}
+	if wait := lastEvictionTime.Add(r.Cooldown).Sub(startTime); wait > 0 {
+		klog.V(2).InfoS("The placement is cooling down from a previous eviction", "clusterResourcePlacement", crpName, "wait", wait)
+		return runtime.Result{RequeueAfter: wait}, nil
+	}
+
+	policySnapshot, err := r.lookupLatestPolicySnapshot(ctx, crp.Name)
+	if err != nil || policySnapshot == nil {
+		return runtime.Result{RequeueAfter: r.Interval}, err
+	}
+	fw, err := r.frameworkFor(policySnapshot)
+	if err != nil {
+		klog.ErrorS(err, "Failed to find the scheduler framework for the placement", "clusterResourcePlacement", crpName)
+		return runtime.Result{RequeueAfter: r.Interval}, nil
+	}
+
+	evaluations, err := fw.RunEvaluationCycleFor(ctx, queue.PlacementKey(crp.Name), policySnapshot)
+	if err != nil {
+		klog.ErrorS(err, "Failed to evaluate the bindings of the placement", "clusterResourcePlacement", crpName)
+		return runtime.Result{}, err
+	}
+
+	eval := r.pickBindingToMove(evaluations, startTime)
+	if eval == nil {
+		return runtime.Result{RequeueAfter: r.Interval}, nil
+	}
+	if err := r.createEviction(ctx, crp.Name, eval); err != nil {
+		return runtime.Result{}, err
+	}
+	return runtime.Result{RequeueAfter: r.Interval}, nil
+}
+
+// isDeschedulingEnabled returns if a placement has opted in for descheduling.
+func isDeschedulingEnabled(crp *placementv1beta1.ClusterResourcePlacement) bool {
+	if crp.DeletionTimestamp != nil || crp.Spec.Policy == nil || crp.Spec.Policy.PlacementType != placementv1beta1.PickNPlacementType {
+		return false
+	}
+	return crp.GetAnnotations()[placementv1beta1.DeschedulingAnnotation] == "true"
+}
+
+// cleanUpEvictions deletes the evictions the descheduler has issued for a placement which have
+// completed for longer than the cooldown period; it returns the creation time of the latest
+// eviction, and whether any of the evictions is still in progress.
+func (r *Reconciler) cleanUpEvictions(ctx context.Context, crpName string) (lastEvictionTime time.Time, inFlight bool, err error) {
+	evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+	if err := r.Client.List(ctx, evictionList, client.MatchingLabels{placementv1beta1.DeschedulerPlacementLabel: crpName}); err != nil {
+		return time.Time{}, false, controller.NewAPIServerError(true, err)
+	}
+
+	for idx := range evictionList.Items {
+		eviction := &evictionList.Items[idx]
+		createdAt := eviction.CreationTimestamp.Time
+		if createdAt.After(lastEvictionTime) {
+			lastEvictionTime = createdAt
+		}
+		if !evictionutils.IsEvictionInTerminalState(eviction) {
+			inFlight = true
+			continue
+		}
+		if time.Since(createdAt) < r.Cooldown {
+			// Keep recently completed evictions around for auditing purposes.
+			continue
+		}
+		if err := r.Client.Delete(ctx, eviction); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete a completed eviction", "clusterResourcePlacementEviction", klog.KObj(eviction))
+			return time.Time{}, false, controller.NewAPIServerError(false, err)
+		}
+	}
+	return lastEvictionTime, inFlight, nil
+}
+
+// lookupLatestPolicySnapshot returns the latest policy snapshot of a placement; it returns nil if
+// the placement does not have exactly one latest policy snapshot, which is usually a transient
+// state the scheduler will resolve.
+func (r *Reconciler) lookupLatestPolicySnapshot(ctx context.Context, crpName string) (placementv1beta1.PolicySnapshotObj, error) {
+	policySnapshotList, err := controller.FetchLatestPolicySnapshot(ctx, r.Client, types.NamespacedName{Name: crpName})
+	if err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	policySnapshots := policySnapshotList.GetPolicySnapshotObjs()
+	if len(policySnapshots) != 1 {
+		klog.V(2).InfoS("The placement does not have exactly one latest policy snapshot", "clusterResourcePlacement", crpName, "count", len(policySnapshots))
+		return nil, nil
+	}
+	return policySnapshots[0], nil
+}
+
+// frameworkFor returns the scheduler framework to use for a policy snapshot, per the scheduling
+// profile specified in the policy.
+func (r *Reconciler) frameworkFor(policySnapshot placementv1beta1.PolicySnapshotObj) (framework.Framework, error) {
+	policy := policySnapshot.GetPolicySnapshotSpec().Policy
+	if policy == nil || len(policy.SchedulingProfile) == 0 {
+		return r.DefaultFramework, nil
+	}
+
+	fw, ok := r.ProfileFrameworks[policy.SchedulingProfile]
+	if !ok {
+		return nil, fmt.Errorf("scheduling profile %q is not found", policy.SchedulingProfile)
+	}
+	return fw, nil
+}
+
+// pickBindingToMove picks the binding that benefits the most from moving, if any.
+//
+// A binding whose target cluster no longer passes the Filter stage is always preferred; otherwise
+// the binding with the largest score gain wins. Ties are broken by the binding names.
+func (r *Reconciler) pickBindingToMove(evaluations []*framework.BindingEvaluation, now time.Time) *framework.BindingEvaluation {
+	candidates := make([]*framework.BindingEvaluation, 0, len(evaluations))
+	for _, eval := range evaluations {
+		if now.Sub(eval.Binding.GetCreationTimestamp().Time) < r.MinBindingAge {
+			continue
+		}
+		if r.shouldMove(eval) {
+			candidates = append(candidates, eval)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if (a.Current == nil) != (b.Current == nil) {
+			return a.Current == nil
+		}
+		if a.Current != nil {
+			if gainA, gainB := weightedScoreGainOf(a), weightedScoreGainOf(b); gainA != gainB {
+				return gainA > gainB
+			}
+		}
+		return a.Binding.GetName() < b.Binding.GetName()
+	})
+	return candidates[0]
+}
+
+// shouldMove returns if the best alternative cluster of a binding is much better than the
+// current one.
+func (r *Reconciler) shouldMove(eval *framework.BindingEvaluation) bool {
+	switch {
+	case eval.Best == nil:
+		// There is no alternative.
+		return false
+	case eval.Current == nil:
+		// The current cluster no longer passes the Filter stage.
+		return true
+	}
+
+	// The weighted score is what the scheduler ranks clusters by first; comparing clusters by any
+	// other score might have the descheduler move a binding to a cluster the scheduler does not
+	// prefer, which the scheduler would then move back.
+	gain := weightedScoreGainOf(eval)
+	return gain > 0 && gain >= r.MinWeightedScoreGain
+}
+
+// weightedScoreGainOf returns how much higher the best alternative cluster of a binding scores
+// than the current one, in weighted scores.
+//
+// Note that this will panic if the binding has no current or best alternative score.
+func weightedScoreGainOf(eval *framework.BindingEvaluation) int64 {
+	return eval.Best.Score.WeightedScore - eval.Current.WeightedScore
+}
+
+// createEviction creates an eviction for a binding.
+func (r *Reconciler) createEviction(ctx context.Context, crpName string, eval *framework.BindingEvaluation) error {
+	clusterName := eval.Binding.GetBindingSpec().TargetCluster
+	eviction := &placementv1beta1.ClusterResourcePlacementEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", crpName, clusterName),
+			Labels: map[string]string{
+				placementv1beta1.DeschedulerPlacementLabel: crpName,
+			},
+		},
+		Spec: placementv1beta1.PlacementEvictionSpec{
+			PlacementName: crpName,
+			ClusterName:   clusterName,
+		},
+	}
+	if err := r.Client.Create(ctx, eviction); err != nil {
+		klog.ErrorS(err, "Failed to create eviction", "clusterResourcePlacement", crpName, "cluster", clusterName)
+		return controller.NewAPIServerError(false, err)
+	}
+
+	bestCluster := eval.Best.Cluster.Name
+	klog.V(2).InfoS("Issued an eviction to move the placement to a better cluster",
+		"clusterResourcePlacement", crpName, "clusterResourcePlacementEviction", klog.KObj(eviction),
+		"cluster", clusterName, "currentScore", eval.Current, "betterCluster", bestCluster, "betterScore", eval.Best.Score)
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr runtime.Manager) error {
+	return runtime.NewControllerManagedBy(mgr).Named("descheduler").
+		For(&placementv1beta1.ClusterResourcePlacement{}, builder.WithPredicates(
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}))).
+		Complete(r)
+}
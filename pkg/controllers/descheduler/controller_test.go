@@ -0,0 +1,368 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package descheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+const (
+	testCRPName       = "test-crp"
+	testPolicyName    = "test-crp-1"
+	testClusterName   = "test-cluster"
+	altTestCluster    = "alt-test-cluster"
+	betterTestCluster = "better-test-cluster"
+)
+
+var (
+	now = time.Now()
+)
+
+// dummyFramework is a scheduler framework that returns canned evaluations.
+type dummyFramework struct {
+	framework.Framework
+
+	evaluations []*framework.BindingEvaluation
+}
+
+func (f *dummyFramework) RunEvaluationCycleFor(_ context.Context, _ queue.PlacementKey, _ placementv1beta1.PolicySnapshotObj) ([]*framework.BindingEvaluation, error) {
+	return f.evaluations, nil
+}
+
+func newTestReconciler(t *testing.T, fw framework.Framework, objs ...client.Object) *Reconciler {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	return &Reconciler{
+		Client:               fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		DefaultFramework:     fw,
+		Interval:             time.Minute * 5,
+		MinBindingAge:        time.Minute * 10,
+		Cooldown:             time.Minute * 30,
+		MinWeightedScoreGain: 10,
+	}
+}
+
+func newEvaluation(bindingName, clusterName string, age time.Duration, current *framework.ClusterScore, best *framework.ClusterScore) *framework.BindingEvaluation {
+	eval := &framework.BindingEvaluation{
+		Binding: &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              bindingName,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName,
+			},
+		},
+		Current: current,
+	}
+	if best != nil {
+		eval.Best = &framework.ScoredCluster{
+			Cluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: betterTestCluster}},
+			Score:   best,
+		}
+	}
+	return eval
+}
+
+func newCRP(annotations map[string]string) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        testCRPName,
+			Annotations: annotations,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+			},
+		},
+	}
+}
+
+func newEviction(name string, createdAt time.Time, executed bool) *placementv1beta1.ClusterResourcePlacementEviction {
+	eviction := &placementv1beta1.ClusterResourcePlacementEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Labels:            map[string]string{placementv1beta1.DeschedulerPlacementLabel: testCRPName},
+			CreationTimestamp: metav1.NewTime(createdAt),
+		},
+		Spec: placementv1beta1.PlacementEvictionSpec{
+			PlacementName: testCRPName,
+			ClusterName:   testClusterName,
+		},
+	}
+	if executed {
+		eviction.Status.Conditions = []metav1.Condition{
+			{
+				Type:   string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+				Status: metav1.ConditionTrue,
+			},
+		}
+	}
+	return eviction
+}
+
+// TestShouldMove tests the shouldMove method.
+func TestShouldMove(t *testing.T) {
+	r := newTestReconciler(t, nil)
+
+	testCases := []struct {
+		name    string
+		current *framework.ClusterScore
+		best    *framework.ClusterScore
+		want    bool
+	}{
+		{
+			name:    "no alternative",
+			current: &framework.ClusterScore{WeightedScore: 10},
+		},
+		{
+			name: "current cluster no longer fits",
+			best: &framework.ClusterScore{},
+			want: true,
+		},
+		{
+			name:    "weighted score gain above threshold",
+			current: &framework.ClusterScore{WeightedScore: 10},
+			best:    &framework.ClusterScore{WeightedScore: 20},
+			want:    true,
+		},
+		{
+			name:    "weighted score gain below threshold",
+			current: &framework.ClusterScore{WeightedScore: 10},
+			best:    &framework.ClusterScore{WeightedScore: 19},
+		},
+		{
+			// The scheduler ranks clusters by the weighted scores first; a better topology spread
+			// score alone does not make a cluster better.
+			name:    "better topology spread score, lower weighted score",
+			current: &framework.ClusterScore{TopologySpreadScore: -1, AffinityScore: 0, WeightedScore: 150},
+			best:    &framework.ClusterScore{TopologySpreadScore: 0, AffinityScore: 0, WeightedScore: 100},
+		},
+		{
+			name:    "worse topology spread and affinity scores, higher weighted score",
+			current: &framework.ClusterScore{TopologySpreadScore: 0, AffinityScore: 50, WeightedScore: 100},
+			best:    &framework.ClusterScore{TopologySpreadScore: -1, AffinityScore: 10, WeightedScore: 200},
+			want:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			eval := newEvaluation("binding", testClusterName, time.Hour, tc.current, tc.best)
+			if got := r.shouldMove(eval); got != tc.want {
+				t.Errorf("shouldMove() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestPickBindingToMove tests the pickBindingToMove method.
+func TestPickBindingToMove(t *testing.T) {
+	r := newTestReconciler(t, nil)
+
+	testCases := []struct {
+		name        string
+		evaluations []*framework.BindingEvaluation
+		wantBinding string
+	}{
+		{
+			name: "no binding to move",
+			evaluations: []*framework.BindingEvaluation{
+				newEvaluation("binding-1", testClusterName, time.Hour, &framework.ClusterScore{WeightedScore: 10}, &framework.ClusterScore{WeightedScore: 15}),
+			},
+		},
+		{
+			name: "binding too young to move",
+			evaluations: []*framework.BindingEvaluation{
+				newEvaluation("binding-1", testClusterName, time.Minute, &framework.ClusterScore{WeightedScore: 10}, &framework.ClusterScore{WeightedScore: 50}),
+			},
+		},
+		{
+			name: "largest gain wins",
+			evaluations: []*framework.BindingEvaluation{
+				newEvaluation("binding-1", testClusterName, time.Hour, &framework.ClusterScore{WeightedScore: 10}, &framework.ClusterScore{WeightedScore: 30}),
+				newEvaluation("binding-2", altTestCluster, time.Hour, &framework.ClusterScore{WeightedScore: 0}, &framework.ClusterScore{WeightedScore: 30}),
+			},
+			wantBinding: "binding-2",
+		},
+		{
+			name: "largest weighted score gain wins, regardless of raw scores",
+			evaluations: []*framework.BindingEvaluation{
+				newEvaluation("binding-1", testClusterName, time.Hour,
+					&framework.ClusterScore{TopologySpreadScore: -2, WeightedScore: 100},
+					&framework.ClusterScore{TopologySpreadScore: 0, WeightedScore: 120}),
+				newEvaluation("binding-2", altTestCluster, time.Hour,
+					&framework.ClusterScore{AffinityScore: 50, WeightedScore: 100},
+					&framework.ClusterScore{AffinityScore: 10, WeightedScore: 200}),
+			},
+			wantBinding: "binding-2",
+		},
+		{
+			name: "cluster that no longer fits wins",
+			evaluations: []*framework.BindingEvaluation{
+				newEvaluation("binding-1", testClusterName, time.Hour, &framework.ClusterScore{WeightedScore: 0}, &framework.ClusterScore{WeightedScore: 30}),
+				newEvaluation("binding-2", altTestCluster, time.Hour, nil, &framework.ClusterScore{WeightedScore: 30}),
+			},
+			wantBinding: "binding-2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := r.pickBindingToMove(tc.evaluations, now)
+			gotBinding := ""
+			if got != nil {
+				gotBinding = got.Binding.GetName()
+			}
+			if gotBinding != tc.wantBinding {
+				t.Errorf("pickBindingToMove() = %s, want %s", gotBinding, tc.wantBinding)
+			}
+		})
+	}
+}
+
+// TestReconcile tests the Reconcile method.
+func TestReconcile(t *testing.T) {
+	policySnapshot := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testPolicyName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: testCRPName,
+				placementv1beta1.IsLatestSnapshotLabel:  "true",
+			},
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+			},
+		},
+	}
+	movable := []*framework.BindingEvaluation{
+		newEvaluation("binding-1", testClusterName, time.Hour, &framework.ClusterScore{WeightedScore: 0}, &framework.ClusterScore{WeightedScore: 30}),
+	}
+	enabled := map[string]string{placementv1beta1.DeschedulingAnnotation: "true"}
+	customSchedulerCRP := newCRP(enabled)
+	customSchedulerCRP.Spec.SchedulerName = "custom-scheduler"
+
+	testCases := []struct {
+		name              string
+		objs              []client.Object
+		evaluations       []*framework.BindingEvaluation
+		wantEvictions     []string
+		wantNewEviction   bool
+		wantRequeueBefore time.Duration
+	}{
+		{
+			name:        "not opted in",
+			objs:        []client.Object{newCRP(nil), policySnapshot},
+			evaluations: movable,
+		},
+		{
+			name:        "opted in, scheduled by another scheduler",
+			objs:        []client.Object{customSchedulerCRP, policySnapshot},
+			evaluations: movable,
+		},
+		{
+			name:            "opted in, binding moved",
+			objs:            []client.Object{newCRP(enabled), policySnapshot},
+			evaluations:     movable,
+			wantNewEviction: true,
+		},
+		{
+			name: "eviction in progress",
+			objs: []client.Object{
+				newCRP(enabled), policySnapshot,
+				newEviction("in-progress", now.Add(-time.Hour), false),
+			},
+			evaluations:   movable,
+			wantEvictions: []string{"in-progress"},
+		},
+		{
+			name: "cooling down",
+			objs: []client.Object{
+				newCRP(enabled), policySnapshot,
+				newEviction("recent", now.Add(-time.Minute), true),
+			},
+			evaluations:       movable,
+			wantEvictions:     []string{"recent"},
+			wantRequeueBefore: time.Minute * 30,
+		},
+		{
+			name: "old evictions cleaned up",
+			objs: []client.Object{
+				newCRP(enabled), policySnapshot,
+				newEviction("old", now.Add(-time.Hour), true),
+			},
+			evaluations:     movable,
+			wantNewEviction: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestReconciler(t, &dummyFramework{evaluations: tc.evaluations}, tc.objs...)
+			ctx := context.Background()
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: testCRPName}})
+			if err != nil {
+				t.Fatalf("Reconcile() = %v, want no error", err)
+			}
+			if tc.wantRequeueBefore > 0 && (res.RequeueAfter <= 0 || res.RequeueAfter > tc.wantRequeueBefore) {
+				t.Errorf("Reconcile() requeueAfter = %v, want in (0, %v]", res.RequeueAfter, tc.wantRequeueBefore)
+			}
+
+			evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+			if err := r.Client.List(ctx, evictionList); err != nil {
+				t.Fatalf("failed to list evictions: %v", err)
+			}
+			var gotEvictions []string
+			newEvictions := 0
+			for _, eviction := range evictionList.Items {
+				if eviction.GenerateName != "" {
+					newEvictions++
+					if eviction.Spec.ClusterName != testClusterName || eviction.Spec.PlacementName != testCRPName {
+						t.Errorf("new eviction spec = %+v, want eviction targeting cluster %s", eviction.Spec, testClusterName)
+					}
+					continue
+				}
+				gotEvictions = append(gotEvictions, eviction.Name)
+			}
+			if diff := cmp.Diff(gotEvictions, tc.wantEvictions); diff != "" {
+				t.Errorf("existing evictions diff (-got, +want): %s", diff)
+			}
+			if (newEvictions == 1) != tc.wantNewEviction || newEvictions > 1 {
+				t.Errorf("created %d evictions, want new eviction: %t", newEvictions, tc.wantNewEviction)
+			}
+		})
+	}
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 )
 
 const (
@@ -116,6 +117,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 
 	// Copy status from InternalMemberCluster to MemberCluster.
 	r.syncInternalMemberClusterStatus(currentIMC, &mc)
+	requeueAfter := syncMaintenanceWindowStatus(&mc, time.Now())
 	if err := r.updateMemberClusterStatus(ctx, &mc); err != nil {
 		if apierrors.IsConflict(err) {
 			klog.V(2).InfoS("Failed to update status due to conflicts", "memberCluster", mcObjRef)
@@ -125,7 +127,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		return runtime.Result{}, client.IgnoreNotFound(err)
 	}
 
-	return runtime.Result{}, nil
+	// Requeue at the next maintenance window boundary so that the status stays up to date.
+	return runtime.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // handleDelete handles the delete event of the member cluster, makes sure the agent has finished leaving the fleet first and
@@ -548,6 +551,26 @@ func (r *Reconciler) syncInternalMemberClusterStatus(imc *clusterv1beta1.Interna
 	mc.Status.Properties = imc.Status.Properties
 }
 
+// syncMaintenanceWindowStatus refreshes the current and next maintenance windows in the member cluster status.
+// It returns the time until the status needs to be refreshed again, or zero if there is no upcoming change.
+func syncMaintenanceWindowStatus(mc *clusterv1beta1.MemberCluster, now time.Time) time.Duration {
+	mc.Status.CurrentMaintenanceWindow = maintenancewindow.Current(mc.Spec.MaintenanceWindows, now)
+	mc.Status.NextMaintenanceWindow = maintenancewindow.Next(mc.Spec.MaintenanceWindows, now)
+
+	var boundary time.Time
+	if current := mc.Status.CurrentMaintenanceWindow; current != nil {
+		boundary = current.End.Time
+	}
+	if next := mc.Status.NextMaintenanceWindow; next != nil && (boundary.IsZero() || next.Start.Time.Before(boundary)) {
+		boundary = next.Start.Time
+	}
+	if boundary.IsZero() {
+		return 0
+	}
+	// Wait for at least a second to avoid a tight requeue loop right at the boundary.
+	return max(boundary.Sub(now), time.Second)
+}
+
 // updateMemberClusterStatus is used to update member cluster status.
 func (r *Reconciler) updateMemberClusterStatus(ctx context.Context, mc *clusterv1beta1.MemberCluster) error {
 	joined := condition.IsConditionStatusTrue(meta.FindStatusCondition(mc.Status.Conditions, string(clusterv1beta1.ConditionTypeMemberClusterJoined)), mc.Generation)
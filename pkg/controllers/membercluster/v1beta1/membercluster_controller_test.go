@@ -1791,3 +1791,60 @@ func TestHandleDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestSyncMaintenanceWindowStatus(t *testing.T) {
+	now := time.Date(2025, 6, 7, 2, 30, 0, 0, time.UTC)
+	absStart := metav1.NewTime(time.Date(2025, 6, 7, 4, 0, 0, 0, time.UTC))
+	absEnd := metav1.NewTime(time.Date(2025, 6, 7, 5, 0, 0, 0, time.UTC))
+	tests := map[string]struct {
+		windows          []clusterv1beta1.MaintenanceWindow
+		wantStatus       clusterv1beta1.MemberClusterStatus
+		wantRequeueAfter time.Duration
+	}{
+		"no maintenance windows": {
+			wantStatus:       clusterv1beta1.MemberClusterStatus{},
+			wantRequeueAfter: 0,
+		},
+		"active window requeues at its end": {
+			windows: []clusterv1beta1.MaintenanceWindow{
+				{Name: "nightly", Schedule: "0 2 * * *", Duration: &metav1.Duration{Duration: time.Hour}},
+			},
+			wantStatus: clusterv1beta1.MemberClusterStatus{
+				CurrentMaintenanceWindow: &clusterv1beta1.MaintenanceWindowOccurrence{
+					Name:  "nightly",
+					Start: metav1.NewTime(time.Date(2025, 6, 7, 2, 0, 0, 0, time.UTC)),
+					End:   metav1.NewTime(time.Date(2025, 6, 7, 3, 0, 0, 0, time.UTC)),
+				},
+				NextMaintenanceWindow: &clusterv1beta1.MaintenanceWindowOccurrence{
+					Name:  "nightly",
+					Start: metav1.NewTime(time.Date(2025, 6, 8, 2, 0, 0, 0, time.UTC)),
+					End:   metav1.NewTime(time.Date(2025, 6, 8, 3, 0, 0, 0, time.UTC)),
+				},
+			},
+			wantRequeueAfter: 30 * time.Minute,
+		},
+		"upcoming window requeues at its start": {
+			windows: []clusterv1beta1.MaintenanceWindow{
+				{Name: "once", Start: &absStart, End: &absEnd},
+			},
+			wantStatus: clusterv1beta1.MemberClusterStatus{
+				NextMaintenanceWindow: &clusterv1beta1.MaintenanceWindowOccurrence{Name: "once", Start: absStart, End: absEnd},
+			},
+			wantRequeueAfter: 90 * time.Minute,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mc := &clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{MaintenanceWindows: tc.windows},
+			}
+			gotRequeueAfter := syncMaintenanceWindowStatus(mc, now)
+			if gotRequeueAfter != tc.wantRequeueAfter {
+				t.Errorf("syncMaintenanceWindowStatus() = %v, want %v", gotRequeueAfter, tc.wantRequeueAfter)
+			}
+			if diff := cmp.Diff(tc.wantStatus, mc.Status); diff != "" {
+				t.Errorf("syncMaintenanceWindowStatus() status mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -1226,6 +1227,20 @@ func (r *Reconciler) determineRolloutStateForPlacementWithExternalRolloutStrateg
 }
 
 func buildScheduledCondition(placementObj fleetv1beta1.PlacementObj, latestSchedulingPolicySnapshot fleetv1beta1.PolicySnapshotObj) metav1.Condition {
+	if spec := placementObj.GetPlacementSpec(); spec.IsSchedulingGated() {
+		// The scheduler does not run any scheduling cycle for the placement until all the scheduling gates are removed.
+		gates := make([]string, 0, len(spec.SchedulingGates))
+		for i := range spec.SchedulingGates {
+			gates = append(gates, spec.SchedulingGates[i].Name)
+		}
+		return metav1.Condition{
+			Status:             metav1.ConditionUnknown,
+			Type:               getPlacementScheduledConditionType(placementObj),
+			Reason:             condition.SchedulingGatedReason,
+			Message:            fmt.Sprintf("Scheduling is blocked by scheduling gates: %s", strings.Join(gates, ", ")),
+			ObservedGeneration: placementObj.GetGeneration(),
+		}
+	}
 	scheduledCondition := latestSchedulingPolicySnapshot.GetCondition(string(fleetv1beta1.PolicySnapshotScheduled))
 
 	if scheduledCondition == nil ||
@@ -4578,3 +4578,92 @@ func TestShouldCreateNewResourceSnapshotNow(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildScheduledCondition(t *testing.T) {
+	crpGeneration := int64(25)
+	scheduledPolicySnapshot := &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       fmt.Sprintf(fleetv1beta1.PolicySnapshotNameFmt, testCRPName, 0),
+			Generation: 1,
+		},
+		Status: fleetv1beta1.SchedulingPolicySnapshotStatus{
+			ObservedCRPGeneration: crpGeneration,
+			Conditions: []metav1.Condition{
+				{
+					Status:             metav1.ConditionTrue,
+					Type:               string(fleetv1beta1.PolicySnapshotScheduled),
+					Reason:             "Scheduled",
+					Message:            "message",
+					ObservedGeneration: 1,
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name            string
+		schedulingGates []fleetv1beta1.PlacementSchedulingGate
+		policySnapshot  *fleetv1beta1.ClusterSchedulingPolicySnapshot
+		want            metav1.Condition
+	}{
+		{
+			name:           "scheduled",
+			policySnapshot: scheduledPolicySnapshot,
+			want: metav1.Condition{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ClusterResourcePlacementScheduledConditionType),
+				Reason:             "Scheduled",
+				Message:            "message",
+				ObservedGeneration: crpGeneration,
+			},
+		},
+		{
+			name: "scheduling has not completed",
+			policySnapshot: &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       fmt.Sprintf(fleetv1beta1.PolicySnapshotNameFmt, testCRPName, 0),
+					Generation: 1,
+				},
+			},
+			want: metav1.Condition{
+				Status:             metav1.ConditionUnknown,
+				Type:               string(fleetv1beta1.ClusterResourcePlacementScheduledConditionType),
+				Reason:             condition.SchedulingUnknownReason,
+				Message:            "Scheduling has not completed",
+				ObservedGeneration: crpGeneration,
+			},
+		},
+		{
+			name: "scheduling is gated",
+			schedulingGates: []fleetv1beta1.PlacementSchedulingGate{
+				{Name: "example.com/crd-installed"},
+				{Name: "example.com/approved"},
+			},
+			// The stale scheduled condition on the policy snapshot should be ignored.
+			policySnapshot: scheduledPolicySnapshot,
+			want: metav1.Condition{
+				Status:             metav1.ConditionUnknown,
+				Type:               string(fleetv1beta1.ClusterResourcePlacementScheduledConditionType),
+				Reason:             condition.SchedulingGatedReason,
+				Message:            "Scheduling is blocked by scheduling gates: example.com/crd-installed, example.com/approved",
+				ObservedGeneration: crpGeneration,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       testCRPName,
+					Generation: crpGeneration,
+				},
+				Spec: fleetv1beta1.PlacementSpec{
+					SchedulingGates: tc.schedulingGates,
+				},
+			}
+			got := buildScheduledCondition(crp, tc.policySnapshot)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("buildScheduledCondition() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -111,6 +111,8 @@ func determineExpectedPlacementAndResourcePlacementStatusCondType(placementObj f
 		return condition.CondTypesForApplyStrategies
 	case placementSpec.Strategy.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff:
 		return condition.CondTypesForReportDiffApplyStrategy
+	case placementSpec.Strategy.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeDryRun:
+		return condition.CondTypesForDryRunApplyStrategy
 	default:
 		return condition.CondTypesForApplyStrategies
 	}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementgroup features a controller that picks a common set of clusters for the members
+// of each PlacementGroup, on behalf of the scheduler.
+package placementgroup
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/membercluster"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// Reconciler picks a common set of clusters for all the members of a PlacementGroup, and commits
+// the decision in the status of the PlacementGroup.
+//
+// The decision is made once per change for the group as a whole; the scheduler then creates the
+// bindings of each member from the committed decision (the members are enqueued by the placement
+// group watcher of the scheduler when the status changes). As controller-runtime never reconciles
+// the same object concurrently, the status of a PlacementGroup has exactly one writer.
+type Reconciler struct {
+	client.Client
+
+	// Framework is the scheduler framework that makes the decisions for placement groups.
+	Framework framework.Framework
+	// SchedulerName is the name of the scheduler; a placement group is processed by the scheduler
+	// of its first member. An empty name stands for the default scheduler.
+	SchedulerName string
+	// EnableResourcePlacement indicates whether ResourcePlacements can be members of placement groups.
+	EnableResourcePlacement bool
+	// ClusterEligibilityChecker helps tell if a member cluster change is relevant to scheduling.
+	ClusterEligibilityChecker *clustereligibilitychecker.ClusterEligibilityChecker
+}
+
+// Reconcile picks a common set of clusters for all the members of a PlacementGroup.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	groupRef := klog.KRef(req.Namespace, req.Name)
+	startTime := time.Now()
+	klog.V(2).InfoS("PlacementGroup reconciliation starts", "placementGroup", groupRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("PlacementGroup reconciliation ends", "placementGroup", groupRef, "latency", latency)
+	}()
+
+	group := &placementv1beta1.PlacementGroup{}
+	if err := r.Client.Get(ctx, req.NamespacedName, group); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The members of a deleted placement group are enqueued by the placement group
+			// watcher of the scheduler.
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get placement group", "placementGroup", groupRef)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if group.DeletionTimestamp != nil {
+		return ctrl.Result{}, nil
+	}
+
+	firstMember := group.Spec.Members[0]
+	firstMemberKey := queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(firstMember.Namespace, firstMember.Name))
+	isScheduledBy, err := controller.IsPlacementKeyScheduledBy(ctx, r.Client, firstMemberKey, r.SchedulerName)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get the first member of placement group", "placementGroup", groupRef, "placement", firstMemberKey)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if !isScheduledBy {
+		// The placement group is processed by another scheduler; ignore it.
+		return ctrl.Result{}, nil
+	}
+
+	if err := r.Framework.RunPlacementGroupSchedulingCycleFor(ctx, group); err != nil {
+		klog.ErrorS(err, "Failed to run placement group scheduling cycle", "placementGroup", groupRef)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// mapPlacementToGroups maps a placement to requests of the placement groups it belongs to.
+func (r *Reconciler) mapPlacementToGroups(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.requestsForGroupsOf(ctx, obj.GetNamespace(), obj.GetName())
+}
+
+// mapPolicySnapshotToGroups maps a scheduling policy snapshot to requests of the placement groups
+// its placement belongs to.
+func (r *Reconciler) mapPolicySnapshotToGroups(ctx context.Context, obj client.Object) []reconcile.Request {
+	placementName := obj.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	if len(placementName) == 0 {
+		return nil
+	}
+	return r.requestsForGroupsOf(ctx, obj.GetNamespace(), placementName)
+}
+
+// mapClusterToGroups maps a member cluster to requests of all the placement groups, as a cluster
+// change may affect the decision of any of them.
+func (r *Reconciler) mapClusterToGroups(ctx context.Context, _ client.Object) []reconcile.Request {
+	return r.requestsForGroupsOf(ctx, "", "")
+}
+
+// requestsForGroupsOf returns requests of the placement groups the placement of the given namespace
+// and name belongs to; an empty name matches all the placement groups.
+func (r *Reconciler) requestsForGroupsOf(ctx context.Context, namespace, name string) []reconcile.Request {
+	groupList := &placementv1beta1.PlacementGroupList{}
+	if err := r.Client.List(ctx, groupList); err != nil {
+		klog.ErrorS(err, "Failed to list placement groups", "placement", klog.KRef(namespace, name))
+		return nil
+	}
+	var requests []reconcile.Request
+	for idx := range groupList.Items {
+		group := &groupList.Items[idx]
+		for _, member := range group.Spec.Members {
+			if len(name) == 0 || (member.Namespace == namespace && member.Name == name) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: group.Name}})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Status updates of placements and policy snapshots (including the ones the scheduler makes)
+	// do not affect the decisions for placement groups.
+	policySnapshotPredicate := predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{})
+	b := ctrl.NewControllerManagedBy(mgr).Named("placementgroup-scheduler").
+		For(&placementv1beta1.PlacementGroup{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&placementv1beta1.ClusterResourcePlacement{},
+			handler.EnqueueRequestsFromMapFunc(r.mapPlacementToGroups),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&placementv1beta1.ClusterSchedulingPolicySnapshot{},
+			handler.EnqueueRequestsFromMapFunc(r.mapPolicySnapshotToGroups),
+			builder.WithPredicates(policySnapshotPredicate)).
+		Watches(&clusterv1beta1.MemberCluster{},
+			handler.EnqueueRequestsFromMapFunc(r.mapClusterToGroups),
+			builder.WithPredicates(membercluster.NewSchedulingRelevantChangePredicate(r.ClusterEligibilityChecker)))
+	if r.EnableResourcePlacement {
+		b = b.Watches(&placementv1beta1.ResourcePlacement{},
+			handler.EnqueueRequestsFromMapFunc(r.mapPlacementToGroups),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+			Watches(&placementv1beta1.SchedulingPolicySnapshot{},
+				handler.EnqueueRequestsFromMapFunc(r.mapPolicySnapshotToGroups),
+				builder.WithPredicates(policySnapshotPredicate))
+	}
+	return b.Complete(r)
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	groupName    = "test-group"
+	altGroupName = "alt-test-group"
+	frontendName = "frontend"
+	backendName  = "backend"
+	appNamespace = "app"
+)
+
+// dummyFramework is a scheduler framework that records the placement groups it has scheduled.
+type dummyFramework struct {
+	framework.Framework
+
+	scheduledGroups []string
+}
+
+func (f *dummyFramework) RunPlacementGroupSchedulingCycleFor(_ context.Context, group *placementv1beta1.PlacementGroup) error {
+	f.scheduledGroups = append(f.scheduledGroups, group.Name)
+	return nil
+}
+
+func newTestReconciler(t *testing.T, fw framework.Framework, objs ...client.Object) *Reconciler {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	return &Reconciler{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		Framework: fw,
+	}
+}
+
+func newGroup(name string, members ...placementv1beta1.PlacementGroupMember) *placementv1beta1.PlacementGroup {
+	return &placementv1beta1.PlacementGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       placementv1beta1.PlacementGroupSpec{Members: members},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	members := []placementv1beta1.PlacementGroupMember{{Name: frontendName}, {Namespace: appNamespace, Name: backendName}}
+
+	tests := map[string]struct {
+		objs       []client.Object
+		wantGroups []string
+	}{
+		"group scheduled by the scheduler": {
+			objs: []client.Object{
+				newGroup(groupName, members...),
+				&placementv1beta1.ClusterResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: frontendName}},
+			},
+			wantGroups: []string{groupName},
+		},
+		"first member scheduled by another scheduler": {
+			objs: []client.Object{
+				newGroup(groupName, members...),
+				&placementv1beta1.ClusterResourcePlacement{
+					ObjectMeta: metav1.ObjectMeta{Name: frontendName},
+					Spec:       placementv1beta1.PlacementSpec{SchedulerName: "custom-scheduler"},
+				},
+			},
+		},
+		"group not found": {},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fw := &dummyFramework{}
+			r := newTestReconciler(t, fw, tt.objs...)
+			if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: groupName}}); err != nil {
+				t.Fatalf("Reconcile() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(tt.wantGroups, fw.scheduledGroups); diff != "" {
+				t.Errorf("Reconcile() scheduled groups mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMapToGroups(t *testing.T) {
+	r := newTestReconciler(t, &dummyFramework{},
+		newGroup(groupName, placementv1beta1.PlacementGroupMember{Name: frontendName}, placementv1beta1.PlacementGroupMember{Namespace: appNamespace, Name: backendName}),
+		newGroup(altGroupName, placementv1beta1.PlacementGroupMember{Name: backendName}),
+	)
+	groupRequest := reconcile.Request{NamespacedName: types.NamespacedName{Name: groupName}}
+	altGroupRequest := reconcile.Request{NamespacedName: types.NamespacedName{Name: altGroupName}}
+
+	tests := map[string]struct {
+		mapFunc func(context.Context, client.Object) []reconcile.Request
+		obj     client.Object
+		want    []reconcile.Request
+	}{
+		"ClusterResourcePlacement": {
+			mapFunc: r.mapPlacementToGroups,
+			obj:     &placementv1beta1.ClusterResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: backendName}},
+			want:    []reconcile.Request{altGroupRequest},
+		},
+		"ResourcePlacement": {
+			mapFunc: r.mapPlacementToGroups,
+			obj:     &placementv1beta1.ResourcePlacement{ObjectMeta: metav1.ObjectMeta{Namespace: appNamespace, Name: backendName}},
+			want:    []reconcile.Request{groupRequest},
+		},
+		"placement not in any group": {
+			mapFunc: r.mapPlacementToGroups,
+			obj:     &placementv1beta1.ClusterResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+		},
+		"policy snapshot": {
+			mapFunc: r.mapPolicySnapshotToGroups,
+			obj: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   frontendName + "-0",
+					Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: frontendName},
+				},
+			},
+			want: []reconcile.Request{groupRequest},
+		},
+		"policy snapshot without placement label": {
+			mapFunc: r.mapPolicySnapshotToGroups,
+			obj:     &placementv1beta1.ClusterSchedulingPolicySnapshot{ObjectMeta: metav1.ObjectMeta{Name: frontendName + "-0"}},
+		},
+		"member cluster": {
+			mapFunc: r.mapClusterToGroups,
+			want:    []reconcile.Request{altGroupRequest, groupRequest},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, tt.mapFunc(context.Background(), tt.obj)); diff != "" {
+				t.Errorf("map func mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementsimulation features a controller that runs simulated scheduling cycles for
+// PlacementSimulation objects.
+package placementsimulation
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/validator"
+)
+
+const (
+	// maxDecisionCount is the maximum number of simulated decisions kept in the status, as
+	// enforced by the API.
+	maxDecisionCount = 1000
+)
+
+// Reconciler runs a simulated scheduling cycle for a PlacementSimulation object, and reports the
+// outcome in its status.
+//
+// The simulation runs once per generation of the object; it never creates, updates, or deletes
+// any binding or member cluster.
+type Reconciler struct {
+	client.Client
+
+	// DefaultFramework is the scheduler framework that runs the default scheduling profile.
+	DefaultFramework framework.Framework
+	// ProfileFrameworks are the scheduler frameworks that run the other scheduling profiles, keyed
+	// by the profile names.
+	//
+	// Note that the frameworks must be set up with the same profiles as the scheduler, so that the
+	// simulation reflects what the scheduler would do.
+	ProfileFrameworks map[string]framework.Framework
+}
+
+// Reconcile runs a simulated scheduling cycle for a PlacementSimulation object.
+func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtime.Result, error) {
+	startTime := time.Now()
+	simName := req.Name
+	klog.V(2).InfoS("PlacementSimulation reconciliation starts", "placementSimulation", simName)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("PlacementSimulation reconciliation ends", "placementSimulation", simName, "latency", latency)
+	}()
+
+	sim := &placementv1beta1.PlacementSimulation{}
+	if err := r.Client.Get(ctx, req.NamespacedName, sim); err != nil {
+		if apierrors.IsNotFound(err) {
+			return runtime.Result{}, nil
+		}
+		return runtime.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if sim.DeletionTimestamp != nil || sim.Status.ObservedGeneration == sim.Generation {
+		// The simulation is being deleted, or has run for its current generation already.
+		return runtime.Result{}, nil
+	}
+
+	decisions, invalidMessage, err := r.simulate(ctx, sim)
+	if err != nil {
+		return runtime.Result{}, err
+	}
+
+	sim.Status.ObservedGeneration = sim.Generation
+	sim.Status.Decisions = nil
+	if len(invalidMessage) > 0 {
+		klog.V(2).InfoS("The placement simulation is invalid", "placementSimulation", simName, "message", invalidMessage)
+		sim.SetConditions(metav1.Condition{
+			Type:               string(placementv1beta1.PlacementSimulationConditionTypeCompleted),
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: sim.Generation,
+			Reason:             condition.PlacementSimulationInvalidReason,
+			Message:            invalidMessage,
+		})
+	} else {
+		selectedCount := 0
+		for _, d := range decisions {
+			if d.Selected {
+				selectedCount++
+			}
+		}
+		sim.Status.Decisions = toAPIDecisions(decisions)
+		sim.SetConditions(metav1.Condition{
+			Type:               string(placementv1beta1.PlacementSimulationConditionTypeCompleted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: sim.Generation,
+			Reason:             condition.PlacementSimulationCompletedReason,
+			Message:            fmt.Sprintf(condition.PlacementSimulationCompletedMessageFmt, selectedCount),
+		})
+	}
+
+	if err := r.Client.Status().Update(ctx, sim); err != nil {
+		klog.ErrorS(err, "Failed to update placement simulation status", "placementSimulation", simName)
+		return runtime.Result{}, controller.NewUpdateIgnoreConflictError(err)
+	}
+	return runtime.Result{}, nil
+}
+
+// simulate runs the simulation; it returns a non-empty message if the simulation is invalid.
+func (r *Reconciler) simulate(ctx context.Context, sim *placementv1beta1.PlacementSimulation) ([]*framework.SimulatedClusterDecision, string, error) {
+	if err := validator.ValidatePlacementSimulation(sim); err != nil {
+		return nil, err.Error(), nil
+	}
+
+	policy := sim.Spec.Policy
+	var placementKey queue.PlacementKey
+	if len(sim.Spec.PlacementName) > 0 {
+		crp := &placementv1beta1.ClusterResourcePlacement{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: sim.Spec.PlacementName}, crp); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, condition.PlacementSimulationInvalidMissingCRPMessage, nil
+			}
+			return nil, "", controller.NewAPIServerError(true, err)
+		}
+		placementKey = queue.PlacementKey(crp.Name)
+		if policy == nil {
+			policy = crp.Spec.Policy
+		}
+	}
+
+	fw := r.DefaultFramework
+	if policy != nil && len(policy.SchedulingProfile) > 0 {
+		var ok bool
+		if fw, ok = r.ProfileFrameworks[policy.SchedulingProfile]; !ok {
+			return nil, fmt.Sprintf(condition.PlacementSimulationInvalidMissingProfileMessageFmt, policy.SchedulingProfile), nil
+		}
+	}
+
+	clusters, missingCluster, err := r.collectClusters(ctx, sim.Spec.ClusterChanges)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(missingCluster) > 0 {
+		return nil, fmt.Sprintf(condition.PlacementSimulationInvalidMissingClusterMessageFmt, missingCluster), nil
+	}
+
+	decisions, err := fw.RunSimulationCycleFor(ctx, placementKey, buildPolicySnapshot(sim, policy), clusters)
+	if err != nil {
+		klog.ErrorS(err, "Failed to run simulation", "placementSimulation", klog.KObj(sim))
+		return nil, "", err
+	}
+	return decisions, "", nil
+}
+
+// buildPolicySnapshot builds an in-memory policy snapshot for the simulated policy; the snapshot
+// is never written to the API server.
+func buildPolicySnapshot(sim *placementv1beta1.PlacementSimulation, policy *placementv1beta1.PlacementPolicy) placementv1beta1.PolicySnapshotObj {
+	snapshot := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        sim.Name,
+			Annotations: map[string]string{},
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: policy.DeepCopy(),
+		},
+	}
+	if len(sim.Spec.PlacementName) > 0 {
+		snapshot.Labels = map[string]string{placementv1beta1.PlacementTrackingLabel: sim.Spec.PlacementName}
+	}
+	if policy != nil && policy.PlacementType == placementv1beta1.PickNPlacementType && policy.NumberOfClusters != nil {
+		snapshot.Annotations[placementv1beta1.NumberOfClustersAnnotation] = strconv.Itoa(int(*policy.NumberOfClusters))
+	}
+	return snapshot
+}
+
+// collectClusters lists all member clusters in the fleet, with the hypothetical changes applied;
+// it returns the name of the first cluster to change that cannot be found, if any.
+func (r *Reconciler) collectClusters(ctx context.Context, changes []placementv1beta1.SimulatedClusterChange) ([]clusterv1beta1.MemberCluster, string, error) {
+	clusterList := &clusterv1beta1.MemberClusterList{}
+	if err := r.Client.List(ctx, clusterList); err != nil {
+		return nil, "", controller.NewAPIServerError(true, err)
+	}
+
+	// The list is a copy of the cache already; it is safe to modify the items in place.
+	clusterIndex := make(map[string]int, len(clusterList.Items))
+	for idx := range clusterList.Items {
+		clusterIndex[clusterList.Items[idx].Name] = idx
+	}
+	for _, change := range changes {
+		idx, ok := clusterIndex[change.ClusterName]
+		if !ok {
+			return nil, change.ClusterName, nil
+		}
+		applyClusterChange(&clusterList.Items[idx], &change)
+	}
+	return clusterList.Items, "", nil
+}
+
+// applyClusterChange applies a hypothetical change to a member cluster.
+func applyClusterChange(cluster *clusterv1beta1.MemberCluster, change *placementv1beta1.SimulatedClusterChange) {
+	if len(change.Labels) > 0 {
+		labels := cluster.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string, len(change.Labels))
+		}
+		for k, v := range change.Labels {
+			labels[k] = v
+		}
+		cluster.SetLabels(labels)
+	}
+
+	cluster.Spec.Taints = append(cluster.Spec.Taints, change.Taints...)
+
+	if len(change.Properties) > 0 {
+		if cluster.Status.Properties == nil {
+			cluster.Status.Properties = make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(change.Properties))
+		}
+		for name, value := range change.Properties {
+			cluster.Status.Properties[name] = clusterv1beta1.PropertyValue{
+				Value:           value,
+				ObservationTime: metav1.Now(),
+			}
+		}
+	}
+}
+
+// toAPIDecisions converts the simulated decisions to their API representation; selected clusters
+// are listed first, and the list is truncated per the API limit.
+func toAPIDecisions(decisions []*framework.SimulatedClusterDecision) []placementv1beta1.SimulatedClusterDecision {
+	apiDecisions := make([]placementv1beta1.SimulatedClusterDecision, 0, len(decisions))
+	for _, selected := range []bool{true, false} {
+		for _, d := range decisions {
+			if d.Selected != selected || len(apiDecisions) == maxDecisionCount {
+				continue
+			}
+			apiDecisions = append(apiDecisions, toAPIDecision(d))
+		}
+	}
+	return apiDecisions
+}
+
+// toAPIDecision converts a simulated decision to its API representation.
+func toAPIDecision(d *framework.SimulatedClusterDecision) placementv1beta1.SimulatedClusterDecision {
+	apiDecision := placementv1beta1.SimulatedClusterDecision{
+		ClusterName: d.ClusterName,
+		Selected:    d.Selected,
+		Reason:      d.Reason,
+	}
+	if d.Score != nil {
+		apiDecision.ClusterScore = &placementv1beta1.ClusterScore{
+			AffinityScore:       ptr.To(d.Score.AffinityScore),
+			TopologySpreadScore: ptr.To(d.Score.TopologySpreadScore),
+			WeightedScore:       ptr.To(d.Score.WeightedScore),
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(d.PluginScores)) {
+		score := d.PluginScores[name]
+		apiDecision.PluginScores = append(apiDecision.PluginScores, placementv1beta1.PluginScore{
+			Name: name,
+			ClusterScore: placementv1beta1.ClusterScore{
+				AffinityScore:       ptr.To(score.AffinityScore),
+				TopologySpreadScore: ptr.To(score.TopologySpreadScore),
+				WeightedScore:       ptr.To(score.WeightedScore),
+			},
+		})
+	}
+	for _, name := range slices.Sorted(maps.Keys(d.ExtenderScores)) {
+		apiDecision.PluginScores = append(apiDecision.PluginScores, placementv1beta1.PluginScore{
+			Name: name,
+			ClusterScore: placementv1beta1.ClusterScore{
+				AffinityScore: ptr.To(d.ExtenderScores[name]),
+				WeightedScore: ptr.To(int64(d.ExtenderScores[name])),
+			},
+		})
+	}
+	return apiDecision
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr runtime.Manager) error {
+	return runtime.NewControllerManagedBy(mgr).Named("placementsimulation-controller").
+		For(&placementv1beta1.PlacementSimulation{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
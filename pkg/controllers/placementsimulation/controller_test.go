@@ -0,0 +1,332 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementsimulation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	testSimName     = "test-sim"
+	testCRPName     = "test-crp"
+	testClusterName = "test-cluster"
+	altTestCluster  = "alt-test-cluster"
+)
+
+// dummyFramework is a scheduler framework that returns canned simulated decisions, and records
+// the arguments it has been called with.
+type dummyFramework struct {
+	framework.Framework
+
+	decisions []*framework.SimulatedClusterDecision
+
+	gotPlacementKey queue.PlacementKey
+	gotPolicy       placementv1beta1.PolicySnapshotObj
+	gotClusters     []clusterv1beta1.MemberCluster
+}
+
+func (f *dummyFramework) RunSimulationCycleFor(_ context.Context, placementKey queue.PlacementKey, policy placementv1beta1.PolicySnapshotObj, clusters []clusterv1beta1.MemberCluster) ([]*framework.SimulatedClusterDecision, error) {
+	f.gotPlacementKey = placementKey
+	f.gotPolicy = policy
+	f.gotClusters = clusters
+	return f.decisions, nil
+}
+
+func newTestReconciler(t *testing.T, fw framework.Framework, objs ...client.Object) *Reconciler {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add cluster v1beta1 scheme: %v", err)
+	}
+	return &Reconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&placementv1beta1.PlacementSimulation{}).
+			Build(),
+		DefaultFramework: fw,
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	clusters := []client.Object{
+		&clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   testClusterName,
+				Labels: map[string]string{"region": "west"},
+			},
+		},
+		&clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: altTestCluster}},
+	}
+	crp := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: testCRPName},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: ptr.To(int32(1)),
+			},
+		},
+	}
+	decisions := []*framework.SimulatedClusterDecision{
+		{
+			ClusterName: altTestCluster,
+			Reason:      "filtered out",
+		},
+		{
+			ClusterName:    testClusterName,
+			Selected:       true,
+			Score:          &framework.ClusterScore{AffinityScore: 15, WeightedScore: 105},
+			PluginScores:   map[string]*framework.ClusterScore{"ClusterAffinity": {AffinityScore: 10, WeightedScore: 100}},
+			ExtenderScores: map[string]int32{"cost": 5},
+			Reason:         "picked",
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		spec                placementv1beta1.PlacementSimulationSpec
+		observedGeneration  int64
+		objs                []client.Object
+		wantCompleted       metav1.ConditionStatus
+		wantReason          string
+		wantDecisions       []placementv1beta1.SimulatedClusterDecision
+		wantPlacementKey    queue.PlacementKey
+		wantNumOfClusters   string
+		wantClusterLabels   map[string]string
+		wantClusterTaints   []clusterv1beta1.Taint
+		wantClusterProperty string
+	}{
+		{
+			name: "missing placement",
+			spec: placementv1beta1.PlacementSimulationSpec{
+				PlacementName: testCRPName,
+			},
+			objs:          clusters,
+			wantCompleted: metav1.ConditionFalse,
+			wantReason:    condition.PlacementSimulationInvalidReason,
+		},
+		{
+			name: "missing cluster to change",
+			spec: placementv1beta1.PlacementSimulationSpec{
+				PlacementName: testCRPName,
+				ClusterChanges: []placementv1beta1.SimulatedClusterChange{
+					{ClusterName: "unknown"},
+				},
+			},
+			objs:          append([]client.Object{crp}, clusters...),
+			wantCompleted: metav1.ConditionFalse,
+			wantReason:    condition.PlacementSimulationInvalidReason,
+		},
+		{
+			name: "invalid policy",
+			spec: placementv1beta1.PlacementSimulationSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType: placementv1beta1.PickFixedPlacementType,
+				},
+			},
+			objs:          clusters,
+			wantCompleted: metav1.ConditionFalse,
+			wantReason:    condition.PlacementSimulationInvalidReason,
+		},
+		{
+			name: "already simulated",
+			spec: placementv1beta1.PlacementSimulationSpec{
+				PlacementName: testCRPName,
+			},
+			observedGeneration: 1,
+			objs:               append([]client.Object{crp}, clusters...),
+		},
+		{
+			name: "simulate the policy of the placement, with cluster changes",
+			spec: placementv1beta1.PlacementSimulationSpec{
+				PlacementName: testCRPName,
+				ClusterChanges: []placementv1beta1.SimulatedClusterChange{
+					{
+						ClusterName: testClusterName,
+						Labels:      map[string]string{"env": "prod"},
+						Taints:      []clusterv1beta1.Taint{{Key: "key1", Value: "value1", Effect: "NoSchedule"}},
+						Properties:  map[clusterv1beta1.PropertyName]string{"kubernetes-fleet.io/node-count": "3"},
+					},
+				},
+			},
+			objs:                append([]client.Object{crp}, clusters...),
+			wantCompleted:       metav1.ConditionTrue,
+			wantReason:          condition.PlacementSimulationCompletedReason,
+			wantPlacementKey:    queue.PlacementKey(testCRPName),
+			wantNumOfClusters:   "1",
+			wantClusterLabels:   map[string]string{"region": "west", "env": "prod"},
+			wantClusterTaints:   []clusterv1beta1.Taint{{Key: "key1", Value: "value1", Effect: "NoSchedule"}},
+			wantClusterProperty: "3",
+			wantDecisions: []placementv1beta1.SimulatedClusterDecision{
+				{
+					ClusterName:  testClusterName,
+					Selected:     true,
+					ClusterScore: &placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(15)), TopologySpreadScore: ptr.To(int32(0)), WeightedScore: ptr.To(int64(105))},
+					PluginScores: []placementv1beta1.PluginScore{
+						{
+							Name:         "ClusterAffinity",
+							ClusterScore: placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(10)), TopologySpreadScore: ptr.To(int32(0)), WeightedScore: ptr.To(int64(100))},
+						},
+						{
+							Name:         "cost",
+							ClusterScore: placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(5)), WeightedScore: ptr.To(int64(5))},
+						},
+					},
+					Reason: "picked",
+				},
+				{
+					ClusterName: altTestCluster,
+					Reason:      "filtered out",
+				},
+			},
+		},
+		{
+			name: "simulate a proposed policy",
+			spec: placementv1beta1.PlacementSimulationSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType:    placementv1beta1.PickNPlacementType,
+					NumberOfClusters: ptr.To(int32(2)),
+				},
+			},
+			objs:              clusters,
+			wantCompleted:     metav1.ConditionTrue,
+			wantReason:        condition.PlacementSimulationCompletedReason,
+			wantNumOfClusters: "2",
+			wantClusterLabels: map[string]string{"region": "west"},
+			wantDecisions: []placementv1beta1.SimulatedClusterDecision{
+				{
+					ClusterName:  testClusterName,
+					Selected:     true,
+					ClusterScore: &placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(15)), TopologySpreadScore: ptr.To(int32(0)), WeightedScore: ptr.To(int64(105))},
+					PluginScores: []placementv1beta1.PluginScore{
+						{
+							Name:         "ClusterAffinity",
+							ClusterScore: placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(10)), TopologySpreadScore: ptr.To(int32(0)), WeightedScore: ptr.To(int64(100))},
+						},
+						{
+							Name:         "cost",
+							ClusterScore: placementv1beta1.ClusterScore{AffinityScore: ptr.To(int32(5)), WeightedScore: ptr.To(int64(5))},
+						},
+					},
+					Reason: "picked",
+				},
+				{
+					ClusterName: altTestCluster,
+					Reason:      "filtered out",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sim := &placementv1beta1.PlacementSimulation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       testSimName,
+					Generation: 1,
+				},
+				Spec: tc.spec,
+				Status: placementv1beta1.PlacementSimulationStatus{
+					ObservedGeneration: tc.observedGeneration,
+				},
+			}
+			fw := &dummyFramework{decisions: decisions}
+			r := newTestReconciler(t, fw, append([]client.Object{sim}, tc.objs...)...)
+
+			if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: testSimName}}); err != nil {
+				t.Fatalf("Reconcile() = %v, want no error", err)
+			}
+
+			got := &placementv1beta1.PlacementSimulation{}
+			if err := r.Client.Get(context.Background(), types.NamespacedName{Name: testSimName}, got); err != nil {
+				t.Fatalf("Get() = %v, want no error", err)
+			}
+			cond := got.GetCondition(string(placementv1beta1.PlacementSimulationConditionTypeCompleted))
+			if len(tc.wantCompleted) == 0 {
+				if cond != nil {
+					t.Fatalf("Completed condition = %v, want no condition", cond)
+				}
+				return
+			}
+			if cond == nil || cond.Status != tc.wantCompleted || cond.Reason != tc.wantReason {
+				t.Fatalf("Completed condition = %v, want status %s and reason %s", cond, tc.wantCompleted, tc.wantReason)
+			}
+			if got.Status.ObservedGeneration != 1 {
+				t.Errorf("ObservedGeneration = %d, want 1", got.Status.ObservedGeneration)
+			}
+			if diff := cmp.Diff(got.Status.Decisions, tc.wantDecisions, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Decisions diff (-got, +want): %s", diff)
+			}
+			if tc.wantCompleted != metav1.ConditionTrue {
+				return
+			}
+
+			if fw.gotPlacementKey != tc.wantPlacementKey {
+				t.Errorf("simulated placement key = %s, want %s", fw.gotPlacementKey, tc.wantPlacementKey)
+			}
+			if gotNum := fw.gotPolicy.GetAnnotations()[placementv1beta1.NumberOfClustersAnnotation]; gotNum != tc.wantNumOfClusters {
+				t.Errorf("simulated number of clusters = %s, want %s", gotNum, tc.wantNumOfClusters)
+			}
+			var changed *clusterv1beta1.MemberCluster
+			for idx := range fw.gotClusters {
+				if fw.gotClusters[idx].Name == testClusterName {
+					changed = &fw.gotClusters[idx]
+				}
+			}
+			if changed == nil {
+				t.Fatalf("simulated clusters do not include %s", testClusterName)
+			}
+			if diff := cmp.Diff(changed.Labels, tc.wantClusterLabels); diff != "" {
+				t.Errorf("simulated cluster labels diff (-got, +want): %s", diff)
+			}
+			if diff := cmp.Diff(changed.Spec.Taints, tc.wantClusterTaints, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("simulated cluster taints diff (-got, +want): %s", diff)
+			}
+			if gotProperty := changed.Status.Properties["kubernetes-fleet.io/node-count"].Value; gotProperty != tc.wantClusterProperty {
+				t.Errorf("simulated cluster property = %s, want %s", gotProperty, tc.wantClusterProperty)
+			}
+
+			// The hypothetical changes must not be persisted.
+			cluster := &clusterv1beta1.MemberCluster{}
+			if err := r.Client.Get(context.Background(), types.NamespacedName{Name: testClusterName}, cluster); err != nil {
+				t.Fatalf("Get() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(cluster.Labels, map[string]string{"region": "west"}); diff != "" {
+				t.Errorf("persisted cluster labels diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
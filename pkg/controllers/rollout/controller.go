@@ -39,12 +39,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	bindingutils "github.com/kubefleet-dev/kubefleet/pkg/utils/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
 )
 
@@ -309,6 +311,8 @@ func waitForResourcesToCleanUp(allBindings []placementv1beta1.BindingObj, placem
 type toBeUpdatedBinding struct {
 	currentBinding placementv1beta1.BindingObj
 	desiredBinding placementv1beta1.BindingObj // only valid for scheduled or bound binding
+	// inMaintenance is set when the binding is stale only because its target cluster is in a maintenance window.
+	inMaintenance bool
 }
 
 func createUpdateInfo(binding placementv1beta1.BindingObj,
@@ -336,6 +340,8 @@ func createUpdateInfo(binding placementv1beta1.BindingObj,
 // strategy.
 // There could be cases that no bindings are ready to be updated because of the maxSurge/maxUnavailable constraints even
 // if there are out of sync bindings.
+// Bindings whose target cluster is in an active maintenance window are never picked; they are returned as stale bindings
+// and the wait time is capped at the end of the earliest such window.
 // Thus, it also returns a bool indicating whether there are out of sync bindings to be rolled to differentiate those
 // two cases.
 func (r *Reconciler) pickBindingsToRoll(
@@ -379,6 +385,13 @@ func (r *Reconciler) pickBindingsToRoll(
 	// resource/override snapshots, but might or might not have the refresh status information.
 	upToDateBoundBindings := make([]toBeUpdatedBinding, 0)
 
+	// Those are the bindings that need to be bound or updated but whose target cluster is in a maintenance window.
+	maintenanceDeferredBindings := make([]toBeUpdatedBinding, 0)
+	clustersInMaintenance, err := r.listClustersInMaintenance(ctx, time.Now())
+	if err != nil {
+		return nil, nil, nil, false, 0, err
+	}
+
 	// calculate the cutoff time for a binding to be applied before so that it can be considered ready
 	placementSpec := placementObj.GetPlacementSpec()
 	readyTimeCutOff := time.Now().Add(-time.Duration(*placementSpec.Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second)
@@ -428,7 +441,14 @@ func (r *Reconciler) pickBindingsToRoll(
 			if err != nil {
 				return nil, nil, nil, false, minWaitTime, err
 			}
-			boundingCandidates = append(boundingCandidates, createUpdateInfo(binding, masterResourceSnapshot, cro, ro))
+			updateInfo := createUpdateInfo(binding, masterResourceSnapshot, cro, ro)
+			if _, inMaintenance := clustersInMaintenance[bindingSpec.TargetCluster]; inMaintenance {
+				klog.V(2).InfoS("Deferred binding a scheduled binding as its target cluster is in a maintenance window", "placement", placementKObj, "binding", bindingKObj)
+				updateInfo.inMaintenance = true
+				maintenanceDeferredBindings = append(maintenanceDeferredBindings, updateInfo)
+				continue
+			}
+			boundingCandidates = append(boundingCandidates, updateInfo)
 		case placementv1beta1.BindingStateBound:
 			bindingFailed := false
 			schedulerTargetedBinds = append(schedulerTargetedBinds, binding)
@@ -460,7 +480,11 @@ func (r *Reconciler) pickBindingsToRoll(
 				// The binding needs update if it's not pointing to the latest resource binding or the overrides.
 				if bindingSpec.ResourceSnapshotName != masterResourceSnapshot.GetName() || !equality.Semantic.DeepEqual(bindingSpec.ClusterResourceOverrideSnapshots, cro) || !equality.Semantic.DeepEqual(bindingSpec.ResourceOverrideSnapshots, ro) {
 					updateInfo := createUpdateInfo(binding, masterResourceSnapshot, cro, ro)
-					if bindingFailed {
+					if _, inMaintenance := clustersInMaintenance[bindingSpec.TargetCluster]; inMaintenance {
+						klog.V(2).InfoS("Deferred updating a bound binding as its target cluster is in a maintenance window", "placement", placementKObj, "binding", bindingKObj)
+						updateInfo.inMaintenance = true
+						maintenanceDeferredBindings = append(maintenanceDeferredBindings, updateInfo)
+					} else if bindingFailed {
 						// the binding has been applied but failed to apply, we can safely update it to latest resources without affecting max unavailable count
 						applyFailedUpdateCandidates = append(applyFailedUpdateCandidates, updateInfo)
 					} else {
@@ -480,6 +504,13 @@ func (r *Reconciler) pickBindingsToRoll(
 	if allReady {
 		minWaitTime = 0
 	}
+	// Check again once the earliest maintenance window that blocks a binding ends.
+	for _, binding := range maintenanceDeferredBindings {
+		waitTime := max(time.Until(clustersInMaintenance[binding.currentBinding.GetBindingSpec().TargetCluster]), 0)
+		if minWaitTime == 0 || waitTime < minWaitTime {
+			minWaitTime = waitTime
+		}
+	}
 
 	// Calculate target number
 	targetNumber := r.calculateRealTarget(placementObj, schedulerTargetedBinds)
@@ -487,18 +518,38 @@ func (r *Reconciler) pickBindingsToRoll(
 		"targetNumber", targetNumber, "readyBindingNumber", len(readyBindings), "canBeUnavailableBindingNumber", len(canBeUnavailableBindings),
 		"canBeReadyBindingNumber", len(canBeReadyBindings), "boundingCandidateNumber", len(boundingCandidates),
 		"removeCandidateNumber", len(removeCandidates), "updateCandidateNumber", len(updateCandidates), "applyFailedUpdateCandidateNumber",
-		len(applyFailedUpdateCandidates), "minWaitTime", minWaitTime)
+		len(applyFailedUpdateCandidates), "maintenanceDeferredBindingNumber", len(maintenanceDeferredBindings), "minWaitTime", minWaitTime)
 
 	// the list of bindings that are to be updated by this rolling phase
 	toBeUpdatedBindingList := make([]toBeUpdatedBinding, 0)
 	if len(removeCandidates)+len(updateCandidates)+len(boundingCandidates)+len(applyFailedUpdateCandidates) == 0 {
-		return toBeUpdatedBindingList, nil, upToDateBoundBindings, false, minWaitTime, nil
+		if len(maintenanceDeferredBindings) == 0 {
+			return toBeUpdatedBindingList, nil, upToDateBoundBindings, false, minWaitTime, nil
+		}
+		return toBeUpdatedBindingList, maintenanceDeferredBindings, upToDateBoundBindings, true, minWaitTime, nil
 	}
 
 	toBeUpdatedBindingList, staleUnselectedBinding := determineBindingsToUpdate(placementObj, removeCandidates, updateCandidates, boundingCandidates, applyFailedUpdateCandidates, targetNumber,
 		readyBindings, canBeReadyBindings, canBeUnavailableBindings)
 
-	return toBeUpdatedBindingList, staleUnselectedBinding, upToDateBoundBindings, true, minWaitTime, nil
+	return toBeUpdatedBindingList, append(staleUnselectedBinding, maintenanceDeferredBindings...), upToDateBoundBindings, true, minWaitTime, nil
+}
+
+// listClustersInMaintenance returns the member clusters that are in an active maintenance window at the given time,
+// mapped to the time at which their window ends.
+func (r *Reconciler) listClustersInMaintenance(ctx context.Context, now time.Time) (map[string]time.Time, error) {
+	var memberClusters clusterv1beta1.MemberClusterList
+	if err := r.Client.List(ctx, &memberClusters); err != nil {
+		klog.ErrorS(err, "Failed to list member clusters")
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	clustersInMaintenance := make(map[string]time.Time)
+	for idx := range memberClusters.Items {
+		if end, inMaintenance := maintenancewindow.InMaintenance(&memberClusters.Items[idx], now); inMaintenance {
+			clustersInMaintenance[memberClusters.Items[idx].Name] = end
+		}
+	}
+	return clustersInMaintenance, nil
 }
 
 // determineBindingsToUpdate determines which bindings to update
@@ -617,6 +668,10 @@ func isBindingReady(binding placementv1beta1.BindingObj, readyTimeCutOff time.Ti
 		// we can move to the next binding
 		return 0, true
 	}
+	// the binding is ready if all the resources have been accepted in dry-run mode
+	if bindingutils.IsBindingDryRunCompleted(binding) {
+		return 0, true
+	}
 	// find the latest applied condition that has the same generation as the binding
 	availableCondition := binding.GetCondition(string(placementv1beta1.ResourceBindingAvailable))
 	if condition.IsConditionStatusTrue(availableCondition, binding.GetGeneration()) {
@@ -993,7 +1048,8 @@ func enqueueBinding(binding client.Object, q workqueue.TypedRateLimitingInterfac
 }
 
 // handleBindingUpdated determines the action to take when a binding is updated.
-// we only care about the Available and DiffReported condition change.
+// we only care about the Available and DiffReported condition change, plus the Applied condition
+// change if the binding uses the DryRun apply strategy.
 func handleBindingUpdated(objectOld, objectNew client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
 	// Check if the update event is valid.
 	if objectOld == nil || objectNew == nil {
@@ -1017,6 +1073,9 @@ func handleBindingUpdated(objectOld, objectNew client.Object, q workqueue.TypedR
 
 	// these are the conditions we care about
 	conditionsToMonitor := []string{string(placementv1beta1.ResourceBindingDiffReported), string(placementv1beta1.ResourceBindingAvailable)}
+	if applyStrategy := newBinding.GetBindingSpec().ApplyStrategy; applyStrategy != nil && applyStrategy.Type == placementv1beta1.ApplyStrategyTypeDryRun {
+		conditionsToMonitor = append(conditionsToMonitor, string(placementv1beta1.ResourceBindingApplied))
+	}
 	for _, conditionType := range conditionsToMonitor {
 		oldCond := oldBinding.GetCondition(conditionType)
 		newCond := newBinding.GetCondition(conditionType)
@@ -1047,6 +1106,9 @@ func (r *Reconciler) updateStaleBindingsStatus(ctx context.Context, staleBinding
 			continue
 		}
 		errs.Go(func() error {
+			if binding.inMaintenance {
+				return r.updateMaintenanceDeferredBindingStatus(cctx, binding.currentBinding)
+			}
 			return r.updateBindingStatus(cctx, binding.currentBinding, false)
 		})
 	}
@@ -1097,6 +1159,25 @@ func (r *Reconciler) updateBindingStatus(ctx context.Context, binding placementv
 	return nil
 }
 
+// updateMaintenanceDeferredBindingStatus marks a stale binding as not started because its target cluster is
+// in a maintenance window.
+func (r *Reconciler) updateMaintenanceDeferredBindingStatus(ctx context.Context, binding placementv1beta1.BindingObj) error {
+	cond := metav1.Condition{
+		Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: binding.GetGeneration(),
+		Reason:             condition.RolloutNotStartedYetReason,
+		Message:            "The resources cannot be updated to the latest because the target cluster is in a maintenance window",
+	}
+	binding.SetConditions(cond)
+	if err := r.Client.Status().Update(ctx, binding); err != nil {
+		klog.ErrorS(err, "Failed to update binding status", "binding", klog.KObj(binding), "condition", cond)
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the status of a binding", "binding", klog.KObj(binding), "condition", cond)
+	return nil
+}
+
 // processApplyStrategyUpdates processes apply strategy updates on the placement end; specifically
 // it will push the update to all applicable bindings.
 func (r *Reconciler) processApplyStrategyUpdates(
@@ -19,6 +19,7 @@ package rollout
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -1013,6 +1014,51 @@ func TestIsBindingReady(t *testing.T) {
 			wantReady:       true,
 			wantWaitTime:    0,
 		},
+		"binding with all resources accepted in dry-run mode is ready": {
+			binding: &placementv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 10,
+				},
+				Spec: placementv1beta1.ResourceBindingSpec{
+					ApplyStrategy: &placementv1beta1.ApplyStrategy{
+						Type: placementv1beta1.ApplyStrategyTypeDryRun,
+					},
+				},
+				Status: placementv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(placementv1beta1.ResourceBindingApplied),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 10,
+							Reason:             "any",
+						},
+					},
+				},
+			},
+			readyTimeCutOff: now,
+			wantReady:       true,
+			wantWaitTime:    0,
+		},
+		"binding applied but not available is not ready": {
+			binding: &placementv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 10,
+				},
+				Status: placementv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(placementv1beta1.ResourceBindingApplied),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 10,
+							Reason:             "any",
+						},
+					},
+				},
+			},
+			readyTimeCutOff: now,
+			wantReady:       false,
+			wantWaitTime:    -1,
+		},
 		"binding available (not trackable) before the ready time cut off should return ready": {
 			binding: &placementv1beta1.ClusterResourceBinding{
 				ObjectMeta: metav1.ObjectMeta{
@@ -1230,12 +1276,71 @@ func TestPickBindingsToRoll(t *testing.T) {
 		wantTobeUpdatedBindings     []int
 		wantDesiredBindingsSpec     []placementv1beta1.ResourceBindingSpec // used to construct the want toBeUpdatedBindings
 		wantStaleUnselectedBindings []int
-		wantUpToDateBoundBindings   []int
-		wantNeedRoll                bool
-		wantWaitTime                time.Duration
-		wantErr                     error
+		// wantMaintenanceDeferredBindings is the subset of the stale bindings that are deferred by a maintenance window.
+		wantMaintenanceDeferredBindings []int
+		wantUpToDateBoundBindings       []int
+		wantNeedRoll                    bool
+		wantWaitTime                    time.Duration
+		wantErr                         error
 	}{
 		// TODO: add more tests
+		"test scheduled binding to a cluster in a maintenance window - rollout deferred": {
+			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
+				return []*placementv1beta1.ClusterResourceBinding{
+					generateClusterResourceBinding(placementv1beta1.BindingStateScheduled, "snapshot-1", cluster1),
+					generateClusterResourceBinding(placementv1beta1.BindingStateScheduled, "snapshot-1", cluster2),
+				}
+			},
+			clusters: []clusterv1beta1.MemberCluster{
+				generateMemberClusterInMaintenance(cluster1, time.Hour),
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			crp: clusterResourcePlacementForTest("test",
+				createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, generateDefaultRollingUpdateConfig(), nil)),
+			wantTobeUpdatedBindings: []int{1},
+			wantDesiredBindingsSpec: []placementv1beta1.ResourceBindingSpec{
+				{
+					State:                placementv1beta1.BindingStateBound,
+					TargetCluster:        cluster1,
+					ResourceSnapshotName: "snapshot-2",
+				},
+				{
+					State:                placementv1beta1.BindingStateBound,
+					TargetCluster:        cluster2,
+					ResourceSnapshotName: "snapshot-2",
+				},
+			},
+			wantStaleUnselectedBindings:     []int{0},
+			wantMaintenanceDeferredBindings: []int{0},
+			wantNeedRoll:                    true,
+			wantWaitTime:                    time.Hour,
+		},
+		"test bound binding with outdated resources on a cluster in a maintenance window - rollout deferred": {
+			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
+				return []*placementv1beta1.ClusterResourceBinding{
+					generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-1", cluster1),
+				}
+			},
+			clusters: []clusterv1beta1.MemberCluster{
+				generateMemberClusterInMaintenance(cluster1, 30*time.Minute),
+			},
+			latestResourceSnapshotName: "snapshot-2",
+			crp: clusterResourcePlacementForTest("test",
+				createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, generateDefaultRollingUpdateConfig(), nil)),
+			wantDesiredBindingsSpec: []placementv1beta1.ResourceBindingSpec{
+				{
+					State:                placementv1beta1.BindingStateBound,
+					TargetCluster:        cluster1,
+					ResourceSnapshotName: "snapshot-2",
+				},
+			},
+			wantStaleUnselectedBindings:     []int{0},
+			wantMaintenanceDeferredBindings: []int{0},
+			wantNeedRoll:                    true,
+			wantWaitTime:                    30 * time.Minute,
+		},
 		"test scheduled binding to bound, outdated resources and nil overrides - rollout allowed": {
 			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
 				return []*placementv1beta1.ClusterResourceBinding{
@@ -2620,6 +2725,7 @@ func TestPickBindingsToRoll(t *testing.T) {
 				} else {
 					wantStaleUnselectedBindings[i].currentBinding = allBindings[index]
 				}
+				wantStaleUnselectedBindings[i].inMaintenance = slices.Contains(tt.wantMaintenanceDeferredBindings, index)
 			}
 			wantUpToDateBoundBindings := make([]toBeUpdatedBinding, len(tt.wantUpToDateBoundBindings))
 			for i, index := range tt.wantUpToDateBoundBindings {
@@ -2638,7 +2744,12 @@ func TestPickBindingsToRoll(t *testing.T) {
 			if gotNeedRoll != tt.wantNeedRoll {
 				t.Errorf("pickBindingsToRoll() = needRoll %v, want %v", gotNeedRoll, tt.wantNeedRoll)
 			}
-			if tt.wantNeedRoll == true {
+			if tt.wantNeedRoll == true && len(tt.wantMaintenanceDeferredBindings) > 0 {
+				// Maintenance window boundaries are stored with second precision.
+				if (gotWaitTime - tt.wantWaitTime).Abs() > 2*time.Second {
+					t.Errorf("pickBindingsToRoll() = waitTime %v, want %v", gotWaitTime, tt.wantWaitTime)
+				}
+			} else if tt.wantNeedRoll == true {
 				if gotWaitTime.Round(time.Second) != tt.wantWaitTime {
 					t.Errorf("pickBindingsToRoll() = waitTime %v, want %v", gotWaitTime, tt.wantWaitTime)
 				}
@@ -2759,6 +2870,25 @@ func setDeletionTimeStampForBinding(binding *placementv1beta1.ClusterResourceBin
 	return binding
 }
 
+// generateMemberClusterInMaintenance returns a member cluster whose maintenance window is active for the given duration.
+func generateMemberClusterInMaintenance(name string, remaining time.Duration) clusterv1beta1.MemberCluster {
+	now := time.Now()
+	return clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: clusterv1beta1.MemberClusterSpec{
+			MaintenanceWindows: []clusterv1beta1.MaintenanceWindow{
+				{
+					Name:  "maintenance",
+					Start: &metav1.Time{Time: now.Add(-time.Hour)},
+					End:   &metav1.Time{Time: now.Add(remaining)},
+				},
+			},
+		},
+	}
+}
+
 func generateDefaultRollingUpdateConfig() *placementv1beta1.RollingUpdateConfig {
 	return &placementv1beta1.RollingUpdateConfig{
 		MaxUnavailable: &intstr.IntOrString{
@@ -0,0 +1,385 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tainteviction features a controller that moves placements off member clusters with
+// NoExecute taints that the placements do not tolerate.
+package tainteviction
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+	taintutils "github.com/kubefleet-dev/kubefleet/pkg/utils/taint"
+)
+
+const (
+	// NoExecuteTaintsEnforcedReason is the reason of the NoExecuteTaintsEnforced condition when all
+	// the placements that do not tolerate the NoExecute taints of a cluster can be evicted.
+	NoExecuteTaintsEnforcedReason = "NoExecuteTaintsEnforced"
+	// PickFixedPlacementsNotEvictedReason is the reason of the NoExecuteTaintsEnforced condition
+	// when some PickFixed placements do not tolerate the NoExecute taints of a cluster.
+	PickFixedPlacementsNotEvictedReason = "PickFixedPlacementsNotEvicted"
+)
+
+// Reconciler evicts the placements that do not tolerate the NoExecute taints of a member cluster
+// from the cluster.
+//
+// Like the descheduler, the controller never removes resources by itself; instead, it creates a
+// ClusterResourcePlacementEviction object for each ClusterResourcePlacement, and a
+// ResourcePlacementEviction object for each ResourcePlacement, that has resources on the cluster,
+// so that the eviction is subject to the disruption budget of the placement (if any). An eviction
+// that is not executed (e.g., blocked by the disruption budget) is retried after RetryInterval.
+// The scheduler will not place the resources back, as its taint toleration plugin filters out
+// clusters with NoExecute taints whose toleration periods have expired, in the same way as the
+// controller decides when to evict.
+//
+// Placements of the PickFixed placement type cannot be evicted; the controller reports them in the
+// NoExecuteTaintsEnforced condition of the member cluster instead, so that the users can remove
+// the cluster from the placements.
+type Reconciler struct {
+	client.Client
+
+	// RetryInterval is how long the controller waits before it retries an eviction that has not
+	// been executed; evictions that have completed for longer than this period are cleaned up.
+	RetryInterval time.Duration
+
+	// EnableResourcePlacement specifies whether ResourcePlacements are evicted as well.
+	EnableResourcePlacement bool
+}
+
+// Reconcile evicts the placements that do not tolerate the NoExecute taints of a member cluster.
+func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtime.Result, error) {
+	startTime := time.Now()
+	clusterName := req.Name
+	klog.V(2).InfoS("Taint eviction reconciliation starts", "memberCluster", clusterName)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Taint eviction reconciliation ends", "memberCluster", clusterName, "latency", latency)
+	}()
+
+	mc := &clusterv1beta1.MemberCluster{}
+	if err := r.Client.Get(ctx, req.NamespacedName, mc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return runtime.Result{}, nil
+		}
+		return runtime.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if mc.DeletionTimestamp != nil {
+		// The cluster is leaving the fleet; all the placements will be removed from it anyway.
+		return runtime.Result{}, nil
+	}
+
+	// Stamp the NoExecute taints with the time they are added, so that toleration periods can be
+	// tracked across restarts.
+	if stampNoExecuteTaints(mc, startTime) {
+		if err := r.Client.Update(ctx, mc); err != nil {
+			klog.ErrorS(err, "Failed to stamp NoExecute taints with the time they are added", "memberCluster", clusterName)
+			return runtime.Result{}, controller.NewUpdateIgnoreConflictError(err)
+		}
+		klog.V(2).InfoS("Stamped NoExecute taints with the time they are added", "memberCluster", clusterName)
+		// The update triggers another reconciliation.
+		return runtime.Result{}, nil
+	}
+
+	inFlight, recent, err := r.cleanUpEvictions(ctx, clusterName, startTime)
+	if err != nil {
+		return runtime.Result{}, err
+	}
+	if !hasNoExecuteTaints(mc) {
+		if meta.FindStatusCondition(mc.Status.Conditions, string(clusterv1beta1.ConditionTypeMemberClusterNoExecuteTaintsEnforced)) != nil {
+			meta.RemoveStatusCondition(&mc.Status.Conditions, string(clusterv1beta1.ConditionTypeMemberClusterNoExecuteTaintsEnforced))
+			if err := r.updateMemberClusterStatus(ctx, mc); err != nil {
+				return runtime.Result{}, err
+			}
+		}
+		return requeueAfterFor(len(inFlight)+len(recent) > 0, r.RetryInterval), nil
+	}
+
+	placementKeys, err := r.collectPlacementsOnCluster(ctx, clusterName)
+	if err != nil {
+		return runtime.Result{}, err
+	}
+
+	var requeueAfter time.Duration
+	var pickFixedPlacements []string
+	for _, placementKey := range placementKeys {
+		placement, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, placementKey)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return runtime.Result{}, controller.NewAPIServerError(true, err)
+		}
+		if placement.GetDeletionTimestamp() != nil {
+			continue
+		}
+		placementRef := klog.KObj(placement)
+
+		spec := placement.GetPlacementSpec()
+		evictAt, shouldEvict := taintutils.NoExecuteEvictionTime(mc.Spec.Taints, spec.Tolerations(), startTime)
+		if !shouldEvict {
+			continue
+		}
+		if wait := evictAt.Sub(startTime); wait > 0 {
+			klog.V(2).InfoS("The placement tolerates the NoExecute taints of the cluster for now", "placement", placementRef, "memberCluster", clusterName, "wait", wait)
+			requeueAfter = minRequeueAfter(requeueAfter, wait)
+			continue
+		}
+		if spec.Policy != nil && spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType {
+			// Placements of the PickFixed type cannot be evicted; report them instead, and check
+			// again later in case they no longer select the cluster.
+			pickFixedPlacements = append(pickFixedPlacements, placementRef.String())
+			requeueAfter = minRequeueAfter(requeueAfter, r.RetryInterval)
+			continue
+		}
+		if inFlight[placementKey] || recent[placementKey] {
+			// Wait for the eviction in progress to complete, or retry the eviction later.
+			requeueAfter = minRequeueAfter(requeueAfter, r.RetryInterval)
+			continue
+		}
+		if err := r.createEviction(ctx, placementKey, clusterName); err != nil {
+			return runtime.Result{}, err
+		}
+		requeueAfter = minRequeueAfter(requeueAfter, r.RetryInterval)
+	}
+
+	if setNoExecuteTaintsEnforcedCondition(mc, pickFixedPlacements) {
+		if err := r.updateMemberClusterStatus(ctx, mc); err != nil {
+			return runtime.Result{}, err
+		}
+	}
+	return runtime.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// setNoExecuteTaintsEnforcedCondition sets the NoExecuteTaintsEnforced condition of a cluster
+// with the PickFixed placements that do not tolerate its NoExecute taints; it returns whether the
+// condition has changed.
+func setNoExecuteTaintsEnforcedCondition(mc *clusterv1beta1.MemberCluster, pickFixedPlacements []string) bool {
+	cond := metav1.Condition{
+		Type:               string(clusterv1beta1.ConditionTypeMemberClusterNoExecuteTaintsEnforced),
+		Status:             metav1.ConditionTrue,
+		Reason:             NoExecuteTaintsEnforcedReason,
+		Message:            "All the placements that do not tolerate the NoExecute taints of the cluster are moved off the cluster once their toleration periods expire",
+		ObservedGeneration: mc.Generation,
+	}
+	if len(pickFixedPlacements) > 0 {
+		sort.Strings(pickFixedPlacements)
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = PickFixedPlacementsNotEvictedReason
+		cond.Message = fmt.Sprintf("The placements %v of the PickFixed type do not tolerate the NoExecute taints of the cluster but cannot be evicted; remove the cluster from their cluster names to move them off the cluster", pickFixedPlacements)
+	}
+	if condition.EqualCondition(meta.FindStatusCondition(mc.Status.Conditions, cond.Type), &cond) {
+		return false
+	}
+	meta.SetStatusCondition(&mc.Status.Conditions, cond)
+	return true
+}
+
+// updateMemberClusterStatus updates the status of a member cluster.
+func (r *Reconciler) updateMemberClusterStatus(ctx context.Context, mc *clusterv1beta1.MemberCluster) error {
+	if err := r.Client.Status().Update(ctx, mc); err != nil {
+		klog.ErrorS(err, "Failed to update the NoExecuteTaintsEnforced condition", "memberCluster", klog.KObj(mc))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	return nil
+}
+
+// stampNoExecuteTaints sets the time added for the NoExecute taints of a cluster that do not have
+// one yet; it returns whether any taint is stamped.
+func stampNoExecuteTaints(mc *clusterv1beta1.MemberCluster, now time.Time) bool {
+	stamped := false
+	for idx := range mc.Spec.Taints {
+		taint := &mc.Spec.Taints[idx]
+		if taint.Effect == corev1.TaintEffectNoExecute && taint.TimeAdded == nil {
+			taint.TimeAdded = &metav1.Time{Time: now}
+			stamped = true
+		}
+	}
+	return stamped
+}
+
+// hasNoExecuteTaints returns whether a cluster has any NoExecute taint.
+func hasNoExecuteTaints(mc *clusterv1beta1.MemberCluster) bool {
+	for idx := range mc.Spec.Taints {
+		if mc.Spec.Taints[idx].Effect == corev1.TaintEffectNoExecute {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanUpEvictions deletes the evictions the controller has issued for a cluster which have
+// completed for longer than the retry interval; it returns the keys of the placements whose
+// evictions are still in progress, and the keys of the placements whose evictions have completed
+// recently.
+func (r *Reconciler) cleanUpEvictions(ctx context.Context, clusterName string, now time.Time) (inFlight, recent map[types.NamespacedName]bool, err error) {
+	evictions, err := r.listEvictions(ctx, clusterName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	inFlight, recent = make(map[types.NamespacedName]bool), make(map[types.NamespacedName]bool)
+	for _, eviction := range evictions {
+		placementKey := types.NamespacedName{Namespace: eviction.GetNamespace(), Name: eviction.GetPlacementEvictionSpec().PlacementName}
+		if !evictionutils.IsEvictionInTerminalState(eviction) {
+			inFlight[placementKey] = true
+			continue
+		}
+		if now.Sub(eviction.GetCreationTimestamp().Time) < r.RetryInterval {
+			// Keep recently completed evictions around for auditing purposes.
+			recent[placementKey] = true
+			continue
+		}
+		if err := r.Client.Delete(ctx, eviction); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete a completed eviction", "placementEviction", klog.KObj(eviction))
+			return nil, nil, controller.NewAPIServerError(false, err)
+		}
+	}
+	return inFlight, recent, nil
+}
+
+// listEvictions lists the evictions the controller has issued for a cluster.
+func (r *Reconciler) listEvictions(ctx context.Context, clusterName string) ([]placementv1beta1.PlacementEvictionObj, error) {
+	labelSelector := client.MatchingLabels{placementv1beta1.TaintEvictionClusterLabel: clusterName}
+	crpEvictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+	if err := r.Client.List(ctx, crpEvictionList, labelSelector); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	evictions := make([]placementv1beta1.PlacementEvictionObj, 0, len(crpEvictionList.Items))
+	for idx := range crpEvictionList.Items {
+		evictions = append(evictions, &crpEvictionList.Items[idx])
+	}
+	if !r.EnableResourcePlacement {
+		return evictions, nil
+	}
+
+	rpEvictionList := &placementv1beta1.ResourcePlacementEvictionList{}
+	if err := r.Client.List(ctx, rpEvictionList, labelSelector); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	for idx := range rpEvictionList.Items {
+		evictions = append(evictions, &rpEvictionList.Items[idx])
+	}
+	return evictions, nil
+}
+
+// collectPlacementsOnCluster returns the keys of the placements that have resources placed on a
+// cluster.
+func (r *Reconciler) collectPlacementsOnCluster(ctx context.Context, clusterName string) ([]types.NamespacedName, error) {
+	crbList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := r.Client.List(ctx, crbList); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	bindings := crbList.GetBindingObjs()
+	if r.EnableResourcePlacement {
+		rbList := &placementv1beta1.ResourceBindingList{}
+		if err := r.Client.List(ctx, rbList); err != nil {
+			return nil, controller.NewAPIServerError(true, err)
+		}
+		bindings = append(bindings, rbList.GetBindingObjs()...)
+	}
+
+	seen := make(map[types.NamespacedName]bool)
+	placementKeys := make([]types.NamespacedName, 0)
+	for _, binding := range bindings {
+		if binding.GetBindingSpec().TargetCluster != clusterName || binding.GetDeletionTimestamp() != nil || !evictionutils.IsPlacementPresent(binding) {
+			continue
+		}
+		placementName, ok := binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+		if !ok {
+			continue
+		}
+		placementKey := types.NamespacedName{Namespace: binding.GetNamespace(), Name: placementName}
+		if seen[placementKey] {
+			continue
+		}
+		seen[placementKey] = true
+		placementKeys = append(placementKeys, placementKey)
+	}
+	return placementKeys, nil
+}
+
+// createEviction creates an eviction for the resources a placement has on a cluster; the
+// eviction is a ClusterResourcePlacementEviction for a ClusterResourcePlacement, or a
+// ResourcePlacementEviction in the same namespace for a ResourcePlacement.
+func (r *Reconciler) createEviction(ctx context.Context, placementKey types.NamespacedName, clusterName string) error {
+	objectMeta := metav1.ObjectMeta{
+		GenerateName: fmt.Sprintf("%s-%s-", placementKey.Name, clusterName),
+		Namespace:    placementKey.Namespace,
+		Labels: map[string]string{
+			placementv1beta1.TaintEvictionClusterLabel: clusterName,
+		},
+	}
+	spec := placementv1beta1.PlacementEvictionSpec{
+		PlacementName: placementKey.Name,
+		ClusterName:   clusterName,
+	}
+	var eviction placementv1beta1.PlacementEvictionObj
+	if placementKey.Namespace == "" {
+		eviction = &placementv1beta1.ClusterResourcePlacementEviction{ObjectMeta: objectMeta, Spec: spec}
+	} else {
+		eviction = &placementv1beta1.ResourcePlacementEviction{ObjectMeta: objectMeta, Spec: spec}
+	}
+	placementRef := klog.KRef(placementKey.Namespace, placementKey.Name)
+	if err := r.Client.Create(ctx, eviction); err != nil {
+		klog.ErrorS(err, "Failed to create eviction", "placement", placementRef, "memberCluster", clusterName)
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Issued an eviction to move the placement off a cluster with NoExecute taints",
+		"placement", placementRef, "placementEviction", klog.KObj(eviction), "memberCluster", clusterName)
+	return nil
+}
+
+// minRequeueAfter returns the shorter of two requeue delays, where zero stands for no requeue.
+func minRequeueAfter(a, b time.Duration) time.Duration {
+	if a == 0 {
+		return b
+	}
+	return min(a, b)
+}
+
+// requeueAfterFor returns a result that requeues after the given interval if needed.
+func requeueAfterFor(needed bool, interval time.Duration) runtime.Result {
+	if !needed {
+		return runtime.Result{}
+	}
+	return runtime.Result{RequeueAfter: interval}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr runtime.Manager) error {
+	return runtime.NewControllerManagedBy(mgr).Named("taint-eviction-controller").
+		For(&clusterv1beta1.MemberCluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
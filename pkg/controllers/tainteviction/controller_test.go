@@ -0,0 +1,502 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tainteviction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
+)
+
+const (
+	testCRPName     = "test-crp"
+	testRPName      = "test-rp"
+	testNamespace   = "test-ns"
+	testClusterName = "test-cluster"
+	altTestCluster  = "alt-test-cluster"
+	testTaintKey    = "maintenance"
+)
+
+var (
+	now = time.Now()
+)
+
+func newTestReconciler(t *testing.T, objs ...client.Object) *Reconciler {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add cluster v1beta1 scheme: %v", err)
+	}
+	return &Reconciler{
+		Client: fake.NewClientBuilder().
+			WithScheme(scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&clusterv1beta1.MemberCluster{}).
+			Build(),
+		RetryInterval:           time.Minute,
+		EnableResourcePlacement: true,
+	}
+}
+
+func newMemberCluster(taints ...clusterv1beta1.Taint) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testClusterName,
+		},
+		Spec: clusterv1beta1.MemberClusterSpec{
+			Taints: taints,
+		},
+	}
+}
+
+func newNoExecuteTaint(addedAt *time.Time) clusterv1beta1.Taint {
+	taint := clusterv1beta1.Taint{
+		Key:    testTaintKey,
+		Effect: corev1.TaintEffectNoExecute,
+	}
+	if addedAt != nil {
+		taint.TimeAdded = &metav1.Time{Time: *addedAt}
+	}
+	return taint
+}
+
+func newCRP(placementType placementv1beta1.PlacementType, tolerations ...placementv1beta1.Toleration) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testCRPName,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementType,
+				Tolerations:   tolerations,
+			},
+		},
+	}
+}
+
+func newRP(placementType placementv1beta1.PlacementType) *placementv1beta1.ResourcePlacement {
+	return &placementv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      testRPName,
+			Namespace: testNamespace,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementType,
+			},
+		},
+	}
+}
+
+func newRPBinding(name, clusterName string) *placementv1beta1.ResourceBinding {
+	return &placementv1beta1.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+			Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: testRPName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: clusterName,
+		},
+	}
+}
+
+func newBinding(name, clusterName string) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: testCRPName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: clusterName,
+		},
+	}
+}
+
+func newEviction(name string, createdAt time.Time, executed bool) *placementv1beta1.ClusterResourcePlacementEviction {
+	eviction := &placementv1beta1.ClusterResourcePlacementEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Labels:            map[string]string{placementv1beta1.TaintEvictionClusterLabel: testClusterName},
+			CreationTimestamp: metav1.NewTime(createdAt),
+		},
+		Spec: placementv1beta1.PlacementEvictionSpec{
+			PlacementName: testCRPName,
+			ClusterName:   testClusterName,
+		},
+	}
+	if executed {
+		eviction.Status.Conditions = []metav1.Condition{
+			{
+				Type:   string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+				Status: metav1.ConditionFalse,
+			},
+		}
+	}
+	return eviction
+}
+
+// TestReconcile tests the Reconcile method.
+func TestReconcile(t *testing.T) {
+	addedLongAgo := now.Add(-time.Hour)
+	noExecuteToleration := func(seconds *int64) placementv1beta1.Toleration {
+		return placementv1beta1.Toleration{
+			Key:               testTaintKey,
+			Operator:          corev1.TolerationOpExists,
+			Effect:            corev1.TaintEffectNoExecute,
+			TolerationSeconds: seconds,
+		}
+	}
+
+	testCases := []struct {
+		name                string
+		objs                []client.Object
+		wantEvictions       []string
+		wantNewEviction     bool
+		wantRequeueBefore   time.Duration
+		wantConditionStatus metav1.ConditionStatus
+	}{
+		{
+			name: "no NoExecute taint",
+			objs: []client.Object{
+				newMemberCluster(clusterv1beta1.Taint{Key: testTaintKey, Effect: corev1.TaintEffectNoSchedule}),
+				newCRP(placementv1beta1.PickNPlacementType), newBinding("binding-1", testClusterName),
+			},
+		},
+		{
+			name: "condition removed after the taint is removed",
+			objs: []client.Object{
+				&clusterv1beta1.MemberCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: testClusterName,
+					},
+					Status: clusterv1beta1.MemberClusterStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:   string(clusterv1beta1.ConditionTypeMemberClusterNoExecuteTaintsEnforced),
+								Status: metav1.ConditionFalse,
+								Reason: PickFixedPlacementsNotEvictedReason,
+							},
+						},
+					},
+				},
+				newCRP(placementv1beta1.PickFixedPlacementType), newBinding("binding-1", testClusterName),
+			},
+		},
+		{
+			name: "NoExecute taint not stamped yet",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(nil)),
+				newCRP(placementv1beta1.PickNPlacementType), newBinding("binding-1", testClusterName),
+			},
+		},
+		{
+			name: "taint not tolerated",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newCRP(placementv1beta1.PickNPlacementType), newBinding("binding-1", testClusterName),
+			},
+			wantNewEviction:     true,
+			wantRequeueBefore:   time.Minute,
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+		{
+			name: "binding on another cluster",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newCRP(placementv1beta1.PickNPlacementType), newBinding("binding-1", altTestCluster),
+			},
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+		{
+			name: "PickFixed placement",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newCRP(placementv1beta1.PickFixedPlacementType), newBinding("binding-1", testClusterName),
+			},
+			wantRequeueBefore:   time.Minute,
+			wantConditionStatus: metav1.ConditionFalse,
+		},
+		{
+			name: "PickFixed resource placement",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newRP(placementv1beta1.PickFixedPlacementType), newRPBinding("binding-1", testClusterName),
+			},
+			wantRequeueBefore:   time.Minute,
+			wantConditionStatus: metav1.ConditionFalse,
+		},
+		{
+			name: "taint tolerated forever",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newCRP(placementv1beta1.PickNPlacementType, noExecuteToleration(nil)), newBinding("binding-1", testClusterName),
+			},
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+		{
+			name: "taint tolerated for now",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newCRP(placementv1beta1.PickNPlacementType, noExecuteToleration(ptr.To(int64(2*60*60)))), newBinding("binding-1", testClusterName),
+			},
+			wantRequeueBefore:   time.Hour,
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+		{
+			name: "toleration period expired",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newCRP(placementv1beta1.PickNPlacementType, noExecuteToleration(ptr.To(int64(60)))), newBinding("binding-1", testClusterName),
+			},
+			wantNewEviction:     true,
+			wantRequeueBefore:   time.Minute,
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+		{
+			name: "eviction in progress",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newCRP(placementv1beta1.PickNPlacementType), newBinding("binding-1", testClusterName),
+				newEviction("in-progress", now.Add(-time.Hour), false),
+			},
+			wantEvictions:       []string{"in-progress"},
+			wantRequeueBefore:   time.Minute,
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+		{
+			name: "eviction recently blocked",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newCRP(placementv1beta1.PickNPlacementType), newBinding("binding-1", testClusterName),
+				newEviction("blocked", now.Add(-time.Second*10), true),
+			},
+			wantEvictions:       []string{"blocked"},
+			wantRequeueBefore:   time.Minute,
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+		{
+			name: "old eviction cleaned up and retried",
+			objs: []client.Object{
+				newMemberCluster(newNoExecuteTaint(&addedLongAgo)),
+				newCRP(placementv1beta1.PickNPlacementType), newBinding("binding-1", testClusterName),
+				newEviction("old", now.Add(-time.Hour), true),
+			},
+			wantNewEviction:     true,
+			wantRequeueBefore:   time.Minute,
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+		{
+			name: "old eviction cleaned up after the taint is removed",
+			objs: []client.Object{
+				newMemberCluster(),
+				newCRP(placementv1beta1.PickNPlacementType), newBinding("binding-1", testClusterName),
+				newEviction("old", now.Add(-time.Hour), true),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestReconciler(t, tc.objs...)
+			ctx := context.Background()
+			res, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: testClusterName}})
+			if err != nil {
+				t.Fatalf("Reconcile() = %v, want no error", err)
+			}
+			if tc.wantRequeueBefore > 0 && (res.RequeueAfter <= 0 || res.RequeueAfter > tc.wantRequeueBefore) {
+				t.Errorf("Reconcile() requeueAfter = %v, want in (0, %v]", res.RequeueAfter, tc.wantRequeueBefore)
+			}
+			if tc.wantRequeueBefore == 0 && res.RequeueAfter != 0 {
+				t.Errorf("Reconcile() requeueAfter = %v, want no requeue", res.RequeueAfter)
+			}
+
+			mc := &clusterv1beta1.MemberCluster{}
+			if err := r.Client.Get(ctx, types.NamespacedName{Name: testClusterName}, mc); err != nil {
+				t.Fatalf("failed to get member cluster: %v", err)
+			}
+			for _, taint := range mc.Spec.Taints {
+				if taint.Effect == corev1.TaintEffectNoExecute && taint.TimeAdded == nil {
+					t.Errorf("NoExecute taint %+v is not stamped", taint)
+				}
+			}
+			var gotConditionStatus metav1.ConditionStatus
+			if cond := meta.FindStatusCondition(mc.Status.Conditions, string(clusterv1beta1.ConditionTypeMemberClusterNoExecuteTaintsEnforced)); cond != nil {
+				gotConditionStatus = cond.Status
+			}
+			if gotConditionStatus != tc.wantConditionStatus {
+				t.Errorf("NoExecuteTaintsEnforced condition status = %q, want %q", gotConditionStatus, tc.wantConditionStatus)
+			}
+
+			evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+			if err := r.Client.List(ctx, evictionList); err != nil {
+				t.Fatalf("failed to list evictions: %v", err)
+			}
+			var gotEvictions []string
+			newEvictions := 0
+			for _, eviction := range evictionList.Items {
+				if eviction.GenerateName != "" {
+					newEvictions++
+					if eviction.Spec.ClusterName != testClusterName || eviction.Spec.PlacementName != testCRPName {
+						t.Errorf("new eviction spec = %+v, want eviction targeting cluster %s", eviction.Spec, testClusterName)
+					}
+					if eviction.Labels[placementv1beta1.TaintEvictionClusterLabel] != testClusterName {
+						t.Errorf("new eviction labels = %v, want label %s=%s", eviction.Labels, placementv1beta1.TaintEvictionClusterLabel, testClusterName)
+					}
+					continue
+				}
+				gotEvictions = append(gotEvictions, eviction.Name)
+			}
+			if diff := cmp.Diff(gotEvictions, tc.wantEvictions); diff != "" {
+				t.Errorf("existing evictions diff (-got, +want): %s", diff)
+			}
+			if (newEvictions == 1) != tc.wantNewEviction || newEvictions > 1 {
+				t.Errorf("created %d evictions, want new eviction: %t", newEvictions, tc.wantNewEviction)
+			}
+		})
+	}
+}
+
+// TestEvictedPlacementNotRescheduled tests that the scheduler does not place resources back onto a
+// cluster that the controller has evicted them from.
+func TestEvictedPlacementNotRescheduled(t *testing.T) {
+	addedLongAgo := now.Add(-time.Hour)
+	noExecuteToleration := func(seconds *int64) placementv1beta1.Toleration {
+		return placementv1beta1.Toleration{
+			Key:               testTaintKey,
+			Operator:          corev1.TolerationOpExists,
+			Effect:            corev1.TaintEffectNoExecute,
+			TolerationSeconds: seconds,
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		crp         *placementv1beta1.ClusterResourcePlacement
+		wantEvicted bool
+	}{
+		{
+			name:        "taint not tolerated",
+			crp:         newCRP(placementv1beta1.PickNPlacementType),
+			wantEvicted: true,
+		},
+		{
+			name:        "toleration period expired",
+			crp:         newCRP(placementv1beta1.PickNPlacementType, noExecuteToleration(ptr.To(int64(60)))),
+			wantEvicted: true,
+		},
+		{
+			name: "taint tolerated for now",
+			crp:  newCRP(placementv1beta1.PickNPlacementType, noExecuteToleration(ptr.To(int64(2*60*60)))),
+		},
+		{
+			name: "taint tolerated forever",
+			crp:  newCRP(placementv1beta1.PickNPlacementType, noExecuteToleration(nil)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestReconciler(t, newMemberCluster(newNoExecuteTaint(&addedLongAgo)), tc.crp, newBinding("binding-1", testClusterName))
+			ctx := context.Background()
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: testClusterName}}); err != nil {
+				t.Fatalf("Reconcile() = %v, want no error", err)
+			}
+			evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+			if err := r.Client.List(ctx, evictionList); err != nil {
+				t.Fatalf("failed to list evictions: %v", err)
+			}
+			if gotEvicted := len(evictionList.Items) > 0; gotEvicted != tc.wantEvicted {
+				t.Fatalf("evicted = %t, want %t", gotEvicted, tc.wantEvicted)
+			}
+
+			// Once the eviction is executed, the scheduler runs the taint toleration filter to
+			// decide whether the resources can be placed back onto the cluster.
+			mc := &clusterv1beta1.MemberCluster{}
+			if err := r.Client.Get(ctx, types.NamespacedName{Name: testClusterName}, mc); err != nil {
+				t.Fatalf("failed to get member cluster: %v", err)
+			}
+			policySnapshot := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: testCRPName + "-0",
+				},
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: tc.crp.Spec.Policy,
+				},
+			}
+			p := tainttoleration.New()
+			status := p.Filter(ctx, nil, policySnapshot, mc)
+			if gotRescheduled := status.IsSuccess(); gotRescheduled == tc.wantEvicted {
+				t.Errorf("Filter() = %v, want cluster schedulable: %t", status, !tc.wantEvicted)
+			}
+		})
+	}
+}
+
+// TestReconcile_ResourcePlacement tests that the Reconcile method evicts ResourcePlacements with
+// ResourcePlacementEvictions in their namespaces.
+func TestReconcile_ResourcePlacement(t *testing.T) {
+	addedLongAgo := now.Add(-time.Hour)
+	r := newTestReconciler(t, newMemberCluster(newNoExecuteTaint(&addedLongAgo)), newRP(placementv1beta1.PickNPlacementType), newRPBinding("binding-1", testClusterName))
+	ctx := context.Background()
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: testClusterName}}); err != nil {
+		t.Fatalf("Reconcile() = %v, want no error", err)
+	}
+
+	evictionList := &placementv1beta1.ResourcePlacementEvictionList{}
+	if err := r.Client.List(ctx, evictionList); err != nil {
+		t.Fatalf("failed to list evictions: %v", err)
+	}
+	if len(evictionList.Items) != 1 {
+		t.Fatalf("created %d evictions, want 1", len(evictionList.Items))
+	}
+	eviction := evictionList.Items[0]
+	if eviction.Namespace != testNamespace || eviction.Spec.PlacementName != testRPName || eviction.Spec.ClusterName != testClusterName {
+		t.Errorf("new eviction = %s/%s with spec %+v, want eviction in namespace %s targeting %s on cluster %s",
+			eviction.Namespace, eviction.Name, eviction.Spec, testNamespace, testRPName, testClusterName)
+	}
+
+	// The eviction is in progress; a second reconciliation should not issue another one.
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: testClusterName}}); err != nil {
+		t.Fatalf("Reconcile() = %v, want no error", err)
+	}
+	if err := r.Client.List(ctx, evictionList); err != nil {
+		t.Fatalf("failed to list evictions: %v", err)
+	}
+	if len(evictionList.Items) != 1 {
+		t.Errorf("got %d evictions after another reconciliation, want 1", len(evictionList.Items))
+	}
+}
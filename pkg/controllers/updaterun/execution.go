@@ -33,10 +33,12 @@ import (
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	bindingutils "github.com/kubefleet-dev/kubefleet/pkg/utils/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 )
 
 var (
@@ -153,6 +155,7 @@ func (r *Reconciler) executeUpdatingStage(
 	finishedClusterCount := 0
 	clusterUpdatingCount := 0
 	var stuckClusterNames []string
+	var inMaintenanceClusterNames []string
 	var clusterUpdateErrors []error
 	// Go through each cluster in the stage and check if it's updating/succeeded/failed.
 	for i := 0; i < len(updatingStageStatus.Clusters) && clusterUpdatingCount < maxConcurrency; i++ {
@@ -175,7 +178,19 @@ func (r *Reconciler) executeUpdatingStage(
 		clusterStartedCond := meta.FindStatusCondition(clusterStatus.Conditions, string(placementv1beta1.ClusterUpdatingConditionStarted))
 		binding := toBeUpdatedBindingsMap[clusterStatus.ClusterName]
 		if !condition.IsConditionStatusTrue(clusterStartedCond, updateRun.GetGeneration()) {
-			// The cluster has not started updating yet.
+			// The cluster has not started updating yet; defer it if it is in a maintenance window.
+			inMaintenance, err := r.isClusterInMaintenance(ctx, clusterStatus.ClusterName)
+			if err != nil {
+				clusterUpdateErrors = append(clusterUpdateErrors, err)
+				continue
+			}
+			if inMaintenance {
+				klog.V(2).InfoS("Deferred updating the cluster as it is in a maintenance window", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
+				inMaintenanceClusterNames = append(inMaintenanceClusterNames, clusterStatus.ClusterName)
+				// The deferred cluster does not count towards maxConcurrency so that other clusters in the stage can proceed.
+				clusterUpdatingCount--
+				continue
+			}
 			if !isBindingSyncedWithClusterStatus(resourceSnapshotName, updateRun, binding, clusterStatus) {
 				klog.V(2).InfoS("Found the first cluster that needs to be updated", "cluster", clusterStatus.ClusterName, "stage", updatingStageStatus.StageName, "updateRun", updateRunRef)
 				// The binding is not up-to-date with the cluster status.
@@ -267,6 +282,10 @@ func (r *Reconciler) executeUpdatingStage(
 
 	// After processing maxConcurrency number of cluster, check if we need to mark the update run as stuck or progressing.
 	aggregateUpdateRunStatus(updateRun, updatingStageStatus.StageName, stuckClusterNames)
+	if len(stuckClusterNames) == 0 && len(inMaintenanceClusterNames) > 0 && clusterUpdatingCount == 0 {
+		// No cluster in the stage can make progress until a maintenance window ends.
+		markUpdateRunWaiting(updateRun, fmt.Sprintf(condition.UpdateRunWaitingForMaintenanceMessageFmt, strings.Join(inMaintenanceClusterNames, ", "), updatingStageStatus.StageName))
+	}
 
 	// Aggregate and return errors.
 	if len(clusterUpdateErrors) > 0 {
@@ -282,6 +301,21 @@ func (r *Reconciler) executeUpdatingStage(
 	return clusterUpdatingWaitTime, nil
 }
 
+// isClusterInMaintenance checks if the member cluster has an active maintenance window.
+func (r *Reconciler) isClusterInMaintenance(ctx context.Context, clusterName string) (bool, error) {
+	var mc clusterv1beta1.MemberCluster
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: clusterName}, &mc); err != nil {
+		if apierrors.IsNotFound(err) {
+			// A missing member cluster has no maintenance window; the binding status will surface the problem.
+			return false, nil
+		}
+		klog.ErrorS(err, "Failed to get the member cluster", "memberCluster", clusterName)
+		return false, controller.NewAPIServerError(true, err)
+	}
+	_, inMaintenance := maintenancewindow.InMaintenance(&mc, time.Now())
+	return inMaintenance, nil
+}
+
 // handleStageCompletion handles the completion logic when all clusters in a stage are finished.
 // Returns the wait time and any error encountered.
 func (r *Reconciler) handleStageCompletion(
@@ -593,8 +627,10 @@ func checkClusterUpdateResult(
 	availCond := binding.GetCondition(string(placementv1beta1.ResourceBindingAvailable))
 	diffReportCondition := binding.GetCondition(string(placementv1beta1.ResourceBindingDiffReported))
 	if condition.IsConditionStatusTrue(availCond, binding.GetGeneration()) ||
-		condition.IsConditionStatusTrue(diffReportCondition, binding.GetGeneration()) {
-		// The resource updated on the cluster is available or diff is successfully reported.
+		condition.IsConditionStatusTrue(diffReportCondition, binding.GetGeneration()) ||
+		bindingutils.IsBindingDryRunCompleted(binding) {
+		// The resource updated on the cluster is available, diff is successfully reported, or
+		// the dry-run apply ops have completed successfully.
 		klog.InfoS("The cluster has been updated", "cluster", clusterStatus.ClusterName, "stage", updatingStage.StageName, "updateRun", klog.KObj(updateRun))
 		markClusterUpdatingSucceeded(clusterStatus, updateRun.GetGeneration())
 		return true, nil
@@ -34,6 +34,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 )
@@ -786,6 +787,7 @@ func TestExecuteUpdatingStage_Error(t *testing.T) {
 			ctx := context.Background()
 			scheme := runtime.NewScheme()
 			_ = placementv1beta1.AddToScheme(scheme)
+			_ = clusterv1beta1.AddToScheme(scheme)
 
 			var fakeClient client.Client
 			objs := make([]client.Object, len(tt.bindings))
@@ -831,6 +833,139 @@ func TestExecuteUpdatingStage_Error(t *testing.T) {
 	}
 }
 
+func TestExecuteUpdatingStage_MaintenanceWindow(t *testing.T) {
+	now := time.Now()
+	inMaintenanceCluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"},
+		Spec: clusterv1beta1.MemberClusterSpec{
+			MaintenanceWindows: []clusterv1beta1.MaintenanceWindow{
+				{
+					Name:  "patching",
+					Start: &metav1.Time{Time: now.Add(-time.Hour)},
+					End:   &metav1.Time{Time: now.Add(time.Hour)},
+				},
+			},
+		},
+	}
+	buildUpdateRun := func(clusterNames ...string) *placementv1beta1.ClusterStagedUpdateRun {
+		clusters := make([]placementv1beta1.ClusterUpdatingStatus, 0, len(clusterNames))
+		for _, name := range clusterNames {
+			clusters = append(clusters, placementv1beta1.ClusterUpdatingStatus{ClusterName: name})
+		}
+		return &placementv1beta1.ClusterStagedUpdateRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-update-run",
+				Generation: 1,
+			},
+			Spec: placementv1beta1.UpdateRunSpec{
+				PlacementName:         "test-placement",
+				ResourceSnapshotIndex: "1",
+				State:                 placementv1beta1.StateRun,
+			},
+			Status: placementv1beta1.UpdateRunStatus{
+				ResourceSnapshotIndexUsed: "1",
+				StagesStatus: []placementv1beta1.StageUpdatingStatus{
+					{
+						StageName: "test-stage",
+						Clusters:  clusters,
+					},
+				},
+				UpdateStrategySnapshot: &placementv1beta1.UpdateStrategySpec{
+					Stages: []placementv1beta1.StageConfig{
+						{
+							Name:           "test-stage",
+							MaxConcurrency: &intstr.IntOrString{Type: intstr.Int, IntVal: 1},
+						},
+					},
+				},
+			},
+		}
+	}
+	buildBinding := func(name, clusterName string) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Generation: 1,
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster:        clusterName,
+				ResourceSnapshotName: "test-placement-0-snapshot",
+				State:                placementv1beta1.BindingStateScheduled,
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		updateRun        *placementv1beta1.ClusterStagedUpdateRun
+		bindings         []*placementv1beta1.ClusterResourceBinding
+		wantBoundCluster []string
+		wantWaiting      bool
+	}{
+		{
+			name:             "cluster in maintenance is deferred and does not count towards max concurrency",
+			updateRun:        buildUpdateRun("cluster-1", "cluster-2"),
+			bindings:         []*placementv1beta1.ClusterResourceBinding{buildBinding("binding-1", "cluster-1"), buildBinding("binding-2", "cluster-2")},
+			wantBoundCluster: []string{"cluster-2"},
+			wantWaiting:      false,
+		},
+		{
+			name:        "update run waits when all remaining clusters are in maintenance",
+			updateRun:   buildUpdateRun("cluster-1"),
+			bindings:    []*placementv1beta1.ClusterResourceBinding{buildBinding("binding-1", "cluster-1")},
+			wantWaiting: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := runtime.NewScheme()
+			_ = placementv1beta1.AddToScheme(scheme)
+			_ = clusterv1beta1.AddToScheme(scheme)
+
+			objs := []client.Object{inMaintenanceCluster.DeepCopy()}
+			bindings := make([]placementv1beta1.BindingObj, len(tt.bindings))
+			for i := range tt.bindings {
+				objs = append(objs, tt.bindings[i])
+				bindings[i] = tt.bindings[i]
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithStatusSubresource(objs...).Build()
+			r := &Reconciler{
+				Client: fakeClient,
+			}
+
+			waitTime, err := r.executeUpdatingStage(ctx, tt.updateRun, 0, bindings, 1)
+			if err != nil {
+				t.Fatalf("executeUpdatingStage() got error: %v", err)
+			}
+			if waitTime != clusterUpdatingWaitTime {
+				t.Errorf("executeUpdatingStage() want waitTime: %v, got waitTime: %v", clusterUpdatingWaitTime, waitTime)
+			}
+
+			var gotBoundClusters []string
+			for _, binding := range tt.bindings {
+				var got placementv1beta1.ClusterResourceBinding
+				if err := fakeClient.Get(ctx, types.NamespacedName{Name: binding.Name}, &got); err != nil {
+					t.Fatalf("failed to get binding %s: %v", binding.Name, err)
+				}
+				if got.Spec.State == placementv1beta1.BindingStateBound {
+					gotBoundClusters = append(gotBoundClusters, got.Spec.TargetCluster)
+				}
+			}
+			if diff := cmp.Diff(tt.wantBoundCluster, gotBoundClusters); diff != "" {
+				t.Errorf("bound clusters mismatch (-want, +got):\n%s", diff)
+			}
+
+			progressingCond := meta.FindStatusCondition(tt.updateRun.Status.Conditions, string(placementv1beta1.StagedUpdateRunConditionProgressing))
+			gotWaiting := progressingCond != nil && progressingCond.Reason == condition.UpdateRunWaitingReason
+			if gotWaiting != tt.wantWaiting {
+				t.Errorf("updateRun waiting = %v, want %v, progressing condition: %+v", gotWaiting, tt.wantWaiting, progressingCond)
+			}
+		})
+	}
+}
+
 func TestCalculateMaxConcurrencyValue(t *testing.T) {
 	tests := []struct {
 		name           string
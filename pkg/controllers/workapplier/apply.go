@@ -52,16 +52,16 @@ func (r *Reconciler) applyInDryRunMode(
 	ctx context.Context,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
+	force bool,
 ) (*unstructured.Unstructured, error) {
-	// In this method, Fleet will always use forced server-side apply
-	// w/o optimistic lock for diff calculation.
+	// In this method, Fleet will always use server-side apply w/o optimistic lock.
 	//
-	// This is OK as partial comparison concerns only fields that are currently present
-	// in the manifest object, and Fleet will clear out system managed and read-only fields
-	// before the comparison.
+	// For diff calculation, the apply op is always forced; this is OK as partial comparison
+	// concerns only fields that are currently present in the manifest object, and Fleet will
+	// clear out system managed and read-only fields before the comparison.
 	//
 	// Note that full comparison can be carried out directly without involving the apply op.
-	return r.serverSideApply(ctx, gvr, manifestObj, inMemberClusterObj, true, false, true)
+	return r.serverSideApply(ctx, gvr, manifestObj, inMemberClusterObj, force, false, true)
 }
 
 func (r *Reconciler) apply(
@@ -303,7 +303,10 @@ func (r *Reconciler) serverSideApply(
 	// first apply attempt being successful, yet any subsequent update would fail due to
 	// conflicts. There are also a few other similar cases that are solved by this check;
 	// see the inner comments for specifics.
-	if shouldUseForcedServerSideApply(inMemberClusterObj) {
+	//
+	// The object might not have been created yet in the member cluster when the apply op
+	// runs in the dry-run mode; no check is needed in this case.
+	if inMemberClusterObj != nil && shouldUseForcedServerSideApply(inMemberClusterObj) {
 		force = true
 	}
 
@@ -25,18 +25,27 @@ import (
 	policyv1 "k8s.io/api/policy/v1"
 	apiextensionshelpers "k8s.io/apiextensions-apiserver/pkg/apihelpers"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/component-helpers/apps/poddisruptionbudget"
 	"k8s.io/klog/v2"
 
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/resourcehealthcheck"
 )
 
 // trackInMemberClusterObjAvailability tracks the availability of applied objects in the member cluster.
 func (r *Reconciler) trackInMemberClusterObjAvailability(ctx context.Context, bundles []*manifestProcessingBundle, workRef klog.ObjectRef) error {
+	healthChecks, err := r.listResourceHealthChecks(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list resource health checks", "work", workRef)
+		return err
+	}
+
 	// Track the availability of all the applied objects in the member cluster in parallel.
 	//
 	// This is concurrency-safe as the bundles slice has been pre-allocated.
@@ -65,7 +74,14 @@ func (r *Reconciler) trackInMemberClusterObjAvailability(ctx context.Context, bu
 			return
 		}
 
-		availabilityResTyp, err := trackInMemberClusterObjAvailabilityByGVR(bundle.gvr, bundle.inMemberClusterObj)
+		var availabilityResTyp ManifestProcessingAvailabilityResultType
+		var err error
+		if len(bundle.hookType) > 0 {
+			// A hook is available once it has succeeded.
+			availabilityResTyp, err = trackHookAvailability(bundle)
+		} else {
+			availabilityResTyp, err = trackInMemberClusterObjAvailabilityByGVR(bundle.gvr, bundle.inMemberClusterObj, healthChecks)
+		}
 		if err != nil {
 			// An unexpected error has occurred during the availability check.
 			bundle.availabilityErr = err
@@ -96,12 +112,34 @@ func (r *Reconciler) trackInMemberClusterObjAvailability(ctx context.Context, bu
 	return nil
 }
 
+// listResourceHealthChecks lists the user-defined resource health checks in the member cluster.
+func (r *Reconciler) listResourceHealthChecks(ctx context.Context) ([]placementv1beta1.ResourceHealthCheck, error) {
+	var healthCheckList placementv1beta1.ResourceHealthCheckList
+	if err := r.spokeClient.List(ctx, &healthCheckList); err != nil {
+		if meta.IsNoMatchError(err) {
+			// The ResourceHealthCheck CRD is not installed in the member cluster; use the built-in
+			// availability checks only.
+			return nil, nil
+		}
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	return healthCheckList.Items, nil
+}
+
 // trackInMemberClusterObjAvailabilityByGVR tracks the availability of an object in the member cluster based
 // on its GVR.
+//
+// User-defined resource health checks that target the kind of the object take precedence over the
+// built-in availability checks.
 func trackInMemberClusterObjAvailabilityByGVR(
 	gvr *schema.GroupVersionResource,
 	inMemberClusterObj *unstructured.Unstructured,
+	healthChecks []placementv1beta1.ResourceHealthCheck,
 ) (ManifestProcessingAvailabilityResultType, error) {
+	if resTyp, tracked, err := trackAvailabilityWithResourceHealthChecks(inMemberClusterObj, healthChecks); tracked {
+		return resTyp, err
+	}
+
 	switch *gvr {
 	case utils.DeploymentGVR:
 		return trackDeploymentAvailability(inMemberClusterObj)
@@ -127,6 +165,38 @@ func trackInMemberClusterObjAvailabilityByGVR(
 	}
 }
 
+// trackAvailabilityWithResourceHealthChecks tracks the availability of an object in the member cluster with
+// the user-defined resource health checks that target its kind. It also returns if any health check applies
+// to the object at all.
+func trackAvailabilityWithResourceHealthChecks(
+	inMemberClusterObj *unstructured.Unstructured,
+	healthChecks []placementv1beta1.ResourceHealthCheck,
+) (ManifestProcessingAvailabilityResultType, bool, error) {
+	gvk := inMemberClusterObj.GroupVersionKind()
+	tracked := false
+	for idx := range healthChecks {
+		hc := &healthChecks[idx]
+		if !resourcehealthcheck.Targets(hc, gvk) {
+			continue
+		}
+		tracked = true
+		available, err := resourcehealthcheck.IsAvailable(hc, inMemberClusterObj)
+		if err != nil {
+			return AvailabilityResultTypeFailed, true, err
+		}
+		if !available {
+			klog.V(2).InfoS("The object from the member cluster does not satisfy a resource health check yet",
+				"resourceHealthCheck", klog.KObj(hc), "inMemberClusterObj", klog.KObj(inMemberClusterObj))
+			return AvailabilityResultTypeNotYetAvailable, true, nil
+		}
+	}
+	if tracked {
+		klog.V(2).InfoS("The object from the member cluster satisfies all resource health checks", "inMemberClusterObj", klog.KObj(inMemberClusterObj))
+		return AvailabilityResultTypeAvailable, true, nil
+	}
+	return "", false, nil
+}
+
 // trackDeploymentAvailability tracks the availability of a deployment in the member cluster.
 func trackDeploymentAvailability(inMemberClusterObj *unstructured.Unstructured) (ManifestProcessingAvailabilityResultType, error) {
 	var deploy appv1.Deployment
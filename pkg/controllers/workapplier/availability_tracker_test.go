@@ -34,8 +34,10 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
@@ -999,7 +1001,7 @@ func TestTrackInMemberClusterObjAvailabilityByGVR(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			gotResTyp, err := trackInMemberClusterObjAvailabilityByGVR(&tc.gvr, tc.inMemberClusterObj)
+			gotResTyp, err := trackInMemberClusterObjAvailabilityByGVR(&tc.gvr, tc.inMemberClusterObj, nil)
 			if err != nil {
 				t.Fatalf("trackInMemberClusterObjAvailabilityByGVR() = %v, want no error", err)
 			}
@@ -1123,6 +1125,7 @@ func TestTrackInMemberClusterObjAvailability(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			r := &Reconciler{
+				spokeClient:  fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
 				parallelizer: parallelizer.NewParallelizer(2),
 			}
 
@@ -1145,3 +1148,141 @@ func TestTrackInMemberClusterObjAvailability(t *testing.T) {
 		})
 	}
 }
+
+// TestTrackInMemberClusterObjAvailabilityWithResourceHealthChecks tests the availability tracking with
+// user-defined resource health checks.
+func TestTrackInMemberClusterObjAvailabilityWithResourceHealthChecks(t *testing.T) {
+	widgetGVR := schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+	widget := func(generation int64, status map[string]any) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata": map[string]any{
+				"name":       "widget",
+				"namespace":  "default",
+				"generation": generation,
+			},
+			"spec": map[string]any{
+				"replicas": int64(3),
+			},
+		}}
+		if status != nil {
+			obj.Object["status"] = status
+		}
+		return obj
+	}
+	readyCondition := func(status string, observedGeneration int64) map[string]any {
+		return map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": status, "observedGeneration": observedGeneration},
+			},
+			"readyReplicas": int64(3),
+		}
+	}
+	widgetHealthCheck := fleetv1beta1.ResourceHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Name: "widgets"},
+		Spec: fleetv1beta1.ResourceHealthCheckSpec{
+			Target: fleetv1beta1.ResourceHealthCheckTarget{Group: "example.com", Kind: "Widget"},
+			Rules: []fleetv1beta1.ResourceHealthCheckRule{
+				{Condition: &fleetv1beta1.ResourceHealthCheckCondition{Type: "Ready", Status: metav1.ConditionTrue}},
+				{Expression: "object.status.readyReplicas == object.spec.replicas"},
+			},
+		},
+	}
+	deploymentHealthCheck := fleetv1beta1.ResourceHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployments"},
+		Spec: fleetv1beta1.ResourceHealthCheckSpec{
+			Target: fleetv1beta1.ResourceHealthCheckTarget{Group: "apps", Kind: "Deployment"},
+			Rules: []fleetv1beta1.ResourceHealthCheckRule{
+				{Expression: "has(object.metadata.labels) && object.metadata.labels['ready'] == 'true'"},
+			},
+		},
+	}
+	invalidHealthCheck := fleetv1beta1.ResourceHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+		Spec: fleetv1beta1.ResourceHealthCheckSpec{
+			Target: fleetv1beta1.ResourceHealthCheckTarget{Group: "example.com", Kind: "Widget"},
+			Rules: []fleetv1beta1.ResourceHealthCheckRule{
+				{Expression: "object.status.readyReplicas +"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                       string
+		gvr                        schema.GroupVersionResource
+		inMemberClusterObj         *unstructured.Unstructured
+		healthChecks               []fleetv1beta1.ResourceHealthCheck
+		wantAvailabilityResultType ManifestProcessingAvailabilityResultType
+		wantErr                    bool
+	}{
+		{
+			name:                       "untrackable without health checks",
+			gvr:                        widgetGVR,
+			inMemberClusterObj:         widget(1, readyCondition("True", 1)),
+			wantAvailabilityResultType: AvailabilityResultTypeNotTrackable,
+		},
+		{
+			name:                       "available when all rules are satisfied",
+			gvr:                        widgetGVR,
+			inMemberClusterObj:         widget(1, readyCondition("True", 1)),
+			healthChecks:               []fleetv1beta1.ResourceHealthCheck{widgetHealthCheck},
+			wantAvailabilityResultType: AvailabilityResultTypeAvailable,
+		},
+		{
+			name:                       "not yet available when the condition is stale",
+			gvr:                        widgetGVR,
+			inMemberClusterObj:         widget(2, readyCondition("True", 1)),
+			healthChecks:               []fleetv1beta1.ResourceHealthCheck{widgetHealthCheck},
+			wantAvailabilityResultType: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name:                       "not yet available when the condition is not met",
+			gvr:                        widgetGVR,
+			inMemberClusterObj:         widget(1, readyCondition("False", 1)),
+			healthChecks:               []fleetv1beta1.ResourceHealthCheck{widgetHealthCheck},
+			wantAvailabilityResultType: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name:                       "not yet available when the status is not populated",
+			gvr:                        widgetGVR,
+			inMemberClusterObj:         widget(1, nil),
+			healthChecks:               []fleetv1beta1.ResourceHealthCheck{widgetHealthCheck},
+			wantAvailabilityResultType: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name:                       "health checks for other kinds do not apply",
+			gvr:                        widgetGVR,
+			inMemberClusterObj:         widget(1, readyCondition("True", 1)),
+			healthChecks:               []fleetv1beta1.ResourceHealthCheck{deploymentHealthCheck},
+			wantAvailabilityResultType: AvailabilityResultTypeNotTrackable,
+		},
+		{
+			name:                       "health checks take precedence over built-in checks",
+			gvr:                        utils.DeploymentGVR,
+			inMemberClusterObj:         toUnstructured(t, deploy.DeepCopy()),
+			healthChecks:               []fleetv1beta1.ResourceHealthCheck{deploymentHealthCheck},
+			wantAvailabilityResultType: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name:                       "invalid rule fails the check",
+			gvr:                        widgetGVR,
+			inMemberClusterObj:         widget(1, readyCondition("True", 1)),
+			healthChecks:               []fleetv1beta1.ResourceHealthCheck{invalidHealthCheck},
+			wantAvailabilityResultType: AvailabilityResultTypeFailed,
+			wantErr:                    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotResTyp, err := trackInMemberClusterObjAvailabilityByGVR(&tc.gvr, tc.inMemberClusterObj, tc.healthChecks)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("trackInMemberClusterObjAvailabilityByGVR() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if gotResTyp != tc.wantAvailabilityResultType {
+				t.Errorf("manifestProcessingAvailabilityResultType = %v, want %v", gotResTyp, tc.wantAvailabilityResultType)
+			}
+		})
+	}
+}
@@ -284,6 +284,15 @@ const (
 	ApplyOrReportDiffResTypeFoundDriftsInDegradedMode      ManifestProcessingApplyOrReportDiffResultType = "FoundDriftsInDegradedMode"
 	// Note that the reason string below uses the same value as kept in the old work applier.
 	ApplyOrReportDiffResTypeFailedToApply ManifestProcessingApplyOrReportDiffResultType = "ManifestApplyFailed"
+	// The result types for manifests that cannot be applied (yet) due to their apply order.
+	ApplyOrReportDiffResTypeInvalidApplyOrder      ManifestProcessingApplyOrReportDiffResultType = "InvalidApplyOrder"
+	ApplyOrReportDiffResTypeWaitingForDependencies ManifestProcessingApplyOrReportDiffResultType = "WaitingForDependencies"
+	// The result types for hooks and for manifests blocked by hooks.
+	ApplyOrReportDiffResTypeInvalidHook             ManifestProcessingApplyOrReportDiffResultType = "InvalidHook"
+	ApplyOrReportDiffResTypeHookFailed              ManifestProcessingApplyOrReportDiffResultType = "HookFailed"
+	ApplyOrReportDiffResTypeWaitingForPreApplyHooks ManifestProcessingApplyOrReportDiffResultType = "WaitingForPreApplyHooks"
+	// The result type for manifests rejected by the member cluster API server in the DryRun mode.
+	ApplyOrReportDiffResTypeDryRunFailed ManifestProcessingApplyOrReportDiffResultType = "DryRunFailed"
 
 	// The result type and description for successful apply ops.
 	ApplyOrReportDiffResTypeApplied ManifestProcessingApplyOrReportDiffResultType = "Applied"
@@ -298,6 +307,18 @@ const (
 	ApplyOrReportDiffResTypeAppliedDescription = "Manifest has been applied successfully"
 )
 
+const (
+	// The result type and description for manifests accepted by the member cluster API server
+	// in the DryRun mode.
+	ApplyOrReportDiffResTypeDryRunSucceeded            ManifestProcessingApplyOrReportDiffResultType = "DryRunSucceeded"
+	ApplyOrReportDiffResTypeDryRunSucceededDescription                                               = "Manifest has been accepted by the member cluster API server in dry-run mode; no changes have been made"
+
+	// The result type and description for manifests that cannot be fully validated in the DryRun
+	// mode, as their parents are placed in the same Work and have been accepted, but not created.
+	ApplyOrReportDiffResTypeDryRunPendingParent            ManifestProcessingApplyOrReportDiffResultType = "DryRunPendingParent"
+	ApplyOrReportDiffResTypeDryRunPendingParentDescription                                               = "Manifest cannot be fully validated in dry-run mode, as its parent (its namespace or its custom resource definition) is placed along with it and has been accepted, but not created; no changes have been made"
+)
+
 const (
 	// The result type for diff reporting failures.
 	ApplyOrReportDiffResTypeFailedToReportDiff ManifestProcessingApplyOrReportDiffResultType = "FailedToReportDiff"
@@ -329,6 +350,14 @@ var (
 		ApplyOrReportDiffResTypeFoundDrifts,
 		ApplyOrReportDiffResTypeFoundDriftsInDegradedMode,
 		ApplyOrReportDiffResTypeFailedToApply,
+		ApplyOrReportDiffResTypeInvalidApplyOrder,
+		ApplyOrReportDiffResTypeWaitingForDependencies,
+		ApplyOrReportDiffResTypeInvalidHook,
+		ApplyOrReportDiffResTypeHookFailed,
+		ApplyOrReportDiffResTypeWaitingForPreApplyHooks,
+		ApplyOrReportDiffResTypeDryRunFailed,
+		ApplyOrReportDiffResTypeDryRunSucceeded,
+		ApplyOrReportDiffResTypeDryRunPendingParent,
 		ApplyOrReportDiffResTypeAppliedWithFailedDriftDetection,
 		ApplyOrReportDiffResTypeApplied,
 	)
@@ -386,6 +415,21 @@ type manifestProcessingBundle struct {
 	applyOrReportDiffResTyp ManifestProcessingApplyOrReportDiffResultType
 	// The result type of the availability check op.
 	availabilityResTyp ManifestProcessingAvailabilityResultType
+	// The bundles (in the same Work) that this bundle depends on, as declared via the
+	// depends-on annotation on the manifest object.
+	dependencies []*manifestProcessingBundle
+	// The objects outside the Work that this bundle depends on, as declared via the depends-on
+	// annotation on the manifest object; they are looked up in the member cluster directly.
+	externalDependencies []manifestObjKey
+	// Whether this bundle should be applied only after all the bundles in earlier waves
+	// and all of its dependencies have become available.
+	waitForAvailable bool
+	// The hook type of the manifest object (pre-apply or post-apply), as declared via the hook
+	// annotation; empty if the manifest object is not a hook.
+	hookType string
+	// The hash of the manifests the hook runs for, i.e., all the non-hook manifests in the Work and
+	// the hook manifest itself; empty if the manifest object is not a hook.
+	hookManifestsHash string
 	// The error that stops the apply op or the diff reporting op.
 	applyOrReportDiffErr error
 	// The error that stops the availability check op.
@@ -91,7 +91,7 @@ func (r *Reconciler) takeOverPreExistingObject(
 	//
 	// Note that the default takeover action is AlwaysApply.
 	if applyStrategy.WhenToTakeOver == fleetv1beta1.WhenToTakeOverTypeIfNoDiff {
-		configDiffs, diffCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObjCopy, applyStrategy.ComparisonOption)
+		configDiffs, diffCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObjCopy, applyStrategy)
 		switch {
 		case err != nil:
 			return nil, nil, false, fmt.Errorf("failed to calculate configuration diffs between the manifest object and the object from the member cluster: %w", err)
@@ -116,19 +116,27 @@ func (r *Reconciler) takeOverPreExistingObject(
 
 // diffBetweenManifestAndInMemberClusterObjects calculates the differences between the manifest object
 // and its corresponding object in the member cluster.
+//
+// Differences in fields that are ignored per the IgnoreDifferences rules in the apply strategy
+// are not reported.
 func (r *Reconciler) diffBetweenManifestAndInMemberClusterObjects(
 	ctx context.Context,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
-	cmpOption fleetv1beta1.ComparisonOptionType,
+	applyStrategy *fleetv1beta1.ApplyStrategy,
 ) ([]fleetv1beta1.PatchDetail, bool, error) {
-	switch cmpOption {
+	ignoredPtrs, err := ignoredJSONPointersFor(manifestObj, inMemberClusterObj, applyStrategy.IgnoreDifferences)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to determine the fields to ignore: %w", err)
+	}
+
+	switch applyStrategy.ComparisonOption {
 	case fleetv1beta1.ComparisonOptionTypePartialComparison:
-		return r.partialDiffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObj)
+		return r.partialDiffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObj, ignoredPtrs)
 	case fleetv1beta1.ComparisonOptionTypeFullComparison:
 		// For the full comparison, Fleet compares directly the JSON representations of the
 		// manifest object and the object in the member cluster.
-		patchDetails, err := preparePatchDetails(manifestObj, inMemberClusterObj)
+		patchDetails, err := preparePatchDetails(manifestObj, inMemberClusterObj, ignoredPtrs)
 		return patchDetails, false, err
 	default:
 		return nil, false, fmt.Errorf("an invalid comparison option is specified")
@@ -143,10 +151,11 @@ func (r *Reconciler) partialDiffBetweenManifestAndInMemberClusterObjects(
 	ctx context.Context,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
+	ignoredPtrs []string,
 ) ([]fleetv1beta1.PatchDetail, bool, error) {
 	// Fleet calculates the partial diff between two objects by running apply ops in the dry-run
 	// mode.
-	appliedObj, err := r.applyInDryRunMode(ctx, gvr, manifestObj, inMemberClusterObj)
+	appliedObj, err := r.applyInDryRunMode(ctx, gvr, manifestObj, inMemberClusterObj, true)
 
 	// After the dry-run apply op, all the managed fields should have been overwritten using the
 	// values from the manifest object, while leaving all the unmanaged fields untouched. This
@@ -165,7 +174,7 @@ func (r *Reconciler) partialDiffBetweenManifestAndInMemberClusterObjects(
 		// for us to assume that there are no drifts, otherwise, any fields that are different
 		// imply that running an actual apply op would lead to unexpected changes, which signifies
 		// the presence of partial drifts (drifts in managed fields).
-		patchDetails, err := preparePatchDetails(appliedObj, inMemberClusterObj)
+		patchDetails, err := preparePatchDetails(appliedObj, inMemberClusterObj, ignoredPtrs)
 		return patchDetails, false, err
 	case errors.IsInvalid(err):
 		// The dry-run apply op has failed as the manifest object provided is not valid. This could
@@ -179,7 +188,7 @@ func (r *Reconciler) partialDiffBetweenManifestAndInMemberClusterObjects(
 		// This is not considered as a diff calculation error.
 		klog.V(2).InfoS("Calculate diffs in degraded mode as the manifest object cannot be server-side applied in dry-run mode",
 			"gvr", gvr, "manifestObj", klog.KObj(manifestObj), "serverErr", err)
-		patchDetails, err := preparePatchDetails(manifestObj, inMemberClusterObj)
+		patchDetails, err := preparePatchDetails(manifestObj, inMemberClusterObj, ignoredPtrs)
 		return patchDetails, true, err
 	default:
 		// An unexpected error has occurred.
@@ -296,8 +305,8 @@ func organizeJSONPatchIntoFleetPatchDetails(patch jsondiff.Patch, manifestObjMap
 }
 
 // preparePatchDetails calculates the differences between two objects in the form
-// of Fleet patch details.
-func preparePatchDetails(srcObj, destObj *unstructured.Unstructured) ([]fleetv1beta1.PatchDetail, error) {
+// of Fleet patch details; differences in the ignored fields (as JSON pointers) are skipped.
+func preparePatchDetails(srcObj, destObj *unstructured.Unstructured, ignoredPtrs []string) ([]fleetv1beta1.PatchDetail, error) {
 	// Discard certain fields from both objects before comparison.
 	srcObjCopy := discardFieldsIrrelevantInComparisonFrom(srcObj)
 	destObjCopy := discardFieldsIrrelevantInComparisonFrom(destObj)
@@ -325,6 +334,9 @@ func preparePatchDetails(srcObj, destObj *unstructured.Unstructured) ([]fleetv1b
 		return nil, wrappedErr
 	}
 
+	// Skip the ignored fields before the JSON patches are organized (and truncated).
+	patch = removeIgnoredPatchOps(patch, destObjCopy.Object, ignoredPtrs)
+
 	// Prepare Fleet patch details from the JSON patches.
 	details, err := organizeJSONPatchIntoFleetPatchDetails(patch, srcObjCopy.Object)
 	if err != nil {
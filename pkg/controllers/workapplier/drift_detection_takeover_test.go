@@ -457,7 +457,7 @@ func TestPreparePatchDetails(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			patchDetails, err := preparePatchDetails(tc.manifestObj, tc.inMemberClusterObj)
+			patchDetails, err := preparePatchDetails(tc.manifestObj, tc.inMemberClusterObj, nil)
 			if err != nil {
 				t.Fatalf("preparePatchDetails() = %v, want no error", err)
 			}
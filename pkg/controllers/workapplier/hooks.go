@@ -0,0 +1,386 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
+)
+
+type hookStatus string
+
+const (
+	hookStatusRunning   hookStatus = "Running"
+	hookStatusSucceeded hookStatus = "Succeeded"
+	hookStatusFailed    hookStatus = "Failed"
+)
+
+// classifyHookBundles sets the hook type of each bundle based on the hook annotation of its
+// manifest object.
+//
+// Only Jobs and Pods can be used as hooks; bundles with an invalid hook annotation are marked
+// as failed.
+//
+// Each hook is also assigned the hash of the manifests it runs for, so that the hook is re-run
+// only when the other manifests in the Work (or the hook itself) change.
+func classifyHookBundles(bundles []*manifestProcessingBundle, workRef klog.ObjectRef) {
+	for idx := range bundles {
+		bundle := bundles[idx]
+		if bundle.gvr == nil || bundle.manifestObj == nil || bundle.applyOrReportDiffErr != nil {
+			continue
+		}
+
+		hookType, ok := bundle.manifestObj.GetAnnotations()[fleetv1beta1.HookAnnotation]
+		if !ok {
+			continue
+		}
+
+		var err error
+		switch {
+		case hookType != fleetv1beta1.PreApplyHook && hookType != fleetv1beta1.PostApplyHook:
+			err = fmt.Errorf("the value %q of annotation %s is not one of %s and %s", hookType, fleetv1beta1.HookAnnotation, fleetv1beta1.PreApplyHook, fleetv1beta1.PostApplyHook)
+		case *bundle.gvr != utils.JobGVR && *bundle.gvr != utils.PodGVR:
+			err = fmt.Errorf("only Jobs and Pods can be used as hooks")
+		}
+		if err != nil {
+			klog.V(2).InfoS("Manifest has an invalid hook annotation", "err", err,
+				"manifestObj", klog.KObj(bundle.manifestObj), "GVR", *bundle.gvr, "work", workRef)
+			bundle.applyOrReportDiffErr = fmt.Errorf("invalid hook: %w", err)
+			bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeInvalidHook
+			continue
+		}
+		bundle.hookType = hookType
+	}
+
+	// Hash the non-hook manifests; this runs before any of the manifest objects is stamped
+	// during processing.
+	var nonHookManifestObjs []map[string]interface{}
+	for idx := range bundles {
+		bundle := bundles[idx]
+		if bundle.manifestObj == nil || len(bundle.hookType) > 0 {
+			continue
+		}
+		nonHookManifestObjs = append(nonHookManifestObjs, bundle.manifestObj.Object)
+	}
+	for idx := range bundles {
+		bundle := bundles[idx]
+		if len(bundle.hookType) == 0 {
+			continue
+		}
+		hash, err := resource.HashOf([]interface{}{nonHookManifestObjs, bundle.manifestObj.Object})
+		if err != nil {
+			klog.ErrorS(err, "Failed to hash the manifests for the hook",
+				"manifestObj", klog.KObj(bundle.manifestObj), "work", workRef)
+			bundle.applyOrReportDiffErr = fmt.Errorf("failed to hash the manifests for the hook: %w", err)
+			bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeFailedToApply
+			bundle.hookType = ""
+			continue
+		}
+		bundle.hookManifestsHash = hash
+	}
+}
+
+type hookPhase int
+
+const (
+	hookPhasePreApply hookPhase = iota
+	hookPhaseMain
+	hookPhasePostApply
+)
+
+// splitWavesIntoHookPhases splits the processing waves into three phases:
+//
+//   - the pre-apply phase, which includes the pre-apply hooks, the manifests they depend on (directly
+//     or indirectly), and the namespaces all these manifests are in, so that the hooks can be created
+//     in the first place;
+//   - the main phase, which includes all the other manifests; and
+//   - the post-apply phase, which includes the post-apply hooks.
+//
+// The wave numbers are kept in each phase. Manifests that depend on manifests in a later phase
+// (e.g., a manifest in the main phase that depends on a post-apply hook) can never be applied; they
+// are marked as failed due to an invalid apply order.
+func splitWavesIntoHookPhases(waves []*bundleProcessingWave, workRef klog.ObjectRef) (preApplyPhase, mainPhase, postApplyPhase []*bundleProcessingWave) {
+	// Index the namespaces in the Work by name, and find all the pre-apply hooks.
+	nsBundleByName := make(map[string]*manifestProcessingBundle)
+	toVisit := make([]*manifestProcessingBundle, 0)
+	for _, wave := range waves {
+		for _, bundle := range wave.bundles {
+			if *bundle.gvr == utils.NamespaceGVR && bundle.manifestObj != nil {
+				nsBundleByName[bundle.manifestObj.GetName()] = bundle
+			}
+			if bundle.hookType == fleetv1beta1.PreApplyHook {
+				toVisit = append(toVisit, bundle)
+			}
+		}
+	}
+
+	// Find all the manifests that the pre-apply hooks depend on, and the namespaces they need.
+	//
+	// Note that the namespaces are hoisted into the pre-apply phase just so that the manifests can
+	// be created; their own dependencies are not.
+	prerequisites := sets.New[*manifestProcessingBundle]()
+	neededNSBundles := sets.New[*manifestProcessingBundle]()
+	for len(toVisit) > 0 {
+		bundle := toVisit[len(toVisit)-1]
+		toVisit = toVisit[:len(toVisit)-1]
+		if bundle.manifestObj != nil {
+			if nsBundle, ok := nsBundleByName[bundle.manifestObj.GetNamespace()]; ok {
+				neededNSBundles.Insert(nsBundle)
+			}
+		}
+		for _, dep := range bundle.dependencies {
+			if dep.hookType == fleetv1beta1.PostApplyHook || prerequisites.Has(dep) {
+				continue
+			}
+			prerequisites.Insert(dep)
+			toVisit = append(toVisit, dep)
+		}
+	}
+
+	phaseByBundle := make(map[*manifestProcessingBundle]hookPhase)
+	for _, wave := range waves {
+		for _, bundle := range wave.bundles {
+			switch {
+			case bundle.hookType == fleetv1beta1.PostApplyHook:
+				phaseByBundle[bundle] = hookPhasePostApply
+			case bundle.hookType == fleetv1beta1.PreApplyHook, prerequisites.Has(bundle), neededNSBundles.Has(bundle):
+				phaseByBundle[bundle] = hookPhasePreApply
+			default:
+				phaseByBundle[bundle] = hookPhaseMain
+			}
+		}
+	}
+
+	addToPhase := func(phase []*bundleProcessingWave, num waveNumber, bundle *manifestProcessingBundle) []*bundleProcessingWave {
+		if len(phase) == 0 || phase[len(phase)-1].num != num {
+			phase = append(phase, &bundleProcessingWave{num: num})
+		}
+		lastWaveInPhase := phase[len(phase)-1]
+		lastWaveInPhase.bundles = append(lastWaveInPhase.bundles, bundle)
+		return phase
+	}
+	for _, wave := range waves {
+		for _, bundle := range wave.bundles {
+			phase := phaseByBundle[bundle]
+			for _, dep := range bundle.dependencies {
+				if depPhase, ok := phaseByBundle[dep]; ok && depPhase > phase {
+					markApplyOrderAsInvalid(bundle, fmt.Errorf("dependency %s is applied in a later phase due to hooks", dep.workResourceIdentifierStr), workRef)
+					break
+				}
+			}
+
+			switch phase {
+			case hookPhasePreApply:
+				preApplyPhase = addToPhase(preApplyPhase, wave.num, bundle)
+			case hookPhasePostApply:
+				postApplyPhase = addToPhase(postApplyPhase, wave.num, bundle)
+			default:
+				mainPhase = addToPhase(mainPhase, wave.num, bundle)
+			}
+		}
+	}
+	return preApplyPhase, mainPhase, postApplyPhase
+}
+
+// hookStatusOf returns the status of a hook object in the member cluster.
+func hookStatusOf(bundle *manifestProcessingBundle) (hookStatus, error) {
+	if bundle.inMemberClusterObj == nil {
+		return hookStatusRunning, nil
+	}
+
+	switch *bundle.gvr {
+	case utils.JobGVR:
+		var job batchv1.Job
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(bundle.inMemberClusterObj.Object, &job); err != nil {
+			// Normally this branch should never run.
+			wrappedErr := fmt.Errorf("failed to convert the unstructured object to a job: %w", err)
+			_ = controller.NewUnexpectedBehaviorError(wrappedErr)
+			return hookStatusRunning, wrappedErr
+		}
+		for _, cond := range job.Status.Conditions {
+			if cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case batchv1.JobComplete:
+				return hookStatusSucceeded, nil
+			case batchv1.JobFailed:
+				return hookStatusFailed, nil
+			}
+		}
+		return hookStatusRunning, nil
+	case utils.PodGVR:
+		var pod corev1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(bundle.inMemberClusterObj.Object, &pod); err != nil {
+			// Normally this branch should never run.
+			wrappedErr := fmt.Errorf("failed to convert the unstructured object to a pod: %w", err)
+			_ = controller.NewUnexpectedBehaviorError(wrappedErr)
+			return hookStatusRunning, wrappedErr
+		}
+		switch pod.Status.Phase {
+		case corev1.PodSucceeded:
+			return hookStatusSucceeded, nil
+		case corev1.PodFailed:
+			return hookStatusFailed, nil
+		}
+		return hookStatusRunning, nil
+	default:
+		// Normally this branch should never run, as only Jobs and Pods can be used as hooks.
+		wrappedErr := fmt.Errorf("found a hook of an unexpected resource type %s", bundle.gvr.String())
+		_ = controller.NewUnexpectedBehaviorError(wrappedErr)
+		return hookStatusRunning, wrappedErr
+	}
+}
+
+// markFailedHooks checks the status of the hooks in the given waves and marks the failed ones.
+//
+// It returns an error if any of the hooks has not succeeded yet.
+func markFailedHooks(waves []*bundleProcessingWave, workRef klog.ObjectRef) error {
+	var firstErr error
+	for _, wave := range waves {
+		for _, bundle := range wave.bundles {
+			if len(bundle.hookType) == 0 {
+				continue
+			}
+			if !isManifestObjectApplied(bundle.applyOrReportDiffResTyp) {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s hook %s has not been applied", bundle.hookType, bundle.workResourceIdentifierStr)
+				}
+				continue
+			}
+
+			status, err := hookStatusOf(bundle)
+			switch {
+			case err != nil:
+				if firstErr == nil {
+					firstErr = err
+				}
+			case status == hookStatusFailed:
+				klog.V(2).InfoS("Hook has failed", "hookType", bundle.hookType,
+					"inMemberClusterObj", klog.KObj(bundle.inMemberClusterObj), "work", workRef)
+				bundle.applyOrReportDiffErr = fmt.Errorf("%s hook has failed; it will be re-run when the Work changes", bundle.hookType)
+				bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeHookFailed
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s hook %s has failed", bundle.hookType, bundle.workResourceIdentifierStr)
+				}
+			case status == hookStatusRunning:
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s hook %s has not completed yet", bundle.hookType, bundle.workResourceIdentifierStr)
+				}
+			}
+		}
+	}
+	return firstErr
+}
+
+// blockBundlesInWaves marks all the bundles in the given waves that have not failed yet as
+// blocked with the given result type and error.
+func blockBundlesInWaves(waves []*bundleProcessingWave, resTyp ManifestProcessingApplyOrReportDiffResultType, err error) {
+	for _, wave := range waves {
+		for _, bundle := range wave.bundles {
+			if bundle.applyOrReportDiffErr != nil {
+				continue
+			}
+			bundle.applyOrReportDiffErr = err
+			bundle.applyOrReportDiffResTyp = resTyp
+		}
+	}
+}
+
+// trackHookAvailability tracks the availability of a hook object in the member cluster; a hook
+// is considered to be available once it has succeeded.
+func trackHookAvailability(bundle *manifestProcessingBundle) (ManifestProcessingAvailabilityResultType, error) {
+	status, err := hookStatusOf(bundle)
+	switch {
+	case err != nil:
+		return AvailabilityResultTypeFailed, err
+	case status == hookStatusSucceeded:
+		return AvailabilityResultTypeAvailable, nil
+	default:
+		return AvailabilityResultTypeNotYetAvailable, nil
+	}
+}
+
+// recreateHookIfOutdated stamps a hook manifest object with the hash of the manifests it runs for,
+// and deletes the hook object in the member cluster if it has run for a different set of manifests,
+// so that the hook can be re-created (and re-run) by the apply op.
+func (r *Reconciler) recreateHookIfOutdated(
+	ctx context.Context,
+	bundle *manifestProcessingBundle,
+	work *fleetv1beta1.Work,
+) (shouldSkipProcessing bool) {
+	if len(bundle.hookType) == 0 {
+		return false
+	}
+
+	annotations := bundle.manifestObj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[fleetv1beta1.HookManifestsHashAnnotation] = bundle.hookManifestsHash
+	bundle.manifestObj.SetAnnotations(annotations)
+
+	inMemberClusterObj := bundle.inMemberClusterObj
+	if inMemberClusterObj == nil {
+		// The hook has not run yet.
+		return false
+	}
+	if inMemberClusterObj.GetDeletionTimestamp() != nil {
+		bundle.applyOrReportDiffErr = fmt.Errorf("the previous run of the hook is still being removed")
+		bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeFailedToApply
+		return true
+	}
+	if inMemberClusterObj.GetAnnotations()[fleetv1beta1.HookManifestsHashAnnotation] == bundle.hookManifestsHash {
+		// The hook has run for the current manifests.
+		return false
+	}
+
+	klog.V(2).InfoS("Removing the previous run of the hook",
+		"hookType", bundle.hookType, "inMemberClusterObj", klog.KObj(inMemberClusterObj), "work", klog.KObj(work))
+	propagationPolicy := metav1.DeletePropagationBackground
+	inMemberClusterObjUID := inMemberClusterObj.GetUID()
+	deleteOpts := metav1.DeleteOptions{
+		PropagationPolicy: &propagationPolicy,
+		Preconditions: &metav1.Preconditions{
+			UID: &inMemberClusterObjUID,
+		},
+	}
+	if err := r.spokeDynamicClient.Resource(*bundle.gvr).Namespace(inMemberClusterObj.GetNamespace()).Delete(ctx, inMemberClusterObj.GetName(), deleteOpts); err != nil && !apierrors.IsNotFound(err) {
+		wrappedErr := controller.NewAPIServerError(false, err)
+		bundle.applyOrReportDiffErr = fmt.Errorf("failed to remove the previous run of the hook: %w", wrappedErr)
+		bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeFailedToApply
+		return true
+	}
+
+	// Note that the object might not be gone yet (e.g., a Pod that is terminating); if so, the
+	// apply op will fail and be retried later.
+	bundle.inMemberClusterObj = nil
+	return false
+}
@@ -0,0 +1,617 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+const (
+	hookJobName = "db-migration"
+)
+
+// hookJob returns an unstructured Job object with the given hook type and job condition (if any).
+func hookJob(hookType string, condType string) *unstructured.Unstructured {
+	job := manifestObjWithAnnotations("batch/v1", "Job", nsName, hookJobName, map[string]string{fleetv1beta1.HookAnnotation: hookType})
+	if len(condType) > 0 {
+		job.Object["status"] = map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{
+					"type":   condType,
+					"status": "True",
+				},
+			},
+		}
+	}
+	return job
+}
+
+// hookPod returns an unstructured Pod object with the given hook type and phase (if any).
+func hookPod(hookType string, phase string) *unstructured.Unstructured {
+	pod := manifestObjWithAnnotations("v1", "Pod", nsName, "smoke-test", map[string]string{fleetv1beta1.HookAnnotation: hookType})
+	if len(phase) > 0 {
+		pod.Object["status"] = map[string]interface{}{
+			"phase": phase,
+		}
+	}
+	return pod
+}
+
+// TestClassifyHookBundles tests the classifyHookBundles function.
+func TestClassifyHookBundles(t *testing.T) {
+	jobGVR := utils.JobGVR
+	podGVR := utils.PodGVR
+	deployGVR := utils.DeploymentGVR
+
+	bundles := []*manifestProcessingBundle{
+		{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 0},
+			gvr:         &jobGVR,
+			manifestObj: hookJob(fleetv1beta1.PreApplyHook, ""),
+		},
+		{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 1},
+			gvr:         &podGVR,
+			manifestObj: hookPod(fleetv1beta1.PostApplyHook, ""),
+		},
+		{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 2},
+			gvr:         &jobGVR,
+			manifestObj: hookJob("pre-install", ""),
+		},
+		{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 3},
+			gvr:         &deployGVR,
+			manifestObj: manifestObjWithAnnotations("apps/v1", "Deployment", nsName, deployName, map[string]string{fleetv1beta1.HookAnnotation: fleetv1beta1.PreApplyHook}),
+		},
+		{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 4},
+			gvr:         &jobGVR,
+			manifestObj: manifestObjWithAnnotations("batch/v1", "Job", nsName, "regular", nil),
+		},
+	}
+	classifyHookBundles(bundles, klog.KRef("", workName))
+
+	wantHookTypes := []string{fleetv1beta1.PreApplyHook, fleetv1beta1.PostApplyHook, "", "", ""}
+	wantResTyps := []ManifestProcessingApplyOrReportDiffResultType{"", "", ApplyOrReportDiffResTypeInvalidHook, ApplyOrReportDiffResTypeInvalidHook, ""}
+	for idx, bundle := range bundles {
+		if bundle.hookType != wantHookTypes[idx] {
+			t.Errorf("bundle %d: hookType = %q, want %q", idx, bundle.hookType, wantHookTypes[idx])
+		}
+		if bundle.applyOrReportDiffResTyp != wantResTyps[idx] {
+			t.Errorf("bundle %d: applyOrReportDiffResTyp = %q, want %q", idx, bundle.applyOrReportDiffResTyp, wantResTyps[idx])
+		}
+		if gotErr := bundle.applyOrReportDiffErr != nil; gotErr != (len(wantResTyps[idx]) > 0) {
+			t.Errorf("bundle %d: applyOrReportDiffErr = %v, want error %t", idx, bundle.applyOrReportDiffErr, len(wantResTyps[idx]) > 0)
+		}
+	}
+}
+
+// TestClassifyHookBundlesManifestsHash tests the manifests hashes the classifyHookBundles function
+// assigns to hooks.
+func TestClassifyHookBundlesManifestsHash(t *testing.T) {
+	jobGVR := utils.JobGVR
+	deployGVR := utils.DeploymentGVR
+
+	// buildBundles returns a pre-apply hook, a post-apply hook, and a regular manifest.
+	buildBundles := func(preApplyHookStatus, deployImage string) []*manifestProcessingBundle {
+		deploy := manifestObjWithAnnotations("apps/v1", "Deployment", nsName, deployName, nil)
+		if err := unstructured.SetNestedField(deploy.Object, deployImage, "spec", "template", "spec", "image"); err != nil {
+			t.Fatalf("failed to set the image of the deployment: %v", err)
+		}
+		bundles := []*manifestProcessingBundle{
+			{
+				id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 0},
+				gvr:         &jobGVR,
+				manifestObj: hookJob(fleetv1beta1.PreApplyHook, preApplyHookStatus),
+			},
+			{
+				id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 1},
+				gvr:         &jobGVR,
+				manifestObj: manifestObjWithAnnotations("batch/v1", "Job", nsName, "post", map[string]string{fleetv1beta1.HookAnnotation: fleetv1beta1.PostApplyHook}),
+			},
+			{
+				id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 2},
+				gvr:         &deployGVR,
+				manifestObj: deploy,
+			},
+		}
+		classifyHookBundles(bundles, klog.KRef("", workName))
+		return bundles
+	}
+
+	original := buildBundles("", "nginx:1.0")
+	if len(original[0].hookManifestsHash) == 0 || len(original[1].hookManifestsHash) == 0 {
+		t.Fatalf("hookManifestsHash = (%q, %q), want non-empty hashes for hooks", original[0].hookManifestsHash, original[1].hookManifestsHash)
+	}
+	if original[0].hookManifestsHash == original[1].hookManifestsHash {
+		t.Errorf("hookManifestsHash of different hooks = %q, want different hashes", original[0].hookManifestsHash)
+	}
+	if len(original[2].hookManifestsHash) != 0 {
+		t.Errorf("hookManifestsHash of a regular manifest = %q, want empty", original[2].hookManifestsHash)
+	}
+
+	unchanged := buildBundles("", "nginx:1.0")
+	for idx := range unchanged {
+		if unchanged[idx].hookManifestsHash != original[idx].hookManifestsHash {
+			t.Errorf("bundle %d: hookManifestsHash = %q with the same manifests, want %q", idx, unchanged[idx].hookManifestsHash, original[idx].hookManifestsHash)
+		}
+	}
+
+	// A change in a regular manifest re-runs all the hooks.
+	regularManifestChanged := buildBundles("", "nginx:2.0")
+	for idx := 0; idx < 2; idx++ {
+		if regularManifestChanged[idx].hookManifestsHash == original[idx].hookManifestsHash {
+			t.Errorf("bundle %d: hookManifestsHash = %q after a regular manifest changed, want a different hash", idx, regularManifestChanged[idx].hookManifestsHash)
+		}
+	}
+
+	// A change in a hook re-runs only that hook.
+	hookChanged := buildBundles("Complete", "nginx:1.0")
+	if hookChanged[0].hookManifestsHash == original[0].hookManifestsHash {
+		t.Errorf("hookManifestsHash of the changed hook = %q, want a different hash", hookChanged[0].hookManifestsHash)
+	}
+	if hookChanged[1].hookManifestsHash != original[1].hookManifestsHash {
+		t.Errorf("hookManifestsHash of the unchanged hook = %q, want %q", hookChanged[1].hookManifestsHash, original[1].hookManifestsHash)
+	}
+}
+
+// TestSplitWavesIntoHookPhases tests the splitWavesIntoHookPhases function.
+func TestSplitWavesIntoHookPhases(t *testing.T) {
+	// newBundles returns a fresh set of bundles for each test case, as the function under test
+	// might mark some of them as failed.
+	newBundles := func() []*manifestProcessingBundle {
+		nsBundle := &manifestProcessingBundle{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 0},
+			gvr:         &utils.NamespaceGVR,
+			manifestObj: manifestObjWithAnnotations("v1", "Namespace", "", nsName, nil),
+		}
+		configMapBundle := &manifestProcessingBundle{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 1},
+			gvr:         &utils.ConfigMapGVR,
+			manifestObj: manifestObjWithAnnotations("v1", "ConfigMap", nsName, configMapName, nil),
+		}
+		serviceAccountBundle := &manifestProcessingBundle{
+			id:           &fleetv1beta1.WorkResourceIdentifier{Ordinal: 2},
+			gvr:          &schema.GroupVersionResource{Version: "v1", Resource: "serviceaccounts"},
+			manifestObj:  manifestObjWithAnnotations("v1", "ServiceAccount", nsName, "db-admin", nil),
+			dependencies: []*manifestProcessingBundle{configMapBundle},
+		}
+		secretBundle := &manifestProcessingBundle{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 3},
+			gvr:         &utils.SecretGVR,
+			manifestObj: manifestObjWithAnnotations("v1", "Secret", nsName, "app-secret", nil),
+		}
+		preApplyHookBundle := &manifestProcessingBundle{
+			id:           &fleetv1beta1.WorkResourceIdentifier{Ordinal: 4},
+			gvr:          &utils.JobGVR,
+			manifestObj:  hookJob(fleetv1beta1.PreApplyHook, ""),
+			hookType:     fleetv1beta1.PreApplyHook,
+			dependencies: []*manifestProcessingBundle{serviceAccountBundle},
+		}
+		deployBundle := &manifestProcessingBundle{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 5},
+			gvr:         &utils.DeploymentGVR,
+			manifestObj: manifestObjWithAnnotations("apps/v1", "Deployment", nsName, deployName, nil),
+		}
+		postApplyHookBundle := &manifestProcessingBundle{
+			id:           &fleetv1beta1.WorkResourceIdentifier{Ordinal: 6},
+			gvr:          &utils.PodGVR,
+			manifestObj:  hookPod(fleetv1beta1.PostApplyHook, ""),
+			hookType:     fleetv1beta1.PostApplyHook,
+			dependencies: []*manifestProcessingBundle{deployBundle},
+		}
+		otherNSBundle := &manifestProcessingBundle{
+			id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 7},
+			gvr:         &utils.NamespaceGVR,
+			manifestObj: manifestObjWithAnnotations("v1", "Namespace", "", "other", nil),
+		}
+		return []*manifestProcessingBundle{
+			nsBundle, configMapBundle, serviceAccountBundle, secretBundle,
+			preApplyHookBundle, deployBundle, postApplyHookBundle, otherNSBundle,
+		}
+	}
+	// allWaves organizes the given bundles into waves as the work applier would by default.
+	allWaves := func(b []*manifestProcessingBundle) []*bundleProcessingWave {
+		return []*bundleProcessingWave{
+			{num: 0, bundles: []*manifestProcessingBundle{b[0], b[7]}},
+			{num: 1, bundles: []*manifestProcessingBundle{b[1], b[2], b[3]}},
+			{num: 4, bundles: []*manifestProcessingBundle{b[4], b[5], b[6]}},
+		}
+	}
+
+	testCases := []struct {
+		name                  string
+		waves                 func(b []*manifestProcessingBundle) []*bundleProcessingWave
+		wantPreApplyOrdinals  map[waveNumber][]int
+		wantMainOrdinals      map[waveNumber][]int
+		wantPostApplyOrdinals map[waveNumber][]int
+		wantInvalidOrdinals   []int
+	}{
+		{
+			name: "no hooks",
+			waves: func(b []*manifestProcessingBundle) []*bundleProcessingWave {
+				return []*bundleProcessingWave{
+					{num: 0, bundles: []*manifestProcessingBundle{b[0]}},
+					{num: 1, bundles: []*manifestProcessingBundle{b[1], b[3]}},
+					{num: 4, bundles: []*manifestProcessingBundle{b[5]}},
+				}
+			},
+			wantMainOrdinals: map[waveNumber][]int{
+				0: {0},
+				1: {1, 3},
+				4: {5},
+			},
+		},
+		{
+			name:  "pre-apply and post-apply hooks",
+			waves: allWaves,
+			wantPreApplyOrdinals: map[waveNumber][]int{
+				0: {0},
+				1: {1, 2},
+				4: {4},
+			},
+			wantMainOrdinals: map[waveNumber][]int{
+				0: {7},
+				1: {3},
+				4: {5},
+			},
+			wantPostApplyOrdinals: map[waveNumber][]int{
+				4: {6},
+			},
+		},
+		{
+			name: "namespace needed by pre-apply hooks depends on a manifest in the main phase",
+			waves: func(b []*manifestProcessingBundle) []*bundleProcessingWave {
+				b[0].dependencies = []*manifestProcessingBundle{b[3]}
+				return allWaves(b)
+			},
+			wantPreApplyOrdinals: map[waveNumber][]int{
+				0: {0},
+				1: {1, 2},
+				4: {4},
+			},
+			wantMainOrdinals: map[waveNumber][]int{
+				0: {7},
+				1: {3},
+				4: {5},
+			},
+			wantPostApplyOrdinals: map[waveNumber][]int{
+				4: {6},
+			},
+			wantInvalidOrdinals: []int{0},
+		},
+		{
+			name: "pre-apply hook depends on a post-apply hook",
+			waves: func(b []*manifestProcessingBundle) []*bundleProcessingWave {
+				b[4].dependencies = append(b[4].dependencies, b[6])
+				return allWaves(b)
+			},
+			wantPreApplyOrdinals: map[waveNumber][]int{
+				0: {0},
+				1: {1, 2},
+				4: {4},
+			},
+			wantMainOrdinals: map[waveNumber][]int{
+				0: {7},
+				1: {3},
+				4: {5},
+			},
+			wantPostApplyOrdinals: map[waveNumber][]int{
+				4: {6},
+			},
+			wantInvalidOrdinals: []int{4},
+		},
+		{
+			name: "manifest in the main phase depends on a post-apply hook",
+			waves: func(b []*manifestProcessingBundle) []*bundleProcessingWave {
+				b[3].dependencies = []*manifestProcessingBundle{b[6]}
+				return allWaves(b)
+			},
+			wantPreApplyOrdinals: map[waveNumber][]int{
+				0: {0},
+				1: {1, 2},
+				4: {4},
+			},
+			wantMainOrdinals: map[waveNumber][]int{
+				0: {7},
+				1: {3},
+				4: {5},
+			},
+			wantPostApplyOrdinals: map[waveNumber][]int{
+				4: {6},
+			},
+			wantInvalidOrdinals: []int{3},
+		},
+	}
+
+	ordinalsByWave := func(waves []*bundleProcessingWave) map[waveNumber][]int {
+		res := make(map[waveNumber][]int)
+		for _, wave := range waves {
+			if _, ok := res[wave.num]; ok {
+				t.Errorf("found duplicate wave %d in the same phase", wave.num)
+			}
+			for _, bundle := range wave.bundles {
+				res[wave.num] = append(res[wave.num], bundle.id.Ordinal)
+			}
+		}
+		return res
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bundles := newBundles()
+			preApplyPhase, mainPhase, postApplyPhase := splitWavesIntoHookPhases(tc.waves(bundles), klog.KRef("", workName))
+			if diff := cmp.Diff(ordinalsByWave(preApplyPhase), tc.wantPreApplyOrdinals, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("pre-apply phase mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(ordinalsByWave(mainPhase), tc.wantMainOrdinals, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("main phase mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(ordinalsByWave(postApplyPhase), tc.wantPostApplyOrdinals, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("post-apply phase mismatch (-got, +want):\n%s", diff)
+			}
+
+			var gotInvalidOrdinals []int
+			for _, bundle := range bundles {
+				if bundle.applyOrReportDiffResTyp == ApplyOrReportDiffResTypeInvalidApplyOrder {
+					gotInvalidOrdinals = append(gotInvalidOrdinals, bundle.id.Ordinal)
+				}
+			}
+			if diff := cmp.Diff(gotInvalidOrdinals, tc.wantInvalidOrdinals); diff != "" {
+				t.Errorf("bundles with invalid apply order mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestMarkFailedHooks tests the markFailedHooks function.
+func TestMarkFailedHooks(t *testing.T) {
+	testCases := []struct {
+		name        string
+		bundle      *manifestProcessingBundle
+		wantErr     bool
+		wantResTyp  ManifestProcessingApplyOrReportDiffResultType
+		wantAvailTy ManifestProcessingAvailabilityResultType
+	}{
+		{
+			name: "job succeeded",
+			bundle: &manifestProcessingBundle{
+				gvr:                     &utils.JobGVR,
+				hookType:                fleetv1beta1.PreApplyHook,
+				inMemberClusterObj:      hookJob(fleetv1beta1.PreApplyHook, "Complete"),
+				applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+			},
+			wantResTyp:  ApplyOrReportDiffResTypeApplied,
+			wantAvailTy: AvailabilityResultTypeAvailable,
+		},
+		{
+			name: "job failed",
+			bundle: &manifestProcessingBundle{
+				gvr:                     &utils.JobGVR,
+				hookType:                fleetv1beta1.PreApplyHook,
+				inMemberClusterObj:      hookJob(fleetv1beta1.PreApplyHook, "Failed"),
+				applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+			},
+			wantErr:     true,
+			wantResTyp:  ApplyOrReportDiffResTypeHookFailed,
+			wantAvailTy: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name: "job running",
+			bundle: &manifestProcessingBundle{
+				gvr:                     &utils.JobGVR,
+				hookType:                fleetv1beta1.PreApplyHook,
+				inMemberClusterObj:      hookJob(fleetv1beta1.PreApplyHook, ""),
+				applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+			},
+			wantErr:     true,
+			wantResTyp:  ApplyOrReportDiffResTypeApplied,
+			wantAvailTy: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name: "pod succeeded",
+			bundle: &manifestProcessingBundle{
+				gvr:                     &utils.PodGVR,
+				hookType:                fleetv1beta1.PostApplyHook,
+				inMemberClusterObj:      hookPod(fleetv1beta1.PostApplyHook, "Succeeded"),
+				applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+			},
+			wantResTyp:  ApplyOrReportDiffResTypeApplied,
+			wantAvailTy: AvailabilityResultTypeAvailable,
+		},
+		{
+			name: "pod failed",
+			bundle: &manifestProcessingBundle{
+				gvr:                     &utils.PodGVR,
+				hookType:                fleetv1beta1.PostApplyHook,
+				inMemberClusterObj:      hookPod(fleetv1beta1.PostApplyHook, "Failed"),
+				applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+			},
+			wantErr:     true,
+			wantResTyp:  ApplyOrReportDiffResTypeHookFailed,
+			wantAvailTy: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name: "hook not applied",
+			bundle: &manifestProcessingBundle{
+				gvr:                     &utils.JobGVR,
+				hookType:                fleetv1beta1.PreApplyHook,
+				applyOrReportDiffResTyp: ApplyOrReportDiffResTypeFailedToApply,
+			},
+			wantErr:     true,
+			wantResTyp:  ApplyOrReportDiffResTypeFailedToApply,
+			wantAvailTy: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name: "not a hook",
+			bundle: &manifestProcessingBundle{
+				gvr:                     &utils.JobGVR,
+				inMemberClusterObj:      hookJob("", "Failed"),
+				applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+			},
+			wantResTyp:  ApplyOrReportDiffResTypeApplied,
+			wantAvailTy: AvailabilityResultTypeNotYetAvailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if len(tc.bundle.hookType) > 0 {
+				availTyp, err := trackHookAvailability(tc.bundle)
+				if err != nil {
+					t.Fatalf("trackHookAvailability() = %v, want no error", err)
+				}
+				if availTyp != tc.wantAvailTy {
+					t.Errorf("trackHookAvailability() = %s, want %s", availTyp, tc.wantAvailTy)
+				}
+			}
+
+			waves := []*bundleProcessingWave{{num: 4, bundles: []*manifestProcessingBundle{tc.bundle}}}
+			err := markFailedHooks(waves, klog.KRef("", workName))
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("markFailedHooks() = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.bundle.applyOrReportDiffResTyp != tc.wantResTyp {
+				t.Errorf("applyOrReportDiffResTyp = %s, want %s", tc.bundle.applyOrReportDiffResTyp, tc.wantResTyp)
+			}
+		})
+	}
+}
+
+// TestRecreateHookIfOutdated tests the recreateHookIfOutdated method.
+func TestRecreateHookIfOutdated(t *testing.T) {
+	ctx := context.Background()
+	work := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       workName,
+			Generation: 2,
+		},
+	}
+	currentHash := "current-hash"
+
+	withHash := func(obj *unstructured.Unstructured, hash string) *unstructured.Unstructured {
+		annotations := obj.GetAnnotations()
+		annotations[fleetv1beta1.HookManifestsHashAnnotation] = hash
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	terminatingJob := withHash(hookJob(fleetv1beta1.PreApplyHook, ""), "earlier-hash")
+	deletionTimestamp := metav1.Now()
+	terminatingJob.SetDeletionTimestamp(&deletionTimestamp)
+
+	testCases := []struct {
+		name                   string
+		hookType               string
+		inMemberClusterObj     *unstructured.Unstructured
+		wantShouldSkip         bool
+		wantInMemberClusterObj bool
+		wantDeleted            bool
+		wantHashStamped        bool
+	}{
+		{
+			name:               "not a hook",
+			inMemberClusterObj: manifestObjWithAnnotations("batch/v1", "Job", nsName, hookJobName, nil),
+			// The object is not touched.
+			wantInMemberClusterObj: true,
+		},
+		{
+			name:            "hook not run yet",
+			hookType:        fleetv1beta1.PreApplyHook,
+			wantHashStamped: true,
+		},
+		{
+			name:                   "hook has run for the current manifests",
+			hookType:               fleetv1beta1.PreApplyHook,
+			inMemberClusterObj:     withHash(hookJob(fleetv1beta1.PreApplyHook, "Complete"), currentHash),
+			wantInMemberClusterObj: true,
+			wantHashStamped:        true,
+		},
+		{
+			name:               "hook has run for different manifests",
+			hookType:           fleetv1beta1.PreApplyHook,
+			inMemberClusterObj: withHash(hookJob(fleetv1beta1.PreApplyHook, "Complete"), "earlier-hash"),
+			wantDeleted:        true,
+			wantHashStamped:    true,
+		},
+		{
+			name:                   "previous run being removed",
+			hookType:               fleetv1beta1.PreApplyHook,
+			inMemberClusterObj:     terminatingJob,
+			wantShouldSkip:         true,
+			wantInMemberClusterObj: true,
+			wantHashStamped:        true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var fakeClient *fake.FakeDynamicClient
+			if tc.inMemberClusterObj != nil {
+				fakeClient = fake.NewSimpleDynamicClient(scheme.Scheme, tc.inMemberClusterObj)
+			} else {
+				fakeClient = fake.NewSimpleDynamicClient(scheme.Scheme)
+			}
+			r := &Reconciler{
+				spokeDynamicClient: fakeClient,
+			}
+
+			bundle := &manifestProcessingBundle{
+				gvr:                &utils.JobGVR,
+				hookType:           tc.hookType,
+				hookManifestsHash:  currentHash,
+				manifestObj:        hookJob(fleetv1beta1.PreApplyHook, ""),
+				inMemberClusterObj: tc.inMemberClusterObj,
+			}
+			if gotShouldSkip := r.recreateHookIfOutdated(ctx, bundle, work); gotShouldSkip != tc.wantShouldSkip {
+				t.Errorf("recreateHookIfOutdated() = %t, want %t", gotShouldSkip, tc.wantShouldSkip)
+			}
+			if gotErr := bundle.applyOrReportDiffErr != nil; gotErr != tc.wantShouldSkip {
+				t.Errorf("applyOrReportDiffErr = %v, want error %t", bundle.applyOrReportDiffErr, tc.wantShouldSkip)
+			}
+			if gotInMemberClusterObj := bundle.inMemberClusterObj != nil; gotInMemberClusterObj != tc.wantInMemberClusterObj {
+				t.Errorf("inMemberClusterObj = %v, want present %t", bundle.inMemberClusterObj, tc.wantInMemberClusterObj)
+			}
+			gotHash, stamped := bundle.manifestObj.GetAnnotations()[fleetv1beta1.HookManifestsHashAnnotation]
+			if stamped != tc.wantHashStamped || (stamped && gotHash != currentHash) {
+				t.Errorf("hook manifests hash annotation = %q (present %t), want present %t", gotHash, stamped, tc.wantHashStamped)
+			}
+
+			if tc.inMemberClusterObj == nil {
+				return
+			}
+			_, err := fakeClient.Resource(utils.JobGVR).Namespace(nsName).Get(ctx, hookJobName, metav1.GetOptions{})
+			if gotDeleted := errors.IsNotFound(err); gotDeleted != tc.wantDeleted {
+				t.Errorf("Get() = %v, want deleted %t", err, tc.wantDeleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/qri-io/jsonpointer"
+	"github.com/wI2L/jsondiff"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v6/value"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// jsonPointerEscaper escapes a reference token for use in a JSON pointer, as specified in RFC 6901.
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// isSubjectToIgnoreDifferenceRule checks if an object is subject to an IgnoreDifferences rule,
+// i.e., if its group, kind, version, and name match those in the rule.
+func isSubjectToIgnoreDifferenceRule(obj *unstructured.Unstructured, rule *fleetv1beta1.IgnoreDifference) (bool, error) {
+	gvk := obj.GroupVersionKind()
+	if rule.Group != gvk.Group || rule.Kind != gvk.Kind {
+		return false, nil
+	}
+	if len(rule.Version) > 0 && rule.Version != gvk.Version {
+		return false, nil
+	}
+	if len(rule.Name) == 0 {
+		return true, nil
+	}
+	matched, err := path.Match(rule.Name, obj.GetName())
+	if err != nil {
+		return false, fmt.Errorf("failed to match the name against pattern %q: %w", rule.Name, err)
+	}
+	return matched, nil
+}
+
+// ignoredJSONPointersFor returns the JSON pointers to the fields that should be skipped when
+// calculating drifts and diffs between a manifest object and its corresponding object in the
+// member cluster, as dictated by the IgnoreDifferences rules in the apply strategy.
+func ignoredJSONPointersFor(
+	manifestObj, inMemberClusterObj *unstructured.Unstructured,
+	rules []fleetv1beta1.IgnoreDifference,
+) ([]string, error) {
+	var ignoredPtrs []string
+	var managers []string
+	for idx := range rules {
+		rule := &rules[idx]
+		isSubject, err := isSubjectToIgnoreDifferenceRule(manifestObj, rule)
+		if err != nil {
+			return nil, fmt.Errorf("failed to process the IgnoreDifferences rule at index %d: %w", idx, err)
+		}
+		if !isSubject {
+			continue
+		}
+		ignoredPtrs = append(ignoredPtrs, rule.JSONPointers...)
+		managers = append(managers, rule.ManagedFieldsManagers...)
+	}
+
+	if len(managers) == 0 || inMemberClusterObj == nil {
+		return ignoredPtrs, nil
+	}
+
+	// Find the fields that are owned by the specified managers in the member cluster.
+	managedFields := inMemberClusterObj.GetManagedFields()
+	for idx := range managedFields {
+		mf := &managedFields[idx]
+		if mf.FieldsV1 == nil || !isManagerListed(mf.Manager, managers) {
+			continue
+		}
+
+		fieldSet := &fieldpath.Set{}
+		if err := fieldSet.FromJSON(bytes.NewReader(mf.FieldsV1.Raw)); err != nil {
+			return nil, fmt.Errorf("failed to parse the managed fields of manager %s: %w", mf.Manager, err)
+		}
+		// Iterate over all the members of the field set rather than just the leaves: a manager
+		// that creates a field with sub-fields (e.g., a list item or a map) owns the field itself
+		// as well (marked with `.` in the managed fields), and adding such a field to the object
+		// is reported as a single diff at the field itself.
+		fieldSet.Iterate(func(p fieldpath.Path) {
+			ptr, ok := jsonPointerForFieldPath(inMemberClusterObj.Object, p)
+			if !ok {
+				// The field cannot be located in the object; normally this should not happen.
+				klog.V(2).InfoS("Failed to locate a managed field in the object from the member cluster; skip it",
+					"fieldPath", p.String(), "manager", mf.Manager, "inMemberClusterObj", klog.KObj(inMemberClusterObj))
+				return
+			}
+			ignoredPtrs = append(ignoredPtrs, ptr)
+		})
+	}
+	return ignoredPtrs, nil
+}
+
+// isManagerListed checks if a field manager is in the given list of managers.
+func isManagerListed(manager string, managers []string) bool {
+	for _, m := range managers {
+		if m == manager {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonPointerForFieldPath resolves a field path (as used in managed fields) into a JSON pointer
+// to the same field in the given object.
+//
+// Field paths select list items by key or by value, while JSON pointers select them by index;
+// consequently the resolution must be done against a specific object.
+func jsonPointerForFieldPath(obj interface{}, p fieldpath.Path) (string, bool) {
+	var sb strings.Builder
+	cur := obj
+	for _, pe := range p {
+		if pe.FieldName != nil {
+			m, ok := cur.(map[string]interface{})
+			if !ok {
+				return "", false
+			}
+			cur = m[*pe.FieldName]
+			sb.WriteString("/")
+			sb.WriteString(jsonPointerEscaper.Replace(*pe.FieldName))
+			continue
+		}
+
+		l, ok := cur.([]interface{})
+		if !ok {
+			return "", false
+		}
+		itemIdx := -1
+		switch {
+		case pe.Key != nil:
+			for i := range l {
+				if isListItemMatchingKey(l[i], *pe.Key) {
+					itemIdx = i
+					break
+				}
+			}
+		case pe.Value != nil:
+			for i := range l {
+				if value.Equals(value.NewValueInterface(l[i]), *pe.Value) {
+					itemIdx = i
+					break
+				}
+			}
+		case pe.Index != nil:
+			itemIdx = *pe.Index
+		}
+		if itemIdx < 0 || itemIdx >= len(l) {
+			return "", false
+		}
+		cur = l[itemIdx]
+		sb.WriteString("/")
+		sb.WriteString(strconv.Itoa(itemIdx))
+	}
+	return sb.String(), true
+}
+
+// isListItemMatchingKey checks if an item in an associative list has all the key fields set
+// to the given values.
+func isListItemMatchingKey(item interface{}, key value.FieldList) bool {
+	m, ok := item.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for _, f := range key {
+		v, found := m[f.Name]
+		if !found || !value.Equals(value.NewValueInterface(v), f.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+// removeIgnoredPatchOps removes the JSON patch operations that concern the ignored fields (or
+// their sub-fields) from a patch that transforms one object into another (the destination object).
+//
+// The operations are filtered before they are organized into Fleet patch details, so that the
+// ignored fields do not count towards the limit on the number of patch details per object.
+func removeIgnoredPatchOps(patch jsondiff.Patch, destObjMap map[string]interface{}, ignoredPtrs []string) jsondiff.Patch {
+	if len(ignoredPtrs) == 0 || len(patch) == 0 {
+		return patch
+	}
+
+	// JSON patches append items to a list with the end-of-list token (`-`) rather than an index;
+	// resolve the token into an index in the destination object so that the operation can be
+	// matched against the ignored fields. Appends to the same list are applied in order, and
+	// together they make up the tail of the list.
+	appendCounts := make(map[string]int)
+	for idx := range patch {
+		if listPath, ok := appendedListPathOf(&patch[idx]); ok {
+			appendCounts[listPath]++
+		}
+	}
+
+	kept := make(jsondiff.Patch, 0, len(patch))
+	appendsSeen := make(map[string]int)
+	for idx := range patch {
+		op := patch[idx]
+		opPath := op.Path
+		if listPath, ok := appendedListPathOf(&op); ok {
+			if listPtr, err := jsonpointer.Parse(listPath); err == nil {
+				if l, err := listPtr.Eval(destObjMap); err == nil {
+					if list, ok := l.([]interface{}); ok {
+						opPath = fmt.Sprintf("%s/%d", listPath, len(list)-appendCounts[listPath]+appendsSeen[listPath])
+					}
+				}
+			}
+			appendsSeen[listPath]++
+		}
+
+		isIgnored := isPathIgnored(opPath, ignoredPtrs)
+		if op.Type == jsondiff.OperationMove {
+			// A move operation concerns both the source and the destination paths.
+			isIgnored = isIgnored && isPathIgnored(op.From, ignoredPtrs)
+		}
+		if isIgnored {
+			continue
+		}
+		kept = append(kept, op)
+	}
+	return kept
+}
+
+// appendedListPathOf returns the path to the list that an add operation appends an item to, if
+// the operation is an append (i.e., uses the end-of-list token).
+func appendedListPathOf(op *jsondiff.Operation) (string, bool) {
+	if op.Type != jsondiff.OperationAdd || !strings.HasSuffix(op.Path, "/-") {
+		return "", false
+	}
+	return strings.TrimSuffix(op.Path, "/-"), true
+}
+
+// isPathIgnored checks if a JSON path points to an ignored field or one of its sub-fields.
+func isPathIgnored(p string, ignoredPtrs []string) bool {
+	for _, ptr := range ignoredPtrs {
+		ptr = strings.TrimSuffix(ptr, "/")
+		if p == ptr || strings.HasPrefix(p, ptr+"/") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,430 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/wI2L/jsondiff"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// TestIsSubjectToIgnoreDifferenceRule tests the isSubjectToIgnoreDifferenceRule function.
+func TestIsSubjectToIgnoreDifferenceRule(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rule    *fleetv1beta1.IgnoreDifference
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "group and kind match",
+			rule: &fleetv1beta1.IgnoreDifference{
+				Group: "apps",
+				Kind:  "Deployment",
+			},
+			want: true,
+		},
+		{
+			name: "kind mismatch",
+			rule: &fleetv1beta1.IgnoreDifference{
+				Group: "apps",
+				Kind:  "StatefulSet",
+			},
+		},
+		{
+			name: "group mismatch",
+			rule: &fleetv1beta1.IgnoreDifference{
+				Kind: "Deployment",
+			},
+		},
+		{
+			name: "version mismatch",
+			rule: &fleetv1beta1.IgnoreDifference{
+				Group:   "apps",
+				Version: "v1beta2",
+				Kind:    "Deployment",
+			},
+		},
+		{
+			name: "name pattern match",
+			rule: &fleetv1beta1.IgnoreDifference{
+				Group:   "apps",
+				Version: "v1",
+				Kind:    "Deployment",
+				Name:    "deploy-*",
+			},
+			want: true,
+		},
+		{
+			name: "name pattern mismatch",
+			rule: &fleetv1beta1.IgnoreDifference{
+				Group: "apps",
+				Kind:  "Deployment",
+				Name:  "web-*",
+			},
+		},
+		{
+			name: "invalid name pattern",
+			rule: &fleetv1beta1.IgnoreDifference{
+				Group: "apps",
+				Kind:  "Deployment",
+				Name:  "deploy-[",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := isSubjectToIgnoreDifferenceRule(deployUnstructured, tc.rule)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("isSubjectToIgnoreDifferenceRule() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("isSubjectToIgnoreDifferenceRule() = %v, want no error", err)
+			}
+			if got != tc.want {
+				t.Errorf("isSubjectToIgnoreDifferenceRule() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestIgnoredJSONPointersFor tests the ignoredJSONPointersFor function.
+func TestIgnoredJSONPointersFor(t *testing.T) {
+	inMemberClusterDeploy := deploy.DeepCopy()
+	inMemberClusterDeploy.Spec.Template.Labels["app.kubernetes.io/name"] = "nginx"
+	inMemberClusterDeploy.Spec.Template.Spec.Containers = append(inMemberClusterDeploy.Spec.Template.Spec.Containers,
+		inMemberClusterDeploy.Spec.Template.Spec.Containers[0])
+	inMemberClusterDeploy.Spec.Template.Spec.Containers[0].Name = "sidecar"
+	inMemberClusterDeploy.ManagedFields = []metav1.ManagedFieldsEntry{
+		{
+			Manager:   "mesh-injector",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1: &metav1.FieldsV1{
+				Raw: []byte(`{"f:spec":{"f:template":{"f:metadata":{"f:labels":{"f:app.kubernetes.io/name":{}}},"f:spec":{"f:containers":{"k:{\"name\":\"sidecar\"}":{".":{},"f:image":{}}}}}}}`),
+			},
+		},
+		{
+			Manager:   "kube-controller-manager",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1: &metav1.FieldsV1{
+				Raw: []byte(`{"f:spec":{"f:replicas":{}}}`),
+			},
+		},
+	}
+	inMemberClusterObj := toUnstructured(t, inMemberClusterDeploy)
+
+	testCases := []struct {
+		name               string
+		inMemberClusterObj bool
+		rules              []fleetv1beta1.IgnoreDifference
+		wantPtrs           []string
+	}{
+		{
+			name: "no rules",
+		},
+		{
+			name: "no matching rules",
+			rules: []fleetv1beta1.IgnoreDifference{
+				{
+					Group:        "apps",
+					Kind:         "StatefulSet",
+					JSONPointers: []string{"/spec/replicas"},
+				},
+			},
+		},
+		{
+			name: "JSON pointers",
+			rules: []fleetv1beta1.IgnoreDifference{
+				{
+					Group:        "apps",
+					Kind:         "Deployment",
+					JSONPointers: []string{"/spec/replicas"},
+				},
+				{
+					Group:        "apps",
+					Kind:         "Deployment",
+					Name:         "deploy-*",
+					JSONPointers: []string{"/metadata/annotations"},
+				},
+			},
+			wantPtrs: []string{"/spec/replicas", "/metadata/annotations"},
+		},
+		{
+			name:               "managed fields managers",
+			inMemberClusterObj: true,
+			rules: []fleetv1beta1.IgnoreDifference{
+				{
+					Group:                 "apps",
+					Kind:                  "Deployment",
+					ManagedFieldsManagers: []string{"mesh-injector"},
+				},
+			},
+			wantPtrs: []string{
+				"/spec/template/metadata/labels/app.kubernetes.io~1name",
+				"/spec/template/spec/containers/0",
+				"/spec/template/spec/containers/0/image",
+			},
+		},
+		{
+			name: "managed fields managers (no object in the member cluster)",
+			rules: []fleetv1beta1.IgnoreDifference{
+				{
+					Group:                 "apps",
+					Kind:                  "Deployment",
+					ManagedFieldsManagers: []string{"mesh-injector"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var obj = inMemberClusterObj
+			if !tc.inMemberClusterObj {
+				obj = nil
+			}
+			gotPtrs, err := ignoredJSONPointersFor(deployUnstructured, obj, tc.rules)
+			if err != nil {
+				t.Fatalf("ignoredJSONPointersFor() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(gotPtrs, tc.wantPtrs); diff != "" {
+				t.Errorf("ignoredJSONPointersFor() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestRemoveIgnoredPatchOps tests the removeIgnoredPatchOps function.
+func TestRemoveIgnoredPatchOps(t *testing.T) {
+	destObjMap := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"containers": []interface{}{"nginx", "sidecar", "proxy"},
+		},
+	}
+	patch := jsondiff.Patch{
+		{
+			Type:  jsondiff.OperationReplace,
+			Path:  "/spec/replicas",
+			Value: 3,
+		},
+		{
+			Type:  jsondiff.OperationAdd,
+			Path:  "/spec/replicasHistory",
+			Value: 3,
+		},
+		{
+			Type: jsondiff.OperationRemove,
+			Path: "/metadata/labels/app",
+		},
+		{
+			Type:  jsondiff.OperationAdd,
+			Path:  "/spec/containers/-",
+			Value: "sidecar",
+		},
+		{
+			Type:  jsondiff.OperationAdd,
+			Path:  "/spec/containers/-",
+			Value: "proxy",
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		ignoredPtrs []string
+		wantPatch   jsondiff.Patch
+	}{
+		{
+			name:      "no ignored fields",
+			wantPatch: patch,
+		},
+		{
+			name:        "exact match",
+			ignoredPtrs: []string{"/spec/replicas"},
+			wantPatch:   patch[1:],
+		},
+		{
+			name:        "parent field",
+			ignoredPtrs: []string{"/metadata/labels/"},
+			wantPatch:   jsondiff.Patch{patch[0], patch[1], patch[3], patch[4]},
+		},
+		{
+			name:        "appended list item",
+			ignoredPtrs: []string{"/spec/containers/2"},
+			wantPatch:   patch[:4],
+		},
+		{
+			name:        "all ignored",
+			ignoredPtrs: []string{"/spec", "/metadata"},
+			wantPatch:   jsondiff.Patch{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotPatch := removeIgnoredPatchOps(patch, destObjMap, tc.ignoredPtrs)
+			if diff := cmp.Diff(gotPatch, tc.wantPatch, cmpopts.IgnoreUnexported(jsondiff.Operation{})); diff != "" {
+				t.Errorf("removeIgnoredPatchOps() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestPreparePatchDetailsWithManyIgnoredFields tests that differences in the ignored fields do not
+// count towards the limit on the number of patch details per object.
+func TestPreparePatchDetailsWithManyIgnoredFields(t *testing.T) {
+	inMemberClusterDeploy := deploy.DeepCopy()
+	inMemberClusterDeploy.Spec.Template.Spec.Containers[0].Image = "nginx:latest"
+	inMemberClusterDeploy.Spec.Template.Labels = map[string]string{}
+	for idx := 0; idx < patchDetailPerObjLimit*2; idx++ {
+		inMemberClusterDeploy.Spec.Template.Labels[fmt.Sprintf("label-%d", idx)] = "value"
+	}
+	inMemberClusterObj := toUnstructured(t, inMemberClusterDeploy)
+
+	gotDetails, err := preparePatchDetails(deployUnstructured, inMemberClusterObj, []string{"/spec/template/metadata/labels"})
+	if err != nil {
+		t.Fatalf("preparePatchDetails() = %v, want no error", err)
+	}
+	wantDetails := []fleetv1beta1.PatchDetail{
+		{
+			Path:          "/spec/template/spec/containers/0/image",
+			ValueInHub:    "nginx",
+			ValueInMember: "nginx:latest",
+		},
+	}
+	if diff := cmp.Diff(gotDetails, wantDetails); diff != "" {
+		t.Errorf("preparePatchDetails() mismatches (-got, +want):\n%s", diff)
+	}
+}
+
+// TestDiffBetweenManifestAndInMemberClusterObjectsWithIgnoredFields tests the
+// diffBetweenManifestAndInMemberClusterObjects function with IgnoreDifferences rules set.
+func TestDiffBetweenManifestAndInMemberClusterObjectsWithIgnoredFields(t *testing.T) {
+	inMemberClusterDeploy := deploy.DeepCopy()
+	inMemberClusterDeploy.Spec.Replicas = ptr.To(int32(3))
+	inMemberClusterDeploy.Spec.Template.Spec.Containers[0].Image = "nginx:latest"
+	inMemberClusterObj := toUnstructured(t, inMemberClusterDeploy)
+
+	applyStrategy := &fleetv1beta1.ApplyStrategy{
+		ComparisonOption: fleetv1beta1.ComparisonOptionTypeFullComparison,
+		IgnoreDifferences: []fleetv1beta1.IgnoreDifference{
+			{
+				Group:        "apps",
+				Kind:         "Deployment",
+				JSONPointers: []string{"/spec/replicas"},
+			},
+		},
+	}
+
+	r := &Reconciler{}
+	gotDiffs, _, err := r.diffBetweenManifestAndInMemberClusterObjects(context.Background(), nil, deployUnstructured, inMemberClusterObj, applyStrategy)
+	if err != nil {
+		t.Fatalf("diffBetweenManifestAndInMemberClusterObjects() = %v, want no error", err)
+	}
+	wantDiffs := []fleetv1beta1.PatchDetail{
+		{
+			Path:          "/spec/template/spec/containers/0/image",
+			ValueInHub:    "nginx",
+			ValueInMember: "nginx:latest",
+		},
+	}
+	if diff := cmp.Diff(gotDiffs, wantDiffs); diff != "" {
+		t.Errorf("diffBetweenManifestAndInMemberClusterObjects() mismatches (-got, +want):\n%s", diff)
+	}
+}
+
+// TestDiffBetweenManifestAndInMemberClusterObjectsWithInjectedContainer tests the
+// diffBetweenManifestAndInMemberClusterObjects function with a container injected into the object
+// in the member cluster by a manager whose fields are ignored.
+func TestDiffBetweenManifestAndInMemberClusterObjectsWithInjectedContainer(t *testing.T) {
+	inMemberClusterDeploy := deploy.DeepCopy()
+	inMemberClusterDeploy.Spec.Template.Spec.Containers = append(inMemberClusterDeploy.Spec.Template.Spec.Containers, corev1.Container{
+		Name:  "sidecar",
+		Image: "mesh-proxy:1.0",
+		// A field defaulted by the API server, which the injector does not own.
+		TerminationMessagePath: corev1.TerminationMessagePathDefault,
+	})
+	inMemberClusterDeploy.Spec.Template.Annotations = map[string]string{
+		"mesh.io/injected": "true",
+	}
+	inMemberClusterDeploy.ManagedFields = []metav1.ManagedFieldsEntry{
+		{
+			Manager:   "mesh-injector",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1: &metav1.FieldsV1{
+				Raw: []byte(`{"f:spec":{"f:template":{"f:metadata":{"f:annotations":{".":{},"f:mesh.io/injected":{}}},"f:spec":{"f:containers":{"k:{\"name\":\"sidecar\"}":{".":{},"f:image":{},"f:name":{}}}}}}}`),
+			},
+		},
+	}
+	inMemberClusterObj := toUnstructured(t, inMemberClusterDeploy)
+
+	testCases := []struct {
+		name      string
+		rules     []fleetv1beta1.IgnoreDifference
+		wantPaths []string
+	}{
+		{
+			name:      "no rules",
+			wantPaths: []string{"/spec/template/metadata/annotations", "/spec/template/spec/containers/-"},
+		},
+		{
+			name: "injector fields ignored",
+			rules: []fleetv1beta1.IgnoreDifference{
+				{
+					Group:                 "apps",
+					Kind:                  "Deployment",
+					ManagedFieldsManagers: []string{"mesh-injector"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			applyStrategy := &fleetv1beta1.ApplyStrategy{
+				ComparisonOption:  fleetv1beta1.ComparisonOptionTypeFullComparison,
+				IgnoreDifferences: tc.rules,
+			}
+
+			r := &Reconciler{}
+			gotDiffs, _, err := r.diffBetweenManifestAndInMemberClusterObjects(context.Background(), nil, deployUnstructured, inMemberClusterObj, applyStrategy)
+			if err != nil {
+				t.Fatalf("diffBetweenManifestAndInMemberClusterObjects() = %v, want no error", err)
+			}
+			var gotPaths []string
+			for _, d := range gotDiffs {
+				gotPaths = append(gotPaths, d.Path)
+			}
+			if diff := cmp.Diff(gotPaths, tc.wantPaths); diff != "" {
+				t.Errorf("diffBetweenManifestAndInMemberClusterObjects() paths mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -145,15 +145,19 @@ func (r *Reconciler) writeAheadManifestProcessingAttempts(
 	}
 
 	// As another shortcut, if the Work object has an apply strategy that has the ReportDiff
-	// mode on, Fleet will skip the write-ahead op.
+	// or the DryRun mode on, Fleet will skip the write-ahead op.
 	//
 	// Note that in this scenario Fleet will not attempt to remove any left over manifests;
-	// such manifests will only get cleaned up when the ReportDiff mode is turned off, or the
-	// CRP itself is deleted.
+	// such manifests will only get cleaned up when the ReportDiff (or DryRun) mode is turned off,
+	// or the CRP itself is deleted.
 	if work.Spec.ApplyStrategy != nil && work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff {
 		klog.V(2).InfoS("The apply strategy is set to report diff; will skip the write-ahead process", "work", workRef)
 		return nil
 	}
+	if work.Spec.ApplyStrategy != nil && work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeDryRun {
+		klog.V(2).InfoS("The apply strategy is set to dry run; will skip the write-ahead process", "work", workRef)
+		return nil
+	}
 
 	// Prepare the status update (the new manifest conditions) for the write-ahead process.
 	//
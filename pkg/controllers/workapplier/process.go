@@ -21,11 +21,14 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
 )
@@ -45,8 +48,13 @@ func (r *Reconciler) processManifests(
 	// processed in parallel, while different waves are processed sequentially.
 
 	// As a special case, if the ReportDiff mode is on, all manifests are processed in parallel in
-	// one wave.
+	// one wave, as no changes will be made in the member cluster.
 	if work.Spec.ApplyStrategy != nil && work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff {
+		// The apply order is still validated, so that manifests with an invalid apply order are
+		// reported the same way regardless of the apply strategy; there is no need to wait for
+		// any dependencies though.
+		resolveApplyOrder(bundles, klog.KObj(work))
+
 		doWork := func(piece int) {
 			if bundles[piece].applyOrReportDiffErr != nil {
 				// Skip a manifest if it has failed pre-processing.
@@ -71,11 +79,116 @@ func (r *Reconciler) processManifests(
 		return nil
 	}
 
+	// If the DryRun mode is on, manifests are processed in the same waves as they would be applied,
+	// so that a manifest whose parent (its namespace or its custom resource definition) is placed
+	// in the same Work can be told apart from one whose parent is missing altogether.
+	//
+	// As no changes will be made in the member cluster, there are no hook phases and no waiting
+	// for availability.
+	if work.Spec.ApplyStrategy != nil && work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeDryRun {
+		processingWaves := organizeBundlesIntoProcessingWaves(bundles, klog.KObj(work))
+		gate := &applyOrderGate{r: r, dryRun: true}
+		processedWaves := make([]*bundleProcessingWave, 0, len(processingWaves))
+		for _, wave := range processingWaves {
+			var err error
+			if processedWaves, err = r.processWaves(ctx, []*bundleProcessingWave{wave}, processedWaves, gate, work, expectedAppliedWorkOwnerRef); err != nil {
+				return err
+			}
+			// Re-classify the manifests after each wave, so that manifests in later waves can
+			// depend on the ones whose parents are pending.
+			markBundlesWithParentsPendingInDryRun(bundles, klog.KObj(work))
+		}
+		return nil
+	}
+
+	// Find the hooks among the manifests, if any.
+	classifyHookBundles(bundles, klog.KObj(work))
+
 	// Organize the bundles into different waves of bundles for parallel processing based on their
 	// GVR information.
 	processingWaves := organizeBundlesIntoProcessingWaves(bundles, klog.KObj(work))
-	for idx := range processingWaves {
-		bundlesInWave := processingWaves[idx].bundles
+
+	// Split the waves into phases, so that the pre-apply hooks run before all the other manifests
+	// (except those the hooks need), and the post-apply hooks run after all the other manifests
+	// have become available.
+	//
+	// If the Work features no hooks, all the waves are in the main phase.
+	preApplyPhase, mainPhase, postApplyPhase := splitWavesIntoHookPhases(processingWaves, klog.KObj(work))
+
+	gate := &applyOrderGate{
+		r:                 r,
+		availableByBundle: make(map[*manifestProcessingBundle]bool),
+	}
+	processedWaves := make([]*bundleProcessingWave, 0, len(processingWaves))
+	var err error
+	if processedWaves, err = r.processWaves(ctx, preApplyPhase, processedWaves, gate, work, expectedAppliedWorkOwnerRef); err != nil {
+		return err
+	}
+	if err := markFailedHooks(preApplyPhase, klog.KObj(work)); err != nil {
+		// Some of the pre-apply hooks have not succeeded yet; hold back all the other manifests.
+		klog.V(2).InfoS("Pre-apply hooks have not succeeded yet; skip the other manifests", "err", err, "work", klog.KObj(work))
+		blockBundlesInWaves(mainPhase, ApplyOrReportDiffResTypeWaitingForPreApplyHooks, err)
+		blockBundlesInWaves(postApplyPhase, ApplyOrReportDiffResTypeWaitingForPreApplyHooks, err)
+		return nil
+	}
+
+	if processedWaves, err = r.processWaves(ctx, mainPhase, processedWaves, gate, work, expectedAppliedWorkOwnerRef); err != nil {
+		return err
+	}
+
+	if len(postApplyPhase) == 0 {
+		return nil
+	}
+	for _, wave := range processedWaves {
+		for _, bundle := range wave.bundles {
+			isAvailable, err := gate.isAvailable(ctx, bundle)
+			if err == nil && !isAvailable {
+				err = fmt.Errorf("manifest %s has not become available yet", bundle.workResourceIdentifierStr)
+			}
+			if err != nil {
+				// Some of the other manifests have not become available; hold back the post-apply hooks.
+				klog.V(2).InfoS("Not all manifests have become available; skip the post-apply hooks", "err", err, "work", klog.KObj(work))
+				blockBundlesInWaves(postApplyPhase, ApplyOrReportDiffResTypeWaitingForDependencies, err)
+				return nil
+			}
+		}
+	}
+	if _, err = r.processWaves(ctx, postApplyPhase, processedWaves, gate, work, expectedAppliedWorkOwnerRef); err != nil {
+		return err
+	}
+	// Post-apply hooks that are still running are reported as not yet available in the
+	// availability check step.
+	_ = markFailedHooks(postApplyPhase, klog.KObj(work))
+	return nil
+}
+
+// processWaves processes the given waves of bundles in order; it returns the list of processed waves,
+// with the given waves appended.
+func (r *Reconciler) processWaves(
+	ctx context.Context,
+	waves, processedWaves []*bundleProcessingWave,
+	gate *applyOrderGate,
+	work *fleetv1beta1.Work,
+	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
+) ([]*bundleProcessingWave, error) {
+	for idx := range waves {
+		bundlesInWave := waves[idx].bundles
+
+		// Hold back the manifests whose dependencies (and, if requested, manifests in earlier
+		// waves) are not ready yet.
+		//
+		// This runs sequentially, as the check is cheap in most cases and its results are cached.
+		for _, bundle := range bundlesInWave {
+			if bundle.applyOrReportDiffErr != nil {
+				continue
+			}
+			if err := gate.check(ctx, bundle, processedWaves); err != nil {
+				klog.V(2).InfoS("Manifest is waiting for its dependencies", "err", err,
+					"manifestObj", klog.KObj(bundle.manifestObj), "work", klog.KObj(work))
+				bundle.applyOrReportDiffErr = err
+				bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeWaitingForDependencies
+			}
+		}
 
 		// TO-DO (chenyu1): evaluate if there is a need to avoid repeated closure
 		// assignment just for capturing variables.
@@ -92,7 +205,8 @@ func (r *Reconciler) processManifests(
 			klog.V(2).InfoS("Processed a manifest", "manifestObj", klog.KObj(bundlesInWave[piece].manifestObj), "work", klog.KObj(work))
 		}
 
-		r.parallelizer.ParallelizeUntil(ctx, len(bundlesInWave), doWork, fmt.Sprintf("processingManifestsInWave%d", idx))
+		r.parallelizer.ParallelizeUntil(ctx, len(bundlesInWave), doWork, fmt.Sprintf("processingManifestsInWave%d", len(processedWaves)))
+		processedWaves = append(processedWaves, waves[idx])
 
 		// Unlike some other steps in the reconciliation loop, the manifest processing step does not end
 		// with a contextual API call; consequently, if the context has been cancelled during this step,
@@ -101,12 +215,169 @@ func (r *Reconciler) processManifests(
 		// cancellation directly.
 		if err := ctx.Err(); err != nil {
 			klog.V(2).InfoS("manifest processing has been interrupted as the main context has been cancelled")
-			return fmt.Errorf("manifest processing has been interrupted: %w", err)
+			return processedWaves, fmt.Errorf("manifest processing has been interrupted: %w", err)
+		}
+	}
+	return processedWaves, nil
+}
+
+// applyOrderGate checks if a manifest is ready to be applied per the apply order declared by users.
+type applyOrderGate struct {
+	r *Reconciler
+	// The ResourceHealthCheck objects in the member cluster; they are listed lazily, only when
+	// an availability check is needed.
+	healthChecks       []fleetv1beta1.ResourceHealthCheck
+	healthChecksListed bool
+	// The cached availability check results.
+	availableByBundle map[*manifestProcessingBundle]bool
+	// The cached check results of the dependencies outside the Work.
+	externalDepErrByKey map[manifestObjKey]error
+	// Whether the manifests are processed in the DryRun mode, in which case a dependency only needs
+	// to be accepted by the member cluster API server (or, if it is outside the Work, to exist), and
+	// no availability check is performed.
+	dryRun bool
+}
+
+// check returns an error if the manifest cannot be applied yet, i.e., some of its dependencies
+// have not been applied, or, if the manifest asks to wait for availability, some of its dependencies
+// or some of the manifests in earlier waves have not become available yet.
+//
+// Dependencies outside the Work must always exist and be available in the member cluster, as
+// Fleet cannot tell whether they have been applied.
+func (g *applyOrderGate) check(ctx context.Context, bundle *manifestProcessingBundle, earlierWaves []*bundleProcessingWave) error {
+	if g.dryRun {
+		for _, dep := range bundle.dependencies {
+			if !isManifestAcceptedInDryRun(dep.applyOrReportDiffResTyp) {
+				return fmt.Errorf("dependency %s has not been accepted in dry-run mode", dep.workResourceIdentifierStr)
+			}
+		}
+		return g.checkExternalDependencies(ctx, bundle)
+	}
+
+	for _, dep := range bundle.dependencies {
+		if !isManifestObjectApplied(dep.applyOrReportDiffResTyp) {
+			return fmt.Errorf("dependency %s has not been applied yet", dep.workResourceIdentifierStr)
+		}
+	}
+	if err := g.checkExternalDependencies(ctx, bundle); err != nil {
+		return err
+	}
+
+	if !bundle.waitForAvailable {
+		return nil
+	}
+	for _, dep := range bundle.dependencies {
+		isAvailable, err := g.isAvailable(ctx, dep)
+		if err != nil {
+			return err
+		}
+		if !isAvailable {
+			return fmt.Errorf("dependency %s is not available yet", dep.workResourceIdentifierStr)
+		}
+	}
+	for _, wave := range earlierWaves {
+		for _, earlierBundle := range wave.bundles {
+			isAvailable, err := g.isAvailable(ctx, earlierBundle)
+			if err != nil {
+				return err
+			}
+			if !isAvailable {
+				return fmt.Errorf("manifest %s in an earlier wave (%d) is not available yet", earlierBundle.workResourceIdentifierStr, wave.num)
+			}
+		}
+	}
+	return nil
+}
+
+// isAvailable returns whether a manifest has been applied and has become available in the
+// member cluster. Untrackable manifests are considered to be available.
+func (g *applyOrderGate) isAvailable(ctx context.Context, bundle *manifestProcessingBundle) (bool, error) {
+	if isAvailable, ok := g.availableByBundle[bundle]; ok {
+		return isAvailable, nil
+	}
+	if !isManifestObjectApplied(bundle.applyOrReportDiffResTyp) || bundle.inMemberClusterObj == nil {
+		g.availableByBundle[bundle] = false
+		return false, nil
+	}
+
+	if err := g.listHealthChecksIfNeeded(ctx); err != nil {
+		return false, err
+	}
+
+	var resTyp ManifestProcessingAvailabilityResultType
+	var err error
+	if len(bundle.hookType) > 0 {
+		resTyp, err = trackHookAvailability(bundle)
+	} else {
+		resTyp, err = trackInMemberClusterObjAvailabilityByGVR(bundle.gvr, bundle.inMemberClusterObj, g.healthChecks)
+	}
+	isAvailable := err == nil && (resTyp == AvailabilityResultTypeAvailable || resTyp == AvailabilityResultTypeNotTrackable)
+	g.availableByBundle[bundle] = isAvailable
+	return isAvailable, nil
+}
+
+// checkExternalDependencies returns an error if some of the dependencies outside the Work do not
+// exist in the member cluster yet, or (unless in the DryRun mode) have not become available yet.
+// Untrackable objects are considered to be available.
+func (g *applyOrderGate) checkExternalDependencies(ctx context.Context, bundle *manifestProcessingBundle) error {
+	for _, key := range bundle.externalDependencies {
+		err, ok := g.externalDepErrByKey[key]
+		if !ok {
+			err = g.checkExternalDependency(ctx, key)
+			if g.externalDepErrByKey == nil {
+				g.externalDepErrByKey = make(map[manifestObjKey]error)
+			}
+			g.externalDepErrByKey[key] = err
+		}
+		if err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// checkExternalDependency checks a dependency outside the Work in the member cluster.
+func (g *applyOrderGate) checkExternalDependency(ctx context.Context, key manifestObjKey) error {
+	mapping, err := g.r.restMapper.RESTMapping(schema.GroupKind{Group: key.group, Kind: key.kind})
+	if err != nil {
+		return fmt.Errorf("failed to find the resource type of dependency %s (outside the Work): %w", key, err)
+	}
+	obj, err := g.r.spokeDynamicClient.Resource(mapping.Resource).Namespace(key.namespace).Get(ctx, key.name, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		return fmt.Errorf("dependency %s (outside the Work) is not found in the member cluster yet", key)
+	case err != nil:
+		return fmt.Errorf("failed to get dependency %s (outside the Work) from the member cluster: %w", key, err)
+	}
+	if g.dryRun {
+		return nil
+	}
+
+	if err := g.listHealthChecksIfNeeded(ctx); err != nil {
+		return err
+	}
+	resTyp, err := trackInMemberClusterObjAvailabilityByGVR(&mapping.Resource, obj, g.healthChecks)
+	if err != nil || (resTyp != AvailabilityResultTypeAvailable && resTyp != AvailabilityResultTypeNotTrackable) {
+		return fmt.Errorf("dependency %s (outside the Work) is not available yet", key)
+	}
+	return nil
+}
+
+// listHealthChecksIfNeeded lists the ResourceHealthCheck objects in the member cluster, if they
+// have not been listed yet.
+func (g *applyOrderGate) listHealthChecksIfNeeded(ctx context.Context) error {
+	if g.healthChecksListed {
+		return nil
+	}
+	healthChecks, err := g.r.listResourceHealthChecks(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check if the dependencies are available: %w", err)
+	}
+	g.healthChecks = healthChecks
+	g.healthChecksListed = true
+	return nil
+}
+
 // processOneManifest processes a manifest (in the JSON format) embedded in the Work object.
 func (r *Reconciler) processOneManifest(
 	ctx context.Context,
@@ -125,6 +396,15 @@ func (r *Reconciler) processOneManifest(
 		return
 	}
 
+	// If the ApplyStrategy is of the DryRun mode, Fleet would submit the manifest object to the
+	// member cluster API server in dry-run mode now; no takeover, drift detection, nor actual
+	// apply op will be executed.
+	//
+	// Note that this runs even if the object is not owned by Fleet.
+	if shouldSkipProcessing := r.dryRunOnlyIfApplicable(ctx, bundle, work, expectedAppliedWorkOwnerRef); shouldSkipProcessing {
+		return
+	}
+
 	// Take over the object in the member cluster that corresponds to the manifest object
 	// if applicable.
 	//
@@ -158,6 +438,12 @@ func (r *Reconciler) processOneManifest(
 		return
 	}
 
+	// If the manifest object is a hook that has run for an earlier generation of the Work,
+	// remove the previous run so that the hook can be re-run.
+	if shouldSkipProcessing := r.recreateHookIfOutdated(ctx, bundle, work); shouldSkipProcessing {
+		return
+	}
+
 	// Perform a round of drift detection before running the apply op, if the ApplyStrategy
 	// dictates that an apply op can only be run when there are no drifts found.
 	if shouldSkipProcessing := r.performPreApplyDriftDetectionIfApplicable(ctx, bundle, work, expectedAppliedWorkOwnerRef); shouldSkipProcessing {
@@ -374,7 +660,7 @@ func (r *Reconciler) reportDiffOnlyIfApplicable(
 	configDiffs, diffCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
 		bundle.gvr,
 		bundle.manifestObj, bundle.inMemberClusterObj,
-		work.Spec.ApplyStrategy.ComparisonOption)
+		work.Spec.ApplyStrategy)
 	switch {
 	case err != nil:
 		// Failed to calculate the configuration diffs.
@@ -414,6 +700,91 @@ func (r *Reconciler) reportDiffOnlyIfApplicable(
 	return true
 }
 
+// dryRunOnlyIfApplicable runs a server-side apply op in the dry-run mode for the manifest object,
+// if the DryRun mode is enabled.
+//
+// Note that if the DryRun mode is on, manifest processing is completed as soon as the dry-run
+// apply op returns.
+func (r *Reconciler) dryRunOnlyIfApplicable(
+	ctx context.Context,
+	bundle *manifestProcessingBundle,
+	work *fleetv1beta1.Work,
+	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
+) (shouldSkipProcessing bool) {
+	if work.Spec.ApplyStrategy == nil || work.Spec.ApplyStrategy.Type != fleetv1beta1.ApplyStrategyTypeDryRun {
+		klog.V(2).InfoS("DryRun mode is not enabled; skip the step")
+		return false
+	}
+
+	// Run the dry-run apply op with a sanitized copy of the manifest object, so that fields
+	// such as the resource version would not interfere with the admission process.
+	//
+	// The returned object is not kept in the bundle, as it does not reflect any actual state
+	// of the member cluster.
+	//
+	// Field manager conflicts are reported unless the apply strategy asks to force them, as
+	// they would fail an actual server-side apply op all the same.
+	manifestObjCopy := sanitizeManifestObject(bundle.manifestObj)
+	force := work.Spec.ApplyStrategy.ServerSideApplyConfig != nil && work.Spec.ApplyStrategy.ServerSideApplyConfig.ForceConflicts
+	if _, err := r.applyInDryRunMode(ctx, bundle.gvr, manifestObjCopy, bundle.inMemberClusterObj, force); err != nil {
+		bundle.applyOrReportDiffErr = fmt.Errorf("the member cluster API server rejected the manifest object in dry-run mode: %w", err)
+		bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeDryRunFailed
+		klog.V(2).InfoS("Dry-run apply op failed",
+			"err", err, "work", klog.KObj(work), "GVR", *bundle.gvr, "manifestObj", klog.KObj(bundle.manifestObj),
+			"inMemberClusterObj", klog.KObj(bundle.inMemberClusterObj), "expectedAppliedWorkOwnerRef", *expectedAppliedWorkOwnerRef)
+		return true
+	}
+
+	bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeDryRunSucceeded
+	klog.V(2).InfoS("DryRun process completed",
+		"manifestObj", klog.KObj(bundle.manifestObj), "GVR", *bundle.gvr, "work", klog.KObj(work))
+	return true
+}
+
+// markBundlesWithParentsPendingInDryRun re-classifies, in the DryRun mode, the manifests that
+// could not be validated only because their parents have not been created yet, where the parents
+// are placed in the same Work and have been accepted by the member cluster API server in the
+// dry-run mode. Specifically, these are:
+// a) namespaced objects rejected with a NotFound error, whose namespace is in the Work; and
+// b) custom resources that cannot be decoded as their kinds are unknown to the member cluster,
+// whose custom resource definition is in the Work.
+//
+// Manifests whose parents are neither in the member cluster nor in the Work are left as failed.
+func markBundlesWithParentsPendingInDryRun(bundles []*manifestProcessingBundle, workRef klog.ObjectRef) {
+	acceptedNamespaces := make(map[string]bool)
+	acceptedKinds := make(map[schema.GroupKind]bool)
+	for _, bundle := range bundles {
+		if bundle.applyOrReportDiffResTyp != ApplyOrReportDiffResTypeDryRunSucceeded || bundle.gvr == nil || bundle.manifestObj == nil {
+			continue
+		}
+		switch *bundle.gvr {
+		case utils.NamespaceGVR:
+			acceptedNamespaces[bundle.manifestObj.GetName()] = true
+		case utils.CustomResourceDefinitionGVR:
+			group, _, _ := unstructured.NestedString(bundle.manifestObj.Object, "spec", "group")
+			kind, _, _ := unstructured.NestedString(bundle.manifestObj.Object, "spec", "names", "kind")
+			acceptedKinds[schema.GroupKind{Group: group, Kind: kind}] = true
+		}
+	}
+
+	for _, bundle := range bundles {
+		var isParentPending bool
+		switch bundle.applyOrReportDiffResTyp {
+		case ApplyOrReportDiffResTypeDryRunFailed:
+			isParentPending = errors.IsNotFound(bundle.applyOrReportDiffErr) && acceptedNamespaces[bundle.manifestObj.GetNamespace()]
+		case ApplyOrReportDiffResTypeDecodingErred:
+			isParentPending = meta.IsNoMatchError(bundle.applyOrReportDiffErr) && acceptedKinds[schema.GroupKind{Group: bundle.id.Group, Kind: bundle.id.Kind}]
+		}
+		if !isParentPending {
+			continue
+		}
+		klog.V(2).InfoS("The parent of the manifest is placed in the same Work and has been accepted in dry-run mode",
+			"err", bundle.applyOrReportDiffErr, "workResourceID", bundle.id, "work", workRef)
+		bundle.applyOrReportDiffErr = nil
+		bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeDryRunPendingParent
+	}
+}
+
 // canApplyWithOwnership checks if Fleet can perform an apply op, knowing that Fleet has
 // acquired the ownership of the object, or that the object has not been created yet.
 //
@@ -466,7 +837,7 @@ func (r *Reconciler) performPreApplyDriftDetectionIfApplicable(
 		drifts, driftsCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
 			bundle.gvr,
 			bundle.manifestObj, bundle.inMemberClusterObj,
-			work.Spec.ApplyStrategy.ComparisonOption)
+			work.Spec.ApplyStrategy)
 		switch {
 		case err != nil:
 			// An unexpected error has occurred.
@@ -549,7 +920,7 @@ func (r *Reconciler) performPostApplyDriftDetectionIfApplicable(
 	drifts, driftsCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
 		bundle.gvr,
 		bundle.manifestObj, bundle.inMemberClusterObj,
-		work.Spec.ApplyStrategy.ComparisonOption)
+		work.Spec.ApplyStrategy)
 	switch {
 	case err != nil:
 		// An unexpected error has occurred.
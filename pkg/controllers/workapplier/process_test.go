@@ -1,12 +1,21 @@
 package workapplier
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 )
@@ -97,3 +106,314 @@ func TestShouldInitiateTakeOverAttempt(t *testing.T) {
 		})
 	}
 }
+
+// TestApplyOrderGateCheck tests the check method of the applyOrderGate type.
+func TestApplyOrderGateCheck(t *testing.T) {
+	nsGVR := &schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	deployGVR := &schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	crGVR := &schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+	newDeploy := func(availableReplicas int64) *unstructured.Unstructured {
+		deploy := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      deployName,
+				"namespace": nsName,
+			},
+			"spec": map[string]interface{}{
+				"replicas": int64(1),
+			},
+			"status": map[string]interface{}{
+				"availableReplicas": availableReplicas,
+				"updatedReplicas":   availableReplicas,
+			},
+		}}
+		return deploy
+	}
+
+	appliedCR := func() *manifestProcessingBundle {
+		return &manifestProcessingBundle{
+			gvr:                     crGVR,
+			inMemberClusterObj:      manifestObjWithAnnotations("example.com/v1", "Widget", nsName, "provider", nil),
+			applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+		}
+	}
+	unavailableDeploy := func() *manifestProcessingBundle {
+		return &manifestProcessingBundle{
+			gvr:                     deployGVR,
+			inMemberClusterObj:      newDeploy(0),
+			applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+		}
+	}
+	availableDeploy := func() *manifestProcessingBundle {
+		return &manifestProcessingBundle{
+			gvr:                     deployGVR,
+			inMemberClusterObj:      newDeploy(1),
+			applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+		}
+	}
+	failedNS := func() *manifestProcessingBundle {
+		return &manifestProcessingBundle{
+			gvr:                     nsGVR,
+			applyOrReportDiffResTyp: ApplyOrReportDiffResTypeFailedToApply,
+		}
+	}
+
+	externalNS := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata": map[string]interface{}{
+			"name": "external",
+		},
+	}}
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{{Version: "v1"}, {Group: "apps", Version: "v1"}})
+	restMapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, meta.RESTScopeRoot)
+	restMapper.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+
+	testCases := []struct {
+		name         string
+		bundle       *manifestProcessingBundle
+		earlierWaves []*bundleProcessingWave
+		dryRun       bool
+		wantErr      bool
+	}{
+		{
+			name:   "no dependencies",
+			bundle: &manifestProcessingBundle{gvr: crGVR},
+			earlierWaves: []*bundleProcessingWave{
+				{num: 4, bundles: []*manifestProcessingBundle{unavailableDeploy()}},
+			},
+		},
+		{
+			name: "dependency not applied",
+			bundle: &manifestProcessingBundle{
+				gvr:          crGVR,
+				dependencies: []*manifestProcessingBundle{failedNS()},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dependency applied but not available (no need to wait)",
+			bundle: &manifestProcessingBundle{
+				gvr:          crGVR,
+				dependencies: []*manifestProcessingBundle{unavailableDeploy()},
+			},
+		},
+		{
+			name: "dependency not available",
+			bundle: &manifestProcessingBundle{
+				gvr:              crGVR,
+				dependencies:     []*manifestProcessingBundle{unavailableDeploy()},
+				waitForAvailable: true,
+			},
+			wantErr: true,
+		},
+		{
+			name: "manifest in an earlier wave not available",
+			bundle: &manifestProcessingBundle{
+				gvr:              crGVR,
+				waitForAvailable: true,
+			},
+			earlierWaves: []*bundleProcessingWave{
+				{num: 0, bundles: []*manifestProcessingBundle{appliedCR()}},
+				{num: 4, bundles: []*manifestProcessingBundle{availableDeploy(), unavailableDeploy()}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "all available (untrackable counts as available)",
+			bundle: &manifestProcessingBundle{
+				gvr:              crGVR,
+				dependencies:     []*manifestProcessingBundle{appliedCR()},
+				waitForAvailable: true,
+			},
+			earlierWaves: []*bundleProcessingWave{
+				{num: 4, bundles: []*manifestProcessingBundle{availableDeploy()}},
+			},
+		},
+		{
+			name: "dry run, dependency accepted (no availability check)",
+			bundle: &manifestProcessingBundle{
+				gvr: crGVR,
+				dependencies: []*manifestProcessingBundle{
+					{gvr: nsGVR, applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDryRunPendingParent},
+				},
+				waitForAvailable: true,
+			},
+			earlierWaves: []*bundleProcessingWave{
+				{num: 4, bundles: []*manifestProcessingBundle{unavailableDeploy()}},
+			},
+			dryRun: true,
+		},
+		{
+			name: "dry run, dependency rejected",
+			bundle: &manifestProcessingBundle{
+				gvr: crGVR,
+				dependencies: []*manifestProcessingBundle{
+					{gvr: nsGVR, applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDryRunFailed},
+				},
+			},
+			dryRun:  true,
+			wantErr: true,
+		},
+		{
+			name: "dependency outside the Work not found",
+			bundle: &manifestProcessingBundle{
+				gvr:                  crGVR,
+				externalDependencies: []manifestObjKey{{kind: "Namespace", name: "missing"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dependency outside the Work available",
+			bundle: &manifestProcessingBundle{
+				gvr:                  crGVR,
+				externalDependencies: []manifestObjKey{{kind: "Namespace", name: "external"}},
+			},
+		},
+		{
+			name: "dependency outside the Work not available (always checked)",
+			bundle: &manifestProcessingBundle{
+				gvr: crGVR,
+				externalDependencies: []manifestObjKey{
+					{kind: "Namespace", name: "external"},
+					{group: "apps", kind: "Deployment", namespace: nsName, name: deployName},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dependency outside the Work with an unknown resource type",
+			bundle: &manifestProcessingBundle{
+				gvr:                  crGVR,
+				externalDependencies: []manifestObjKey{{group: "example.com", kind: "Widget", name: "provider"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "dry run, dependency outside the Work found (no availability check)",
+			bundle: &manifestProcessingBundle{
+				gvr:                  crGVR,
+				externalDependencies: []manifestObjKey{{group: "apps", kind: "Deployment", namespace: nsName, name: deployName}},
+			},
+			dryRun: true,
+		},
+		{
+			name: "dry run, dependency outside the Work not found",
+			bundle: &manifestProcessingBundle{
+				gvr:                  crGVR,
+				externalDependencies: []manifestObjKey{{kind: "Namespace", name: "missing"}},
+			},
+			dryRun:  true,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gate := &applyOrderGate{
+				r: &Reconciler{
+					spokeClient:        fake.NewClientBuilder().WithScheme(scheme.Scheme).Build(),
+					spokeDynamicClient: dynamicfake.NewSimpleDynamicClient(scheme.Scheme, externalNS, newDeploy(0)),
+					restMapper:         restMapper,
+				},
+				availableByBundle: make(map[*manifestProcessingBundle]bool),
+				dryRun:            tc.dryRun,
+			}
+			err := gate.check(context.Background(), tc.bundle, tc.earlierWaves)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("check() = %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestMarkBundlesWithParentsPendingInDryRun tests the markBundlesWithParentsPendingInDryRun function.
+func TestMarkBundlesWithParentsPendingInDryRun(t *testing.T) {
+	nsGVR := &schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	crdGVR := &schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	cmGVR := &schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+
+	nsNotFoundErr := fmt.Errorf("the member cluster API server rejected the manifest object in dry-run mode: %w",
+		apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, nsName))
+	noKindMatchErr := fmt.Errorf("failed to decode manifest: %w",
+		&meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "example.com", Kind: "Widget"}, SearchedVersions: []string{"v1"}})
+
+	acceptedNS := &manifestProcessingBundle{
+		gvr:                     nsGVR,
+		manifestObj:             manifestObjWithAnnotations("v1", "Namespace", "", nsName, nil),
+		applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDryRunSucceeded,
+	}
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata": map[string]interface{}{
+			"name": "widgets.example.com",
+		},
+		"spec": map[string]interface{}{
+			"group": "example.com",
+			"names": map[string]interface{}{
+				"kind": "Widget",
+			},
+		},
+	}}
+	acceptedCRD := &manifestProcessingBundle{
+		gvr:                     crdGVR,
+		manifestObj:             crd,
+		applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDryRunSucceeded,
+	}
+	cmInPlacedNS := &manifestProcessingBundle{
+		gvr:                     cmGVR,
+		manifestObj:             manifestObjWithAnnotations("v1", "ConfigMap", nsName, "app-config", nil),
+		applyOrReportDiffErr:    nsNotFoundErr,
+		applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDryRunFailed,
+	}
+	cmInMissingNS := &manifestProcessingBundle{
+		gvr:                     cmGVR,
+		manifestObj:             manifestObjWithAnnotations("v1", "ConfigMap", "missing", "app-config", nil),
+		applyOrReportDiffErr:    nsNotFoundErr,
+		applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDryRunFailed,
+	}
+	cmRejected := &manifestProcessingBundle{
+		gvr:                     cmGVR,
+		manifestObj:             manifestObjWithAnnotations("v1", "ConfigMap", nsName, "other-config", nil),
+		applyOrReportDiffErr:    fmt.Errorf("admission webhook denied the request"),
+		applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDryRunFailed,
+	}
+	crOfPlacedCRD := &manifestProcessingBundle{
+		id:                      &fleetv1beta1.WorkResourceIdentifier{Group: "example.com", Version: "v1", Kind: "Widget", Namespace: nsName, Name: "widget"},
+		applyOrReportDiffErr:    noKindMatchErr,
+		applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDecodingErred,
+	}
+	crOfUnknownKind := &manifestProcessingBundle{
+		id:                      &fleetv1beta1.WorkResourceIdentifier{Group: "other.example.com", Version: "v1", Kind: "Gadget", Namespace: nsName, Name: "gadget"},
+		applyOrReportDiffErr:    noKindMatchErr,
+		applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDecodingErred,
+	}
+
+	markBundlesWithParentsPendingInDryRun([]*manifestProcessingBundle{
+		acceptedNS, acceptedCRD, cmInPlacedNS, cmInMissingNS, cmRejected, crOfPlacedCRD, crOfUnknownKind,
+	}, klog.KRef(memberReservedNSName1, workName))
+
+	wantResTypes := map[string]struct {
+		bundle  *manifestProcessingBundle
+		resTyp  ManifestProcessingApplyOrReportDiffResultType
+		wantErr bool
+	}{
+		"namespace":                          {bundle: acceptedNS, resTyp: ApplyOrReportDiffResTypeDryRunSucceeded},
+		"config map in a placed namespace":   {bundle: cmInPlacedNS, resTyp: ApplyOrReportDiffResTypeDryRunPendingParent},
+		"config map in a missing namespace":  {bundle: cmInMissingNS, resTyp: ApplyOrReportDiffResTypeDryRunFailed, wantErr: true},
+		"config map rejected otherwise":      {bundle: cmRejected, resTyp: ApplyOrReportDiffResTypeDryRunFailed, wantErr: true},
+		"custom resource of a placed CRD":    {bundle: crOfPlacedCRD, resTyp: ApplyOrReportDiffResTypeDryRunPendingParent},
+		"custom resource of an unknown kind": {bundle: crOfUnknownKind, resTyp: ApplyOrReportDiffResTypeDecodingErred, wantErr: true},
+	}
+	for name, want := range wantResTypes {
+		if got := want.bundle.applyOrReportDiffResTyp; got != want.resTyp {
+			t.Errorf("%s: result type = %s, want %s", name, got, want.resTyp)
+		}
+		if gotErr := want.bundle.applyOrReportDiffErr != nil; gotErr != want.wantErr {
+			t.Errorf("%s: error = %v, want error %t", name, want.bundle.applyOrReportDiffErr, want.wantErr)
+		}
+	}
+}
@@ -185,6 +185,12 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 				backReportStatus(bundle.inMemberClusterObj, manifestCond, now, klog.KObj(work))
 			}
 		}
+		if isManifestAcceptedInDryRun(bundle.applyOrReportDiffResTyp) {
+			// In the DryRun mode, manifests accepted by the member cluster API server count
+			// towards the Applied condition of the Work object; no status back-reporting is
+			// performed as no object has been applied.
+			appliedManifestsCount++
+		}
 		if isAppliedObjectAvailable(bundle.availabilityResTyp) {
 			availableAppliedObjectsCount++
 		}
@@ -324,7 +330,9 @@ func isAppliedObjectAvailable(availabilityResTyp ManifestProcessingAvailabilityR
 // isManifestObjectDiffReported returns if a diff report result type indicates that a manifest
 // object has been checked for configuration differences.
 func isManifestObjectDiffReported(reportDiffResTyp ManifestProcessingApplyOrReportDiffResultType) bool {
-	return reportDiffResTyp == ApplyOrReportDiffResTypeFoundDiff || reportDiffResTyp == ApplyOrReportDiffResTypeNoDiffFound
+	return reportDiffResTyp == ApplyOrReportDiffResTypeFoundDiff ||
+		reportDiffResTyp == ApplyOrReportDiffResTypeFoundDiffInDegradedMode ||
+		reportDiffResTyp == ApplyOrReportDiffResTypeNoDiffFound
 }
 
 // setManifestAppliedCondition sets the Applied condition on an applied manifest.
@@ -349,6 +357,25 @@ func setManifestAppliedCondition(
 			Message:            ApplyOrReportDiffResTypeAppliedDescription,
 			ObservedGeneration: inMemberClusterObjGeneration,
 		}
+	case applyOrReportDiffResTyp == ApplyOrReportDiffResTypeDryRunSucceeded:
+		// The manifest has been accepted by the member cluster API server in the dry-run mode.
+		appliedCond = &metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeApplied,
+			Status:             metav1.ConditionTrue,
+			Reason:             string(ApplyOrReportDiffResTypeDryRunSucceeded),
+			Message:            ApplyOrReportDiffResTypeDryRunSucceededDescription,
+			ObservedGeneration: inMemberClusterObjGeneration,
+		}
+	case applyOrReportDiffResTyp == ApplyOrReportDiffResTypeDryRunPendingParent:
+		// The parent of the manifest has been accepted by the member cluster API server in the
+		// dry-run mode, but the manifest itself cannot be validated before the parent is created.
+		appliedCond = &metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeApplied,
+			Status:             metav1.ConditionTrue,
+			Reason:             string(ApplyOrReportDiffResTypeDryRunPendingParent),
+			Message:            ApplyOrReportDiffResTypeDryRunPendingParentDescription,
+			ObservedGeneration: inMemberClusterObjGeneration,
+		}
 	case applyOrReportDiffResTyp == ApplyOrReportDiffResTypeAppliedWithFailedDriftDetection:
 		// The manifest has been successfully applied, but drift detection has failed.
 		//
@@ -617,6 +644,9 @@ func setWorkAvailableCondition(
 	case work.Spec.ApplyStrategy != nil && work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff:
 		// ReportDiff mode is on; no apply op has been performed, and consequently
 		// Fleet will not update the Available condition.
+	case work.Spec.ApplyStrategy != nil && work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeDryRun:
+		// DryRun mode is on; no object has been applied, and consequently
+		// Fleet will not update the Available condition.
 	case !condition.IsConditionStatusTrue(appliedCond, work.Generation):
 		// Not all manifests have been applied; skip updating the Available condition.
 	case availableManifestCount == manifestCount && untrackableAppliedObjectsCount == 0:
@@ -1276,6 +1276,55 @@ func TestSetManifestAppliedCondition(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                              "dry run succeeded",
+			manifestCond:                      &fleetv1beta1.ManifestCondition{},
+			applyOrReportDiffResTyp:           ApplyOrReportDiffResTypeDryRunSucceeded,
+			observedInMemberClusterGeneration: 1,
+			wantManifestCond: &fleetv1beta1.ManifestCondition{
+				Conditions: []metav1.Condition{
+					{
+						Type:               fleetv1beta1.WorkConditionTypeApplied,
+						Status:             metav1.ConditionTrue,
+						Reason:             string(ApplyOrReportDiffResTypeDryRunSucceeded),
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		},
+		{
+			name:                              "dry run pending parent",
+			manifestCond:                      &fleetv1beta1.ManifestCondition{},
+			applyOrReportDiffResTyp:           ApplyOrReportDiffResTypeDryRunPendingParent,
+			observedInMemberClusterGeneration: 0,
+			wantManifestCond: &fleetv1beta1.ManifestCondition{
+				Conditions: []metav1.Condition{
+					{
+						Type:               fleetv1beta1.WorkConditionTypeApplied,
+						Status:             metav1.ConditionTrue,
+						Reason:             string(ApplyOrReportDiffResTypeDryRunPendingParent),
+						ObservedGeneration: 0,
+					},
+				},
+			},
+		},
+		{
+			name:                              "dry run failed",
+			manifestCond:                      &fleetv1beta1.ManifestCondition{},
+			applyOrReportDiffResTyp:           ApplyOrReportDiffResTypeDryRunFailed,
+			applyOrReportDiffErr:              fmt.Errorf("admission webhook denied the request"),
+			observedInMemberClusterGeneration: 0,
+			wantManifestCond: &fleetv1beta1.ManifestCondition{
+				Conditions: []metav1.Condition{
+					{
+						Type:               fleetv1beta1.WorkConditionTypeApplied,
+						Status:             metav1.ConditionFalse,
+						Reason:             string(ApplyOrReportDiffResTypeDryRunFailed),
+						ObservedGeneration: 0,
+					},
+				},
+			},
+		},
 		{
 			name: "applied with failed drift detection",
 			manifestCond: &fleetv1beta1.ManifestCondition{
@@ -1793,6 +1842,41 @@ func TestSetWorkAvailableCondition(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "dry run mode",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       workName,
+					Generation: 1,
+				},
+				Spec: fleetv1beta1.WorkSpec{
+					ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+						Type: fleetv1beta1.ApplyStrategyTypeDryRun,
+					},
+				},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               fleetv1beta1.WorkConditionTypeApplied,
+							Status:             metav1.ConditionTrue,
+							Reason:             condition.WorkAllManifestsAppliedReason,
+							ObservedGeneration: 1,
+						},
+					},
+				},
+			},
+			manifestCount:            2,
+			availableManifestCount:   0,
+			untrackableManifestCount: 0,
+			wantWorkStatusConditions: []metav1.Condition{
+				{
+					Type:               fleetv1beta1.WorkConditionTypeApplied,
+					Status:             metav1.ConditionTrue,
+					Reason:             condition.WorkAllManifestsAppliedReason,
+					ObservedGeneration: 1,
+				},
+			},
+		},
 		{
 			name: "all available, partially untrackable",
 			work: &fleetv1beta1.Work{
@@ -49,6 +49,14 @@ func isManifestObjectApplied(appliedResTyp ManifestProcessingApplyOrReportDiffRe
 		appliedResTyp == ApplyOrReportDiffResTypeAppliedWithFailedDriftDetection
 }
 
+// isManifestAcceptedInDryRun returns if an applied result type indicates that a manifest object
+// in a bundle has been accepted in the DryRun mode, including the case where it could not be fully
+// validated only because its parent is placed in the same Work and has not been created yet.
+func isManifestAcceptedInDryRun(appliedResTyp ManifestProcessingApplyOrReportDiffResultType) bool {
+	return appliedResTyp == ApplyOrReportDiffResTypeDryRunSucceeded ||
+		appliedResTyp == ApplyOrReportDiffResTypeDryRunPendingParent
+}
+
 // isPlacedByFleetInDuplicate checks if the object has already been placed by Fleet via another
 // CRP.
 func isPlacedByFleetInDuplicate(ownerRefs []metav1.OwnerReference, expectedAppliedWorkOwnerRef *metav1.OwnerReference) bool {
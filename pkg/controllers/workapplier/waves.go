@@ -17,10 +17,15 @@ limitations under the License.
 package workapplier
 
 import (
+	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 )
 
 type waveNumber int
@@ -132,6 +137,10 @@ func organizeBundlesIntoProcessingWaves(bundles []*manifestProcessingBundle, wor
 		return wave
 	}
 
+	// Resolve the apply order (the wave override and the dependencies) declared by users
+	// on the manifest objects, if any.
+	effectiveWaveNumByBundle := resolveApplyOrder(bundles, workRef)
+
 	// For simplicity reasons, the organization itself runs in sequential order.
 	// Considering that the categorization itself is quick and the total number of bundles
 	// should be limited in most cases, this should not introduce significant overhead.
@@ -153,11 +162,11 @@ func organizeBundlesIntoProcessingWaves(bundles []*manifestProcessingBundle, wor
 			continue
 		}
 
-		waveNum := lastWave
-		defaultWaveNum, foundInDefaultWaveNumber := defaultWaveNumberByResourceType[bundle.gvr.Resource]
-		if foundInDefaultWaveNumber && knownAPIGroups.Has(bundle.gvr.Group) {
-			// The resource is a known one; assign the bundle to its default wave.
-			waveNum = defaultWaveNum
+		waveNum, ok := effectiveWaveNumByBundle[bundle]
+		if !ok {
+			// The apply order of the bundle cannot be resolved; an error has been set on
+			// the bundle by the resolution step.
+			continue
 		}
 
 		wave := getOrAddWave(waveNum)
@@ -178,3 +187,227 @@ func organizeBundlesIntoProcessingWaves(bundles []*manifestProcessingBundle, wor
 	})
 	return waves
 }
+
+// defaultWaveNumberFor returns the default wave number of a bundle based on its GVR information.
+func defaultWaveNumberFor(bundle *manifestProcessingBundle) waveNumber {
+	defaultWaveNum, foundInDefaultWaveNumber := defaultWaveNumberByResourceType[bundle.gvr.Resource]
+	if foundInDefaultWaveNumber && knownAPIGroups.Has(bundle.gvr.Group) {
+		// The resource is a known one; use its default wave.
+		return defaultWaveNum
+	}
+	return lastWave
+}
+
+// manifestObjKey identifies a manifest object in a Work by its API group, kind, namespace (if
+// applicable), and name.
+type manifestObjKey struct {
+	group     string
+	kind      string
+	namespace string
+	name      string
+}
+
+// String returns the reference of the key in the format of the depends-on annotation.
+func (k manifestObjKey) String() string {
+	kind := k.kind
+	if len(k.group) > 0 {
+		kind = fmt.Sprintf("%s.%s", k.kind, k.group)
+	}
+	if len(k.namespace) > 0 {
+		return fmt.Sprintf("%s/%s/%s", kind, k.namespace, k.name)
+	}
+	return fmt.Sprintf("%s/%s", kind, k.name)
+}
+
+// parseDependsOnRef parses a reference in the depends-on annotation, which is of the format
+// `<Kind>[.<group>]/[<namespace>/]<name>`.
+func parseDependsOnRef(ref string) (manifestObjKey, error) {
+	segs := strings.Split(ref, "/")
+	key := manifestObjKey{}
+	switch len(segs) {
+	case 2:
+		key.name = segs[1]
+	case 3:
+		key.namespace = segs[1]
+		key.name = segs[2]
+	default:
+		return key, fmt.Errorf("reference %q is not of the format <Kind>[.<group>]/[<namespace>/]<name>", ref)
+	}
+	key.kind, key.group, _ = strings.Cut(segs[0], ".")
+	if len(key.kind) == 0 || len(key.name) == 0 || (len(segs) == 3 && len(key.namespace) == 0) {
+		return key, fmt.Errorf("reference %q is not of the format <Kind>[.<group>]/[<namespace>/]<name>", ref)
+	}
+	return key, nil
+}
+
+// applyOrderResolver resolves the effective wave numbers of bundles in a Work, based on their
+// default wave numbers, the apply-wave annotations, and the depends-on annotations.
+type applyOrderResolver struct {
+	// The wave numbers of bundles before their dependencies are considered.
+	baseWaveNumByBundle map[*manifestProcessingBundle]waveNumber
+	// The resolved wave numbers of bundles.
+	effectiveWaveNumByBundle map[*manifestProcessingBundle]waveNumber
+	// The bundles on the current resolution path; used for cycle detection.
+	visiting sets.Set[*manifestProcessingBundle]
+}
+
+// resolve returns the effective wave number of a bundle, which is the larger one of its own
+// wave number and the wave numbers of its dependencies plus one.
+func (r *applyOrderResolver) resolve(bundle *manifestProcessingBundle) (waveNumber, error) {
+	if waveNum, ok := r.effectiveWaveNumByBundle[bundle]; ok {
+		return waveNum, nil
+	}
+	if r.visiting.Has(bundle) {
+		return 0, fmt.Errorf("found a dependency cycle involving %s", bundle.workResourceIdentifierStr)
+	}
+	r.visiting.Insert(bundle)
+	defer r.visiting.Delete(bundle)
+
+	waveNum := r.baseWaveNumByBundle[bundle]
+	for _, dep := range bundle.dependencies {
+		depBaseWaveNum, ok := r.baseWaveNumByBundle[dep]
+		if !ok {
+			// The dependency itself cannot be processed; it will block this bundle when
+			// the dependencies are checked. Use its default wave number.
+			depBaseWaveNum = defaultWaveNumberFor(dep)
+			if depBaseWaveNum+1 > waveNum {
+				waveNum = depBaseWaveNum + 1
+			}
+			continue
+		}
+		depWaveNum, err := r.resolve(dep)
+		if err != nil {
+			return 0, err
+		}
+		if depWaveNum+1 > waveNum {
+			waveNum = depWaveNum + 1
+		}
+	}
+	r.effectiveWaveNumByBundle[bundle] = waveNum
+	return waveNum, nil
+}
+
+// resolveApplyOrder resolves the effective wave numbers of all the bundles that are ready
+// for processing.
+//
+// Bundles with an invalid apply order (e.g., an unparsable wave number, a malformed dependency
+// reference, or a dependency cycle) are marked as failed and are not included in the returned map.
+// Dependencies that are not placed in the same Work are recorded as external dependencies, which
+// are looked up in the member cluster when the bundle is about to be processed.
+func resolveApplyOrder(bundles []*manifestProcessingBundle, workRef klog.ObjectRef) map[*manifestProcessingBundle]waveNumber {
+	// Index all the bundles with a decoded manifest object, including those with prior processing
+	// errors, so that dependencies on such bundles can still be resolved.
+	bundleByKey := make(map[manifestObjKey]*manifestProcessingBundle, len(bundles))
+	for idx := range bundles {
+		bundle := bundles[idx]
+		if bundle.gvr == nil || bundle.manifestObj == nil {
+			continue
+		}
+		key := manifestObjKey{
+			group:     bundle.manifestObj.GroupVersionKind().Group,
+			kind:      bundle.manifestObj.GetKind(),
+			namespace: bundle.manifestObj.GetNamespace(),
+			name:      bundle.manifestObj.GetName(),
+		}
+		bundleByKey[key] = bundle
+	}
+
+	markAsInvalid := func(bundle *manifestProcessingBundle, err error) {
+		markApplyOrderAsInvalid(bundle, err, workRef)
+	}
+
+	resolver := &applyOrderResolver{
+		baseWaveNumByBundle:      make(map[*manifestProcessingBundle]waveNumber, len(bundles)),
+		effectiveWaveNumByBundle: make(map[*manifestProcessingBundle]waveNumber, len(bundles)),
+		visiting:                 sets.New[*manifestProcessingBundle](),
+	}
+	for idx := range bundles {
+		bundle := bundles[idx]
+		if bundle.gvr == nil || bundle.applyOrReportDiffErr != nil {
+			continue
+		}
+
+		waveNum := defaultWaveNumberFor(bundle)
+		var annotations map[string]string
+		if bundle.manifestObj != nil {
+			annotations = bundle.manifestObj.GetAnnotations()
+		}
+
+		if waveStr, ok := annotations[fleetv1beta1.ApplyWaveAnnotation]; ok {
+			overriddenWaveNum, err := strconv.Atoi(strings.TrimSpace(waveStr))
+			if err != nil || overriddenWaveNum < 0 || overriddenWaveNum > int(lastWave) {
+				markAsInvalid(bundle, fmt.Errorf("the value %q of annotation %s is not an integer between 0 and %d", waveStr, fleetv1beta1.ApplyWaveAnnotation, lastWave))
+				continue
+			}
+			waveNum = waveNumber(overriddenWaveNum)
+		}
+
+		var dependencies []*manifestProcessingBundle
+		var externalDependencies []manifestObjKey
+		var depErr error
+		if refs, ok := annotations[fleetv1beta1.DependsOnAnnotation]; ok {
+			for _, ref := range strings.Split(refs, ",") {
+				ref = strings.TrimSpace(ref)
+				if len(ref) == 0 {
+					continue
+				}
+				key, err := parseDependsOnRef(ref)
+				if err != nil {
+					depErr = err
+					break
+				}
+				dep, found := bundleByKey[key]
+				if !found {
+					externalDependencies = append(externalDependencies, key)
+					continue
+				}
+				if dep == bundle {
+					depErr = fmt.Errorf("dependency %q refers to the manifest object itself", ref)
+					break
+				}
+				dependencies = append(dependencies, dep)
+			}
+		}
+		if depErr != nil {
+			markAsInvalid(bundle, depErr)
+			continue
+		}
+
+		bundle.dependencies = dependencies
+		bundle.externalDependencies = externalDependencies
+		bundle.waitForAvailable = annotations[fleetv1beta1.WaitForAvailableAnnotation] == "true"
+		resolver.baseWaveNumByBundle[bundle] = waveNum
+	}
+
+	// Resolve the effective wave numbers, in the order of the bundles to keep the results stable.
+	//
+	// Note that the resolver only records the wave numbers of bundles that are resolved
+	// successfully; bundles involved in (or depending on) a cycle are not recorded.
+	// Bundles are marked as invalid only after all the resolutions complete, as marking resets
+	// the dependencies of a bundle.
+	errByBundle := make(map[*manifestProcessingBundle]error)
+	for idx := range bundles {
+		bundle := bundles[idx]
+		if _, ok := resolver.baseWaveNumByBundle[bundle]; !ok {
+			continue
+		}
+		if _, err := resolver.resolve(bundle); err != nil {
+			errByBundle[bundle] = err
+		}
+	}
+	for bundle, err := range errByBundle {
+		markAsInvalid(bundle, err)
+	}
+	return resolver.effectiveWaveNumByBundle
+}
+
+// markApplyOrderAsInvalid marks a bundle as failed due to an invalid apply order.
+func markApplyOrderAsInvalid(bundle *manifestProcessingBundle, err error, workRef klog.ObjectRef) {
+	klog.V(2).InfoS("Manifest has an invalid apply order", "err", err,
+		"manifestObj", klog.KObj(bundle.manifestObj), "GVR", *bundle.gvr, "work", workRef)
+	bundle.applyOrReportDiffErr = fmt.Errorf("invalid apply order: %w", err)
+	bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeInvalidApplyOrder
+	bundle.dependencies = nil
+	bundle.externalDependencies = nil
+	bundle.waitForAvailable = false
+}
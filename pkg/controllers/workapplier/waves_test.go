@@ -21,10 +21,12 @@ import (
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 )
 
@@ -1118,3 +1120,341 @@ func TestOrganizeBundlesIntoProcessingWaves(t *testing.T) {
 		})
 	}
 }
+
+// manifestObjWithAnnotations returns an unstructured manifest object with the given annotations.
+func manifestObjWithAnnotations(apiVersion, kind, namespace, name string, annotations map[string]string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetAnnotations(annotations)
+	return obj
+}
+
+// TestParseDependsOnRef tests the parseDependsOnRef function.
+func TestParseDependsOnRef(t *testing.T) {
+	testCases := []struct {
+		name    string
+		ref     string
+		wantKey manifestObjKey
+		wantErr bool
+	}{
+		{
+			name: "cluster-scoped object in the core API group",
+			ref:  "Namespace/app",
+			wantKey: manifestObjKey{
+				kind: "Namespace",
+				name: "app",
+			},
+		},
+		{
+			name: "namespaced object in a named API group",
+			ref:  "Deployment.apps/default/web",
+			wantKey: manifestObjKey{
+				group:     "apps",
+				kind:      "Deployment",
+				namespace: "default",
+				name:      "web",
+			},
+		},
+		{
+			name: "custom resource with a multi-segment API group",
+			ref:  "Certificate.cert-manager.io/default/tls",
+			wantKey: manifestObjKey{
+				group:     "cert-manager.io",
+				kind:      "Certificate",
+				namespace: "default",
+				name:      "tls",
+			},
+		},
+		{
+			name:    "no name",
+			ref:     "Namespace",
+			wantErr: true,
+		},
+		{
+			name:    "too many segments",
+			ref:     "Deployment.apps/default/web/extra",
+			wantErr: true,
+		},
+		{
+			name:    "empty kind",
+			ref:     ".apps/default/web",
+			wantErr: true,
+		},
+		{
+			name:    "empty namespace",
+			ref:     "Deployment.apps//web",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			key, err := parseDependsOnRef(tc.ref)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("parseDependsOnRef() = %v, want error", key)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseDependsOnRef() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(key, tc.wantKey, cmp.AllowUnexported(manifestObjKey{})); diff != "" {
+				t.Errorf("parseDependsOnRef() mismatch (-got, +want):\n%s", diff)
+			}
+			if got := key.String(); got != tc.ref {
+				t.Errorf("String() = %q, want %q", got, tc.ref)
+			}
+		})
+	}
+}
+
+// TestOrganizeBundlesIntoProcessingWavesWithApplyOrder tests the organizeBundlesIntoProcessingWaves
+// function with user-declared apply orders.
+func TestOrganizeBundlesIntoProcessingWavesWithApplyOrder(t *testing.T) {
+	workRef := klog.KRef("", workName)
+
+	nsGVR := &schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	deployGVR := &schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	crGVR := &schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+
+	testCases := []struct {
+		name string
+		// A function that returns the bundles to organize; the bundles are built freshly for each
+		// test case as the organization step mutates them.
+		bundles func() []*manifestProcessingBundle
+		// The ordinals of bundles in each wave, keyed by the wave number.
+		wantOrdinalsByWave map[waveNumber][]int
+		// The ordinals of bundles that should be marked with an invalid apply order.
+		wantInvalidOrdinals []int
+		// The ordinals of dependencies, keyed by the ordinals of the dependents.
+		wantDependencyOrdinals map[int][]int
+		// The references of dependencies outside the Work, keyed by the ordinals of the dependents.
+		wantExternalDependencies map[int][]string
+		// The ordinals of bundles that should wait for availability.
+		wantWaitForAvailableOrdinals []int
+	}{
+		{
+			name: "wave override",
+			bundles: func() []*manifestProcessingBundle {
+				return []*manifestProcessingBundle{
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 0},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "", "first", map[string]string{fleetv1beta1.ApplyWaveAnnotation: "2"}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 1},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "", "second", nil),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 2},
+						gvr:         nsGVR,
+						manifestObj: manifestObjWithAnnotations("v1", "Namespace", "", "app", map[string]string{fleetv1beta1.ApplyWaveAnnotation: " 10 "}),
+					},
+				}
+			},
+			wantOrdinalsByWave: map[waveNumber][]int{
+				2:        {0},
+				10:       {2},
+				lastWave: {1},
+			},
+		},
+		{
+			name: "dependencies raise the wave",
+			bundles: func() []*manifestProcessingBundle {
+				return []*manifestProcessingBundle{
+					{
+						id:  &fleetv1beta1.WorkResourceIdentifier{Ordinal: 0},
+						gvr: crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "app", "consumer", map[string]string{
+							fleetv1beta1.ApplyWaveAnnotation:        "0",
+							fleetv1beta1.DependsOnAnnotation:        "Widget.example.com/app/provider, Namespace/app",
+							fleetv1beta1.WaitForAvailableAnnotation: "true",
+						}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 1},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "app", "provider", map[string]string{fleetv1beta1.ApplyWaveAnnotation: "5"}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 2},
+						gvr:         nsGVR,
+						manifestObj: manifestObjWithAnnotations("v1", "Namespace", "", "app", nil),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 3},
+						gvr:         deployGVR,
+						manifestObj: manifestObjWithAnnotations("apps/v1", "Deployment", "app", "web", map[string]string{fleetv1beta1.DependsOnAnnotation: "Widget.example.com/app/consumer"}),
+					},
+				}
+			},
+			wantOrdinalsByWave: map[waveNumber][]int{
+				0: {2},
+				5: {1},
+				6: {0},
+				7: {3},
+			},
+			wantDependencyOrdinals: map[int][]int{
+				0: {1, 2},
+				3: {0},
+			},
+			wantWaitForAvailableOrdinals: []int{0},
+		},
+		{
+			name: "invalid wave number, malformed dependency, and dependency outside the Work",
+			bundles: func() []*manifestProcessingBundle {
+				return []*manifestProcessingBundle{
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 0},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "", "bad-wave", map[string]string{fleetv1beta1.ApplyWaveAnnotation: "first"}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 1},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "", "negative-wave", map[string]string{fleetv1beta1.ApplyWaveAnnotation: "-1"}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 2},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "", "external-dep", map[string]string{fleetv1beta1.DependsOnAnnotation: "Namespace/external, Namespace/app"}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 3},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "", "malformed-dep", map[string]string{fleetv1beta1.DependsOnAnnotation: "Namespace"}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 4},
+						gvr:         nsGVR,
+						manifestObj: manifestObjWithAnnotations("v1", "Namespace", "", "app", nil),
+					},
+				}
+			},
+			wantOrdinalsByWave: map[waveNumber][]int{
+				0:        {4},
+				lastWave: {2},
+			},
+			wantInvalidOrdinals: []int{0, 1, 3},
+			wantDependencyOrdinals: map[int][]int{
+				2: {4},
+			},
+			wantExternalDependencies: map[int][]string{
+				2: {"Namespace/external"},
+			},
+		},
+		{
+			name: "dependency cycle",
+			bundles: func() []*manifestProcessingBundle {
+				return []*manifestProcessingBundle{
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 0},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "", "a", map[string]string{fleetv1beta1.DependsOnAnnotation: "Widget.example.com/b"}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 1},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "", "b", map[string]string{fleetv1beta1.DependsOnAnnotation: "Widget.example.com/a"}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 2},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "", "self", map[string]string{fleetv1beta1.DependsOnAnnotation: "Widget.example.com/self"}),
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 3},
+						gvr:         nsGVR,
+						manifestObj: manifestObjWithAnnotations("v1", "Namespace", "", "app", nil),
+					},
+				}
+			},
+			wantOrdinalsByWave: map[waveNumber][]int{
+				0: {3},
+			},
+			wantInvalidOrdinals: []int{0, 1, 2},
+		},
+		{
+			name: "dependency with a prior processing error",
+			bundles: func() []*manifestProcessingBundle {
+				return []*manifestProcessingBundle{
+					{
+						id:                      &fleetv1beta1.WorkResourceIdentifier{Ordinal: 0},
+						gvr:                     nsGVR,
+						manifestObj:             manifestObjWithAnnotations("v1", "Namespace", "", "app", nil),
+						applyOrReportDiffErr:    fmt.Errorf("duplicated"),
+						applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDuplicated,
+					},
+					{
+						id:          &fleetv1beta1.WorkResourceIdentifier{Ordinal: 1},
+						gvr:         crGVR,
+						manifestObj: manifestObjWithAnnotations("example.com/v1", "Widget", "app", "consumer", map[string]string{fleetv1beta1.DependsOnAnnotation: "Namespace/app"}),
+					},
+				}
+			},
+			wantOrdinalsByWave: map[waveNumber][]int{
+				lastWave: {1},
+			},
+			wantDependencyOrdinals: map[int][]int{
+				1: {0},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bundles := tc.bundles()
+			waves := organizeBundlesIntoProcessingWaves(bundles, workRef)
+
+			gotOrdinalsByWave := make(map[waveNumber][]int, len(waves))
+			for _, wave := range waves {
+				for _, bundle := range wave.bundles {
+					gotOrdinalsByWave[wave.num] = append(gotOrdinalsByWave[wave.num], bundle.id.Ordinal)
+				}
+			}
+			if diff := cmp.Diff(gotOrdinalsByWave, tc.wantOrdinalsByWave); diff != "" {
+				t.Errorf("organized waves mismatch (-got, +want):\n%s", diff)
+			}
+
+			gotInvalidOrdinals := []int{}
+			gotDependencyOrdinals := map[int][]int{}
+			gotExternalDependencies := map[int][]string{}
+			gotWaitForAvailableOrdinals := []int{}
+			for _, bundle := range bundles {
+				if bundle.applyOrReportDiffResTyp == ApplyOrReportDiffResTypeInvalidApplyOrder {
+					if bundle.applyOrReportDiffErr == nil {
+						t.Errorf("bundle %d has an invalid apply order but no error", bundle.id.Ordinal)
+					}
+					gotInvalidOrdinals = append(gotInvalidOrdinals, bundle.id.Ordinal)
+				}
+				for _, dep := range bundle.dependencies {
+					gotDependencyOrdinals[bundle.id.Ordinal] = append(gotDependencyOrdinals[bundle.id.Ordinal], dep.id.Ordinal)
+				}
+				for _, key := range bundle.externalDependencies {
+					gotExternalDependencies[bundle.id.Ordinal] = append(gotExternalDependencies[bundle.id.Ordinal], key.String())
+				}
+				if bundle.waitForAvailable {
+					gotWaitForAvailableOrdinals = append(gotWaitForAvailableOrdinals, bundle.id.Ordinal)
+				}
+			}
+			if diff := cmp.Diff(gotInvalidOrdinals, tc.wantInvalidOrdinals, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("bundles with invalid apply order mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(gotDependencyOrdinals, tc.wantDependencyOrdinals, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("dependencies mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(gotExternalDependencies, tc.wantExternalDependencies, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("dependencies outside the Work mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(gotWaitForAvailableOrdinals, tc.wantWaitForAvailableOrdinals, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("bundles waiting for availability mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
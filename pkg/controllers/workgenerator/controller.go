@@ -496,6 +496,13 @@ func (r *Reconciler) syncAllWork(ctx context.Context, resourceBinding fleetv1bet
 		selectedRes := snapshot.GetResourceSnapshotSpec().SelectedResources
 		for j := range selectedRes {
 			selectedResource := selectedRes[j].DeepCopy()
+			// Set the share of replicas assigned to the target cluster before applying the overrides, so that
+			// the override rules can still adjust the replica count on a per-cluster basis. Workloads wrapped
+			// in envelopes get their share when the envelopes are unpacked.
+			if err := applyReplicas(&selectedResource.RawExtension, resourceBinding); err != nil {
+				klog.ErrorS(err, "Failed to apply the share of replicas", "snapshot", klog.KObj(snapshot), "selectedResourceIdx", j)
+				return false, false, err
+			}
 			// TODO: apply the override rules on the envelope resources by applying them on the work instead of the selected resource
 			resourceDeleted, overrideErr := r.applyOverrides(selectedResource, cluster, croMap, roMap)
 			if overrideErr != nil {
@@ -741,16 +748,18 @@ func generateSnapshotWorkObj(workName string, resourceBinding fleetv1beta1.Bindi
 	if resourceBinding.GetNamespace() != "" {
 		labels[fleetv1beta1.ParentNamespaceLabel] = resourceBinding.GetNamespace()
 	}
+	annotations := map[string]string{
+		fleetv1beta1.ParentResourceSnapshotNameAnnotation:                resourceBinding.GetBindingSpec().ResourceSnapshotName,
+		fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation:        resourceOverrideSnapshotHash,
+		fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation: clusterResourceOverrideSnapshotHash,
+	}
+	setReplicaAnnotations(annotations, resourceBinding)
 	return &fleetv1beta1.Work{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      workName,
-			Namespace: fmt.Sprintf(utils.NamespaceNameFormat, resourceBinding.GetBindingSpec().TargetCluster),
-			Labels:    labels,
-			Annotations: map[string]string{
-				fleetv1beta1.ParentResourceSnapshotNameAnnotation:                resourceBinding.GetBindingSpec().ResourceSnapshotName,
-				fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation:        resourceOverrideSnapshotHash,
-				fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation: clusterResourceOverrideSnapshotHash,
-			},
+			Name:        workName,
+			Namespace:   fmt.Sprintf(utils.NamespaceNameFormat, resourceBinding.GetBindingSpec().TargetCluster),
+			Labels:      labels,
+			Annotations: annotations,
 			// OwnerReferences cannot be added, as the namespaces of work and resourceBinding are different.
 			// Garbage collector will assume the resourceBinding is invalid as it cannot be found in the same namespace.
 		},
@@ -763,6 +772,20 @@ func generateSnapshotWorkObj(workName string, resourceBinding fleetv1beta1.Bindi
 	}
 }
 
+// setReplicaAnnotations records the share of replicas and the replica target of a binding in the
+// annotations of a work, so that the work is regenerated when either of them changes.
+func setReplicaAnnotations(annotations map[string]string, resourceBinding fleetv1beta1.BindingObj) {
+	spec := resourceBinding.GetBindingSpec()
+	if spec.Replicas == nil || spec.ReplicaTarget == nil {
+		delete(annotations, fleetv1beta1.ParentBindingReplicasAnnotation)
+		delete(annotations, fleetv1beta1.ParentBindingReplicaTargetAnnotation)
+		return
+	}
+	target := spec.ReplicaTarget
+	annotations[fleetv1beta1.ParentBindingReplicasAnnotation] = strconv.Itoa(int(*spec.Replicas))
+	annotations[fleetv1beta1.ParentBindingReplicaTargetAnnotation] = fmt.Sprintf("%s/%s/%s/%s/%s", target.Group, target.Version, target.Kind, target.Namespace, target.Name)
+}
+
 // upsertWork creates or updates the new work for the corresponding resource snapshot.
 // it returns if any change is made to the existing work and the possible error code.
 func (r *Reconciler) upsertWork(ctx context.Context, newWork, existingWork *fleetv1beta1.Work, resourceSnapshot fleetv1beta1.ResourceSnapshotObj) (bool, error) {
@@ -789,7 +812,9 @@ func (r *Reconciler) upsertWork(ctx context.Context, newWork, existingWork *flee
 			// no need to do anything if the work is generated from the same resource/override snapshots.
 			// Note that apply strategy is updated separately beforehand.
 			if existingWork.Annotations[fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation] == newWork.Annotations[fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation] &&
-				existingWork.Annotations[fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation] == newWork.Annotations[fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation] {
+				existingWork.Annotations[fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation] == newWork.Annotations[fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation] &&
+				existingWork.Annotations[fleetv1beta1.ParentBindingReplicasAnnotation] == newWork.Annotations[fleetv1beta1.ParentBindingReplicasAnnotation] &&
+				existingWork.Annotations[fleetv1beta1.ParentBindingReplicaTargetAnnotation] == newWork.Annotations[fleetv1beta1.ParentBindingReplicaTargetAnnotation] {
 				klog.V(2).InfoS("Work is associated with the desired resource/override snapshots", "existingROHash", existingWork.Annotations[fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation],
 					"existingCROHash", existingWork.Annotations[fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation], "work", workObj)
 				return false, nil
@@ -808,6 +833,13 @@ func (r *Reconciler) upsertWork(ctx context.Context, newWork, existingWork *flee
 	existingWork.Annotations[fleetv1beta1.ParentResourceSnapshotNameAnnotation] = newWork.Annotations[fleetv1beta1.ParentResourceSnapshotNameAnnotation]
 	existingWork.Annotations[fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation] = newWork.Annotations[fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation]
 	existingWork.Annotations[fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation] = newWork.Annotations[fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation]
+	for _, key := range []string{fleetv1beta1.ParentBindingReplicasAnnotation, fleetv1beta1.ParentBindingReplicaTargetAnnotation} {
+		if value, ok := newWork.Annotations[key]; ok {
+			existingWork.Annotations[key] = value
+		} else {
+			delete(existingWork.Annotations, key)
+		}
+	}
 	existingWork.Spec.Workload.Manifests = newWork.Spec.Workload.Manifests
 	existingWork.Spec.ApplyStrategy = newWork.Spec.ApplyStrategy
 	if err := r.Client.Update(ctx, existingWork); err != nil {
@@ -887,16 +919,26 @@ func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleet
 	//    the DiffReported condition in the status, plus the details about diffed placements;
 	//    the Applied and Available conditions (plus the details about failed and/or drifted placements)
 	//    will not be updated.
+	// c) If the currently active apply strategy is DryRun, the work generator will update the
+	//    Applied condition in the status, plus the details about failed placements; the Available
+	//    and DiffReported conditions (plus the details about diffed and/or drifted placements)
+	//    will not be updated.
 
 	// try to gather the resource binding applied status if we didn't update any associated work spec this time
 
 	var isReportDiffModeOn = resourceBinding.GetBindingSpec().ApplyStrategy != nil && resourceBinding.GetBindingSpec().ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff
+	var isDryRunModeOn = resourceBinding.GetBindingSpec().ApplyStrategy != nil && resourceBinding.GetBindingSpec().ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeDryRun
 	var appliedSummarizedStatus, availabilitySummarizedStatus, diffReportedSummarizedStatus workConditionSummarizedStatus
-	if isReportDiffModeOn {
+	switch {
+	case isReportDiffModeOn:
 		// Set the DiffReported condition if (and only if) a ReportDiff apply strategy is currently
 		// being used.
 		diffReportedSummarizedStatus = setAllWorkDiffReportedCondition(works, resourceBinding)
-	} else {
+	case isDryRunModeOn:
+		// Set only the Applied condition if a DryRun apply strategy is currently being used; no
+		// object is actually applied, and consequently there is no availability to check.
+		appliedSummarizedStatus = setAllWorkAppliedCondition(works, resourceBinding)
+	default:
 		// Set the Applied and Available condition if (and only if) a ClientSideApply or ServerSideApply
 		// apply strategy is currently being used.
 		appliedSummarizedStatus = setAllWorkAppliedCondition(works, resourceBinding)
@@ -941,6 +983,19 @@ func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleet
 			// In this case, no diffed, failed, or drifted placements will be set (diff information present
 			// might be incomplete or stale; apply/availability check failure and drifts cannot occur in
 			// report diff mode).
+		case isDryRunModeOn && appliedSummarizedStatus == workConditionSummarizedStatusFalse:
+			// The DryRun apply strategy is in use and some of the works have manifests rejected by
+			// the member cluster API server.
+			//
+			// In this case, set failed placements only; diffed and drifted placements will not be set
+			// (diffs and drifts cannot occur in dry run mode).
+			failedManifests := extractFailedResourcePlacementsFromWork(w)
+			failedResourcePlacements = append(failedResourcePlacements, failedManifests...)
+		case isDryRunModeOn:
+			// The DryRun apply strategy is in use, and either not all works have completed the
+			// dry-run apply ops, or all manifests have been accepted.
+			//
+			// In this case, no diffed, failed, or drifted placements will be set.
 		case appliedSummarizedStatus == workConditionSummarizedStatusIncomplete:
 			// The ClientSideApply or ServerSideApply apply strategy is in use but some of the works have not been applied yet.
 			//
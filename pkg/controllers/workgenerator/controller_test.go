@@ -324,6 +324,30 @@ func TestUpsertWork(t *testing.T) {
 			},
 			expectChanged: true,
 		},
+		{
+			name: "Update existing work if it does not have the correct share of replicas",
+			existingWork: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      workName,
+					Namespace: namespace,
+					Labels: map[string]string{
+						fleetv1beta1.ParentResourceSnapshotIndexLabel: "1",
+					},
+					Annotations: map[string]string{
+						fleetv1beta1.ParentResourceSnapshotNameAnnotation:                "snapshot-1",
+						fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation: "hash1",
+						fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation:        "hash2",
+						fleetv1beta1.ParentBindingReplicasAnnotation:                     "3",
+					},
+				},
+				Spec: fleetv1beta1.WorkSpec{
+					Workload: fleetv1beta1.WorkloadTemplate{
+						Manifests: []fleetv1beta1.Manifest{{RawExtension: runtime.RawExtension{Raw: []byte("{}")}}},
+					},
+				},
+			},
+			expectChanged: true,
+		},
 		{
 			name: "Do not update the existing work if it already points to the same resource and override snapshots",
 			existingWork: &fleetv1beta1.Work{
@@ -2208,6 +2232,75 @@ func TestSetBindingStatus(t *testing.T) {
 				},
 			},
 		},
+		"dry run mode with one manifest rejected": {
+			works: map[string]*fleetv1beta1.Work{
+				"work1": {
+					Status: fleetv1beta1.WorkStatus{
+						ManifestConditions: []fleetv1beta1.ManifestCondition{
+							{
+								Identifier: fleetv1beta1.WorkResourceIdentifier{
+									Ordinal:   0,
+									Group:     "",
+									Version:   "v1",
+									Kind:      "ConfigMap",
+									Name:      "config-name",
+									Namespace: "config-namespace",
+								},
+								Conditions: []metav1.Condition{
+									{
+										Type:   fleetv1beta1.WorkConditionTypeApplied,
+										Status: metav1.ConditionTrue,
+										Reason: "DryRunSucceeded",
+									},
+								},
+							},
+							{
+								Identifier: fleetv1beta1.WorkResourceIdentifier{
+									Ordinal:   1,
+									Group:     "",
+									Version:   "v1",
+									Kind:      "Service",
+									Name:      "svc-name",
+									Namespace: "svc-namespace",
+								},
+								Conditions: []metav1.Condition{
+									{
+										Type:   fleetv1beta1.WorkConditionTypeApplied,
+										Status: metav1.ConditionFalse,
+										Reason: "DryRunFailed",
+									},
+								},
+							},
+						},
+						Conditions: []metav1.Condition{
+							{
+								Type:   fleetv1beta1.WorkConditionTypeApplied,
+								Status: metav1.ConditionFalse,
+							},
+						},
+					},
+				},
+			},
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type: fleetv1beta1.ApplyStrategyTypeDryRun,
+			},
+			wantFailedResourcePlacements: []fleetv1beta1.FailedResourcePlacement{
+				{
+					ResourceIdentifier: fleetv1beta1.ResourceIdentifier{
+						Group:     "",
+						Version:   "v1",
+						Kind:      "Service",
+						Name:      "svc-name",
+						Namespace: "svc-namespace",
+					},
+					Condition: metav1.Condition{
+						Type:   fleetv1beta1.WorkConditionTypeApplied,
+						Status: metav1.ConditionFalse,
+						Reason: "DryRunFailed",
+					},
+				},
+			},
+		},
 	}
 
 	originalMaxFailedResourcePlacementLimit := maxFailedResourcePlacementLimit
@@ -50,6 +50,15 @@ func (r *Reconciler) createOrUpdateEnvelopeCRWorkObj(
 			"envelope", envelopeReader.GetEnvelopeObjRef())
 		return nil, err
 	}
+	for idx := range manifests {
+		if err := applyReplicas(&manifests[idx].RawExtension, binding); err != nil {
+			klog.ErrorS(err, "Failed to apply the share of replicas to a wrapped manifest",
+				"resourceBinding", klog.KObj(binding),
+				"resourceSnapshot", klog.KObj(resourceSnapshot),
+				"envelope", envelopeReader.GetEnvelopeObjRef())
+			return nil, err
+		}
+	}
 	klog.V(2).InfoS("Successfully extracted wrapped manifests from the envelope",
 		"numOfResources", len(manifests),
 		"resourceBinding", klog.KObj(binding),
@@ -183,6 +192,7 @@ func refreshWorkForEnvelopeCR(
 	work.Annotations[fleetv1beta1.ParentResourceSnapshotNameAnnotation] = resourceBinding.GetBindingSpec().ResourceSnapshotName
 	work.Annotations[fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation] = resourceOverrideSnapshotHash
 	work.Annotations[fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation] = clusterResourceOverrideSnapshotHash
+	setReplicaAnnotations(work.Annotations, resourceBinding)
 	// Update the work spec (the manifests and the apply strategy).
 	work.Spec.Workload.Manifests = manifests
 	work.Spec.ApplyStrategy = resourceBinding.GetBindingSpec().ApplyStrategy
@@ -213,16 +223,19 @@ func buildNewWorkForEnvelopeCR(
 		labels[fleetv1beta1.ParentNamespaceLabel] = resourceBinding.GetNamespace()
 	}
 
+	annotations := map[string]string{
+		fleetv1beta1.ParentResourceSnapshotNameAnnotation:                resourceBinding.GetBindingSpec().ResourceSnapshotName,
+		fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation:        resourceOverrideSnapshotHash,
+		fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation: clusterResourceOverrideSnapshotHash,
+	}
+	setReplicaAnnotations(annotations, resourceBinding)
+
 	return &fleetv1beta1.Work{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      workName,
-			Namespace: workNamespace,
-			Labels:    labels,
-			Annotations: map[string]string{
-				fleetv1beta1.ParentResourceSnapshotNameAnnotation:                resourceBinding.GetBindingSpec().ResourceSnapshotName,
-				fleetv1beta1.ParentResourceOverrideSnapshotHashAnnotation:        resourceOverrideSnapshotHash,
-				fleetv1beta1.ParentClusterResourceOverrideSnapshotHashAnnotation: clusterResourceOverrideSnapshotHash,
-			},
+			Name:        workName,
+			Namespace:   workNamespace,
+			Labels:      labels,
+			Annotations: annotations,
 			// OwnerReferences cannot be added, as the namespaces of work and resourceBinding are different.
 			// Garbage collector will assume the resourceBinding is invalid as it cannot be found in the same namespace.
 		},
@@ -25,8 +25,10 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -517,6 +519,86 @@ func TestCreateOrUpdateEnvelopeCRWorkObj(t *testing.T) {
 	}
 }
 
+// TestCreateOrUpdateEnvelopeCRWorkObj_DividedReplicas tests that only the replica target among the
+// wrapped workloads gets the share of replicas assigned to the cluster.
+func TestCreateOrUpdateEnvelopeCRWorkObj_DividedReplicas(t *testing.T) {
+	resourceSnapshot := &fleetv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-snapshot",
+			Labels: map[string]string{
+				fleetv1beta1.PlacementTrackingLabel: "test-crp",
+			},
+		},
+	}
+	resourceBinding := &fleetv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-binding",
+			Labels: map[string]string{
+				fleetv1beta1.PlacementTrackingLabel: "test-crp",
+			},
+		},
+		Spec: fleetv1beta1.ResourceBindingSpec{
+			TargetCluster:        "test-cluster-1",
+			ResourceSnapshotName: resourceSnapshot.Name,
+			Replicas:             ptr.To(int32(2)),
+			ReplicaTarget: &fleetv1beta1.WorkloadReference{
+				Group:     "apps",
+				Version:   "v1",
+				Kind:      "Deployment",
+				Name:      "web",
+				Namespace: "default",
+			},
+		},
+	}
+	resourceEnvelope := &fleetv1beta1.ResourceEnvelope{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-envelope",
+			Namespace: "default",
+		},
+		Data: map[string]runtime.RawExtension{
+			"web": {
+				Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"default"},"spec":{"replicas":10}}`),
+			},
+			"worker": {
+				Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"worker","namespace":"default"},"spec":{"replicas":10}}`),
+			},
+		},
+	}
+
+	r := &Reconciler{
+		Client:          fake.NewClientBuilder().WithScheme(serviceScheme(t)).Build(),
+		recorder:        record.NewFakeRecorder(10),
+		InformerManager: &informer.FakeManager{},
+	}
+	work, err := r.createOrUpdateEnvelopeCRWorkObj(ctx, resourceEnvelope, "test-work", resourceBinding, resourceSnapshot, "resource-hash", "cluster-resource-hash")
+	if err != nil {
+		t.Fatalf("createOrUpdateEnvelopeCRWorkObj() = %v, want no error", err)
+	}
+
+	wantReplicas := map[string]int64{"web": 2, "worker": 10}
+	gotReplicas := make(map[string]int64, len(work.Spec.Workload.Manifests))
+	for _, manifest := range work.Spec.Workload.Manifests {
+		var obj unstructured.Unstructured
+		if err := obj.UnmarshalJSON(manifest.Raw); err != nil {
+			t.Fatalf("Failed to unmarshal manifest: %v", err)
+		}
+		replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		gotReplicas[obj.GetName()] = replicas
+	}
+	if diff := cmp.Diff(gotReplicas, wantReplicas); diff != "" {
+		t.Errorf("wrapped workload replicas mismatch (-got +want):\n%s", diff)
+	}
+	wantAnnotations := map[string]string{
+		fleetv1beta1.ParentBindingReplicasAnnotation:      "2",
+		fleetv1beta1.ParentBindingReplicaTargetAnnotation: "apps/v1/Deployment/default/web",
+	}
+	for key, want := range wantAnnotations {
+		if got := work.Annotations[key]; got != want {
+			t.Errorf("work annotation %s = %q, want %q", key, got, want)
+		}
+	}
+}
+
 // Test processOneSelectedResource with both envelope types
 func TestProcessOneSelectedResource(t *testing.T) {
 	scheme := serviceScheme(t)
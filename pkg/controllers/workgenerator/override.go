@@ -25,6 +25,7 @@ import (
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 
@@ -180,6 +181,50 @@ func (r *Reconciler) applyOverrides(resource *placementv1beta1.ResourceContent,
 	return resource.Raw == nil, nil
 }
 
+// applyReplicas sets the `spec.replicas` field of the replica target of the binding to the share of
+// replicas assigned to the target cluster, if the placement divides replicas across clusters.
+// Resources other than the replica target are left as they are; the field is added to the replica
+// target if it is absent.
+func applyReplicas(resource *runtime.RawExtension, binding placementv1beta1.BindingObj) error {
+	spec := binding.GetBindingSpec()
+	if spec.Replicas == nil || spec.ReplicaTarget == nil || resource.Raw == nil {
+		return nil
+	}
+
+	var uResource unstructured.Unstructured
+	if err := uResource.UnmarshalJSON(resource.Raw); err != nil {
+		klog.ErrorS(err, "Work has invalid content", "selectedResource", resource.Raw)
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	if !isReplicaTarget(&uResource, spec.ReplicaTarget, binding.GetNamespace()) {
+		return nil
+	}
+	if err := unstructured.SetNestedField(uResource.Object, int64(*spec.Replicas), "spec", "replicas"); err != nil {
+		klog.ErrorS(err, "Failed to set the replicas", "resource", klog.KObj(&uResource))
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	raw, err := uResource.MarshalJSON()
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal the resource", "resource", klog.KObj(&uResource))
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	resource.Raw = raw
+	klog.V(2).InfoS("Applied the share of replicas", "resource", klog.KObj(&uResource), "binding", klog.KObj(binding), "replicas", *spec.Replicas)
+	return nil
+}
+
+// isReplicaTarget returns whether a resource is the workload the reference points to; for bindings of
+// ResourcePlacements, an empty namespace in the reference stands for the namespace of the binding.
+func isReplicaTarget(resource *unstructured.Unstructured, target *placementv1beta1.WorkloadReference, bindingNamespace string) bool {
+	namespace := target.Namespace
+	if namespace == "" {
+		namespace = bindingNamespace
+	}
+	gvk := resource.GroupVersionKind()
+	return gvk.Group == target.Group && gvk.Version == target.Version && gvk.Kind == target.Kind &&
+		resource.GetName() == target.Name && resource.GetNamespace() == namespace
+}
+
 func applyOverrideRules(resource *placementv1beta1.ResourceContent, cluster *clusterv1beta1.MemberCluster, rules []placementv1beta1.OverrideRule) error {
 	for _, rule := range rules {
 		matched, err := overrider.IsClusterMatched(cluster, rule)
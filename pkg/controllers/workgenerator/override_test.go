@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -2772,6 +2773,230 @@ func TestApplyJSONPatchOverride(t *testing.T) {
 	}
 }
 
+func TestApplyReplicas(t *testing.T) {
+	deploymentType := metav1.TypeMeta{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+	}
+	deploymentObjectMeta := metav1.ObjectMeta{
+		Name:      "deployment-name",
+		Namespace: "deployment-namespace",
+	}
+	replicaTarget := &placementv1beta1.WorkloadReference{
+		Group:     "apps",
+		Version:   "v1",
+		Kind:      "Deployment",
+		Name:      "deployment-name",
+		Namespace: "deployment-namespace",
+	}
+	crbWithReplicas := func(replicas *int32, target *placementv1beta1.WorkloadReference) placementv1beta1.BindingObj {
+		return &placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{
+				Replicas:      replicas,
+				ReplicaTarget: target,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		resource runtime.Object
+		binding  placementv1beta1.BindingObj
+		want     runtime.Object
+	}{
+		{
+			name: "no share of replicas",
+			resource: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(10)),
+				},
+			},
+			binding: crbWithReplicas(nil, nil),
+			want: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(10)),
+				},
+			},
+		},
+		{
+			name: "rewrite replicas",
+			resource: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(10)),
+				},
+			},
+			binding: crbWithReplicas(ptr.To(int32(3)), replicaTarget),
+			want: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(3)),
+				},
+			},
+		},
+		{
+			name: "rewrite replicas to zero",
+			resource: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(10)),
+				},
+			},
+			binding: crbWithReplicas(ptr.To(int32(0)), replicaTarget),
+			want: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(0)),
+				},
+			},
+		},
+		{
+			name: "set replicas when the field is absent",
+			resource: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+			},
+			binding: crbWithReplicas(ptr.To(int32(3)), replicaTarget),
+			want: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(3)),
+				},
+			},
+		},
+		{
+			name: "another workload of the same kind",
+			resource: &appsv1.Deployment{
+				TypeMeta: deploymentType,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-deployment-name",
+					Namespace: "deployment-namespace",
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(10)),
+				},
+			},
+			binding: crbWithReplicas(ptr.To(int32(3)), replicaTarget),
+			want: &appsv1.Deployment{
+				TypeMeta: deploymentType,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-deployment-name",
+					Namespace: "deployment-namespace",
+				},
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(10)),
+				},
+			},
+		},
+		{
+			name: "workload of another kind with the same name",
+			resource: &appsv1.StatefulSet{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+				},
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To(int32(10)),
+				},
+			},
+			binding: crbWithReplicas(ptr.To(int32(3)), replicaTarget),
+			want: &appsv1.StatefulSet{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "apps/v1",
+					Kind:       "StatefulSet",
+				},
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: ptr.To(int32(10)),
+				},
+			},
+		},
+		{
+			name: "resource without replicas",
+			resource: &rbacv1.ClusterRole{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "rbac.authorization.k8s.io/v1",
+					Kind:       "ClusterRole",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "clusterrole-name",
+				},
+			},
+			binding: crbWithReplicas(ptr.To(int32(3)), replicaTarget),
+			want: &rbacv1.ClusterRole{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "rbac.authorization.k8s.io/v1",
+					Kind:       "ClusterRole",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "clusterrole-name",
+				},
+			},
+		},
+		{
+			name: "resource binding, target in the namespace of the placement",
+			resource: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(10)),
+				},
+			},
+			binding: &placementv1beta1.ResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "deployment-namespace",
+				},
+				Spec: placementv1beta1.ResourceBindingSpec{
+					Replicas: ptr.To(int32(3)),
+					ReplicaTarget: &placementv1beta1.WorkloadReference{
+						Group:   "apps",
+						Version: "v1",
+						Kind:    "Deployment",
+						Name:    "deployment-name",
+					},
+				},
+			},
+			want: &appsv1.Deployment{
+				TypeMeta:   deploymentType,
+				ObjectMeta: deploymentObjectMeta,
+				Spec: appsv1.DeploymentSpec{
+					Replicas: ptr.To(int32(3)),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rc := resource.CreateResourceContentForTest(t, tc.resource)
+			if err := applyReplicas(&rc.RawExtension, tc.binding); err != nil {
+				t.Fatalf("applyReplicas() = %v, want no error", err)
+			}
+			want := resource.CreateResourceContentForTest(t, tc.want)
+			var got, wantObj unstructured.Unstructured
+			if err := got.UnmarshalJSON(rc.Raw); err != nil {
+				t.Fatalf("Failed to unmarshal the result: %v, want nil", err)
+			}
+			if err := wantObj.UnmarshalJSON(want.Raw); err != nil {
+				t.Fatalf("Failed to unmarshal the wanted resource: %v, want nil", err)
+			}
+			if diff := cmp.Diff(wantObj.Object, got.Object); diff != "" {
+				t.Errorf("applyReplicas() resource mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestReplaceClusterLabelKeyVariables(t *testing.T) {
 	tests := map[string]struct {
 		cluster   *clusterv1beta1.MemberCluster
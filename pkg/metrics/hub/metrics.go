@@ -67,6 +67,26 @@ var (
 		Name: "scheduling_active_workers",
 		Help: "Number of currently running scheduling loop",
 	}, []string{})
+
+	// SchedulingQueueDepth is a prometheus metric which holds the number of placements waiting in the
+	// (fair) scheduling queue, per namespace.
+	SchedulingQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "scheduling_queue_depth",
+		Help: "Number of placements waiting in the scheduling queue per namespace",
+	}, []string{"namespace"})
+
+	// SchedulingQueueWaitDurationSeconds is a prometheus metric which tracks how long placements wait
+	// in the (fair) scheduling queue before the scheduler picks them up, per namespace.
+	SchedulingQueueWaitDurationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "scheduling_queue_wait_duration_seconds",
+			Help: "How long a placement waits in the scheduling queue in seconds per namespace",
+			Buckets: []float64{
+				0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300,
+			},
+		},
+		[]string{"namespace"},
+	)
 )
 
 func init() {
@@ -76,5 +96,7 @@ func init() {
 		FleetUpdateRunStatusLastTimestampSeconds,
 		SchedulingCycleDurationMilliseconds,
 		SchedulerActiveWorkers,
+		SchedulingQueueDepth,
+		SchedulingQueueWaitDurationSeconds,
 	)
 }
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultHTTPTimeout is the default timeout for calls to an extender.
+	defaultHTTPTimeout = 5 * time.Second
+
+	// defaultWeight is the default weight of the scores an extender gives.
+	defaultWeight int32 = 1
+
+	// minWeight and maxWeight are the boundaries (inclusive) of the weight of an extender.
+	minWeight int32 = 1
+	maxWeight int32 = 100
+)
+
+// Configuration is the configuration of an HTTP scheduler extender.
+type Configuration struct {
+	// Name is the name of the extender; it is used in logs and in the reasons of scheduling decisions.
+	Name string `json:"name"`
+
+	// URLPrefix is the URL prefix at which the extender is available, e.g., https://extender.example.com/fleet.
+	URLPrefix string `json:"urlPrefix"`
+
+	// FilterVerb is the path (relative to the URL prefix) of the filter call; if not set, the
+	// extender does not run at the Filter stage.
+	FilterVerb string `json:"filterVerb,omitempty"`
+
+	// ScoreVerb is the path (relative to the URL prefix) of the score call; if not set, the
+	// extender does not run at the Score stage.
+	ScoreVerb string `json:"scoreVerb,omitempty"`
+
+	// Weight is the multiplier of the scores the extender gives; it must be in the range of [1, 100].
+	// Defaults to 1.
+	Weight int32 `json:"weight,omitempty"`
+
+	// HTTPTimeout is the timeout for each call to the extender. Defaults to 5s.
+	HTTPTimeout metav1.Duration `json:"httpTimeout,omitempty"`
+
+	// Ignorable specifies whether the scheduler should proceed when the extender fails (e.g., it is
+	// unreachable, times out, or reports an error), as if the extender were not configured. If not
+	// ignorable, such failures fail the scheduling cycle, which will be retried later.
+	Ignorable bool `json:"ignorable,omitempty"`
+
+	// TLSConfig specifies the transport layer security configuration for HTTPS connections.
+	TLSConfig *TLSConfiguration `json:"tlsConfig,omitempty"`
+}
+
+// TLSConfiguration is the transport layer security configuration of an extender.
+type TLSConfiguration struct {
+	// Insecure skips the verification of the server certificate; it should be used for testing only.
+	Insecure bool `json:"insecure,omitempty"`
+
+	// CAFile is the path to the PEM-encoded root certificates for verifying the server certificate.
+	CAFile string `json:"caFile,omitempty"`
+
+	// CertFile and KeyFile are the paths to the PEM-encoded client certificate and key.
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+}
+
+// Validate checks if the extender configuration is valid.
+func (cfg *Configuration) Validate() error {
+	if len(cfg.Name) == 0 {
+		return fmt.Errorf("extender has no name")
+	}
+	u, err := url.Parse(cfg.URLPrefix)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return fmt.Errorf("extender %s has an invalid URL prefix %q", cfg.Name, cfg.URLPrefix)
+	}
+	if len(cfg.FilterVerb) == 0 && len(cfg.ScoreVerb) == 0 {
+		return fmt.Errorf("extender %s has neither a filter verb nor a score verb", cfg.Name)
+	}
+	if cfg.Weight != 0 && (cfg.Weight < minWeight || cfg.Weight > maxWeight) {
+		return fmt.Errorf("weight %d of extender %s is out of range [%d, %d]", cfg.Weight, cfg.Name, minWeight, maxWeight)
+	}
+	if cfg.HTTPTimeout.Duration < 0 {
+		return fmt.Errorf("extender %s has a negative HTTP timeout %s", cfg.Name, cfg.HTTPTimeout.Duration)
+	}
+	if cfg.TLSConfig != nil && (len(cfg.TLSConfig.CertFile) == 0) != (len(cfg.TLSConfig.KeyFile) == 0) {
+		return fmt.Errorf("extender %s must have both or neither of the client certificate and key files", cfg.Name)
+	}
+	return nil
+}
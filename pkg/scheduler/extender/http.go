@@ -0,0 +1,257 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	// maxResponseBodySize is the maximum size of a response body the scheduler reads from an extender.
+	maxResponseBodySize = 10 << 20
+)
+
+// HTTPExtender is a scheduler extender which the scheduler framework consults with via HTTP calls.
+type HTTPExtender struct {
+	name       string
+	urlPrefix  string
+	filterVerb string
+	scoreVerb  string
+	weight     int32
+	ignorable  bool
+	client     *http.Client
+}
+
+var (
+	// Verify that HTTPExtender implements framework.Extender.
+	_ framework.Extender = &HTTPExtender{}
+)
+
+// NewHTTPExtender returns a new HTTP scheduler extender from its configuration.
+func NewHTTPExtender(cfg *Configuration) (*HTTPExtender, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if cfg.TLSConfig != nil {
+		tlsConfig, err := newTLSConfig(cfg.TLSConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set up TLS for extender %s: %w", cfg.Name, err)
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	timeout := cfg.HTTPTimeout.Duration
+	if timeout == 0 {
+		timeout = defaultHTTPTimeout
+	}
+	weight := cfg.Weight
+	if weight == 0 {
+		weight = defaultWeight
+	}
+
+	return &HTTPExtender{
+		name:       cfg.Name,
+		urlPrefix:  strings.TrimSuffix(cfg.URLPrefix, "/"),
+		filterVerb: strings.TrimPrefix(cfg.FilterVerb, "/"),
+		scoreVerb:  strings.TrimPrefix(cfg.ScoreVerb, "/"),
+		weight:     weight,
+		ignorable:  cfg.Ignorable,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+		},
+	}, nil
+}
+
+// NewHTTPExtenders returns a list of HTTP scheduler extenders from their configurations.
+func NewHTTPExtenders(cfgs []Configuration) ([]framework.Extender, error) {
+	extenders := make([]framework.Extender, 0, len(cfgs))
+	for idx := range cfgs {
+		ext, err := NewHTTPExtender(&cfgs[idx])
+		if err != nil {
+			return nil, err
+		}
+		extenders = append(extenders, ext)
+	}
+	return extenders, nil
+}
+
+// newTLSConfig builds the TLS configuration for connecting to an extender.
+func newTLSConfig(cfg *TLSConfiguration) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.Insecure, //nolint:gosec // skipping verification is opt-in and meant for testing only
+	}
+	if len(cfg.CAFile) > 0 {
+		caData, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file %s: %w", cfg.CAFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("no valid certificates found in CA file %s", cfg.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if len(cfg.CertFile) > 0 {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// Name returns the name of the extender.
+func (e *HTTPExtender) Name() string {
+	return e.name
+}
+
+// IsIgnorable returns true if failures of the extender should not fail the scheduling cycle.
+func (e *HTTPExtender) IsIgnorable() bool {
+	return e.ignorable
+}
+
+// SupportsFilter returns true if the extender runs at the Filter stage.
+func (e *HTTPExtender) SupportsFilter() bool {
+	return len(e.filterVerb) > 0
+}
+
+// SupportsScore returns true if the extender runs at the Score stage.
+func (e *HTTPExtender) SupportsScore() bool {
+	return len(e.scoreVerb) > 0
+}
+
+// Filter calls the extender to filter the candidate clusters.
+func (e *HTTPExtender) Filter(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []*clusterv1beta1.MemberCluster,
+) (passed []*clusterv1beta1.MemberCluster, failedWithReasons map[string]string, err error) {
+	result := &ExtenderFilterResult{}
+	if err := e.send(ctx, e.filterVerb, newExtenderArgs(policy, clusters), result); err != nil {
+		return nil, nil, err
+	}
+	if len(result.Error) > 0 {
+		return nil, nil, fmt.Errorf("extender reported an error: %s", result.Error)
+	}
+
+	// Only clusters among the candidates can pass the filter.
+	passedNames := make(map[string]bool, len(result.ClusterNames))
+	for _, name := range result.ClusterNames {
+		passedNames[name] = true
+	}
+	passed = make([]*clusterv1beta1.MemberCluster, 0, len(result.ClusterNames))
+	for _, cluster := range clusters {
+		if passedNames[cluster.Name] {
+			passed = append(passed, cluster)
+		}
+	}
+	return passed, result.FailedClusters, nil
+}
+
+// Score calls the extender to score the candidate clusters; the returned scores have been
+// multiplied by the weight of the extender.
+func (e *HTTPExtender) Score(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []*clusterv1beta1.MemberCluster,
+) (scores map[string]int32, err error) {
+	result := &ExtenderScoreResult{}
+	if err := e.send(ctx, e.scoreVerb, newExtenderArgs(policy, clusters), result); err != nil {
+		return nil, err
+	}
+	if len(result.Error) > 0 {
+		return nil, fmt.Errorf("extender reported an error: %s", result.Error)
+	}
+
+	candidates := make(map[string]bool, len(clusters))
+	for _, cluster := range clusters {
+		candidates[cluster.Name] = true
+	}
+	scores = make(map[string]int32, len(result.Scores))
+	for _, s := range result.Scores {
+		if !candidates[s.ClusterName] {
+			continue
+		}
+		if s.Score < 0 || s.Score > MaxExtenderScore {
+			return nil, fmt.Errorf("extender gave cluster %s a score %d out of range [0, %d]", s.ClusterName, s.Score, MaxExtenderScore)
+		}
+		scores[s.ClusterName] = s.Score * e.weight
+	}
+	return scores, nil
+}
+
+// send sends a request to the extender and decodes the response.
+func (e *HTTPExtender) send(ctx context.Context, verb string, args *ExtenderArgs, result interface{}) error {
+	body, err := json.Marshal(args)
+	if err != nil {
+		return fmt.Errorf("failed to encode extender request: %w", err)
+	}
+
+	url := fmt.Sprintf("%s/%s", e.urlPrefix, verb)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build extender request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call extender at %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("extender at %s returned HTTP status %d", url, resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBodySize)).Decode(result); err != nil {
+		return fmt.Errorf("failed to decode extender response from %s: %w", url, err)
+	}
+	return nil
+}
+
+// newExtenderArgs builds the request body for an extender call.
+func newExtenderArgs(policy placementv1beta1.PolicySnapshotObj, clusters []*clusterv1beta1.MemberCluster) *ExtenderArgs {
+	args := &ExtenderArgs{
+		PolicySnapshotName:      policy.GetName(),
+		PolicySnapshotNamespace: policy.GetNamespace(),
+		PolicySnapshotLabels:    policy.GetLabels(),
+		PolicySnapshotSpec:      *policy.GetPolicySnapshotSpec(),
+		Clusters:                make([]clusterv1beta1.MemberCluster, 0, len(clusters)),
+	}
+	for _, cluster := range clusters {
+		args.Clusters = append(args.Clusters, *cluster)
+	}
+	return args
+}
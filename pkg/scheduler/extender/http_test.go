@@ -0,0 +1,309 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package extender
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	extenderName = "test-extender"
+	policyName   = "test-policy"
+	crpName      = "test-crp"
+
+	clusterName    = "bravelion"
+	altClusterName = "smartcat"
+)
+
+var (
+	policy = &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+			},
+		},
+	}
+	clusters = []*clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+	}
+)
+
+// newTestServer returns a test server which checks the request body and replies with the given response.
+func newTestServer(t *testing.T, verb string, statusCode int, resp interface{}, delay time.Duration) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+verb {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		args := &ExtenderArgs{}
+		if err := json.NewDecoder(r.Body).Decode(args); err != nil {
+			t.Errorf("failed to decode extender args: %v", err)
+		}
+		if args.PolicySnapshotName != policyName || args.PolicySnapshotLabels[placementv1beta1.PlacementTrackingLabel] != crpName || len(args.Clusters) != len(clusters) {
+			t.Errorf("extender args = %+v, want policy snapshot %s with %d clusters", args, policyName, len(clusters))
+		}
+
+		time.Sleep(delay)
+		w.WriteHeader(statusCode)
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Errorf("failed to encode extender response: %v", err)
+		}
+	}))
+}
+
+// TestFilter tests the Filter method.
+func TestFilter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		resp       *ExtenderFilterResult
+		delay      time.Duration
+		wantPassed []*clusterv1beta1.MemberCluster
+		wantFailed map[string]string
+		wantErr    bool
+	}{
+		{
+			name:       "some clusters filtered out",
+			statusCode: http.StatusOK,
+			resp: &ExtenderFilterResult{
+				// Clusters that are not candidates are ignored.
+				ClusterNames:   []string{altClusterName, "unknown"},
+				FailedClusters: map[string]string{clusterName: "not enough quota"},
+			},
+			wantPassed: []*clusterv1beta1.MemberCluster{clusters[1]},
+			wantFailed: map[string]string{clusterName: "not enough quota"},
+		},
+		{
+			name:       "extender reports an error",
+			statusCode: http.StatusOK,
+			resp: &ExtenderFilterResult{
+				Error: "backend unavailable",
+			},
+			wantErr: true,
+		},
+		{
+			name:       "unexpected HTTP status",
+			statusCode: http.StatusInternalServerError,
+			resp:       &ExtenderFilterResult{},
+			wantErr:    true,
+		},
+		{
+			name:       "timeout",
+			statusCode: http.StatusOK,
+			resp:       &ExtenderFilterResult{},
+			delay:      time.Second,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, "filter", tc.statusCode, tc.resp, tc.delay)
+			defer server.Close()
+
+			ext, err := NewHTTPExtender(&Configuration{
+				Name:        extenderName,
+				URLPrefix:   server.URL,
+				FilterVerb:  "filter",
+				HTTPTimeout: metav1.Duration{Duration: 200 * time.Millisecond},
+			})
+			if err != nil {
+				t.Fatalf("NewHTTPExtender() = %v, want no error", err)
+			}
+
+			passed, failed, err := ext.Filter(context.Background(), policy, clusters)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("Filter() = %v, %v, want error", passed, failed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Filter() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(passed, tc.wantPassed); diff != "" {
+				t.Errorf("Filter() passed diff (-got, +want): %s", diff)
+			}
+			if diff := cmp.Diff(failed, tc.wantFailed); diff != "" {
+				t.Errorf("Filter() failed diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestScore tests the Score method.
+func TestScore(t *testing.T) {
+	testCases := []struct {
+		name       string
+		weight     int32
+		resp       *ExtenderScoreResult
+		wantScores map[string]int32
+		wantErr    bool
+	}{
+		{
+			name:   "weighted scores",
+			weight: 2,
+			resp: &ExtenderScoreResult{
+				Scores: []ExtenderClusterScore{
+					{ClusterName: clusterName, Score: 10},
+					{ClusterName: altClusterName, Score: 0},
+					{ClusterName: "unknown", Score: 100},
+				},
+			},
+			wantScores: map[string]int32{clusterName: 20, altClusterName: 0},
+		},
+		{
+			name: "default weight",
+			resp: &ExtenderScoreResult{
+				Scores: []ExtenderClusterScore{
+					{ClusterName: clusterName, Score: 10},
+				},
+			},
+			wantScores: map[string]int32{clusterName: 10},
+		},
+		{
+			name: "score out of range",
+			resp: &ExtenderScoreResult{
+				Scores: []ExtenderClusterScore{
+					{ClusterName: clusterName, Score: 101},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := newTestServer(t, "score", http.StatusOK, tc.resp, 0)
+			defer server.Close()
+
+			ext, err := NewHTTPExtender(&Configuration{
+				Name:      extenderName,
+				URLPrefix: server.URL + "/",
+				ScoreVerb: "/score",
+				Weight:    tc.weight,
+			})
+			if err != nil {
+				t.Fatalf("NewHTTPExtender() = %v, want no error", err)
+			}
+
+			scores, err := ext.Score(context.Background(), policy, clusters)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("Score() = %v, want error", scores)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Score() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(scores, tc.wantScores); diff != "" {
+				t.Errorf("Score() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestValidate tests the Validate method of Configuration.
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cfg     *Configuration
+		wantErr bool
+	}{
+		{
+			name: "valid configuration",
+			cfg: &Configuration{
+				Name:       extenderName,
+				URLPrefix:  "https://extender.example.com/fleet",
+				FilterVerb: "filter",
+				ScoreVerb:  "score",
+				Weight:     5,
+				Ignorable:  true,
+			},
+		},
+		{
+			name: "no name",
+			cfg: &Configuration{
+				URLPrefix:  "https://extender.example.com",
+				FilterVerb: "filter",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid URL prefix",
+			cfg: &Configuration{
+				Name:       extenderName,
+				URLPrefix:  "extender.example.com",
+				FilterVerb: "filter",
+			},
+			wantErr: true,
+		},
+		{
+			name: "no verbs",
+			cfg: &Configuration{
+				Name:      extenderName,
+				URLPrefix: "https://extender.example.com",
+			},
+			wantErr: true,
+		},
+		{
+			name: "weight out of range",
+			cfg: &Configuration{
+				Name:      extenderName,
+				URLPrefix: "https://extender.example.com",
+				ScoreVerb: "score",
+				Weight:    101,
+			},
+			wantErr: true,
+		},
+		{
+			name: "client certificate without key",
+			cfg: &Configuration{
+				Name:       extenderName,
+				URLPrefix:  "https://extender.example.com",
+				FilterVerb: "filter",
+				TLSConfig: &TLSConfiguration{
+					CertFile: "/etc/fleet/extender/tls.crt",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("Validate() = %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package extender features the HTTP scheduler extender, which allows the scheduler framework to
+// consult with an external process at the Filter and Score stages.
+package extender
+
+import (
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// MaxExtenderScore is the maximum score an extender can give to a cluster; the minimum is 0.
+	MaxExtenderScore int32 = 100
+)
+
+// ExtenderArgs is the request body the scheduler sends to an extender, for both filtering and scoring.
+type ExtenderArgs struct {
+	// PolicySnapshotName is the name of the scheduling policy snapshot being scheduled.
+	PolicySnapshotName string `json:"policySnapshotName"`
+
+	// PolicySnapshotNamespace is the namespace of the scheduling policy snapshot being scheduled;
+	// it is empty for cluster-scoped placements.
+	PolicySnapshotNamespace string `json:"policySnapshotNamespace,omitempty"`
+
+	// PolicySnapshotLabels are the labels of the scheduling policy snapshot being scheduled; the
+	// name of the placement can be found in the kubernetes-fleet.io/parent-CRP label.
+	PolicySnapshotLabels map[string]string `json:"policySnapshotLabels,omitempty"`
+
+	// PolicySnapshotSpec is the spec of the scheduling policy snapshot being scheduled.
+	PolicySnapshotSpec placementv1beta1.SchedulingPolicySnapshotSpec `json:"policySnapshotSpec"`
+
+	// Clusters are the candidate clusters.
+	Clusters []clusterv1beta1.MemberCluster `json:"clusters"`
+}
+
+// ExtenderFilterResult is the response body an extender returns for filtering.
+type ExtenderFilterResult struct {
+	// ClusterNames are the names of the clusters that have passed the filter.
+	ClusterNames []string `json:"clusterNames"`
+
+	// FailedClusters are the reasons why clusters are filtered out, keyed by the cluster names.
+	FailedClusters map[string]string `json:"failedClusters,omitempty"`
+
+	// Error is the error message, if the extender has failed to filter the clusters.
+	Error string `json:"error,omitempty"`
+}
+
+// ExtenderClusterScore is the score an extender gives to a cluster.
+type ExtenderClusterScore struct {
+	// ClusterName is the name of the cluster.
+	ClusterName string `json:"clusterName"`
+
+	// Score is the score of the cluster, in the range of [0, MaxExtenderScore].
+	Score int32 `json:"score"`
+}
+
+// ExtenderScoreResult is the response body an extender returns for scoring.
+type ExtenderScoreResult struct {
+	// Scores are the scores of the clusters; clusters that are not present are of the score 0.
+	Scores []ExtenderClusterScore `json:"scores"`
+
+	// Error is the error message, if the extender has failed to score the clusters.
+	Error string `json:"error,omitempty"`
+}
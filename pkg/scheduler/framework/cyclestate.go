@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"sync"
 
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
@@ -44,6 +45,7 @@ type CycleStatePluginReadWriter interface {
 	ListClusters() []clusterv1beta1.MemberCluster
 	HasScheduledOrBoundBindingFor(clusterName string) bool
 	HasObsoleteBindingFor(clusterName string) bool
+	IsBindingPreempted(binding types.NamespacedName) bool
 }
 
 // CycleState is, similar to its namesake in kube-scheduler, provides a way for plugins to
@@ -68,6 +70,10 @@ type CycleState struct {
 	// cycle associated with the cluster.
 	obsoleteBindings map[string]bool
 
+	// preemptedBindings is the set of bindings, of other placements, that the scheduler
+	// considers preempting in the current cycle.
+	preemptedBindings sets.Set[types.NamespacedName]
+
 	// skippedFilterPlugins is a set of Filter plugins that should be skipped in the current scheduling cycle.
 	skippedFilterPlugins sets.Set[string]
 
@@ -82,6 +88,14 @@ type CycleState struct {
 	//
 	// This is set when scheduling policies of the PickN placement type.
 	batchSizeLimit int
+
+	// quotaLimits is the limits that fleet resource quotas impose on the placement in the current
+	// scheduling cycle; it is nil if no quota applies.
+	quotaLimits *fleetResourceQuotaLimits
+
+	// nominatedClusters maps the clusters that have been nominated, through preemption, for
+	// placements of a higher priority to the placements they are nominated for.
+	nominatedClusters map[string]string
 }
 
 // Read retrieves a value from CycleState by a key.
@@ -137,6 +151,15 @@ func (c *CycleState) HasObsoleteBindingFor(clusterName string) bool {
 	return c.obsoleteBindings[clusterName]
 }
 
+// IsBindingPreempted returns whether a binding of another placement is being preempted in
+// the current cycle.
+//
+// Plugins that account for the capacity taken by other placements should treat a preempted
+// binding as if it were gone from its target cluster.
+func (c *CycleState) IsBindingPreempted(binding types.NamespacedName) bool {
+	return c.preemptedBindings.Has(binding)
+}
+
 // IsClusterObsolete
 
 // NewCycleState creates a CycleState.
@@ -146,6 +169,7 @@ func NewCycleState(clusters []clusterv1beta1.MemberCluster, obsoleteBindings []p
 		clusters:                 clusters,
 		scheduledOrBoundBindings: prepareScheduledOrBoundBindingsMap(scheduledOrBoundBindings...),
 		obsoleteBindings:         prepareObsoleteBindingsMap(obsoleteBindings),
+		preemptedBindings:        sets.New[types.NamespacedName](),
 		skippedFilterPlugins:     sets.New[string](),
 		skippedScorePlugins:      sets.New[string](),
 	}
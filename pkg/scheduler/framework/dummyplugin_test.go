@@ -78,3 +78,17 @@ func (p *DummyAllPurposePlugin) Score(ctx context.Context, state CycleStatePlugi
 
 // SetUpWithFramework is a no-op to satisfy the Plugin interface.
 func (p *DummyAllPurposePlugin) SetUpWithFramework(handle Handle) {} // nolint:revive
+
+// A dummy score plugin which normalizes its own scores.
+type DummyScoreNormalizerPlugin struct {
+	DummyAllPurposePlugin
+	normalizeRunner func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, scores map[string]int64) (status *Status)
+}
+
+// Check that the dummy plugin implements the ScoreNormalizer interface at compile time.
+var _ ScoreNormalizer = &DummyScoreNormalizerPlugin{}
+
+// NormalizeScores implements the ScoreNormalizer interface for the dummy plugin.
+func (p *DummyScoreNormalizerPlugin) NormalizeScores(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, scores map[string]int64) (status *Status) { //nolint:revive
+	return p.normalizeRunner(ctx, state, policy, scores)
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/annotations"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// BindingEvaluation is the result of re-evaluating a bound binding of a placement against
+// all the clusters in the fleet.
+type BindingEvaluation struct {
+	// Binding is the binding being evaluated.
+	Binding placementv1beta1.BindingObj
+	// Current is the score the target cluster of the binding receives; it is nil if the
+	// cluster no longer passes the Filter stage.
+	Current *ClusterScore
+	// Best is the top scored cluster which has not been picked by the placement; it is nil
+	// if no such cluster passes the Filter stage.
+	Best *ScoredCluster
+}
+
+// RunEvaluationCycleFor re-evaluates the bound bindings of a placement of the PickN placement
+// type with the plugins (and the scheduler extenders, if any) of the profile.
+//
+// Each bound binding is evaluated as if it were absent, i.e., the evaluation answers the question
+// that, if the scheduler were to pick a cluster for the binding right now, how its current target
+// cluster would compare with the best alternative. Clusters picked by the other bindings of the
+// placement are not considered as alternatives.
+//
+// Only placements in a steady state are evaluated, i.e., the latest scheduling policy snapshot
+// has been fully scheduled and all of its bindings are bound; otherwise, no evaluation is
+// returned. The evaluation does not modify any object.
+func (f *framework) RunEvaluationCycleFor(ctx context.Context, placementKey queue.PlacementKey, policy placementv1beta1.PolicySnapshotObj) ([]*BindingEvaluation, error) {
+	policyRef := klog.KObj(policy)
+
+	spec := policy.GetPolicySnapshotSpec()
+	if spec.Policy == nil || spec.Policy.PlacementType != placementv1beta1.PickNPlacementType {
+		// Only policies of the PickN placement type can be evaluated.
+		return nil, nil
+	}
+	numOfClusters, err := annotations.ExtractNumOfClustersFromPolicySnapshot(policy)
+	if err != nil {
+		klog.ErrorS(err, "Failed to extract number of clusters required from policy snapshot", "policySnapshot", policyRef)
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+
+	namespace, name, err := controller.ExtractNamespaceNameFromKey(placementKey)
+	if err != nil {
+		klog.ErrorS(err, "Failed to extract namespace and name from placement key", "policySnapshot", policyRef)
+		return nil, err
+	}
+
+	// Collect all clusters and bindings.
+	//
+	// Note that unlike a scheduling cycle, bindings are listed from the cache here, as the
+	// evaluation is read-only; a stale view at worst leads to a skipped evaluation.
+	clusters, err := f.collectClusters(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect clusters", "policySnapshot", policyRef)
+		return nil, err
+	}
+	bindings, err := controller.ListBindingsFromKey(ctx, f.client, types.NamespacedName{Namespace: namespace, Name: name}, true)
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect bindings", "policySnapshot", policyRef)
+		return nil, err
+	}
+
+	bound, scheduled, obsolete, _, dangling, deleting := classifyBindings(policy, bindings, clusters)
+	if len(bound) != numOfClusters || len(scheduled)+len(obsolete)+len(dangling)+len(deleting) > 0 {
+		klog.V(2).InfoS("Placement is not in a steady state; skip the evaluation", "policySnapshot", policyRef,
+			"numOfClusters", numOfClusters, "bound", len(bound), "scheduled", len(scheduled), "obsolete", len(obsolete),
+			"dangling", len(dangling), "deleting", len(deleting))
+		return nil, nil
+	}
+
+	evaluations := make([]*BindingEvaluation, 0, len(bound))
+	for idx := range bound {
+		others := make([]placementv1beta1.BindingObj, 0, len(bound)-1)
+		others = append(others, bound[:idx]...)
+		others = append(others, bound[idx+1:]...)
+
+		eval, err := f.evaluateBinding(ctx, policy, clusters, bound[idx], others)
+		if err != nil {
+			klog.ErrorS(err, "Failed to evaluate binding", "policySnapshot", policyRef, "binding", klog.KObj(bound[idx]))
+			return nil, err
+		}
+		evaluations = append(evaluations, eval)
+	}
+	return evaluations, nil
+}
+
+// evaluateBinding evaluates a single bound binding, with the other bound bindings of the same
+// placement in place.
+func (f *framework) evaluateBinding(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []clusterv1beta1.MemberCluster,
+	binding placementv1beta1.BindingObj,
+	others []placementv1beta1.BindingObj,
+) (*BindingEvaluation, error) {
+	state := NewCycleState(clusters, nil, others)
+
+	if status := f.runPreFilterPlugins(ctx, state, policy); status.IsInteralError() {
+		return nil, controller.NewUnexpectedBehaviorError(status.AsError())
+	}
+	passed, _, err := f.runFilterPlugins(ctx, state, policy, clusters)
+	if err != nil {
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+	if passed, _, err = f.runExtenderFilters(ctx, policy, passed); err != nil {
+		return nil, err
+	}
+
+	if status := f.runPreScorePlugins(ctx, state, policy); status.IsInteralError() {
+		return nil, controller.NewUnexpectedBehaviorError(status.AsError())
+	}
+	scored, err := f.runScorePlugins(ctx, state, policy, passed)
+	if err != nil {
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+	if err := f.runExtenderScores(ctx, policy, scored); err != nil {
+		return nil, err
+	}
+
+	// Sort the clusters in the same order as the scheduler does when picking clusters.
+	sort.Sort(sort.Reverse(scored))
+
+	eval := &BindingEvaluation{Binding: binding}
+	target := binding.GetBindingSpec().TargetCluster
+	for _, sc := range scored {
+		switch {
+		case sc.Cluster.Name == target:
+			eval.Current = sc.Score
+		case eval.Best == nil:
+			eval.Best = sc
+		}
+	}
+	return eval, nil
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+)
+
+// TestRunEvaluationCycleFor tests the RunEvaluationCycleFor method.
+func TestRunEvaluationCycleFor(t *testing.T) {
+	clusters := []client.Object{
+		&clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+		&clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+		&clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: anotherClusterName}},
+	}
+	scores := map[string]int32{
+		clusterName:        1,
+		altClusterName:     5,
+		anotherClusterName: 3,
+	}
+
+	newPolicy := func(placementType placementv1beta1.PlacementType, numOfClusters int) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+		return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: policyName,
+				Annotations: map[string]string{
+					placementv1beta1.NumberOfClustersAnnotation: fmt.Sprintf("%d", numOfClusters),
+				},
+			},
+			Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType: placementType,
+				},
+			},
+		}
+	}
+	newBinding := func(targetCluster string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("binding-%s", targetCluster),
+				Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:                        state,
+				TargetCluster:                targetCluster,
+				SchedulingPolicySnapshotName: policyName,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		policy     *placementv1beta1.ClusterSchedulingPolicySnapshot
+		bindings   []client.Object
+		wantScores map[string][2]int32
+	}{
+		{
+			name:     "not a PickN policy",
+			policy:   newPolicy(placementv1beta1.PickAllPlacementType, 0),
+			bindings: []client.Object{newBinding(clusterName, placementv1beta1.BindingStateBound)},
+		},
+		{
+			name:     "not fully scheduled",
+			policy:   newPolicy(placementv1beta1.PickNPlacementType, 2),
+			bindings: []client.Object{newBinding(clusterName, placementv1beta1.BindingStateBound)},
+		},
+		{
+			name:   "has scheduled bindings",
+			policy: newPolicy(placementv1beta1.PickNPlacementType, 2),
+			bindings: []client.Object{
+				newBinding(clusterName, placementv1beta1.BindingStateBound),
+				newBinding(altClusterName, placementv1beta1.BindingStateScheduled),
+			},
+		},
+		{
+			name:   "steady state",
+			policy: newPolicy(placementv1beta1.PickNPlacementType, 2),
+			bindings: []client.Object{
+				newBinding(clusterName, placementv1beta1.BindingStateBound),
+				newBinding(anotherClusterName, placementv1beta1.BindingStateBound),
+			},
+			// Each binding is compared with the best cluster not picked by the other binding.
+			wantScores: map[string][2]int32{
+				clusterName:        {1, 5},
+				anotherClusterName: {3, 5},
+			},
+		},
+		{
+			name:   "steady state, all clusters picked",
+			policy: newPolicy(placementv1beta1.PickNPlacementType, 3),
+			bindings: []client.Object{
+				newBinding(clusterName, placementv1beta1.BindingStateBound),
+				newBinding(altClusterName, placementv1beta1.BindingStateBound),
+				newBinding(anotherClusterName, placementv1beta1.BindingStateBound),
+			},
+			// No alternative is available; -1 denotes the absence of one.
+			wantScores: map[string][2]int32{
+				clusterName:        {1, -1},
+				altClusterName:     {5, -1},
+				anotherClusterName: {3, -1},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(clusters...).
+				WithObjects(tc.bindings...).
+				Build()
+
+			profile := NewProfile(dummyProfileName)
+			profile.WithFilterPlugin(&DummyAllPurposePlugin{
+				name: fmt.Sprintf(dummyAllPurposePluginNameFormat, 0),
+				filterRunner: func(_ context.Context, state CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (status *Status) {
+					if state.HasScheduledOrBoundBindingFor(cluster.Name) {
+						return NewNonErrorStatus(ClusterAlreadySelected, dummyProfileName)
+					}
+					return nil
+				},
+			})
+			profile.WithScorePlugin(&DummyAllPurposePlugin{
+				name: fmt.Sprintf(dummyAllPurposePluginNameFormat, 1),
+				scoreRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (*ClusterScore, *Status) {
+					return &ClusterScore{AffinityScore: scores[cluster.Name]}, nil
+				},
+			})
+			f := &framework{
+				profile:      profile,
+				client:       fakeClient,
+				parallelizer: parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
+			}
+
+			evaluations, err := f.RunEvaluationCycleFor(context.Background(), queue.PlacementKey(crpName), tc.policy)
+			if err != nil {
+				t.Fatalf("RunEvaluationCycleFor() = %v, want no error", err)
+			}
+
+			var gotScores map[string][2]int32
+			for _, eval := range evaluations {
+				if gotScores == nil {
+					gotScores = map[string][2]int32{}
+				}
+				got := [2]int32{-1, -1}
+				if eval.Current != nil {
+					got[0] = eval.Current.AffinityScore
+				}
+				if eval.Best != nil {
+					got[1] = eval.Best.Score.AffinityScore
+				}
+				gotScores[eval.Binding.GetBindingSpec().TargetCluster] = got
+			}
+			if diff := cmp.Diff(gotScores, tc.wantScores); diff != "" {
+				t.Errorf("RunEvaluationCycleFor() scores diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
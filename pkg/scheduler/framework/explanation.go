@@ -0,0 +1,200 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/annotations"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// newSchedulingExplanationEntries returns the explanations for every cluster the scheduler has
+// considered in a scheduling cycle, i.e., the clusters that are selected (as represented by the
+// existing bindings), the clusters that are scored but not picked, and the clusters that have
+// been filtered out, in that order.
+//
+// Unlike scheduling decisions, the number of explanations is only capped by the API limit.
+func newSchedulingExplanationEntries(
+	notPicked ScoredClusters,
+	filtered []*filteredClusterWithStatus,
+	existing ...[]placementv1beta1.BindingObj,
+) []placementv1beta1.ClusterSchedulingExplanationEntry {
+	entries := make([]placementv1beta1.ClusterSchedulingExplanationEntry, 0, len(notPicked)+len(filtered))
+	// Track clusters that have been explained; see newSchedulingDecisionsFromBindings for why a
+	// cluster might be present more than once.
+	seenClusters := make(map[string]bool)
+
+	for _, bindingSet := range existing {
+		for _, binding := range bindingSet {
+			decision := binding.GetBindingSpec().ClusterDecision
+			if seenClusters[decision.ClusterName] {
+				continue
+			}
+			seenClusters[decision.ClusterName] = true
+			entries = append(entries, newSchedulingExplanationEntryFromDecision(decision))
+		}
+	}
+
+	for _, sc := range notPicked {
+		if seenClusters[sc.Cluster.Name] {
+			continue
+		}
+		seenClusters[sc.Cluster.Name] = true
+		entries = append(entries, placementv1beta1.ClusterSchedulingExplanationEntry{
+			ClusterName:  sc.Cluster.Name,
+			ClusterScore: toAPIClusterScore(sc.Score),
+			PluginScores: toAPIPluginScores(sc),
+			Reason:       fmt.Sprintf(notPickedByScoreReasonTemplate, sc.Cluster.Name, sc.Score.AffinityScore, sc.Score.TopologySpreadScore) + extenderScoresReasonSuffix(sc.ExtenderScores),
+		})
+	}
+
+	// Sort the filtered clusters to produce deterministic outputs.
+	sort.Sort(filteredClusterWithStatusList(filtered))
+	for _, fc := range filtered {
+		if seenClusters[fc.cluster.Name] {
+			continue
+		}
+		seenClusters[fc.cluster.Name] = true
+		reason := strings.Join(fc.status.Reasons(), "; ")
+		if len(reason) == 0 {
+			reason = fc.status.String()
+		}
+		entries = append(entries, placementv1beta1.ClusterSchedulingExplanationEntry{
+			ClusterName: fc.cluster.Name,
+			FilteredBy:  fc.status.SourcePlugin(),
+			Reason:      reason,
+		})
+	}
+
+	if len(entries) > clustersDecisionArrayLengthLimitInAPI {
+		klog.V(2).InfoS("Reached API limit of scheduling explanation count; explanations off the limit will be discarded", "count", len(entries))
+		entries = entries[:clustersDecisionArrayLengthLimitInAPI]
+	}
+	return entries
+}
+
+// newSchedulingExplanationEntriesFromDecisions returns the explanations for a list of scheduling
+// decisions; it is used for policies of the PickFixed placement type, where no plugin runs.
+func newSchedulingExplanationEntriesFromDecisions(decisions []placementv1beta1.ClusterDecision) []placementv1beta1.ClusterSchedulingExplanationEntry {
+	entries := make([]placementv1beta1.ClusterSchedulingExplanationEntry, 0, len(decisions))
+	for _, decision := range decisions {
+		entries = append(entries, newSchedulingExplanationEntryFromDecision(decision))
+	}
+	return entries
+}
+
+// newSchedulingExplanationEntryFromDecision returns the explanation for a scheduling decision.
+func newSchedulingExplanationEntryFromDecision(decision placementv1beta1.ClusterDecision) placementv1beta1.ClusterSchedulingExplanationEntry {
+	return placementv1beta1.ClusterSchedulingExplanationEntry{
+		ClusterName:  decision.ClusterName,
+		Selected:     decision.Selected,
+		ClusterScore: decision.ClusterScore,
+		PluginScores: decision.PluginScores,
+		Reason:       decision.Reason,
+	}
+}
+
+// updateSchedulingExplanation writes the explanations of the latest scheduling cycle to the
+// scheduling explanation object of a placement, creating the object if it does not exist yet.
+//
+// This is a no-op if scheduling explanations are not enabled.
+func (f *framework) updateSchedulingExplanation(
+	ctx context.Context,
+	placementKey queue.PlacementKey,
+	policy placementv1beta1.PolicySnapshotObj,
+	entries []placementv1beta1.ClusterSchedulingExplanationEntry,
+) error {
+	if !f.enableSchedulingExplanations {
+		return nil
+	}
+	policyRef := klog.KObj(policy)
+
+	namespace, name, err := controller.ExtractNamespaceNameFromKey(placementKey)
+	if err != nil {
+		klog.ErrorS(err, "Failed to extract namespace and name from placement key", "policySnapshot", policyRef)
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	observedPlacementGeneration, err := annotations.ExtractObservedPlacementGenerationFromPolicySnapshot(policy)
+	if err != nil {
+		klog.ErrorS(err, "Failed to retrieve placement generation from annotation", "policySnapshot", policyRef)
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+
+	var explanation placementv1beta1.SchedulingExplanationObj
+	if namespace == "" {
+		explanation = &placementv1beta1.ClusterSchedulingExplanation{}
+	} else {
+		explanation = &placementv1beta1.SchedulingExplanation{}
+	}
+	explanationRef := klog.KRef(namespace, name)
+
+	// Explanations are read from the cache, as they are written on every scheduling cycle and
+	// the scheduler is the only writer.
+	if err := f.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, explanation); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get scheduling explanation", "schedulingExplanation", explanationRef)
+			return controller.NewAPIServerError(false, err)
+		}
+		// The explanation object does not exist yet; create one, owned by the placement (the
+		// owner of the policy snapshot) so that it is garbage collected with the placement.
+		explanation.SetNamespace(namespace)
+		explanation.SetName(name)
+		if owner := metav1.GetControllerOf(policy); owner != nil {
+			explanation.SetOwnerReferences([]metav1.OwnerReference{*owner})
+		}
+		if err := f.client.Create(ctx, explanation); err != nil {
+			// The explanation might have been created in an earlier cycle but has not been
+			// synced to the cache yet; the error will trigger a retry.
+			klog.ErrorS(err, "Failed to create scheduling explanation", "schedulingExplanation", explanationRef)
+			return controller.NewAPIServerError(false, err)
+		}
+	}
+
+	status := explanation.GetSchedulingExplanationStatus()
+	if status.PolicySnapshotName == policy.GetName() &&
+		status.ObservedCRPGeneration == observedPlacementGeneration &&
+		equality.Semantic.DeepEqual(status.Clusters, entries) {
+		// Skip if there is no change in the explanations.
+		klog.V(2).InfoS("No change in scheduling explanation", "schedulingExplanation", explanationRef)
+		return nil
+	}
+
+	now := metav1.Now()
+	explanation.SetSchedulingExplanationStatus(placementv1beta1.SchedulingExplanationStatus{
+		PolicySnapshotName:    policy.GetName(),
+		ObservedCRPGeneration: observedPlacementGeneration,
+		LastUpdatedTime:       &now,
+		Clusters:              entries,
+	})
+	if err := f.client.Status().Update(ctx, explanation); err != nil {
+		klog.ErrorS(err, "Failed to update scheduling explanation", "schedulingExplanation", explanationRef)
+		return controller.NewAPIServerError(false, err)
+	}
+	return nil
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+const (
+	explainedPlacementName  = "explained-placement"
+	explainedNamespace      = "explained-ns"
+	explainFilterPluginName = "explain-filter-plugin"
+	explainScorePluginName  = "explain-score-plugin"
+)
+
+// TestNewSchedulingExplanationEntries tests the newSchedulingExplanationEntries function.
+func TestNewSchedulingExplanationEntries(t *testing.T) {
+	selectedDecision := placementv1beta1.ClusterDecision{
+		ClusterName: clusterName,
+		Selected:    true,
+		ClusterScore: &placementv1beta1.ClusterScore{
+			AffinityScore:       ptr.To(int32(10)),
+			TopologySpreadScore: ptr.To(int32(0)),
+			WeightedScore:       ptr.To(int64(100)),
+		},
+		Reason: "picked",
+	}
+	bound := []placementv1beta1.BindingObj{
+		&placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster:   clusterName,
+				ClusterDecision: selectedDecision,
+			},
+		},
+	}
+	notPicked := ScoredClusters{
+		{
+			Cluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+			Score:   &ClusterScore{AffinityScore: 1, WeightedScore: 10},
+			PluginScores: map[string]*ClusterScore{
+				explainScorePluginName: {AffinityScore: 1, WeightedScore: 10},
+			},
+		},
+	}
+	filtered := []*filteredClusterWithStatus{
+		{
+			cluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: anotherClusterName}},
+			status:  NewNonErrorStatus(ClusterUnschedulable, explainFilterPluginName, "label mismatch", "taint not tolerated"),
+		},
+		{
+			// A cluster that has been selected already should only be explained once.
+			cluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+			status:  NewNonErrorStatus(ClusterUnschedulable, explainFilterPluginName, "label mismatch"),
+		},
+	}
+
+	want := []placementv1beta1.ClusterSchedulingExplanationEntry{
+		newSchedulingExplanationEntryFromDecision(selectedDecision),
+		{
+			ClusterName: altClusterName,
+			ClusterScore: &placementv1beta1.ClusterScore{
+				AffinityScore:       ptr.To(int32(1)),
+				TopologySpreadScore: ptr.To(int32(0)),
+				WeightedScore:       ptr.To(int64(10)),
+			},
+			PluginScores: []placementv1beta1.PluginScore{
+				{
+					Name: explainScorePluginName,
+					ClusterScore: placementv1beta1.ClusterScore{
+						AffinityScore:       ptr.To(int32(1)),
+						TopologySpreadScore: ptr.To(int32(0)),
+						WeightedScore:       ptr.To(int64(10)),
+					},
+				},
+			},
+			Reason: `Cluster "` + altClusterName + `" does not score high enough (affinity score: 1, topology spread score: 0)`,
+		},
+		{
+			ClusterName: anotherClusterName,
+			FilteredBy:  explainFilterPluginName,
+			Reason:      "label mismatch; taint not tolerated",
+		},
+	}
+	got := newSchedulingExplanationEntries(notPicked, filtered, bound)
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("newSchedulingExplanationEntries() diff (-got, +want):\n%s", diff)
+	}
+}
+
+// TestUpdateSchedulingExplanation tests the updateSchedulingExplanation method.
+func TestUpdateSchedulingExplanation(t *testing.T) {
+	entries := []placementv1beta1.ClusterSchedulingExplanationEntry{
+		{
+			ClusterName: clusterName,
+			Selected:    true,
+			Reason:      "picked",
+		},
+		{
+			ClusterName: altClusterName,
+			FilteredBy:  explainFilterPluginName,
+			Reason:      "label mismatch",
+		},
+	}
+	owner := metav1.OwnerReference{
+		APIVersion: placementv1beta1.GroupVersion.String(),
+		Kind:       placementv1beta1.ClusterResourcePlacementKind,
+		Name:       explainedPlacementName,
+		UID:        "crp-uid",
+		Controller: ptr.To(true),
+	}
+	lastUpdatedTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+
+	testCases := []struct {
+		name            string
+		disabled        bool
+		placementKey    queue.PlacementKey
+		existing        []client.Object
+		wantExplanation placementv1beta1.SchedulingExplanationObj
+		wantUnchanged   bool
+	}{
+		{
+			name:         "disabled",
+			disabled:     true,
+			placementKey: queue.PlacementKey(explainedPlacementName),
+		},
+		{
+			name:         "create for a cluster-scoped placement",
+			placementKey: queue.PlacementKey(explainedPlacementName),
+			wantExplanation: &placementv1beta1.ClusterSchedulingExplanation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            explainedPlacementName,
+					OwnerReferences: []metav1.OwnerReference{owner},
+				},
+				Status: placementv1beta1.SchedulingExplanationStatus{
+					PolicySnapshotName:    policyName,
+					ObservedCRPGeneration: 2,
+					Clusters:              entries,
+				},
+			},
+		},
+		{
+			name:         "update for a namespaced placement",
+			placementKey: queue.PlacementKey(explainedNamespace + "/" + explainedPlacementName),
+			existing: []client.Object{
+				&placementv1beta1.SchedulingExplanation{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: explainedNamespace,
+						Name:      explainedPlacementName,
+					},
+					Status: placementv1beta1.SchedulingExplanationStatus{
+						PolicySnapshotName:    "old-policy",
+						ObservedCRPGeneration: 1,
+						LastUpdatedTime:       &lastUpdatedTime,
+					},
+				},
+			},
+			wantExplanation: &placementv1beta1.SchedulingExplanation{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: explainedNamespace,
+					Name:      explainedPlacementName,
+				},
+				Status: placementv1beta1.SchedulingExplanationStatus{
+					PolicySnapshotName:    policyName,
+					ObservedCRPGeneration: 2,
+					Clusters:              entries,
+				},
+			},
+		},
+		{
+			name:         "no change",
+			placementKey: queue.PlacementKey(explainedPlacementName),
+			existing: []client.Object{
+				&placementv1beta1.ClusterSchedulingExplanation{
+					ObjectMeta: metav1.ObjectMeta{Name: explainedPlacementName},
+					Status: placementv1beta1.SchedulingExplanationStatus{
+						PolicySnapshotName:    policyName,
+						ObservedCRPGeneration: 2,
+						LastUpdatedTime:       &lastUpdatedTime,
+						Clusters:              entries,
+					},
+				},
+			},
+			wantExplanation: &placementv1beta1.ClusterSchedulingExplanation{
+				ObjectMeta: metav1.ObjectMeta{Name: explainedPlacementName},
+				Status: placementv1beta1.SchedulingExplanationStatus{
+					PolicySnapshotName:    policyName,
+					ObservedCRPGeneration: 2,
+					LastUpdatedTime:       &lastUpdatedTime,
+					Clusters:              entries,
+				},
+			},
+			wantUnchanged: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(tc.existing...).
+				WithStatusSubresource(&placementv1beta1.ClusterSchedulingExplanation{}, &placementv1beta1.SchedulingExplanation{}).
+				Build()
+			f := &framework{
+				client:                       fakeClient,
+				enableSchedulingExplanations: !tc.disabled,
+			}
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+					Annotations: map[string]string{
+						placementv1beta1.CRPGenerationAnnotation: "2",
+					},
+					OwnerReferences: []metav1.OwnerReference{owner},
+				},
+			}
+
+			if err := f.updateSchedulingExplanation(context.Background(), tc.placementKey, policy, entries); err != nil {
+				t.Fatalf("updateSchedulingExplanation() = %v, want no error", err)
+			}
+
+			if tc.wantExplanation == nil {
+				var explanations placementv1beta1.ClusterSchedulingExplanationList
+				if err := fakeClient.List(context.Background(), &explanations); err != nil {
+					t.Fatalf("List() = %v, want no error", err)
+				}
+				if len(explanations.Items) != 0 {
+					t.Errorf("scheduling explanations = %v, want none", explanations.Items)
+				}
+				return
+			}
+
+			got := tc.wantExplanation.DeepCopyObject().(placementv1beta1.SchedulingExplanationObj)
+			key := types.NamespacedName{Namespace: tc.wantExplanation.GetNamespace(), Name: tc.wantExplanation.GetName()}
+			if err := fakeClient.Get(context.Background(), key, got); err != nil {
+				t.Fatalf("Get() = %v, want no error", err)
+			}
+			gotTime := got.GetSchedulingExplanationStatus().LastUpdatedTime
+			if gotTime == nil {
+				t.Fatalf("lastUpdatedTime is not set")
+			}
+			if unchanged := gotTime.Equal(&lastUpdatedTime); unchanged != tc.wantUnchanged {
+				t.Errorf("lastUpdatedTime unchanged = %t, want %t", unchanged, tc.wantUnchanged)
+			}
+			if diff := cmp.Diff(got, tc.wantExplanation,
+				ignoreObjectMetaResourceVersionField,
+				ignoreTypeMetaAPIVersionKindFields,
+				cmpopts.IgnoreFields(placementv1beta1.SchedulingExplanationStatus{}, "LastUpdatedTime"),
+			); diff != "" {
+				t.Errorf("scheduling explanation diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
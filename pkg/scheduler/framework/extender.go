@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// extenderFilteredReasonTemplate is the template of the reason for clusters filtered out by
+	// a scheduler extender.
+	extenderFilteredReasonTemplate = "cluster is filtered out by scheduler extender %s: %s"
+	// extenderScoresReasonTemplate is the template of the suffix added to the reasons of scheduling
+	// decisions, which reports the scores scheduler extenders have given to a cluster.
+	extenderScoresReasonTemplate = "; scheduler extender scores: %s"
+)
+
+// runExtenderFilters runs all extenders that support filtering, in sequence, on clusters that have passed the
+// Filter stage; it returns the clusters that have passed all extenders, along with the clusters that have
+// been filtered out.
+func (f *framework) runExtenderFilters(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, clusters []*clusterv1beta1.MemberCluster) (passed []*clusterv1beta1.MemberCluster, filtered filteredClusterWithStatusList, err error) {
+	policyRef := klog.KObj(policy)
+
+	passed = clusters
+	for _, ext := range f.extenders {
+		// As a shortcut, stop immediately if there is no cluster left to inspect.
+		if len(passed) == 0 {
+			break
+		}
+		if !ext.SupportsFilter() {
+			continue
+		}
+
+		extPassed, failedWithReasons, err := ext.Filter(ctx, policy, passed)
+		if err != nil {
+			if ext.IsIgnorable() {
+				klog.ErrorS(err, "Ignorable scheduler extender failed to filter clusters; skipping", "extender", ext.Name(), "policySnapshot", policyRef)
+				continue
+			}
+			klog.ErrorS(err, "Scheduler extender failed to filter clusters", "extender", ext.Name(), "policySnapshot", policyRef)
+			return nil, nil, fmt.Errorf("scheduler extender %s failed to filter clusters: %w", ext.Name(), err)
+		}
+
+		passedNames := make(map[string]bool, len(extPassed))
+		for _, cluster := range extPassed {
+			passedNames[cluster.Name] = true
+		}
+		for _, cluster := range passed {
+			if passedNames[cluster.Name] {
+				continue
+			}
+			reason := failedWithReasons[cluster.Name]
+			if len(reason) == 0 {
+				reason = "no reason given"
+			}
+			filtered = append(filtered, &filteredClusterWithStatus{
+				cluster: cluster,
+				status:  NewNonErrorStatus(ClusterUnschedulable, ext.Name(), fmt.Sprintf(extenderFilteredReasonTemplate, ext.Name(), reason)),
+			})
+		}
+		passed = extPassed
+	}
+	return passed, filtered, nil
+}
+
+// runExtenderScores runs all extenders that support scoring on the scored clusters; the scores
+// each extender gives, which are normalized and weighted already, are added to the affinity
+// scores and the weighted scores of the clusters.
+func (f *framework) runExtenderScores(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, scored ScoredClusters) error {
+	policyRef := klog.KObj(policy)
+
+	// As a shortcut, return immediately if there is no cluster to score.
+	if len(scored) == 0 {
+		return nil
+	}
+
+	clusters := make([]*clusterv1beta1.MemberCluster, 0, len(scored))
+	for _, sc := range scored {
+		clusters = append(clusters, sc.Cluster)
+	}
+
+	for _, ext := range f.extenders {
+		if !ext.SupportsScore() {
+			continue
+		}
+
+		scores, err := ext.Score(ctx, policy, clusters)
+		if err != nil {
+			if ext.IsIgnorable() {
+				klog.ErrorS(err, "Ignorable scheduler extender failed to score clusters; skipping", "extender", ext.Name(), "policySnapshot", policyRef)
+				continue
+			}
+			klog.ErrorS(err, "Scheduler extender failed to score clusters", "extender", ext.Name(), "policySnapshot", policyRef)
+			return fmt.Errorf("scheduler extender %s failed to score clusters: %w", ext.Name(), err)
+		}
+
+		for _, sc := range scored {
+			score, ok := scores[sc.Cluster.Name]
+			if !ok {
+				continue
+			}
+			sc.Score.AffinityScore += score
+			sc.Score.WeightedScore += int64(score)
+			if sc.ExtenderScores == nil {
+				sc.ExtenderScores = map[string]int32{}
+			}
+			sc.ExtenderScores[ext.Name()] = score
+		}
+	}
+	return nil
+}
+
+// extenderScoresReasonSuffix returns the suffix to add to the reason of a scheduling decision,
+// which reports the scores scheduler extenders have given to a cluster; it returns an empty
+// string if no extender has scored the cluster.
+func extenderScoresReasonSuffix(extenderScores map[string]int32) string {
+	if len(extenderScores) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(extenderScores))
+	for name := range extenderScores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	scores := make([]string, 0, len(names))
+	for _, name := range names {
+		scores = append(scores, fmt.Sprintf("%s: %d", name, extenderScores[name]))
+	}
+	return fmt.Sprintf(extenderScoresReasonTemplate, strings.Join(scores, ", "))
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	dummyExtenderName    = "dummyExtender"
+	altDummyExtenderName = "altDummyExtender"
+)
+
+// dummyExtender is a scheduler extender for testing purposes.
+type dummyExtender struct {
+	name      string
+	ignorable bool
+	// filterFailures are the reasons for filtering out clusters, keyed by cluster names; nil
+	// if the extender does not support filtering.
+	filterFailures map[string]string
+	// scores are the scores of clusters, keyed by cluster names; nil if the extender does not
+	// support scoring.
+	scores map[string]int32
+	err    error
+}
+
+var _ Extender = &dummyExtender{}
+
+func (e *dummyExtender) Name() string         { return e.name }
+func (e *dummyExtender) IsIgnorable() bool    { return e.ignorable }
+func (e *dummyExtender) SupportsFilter() bool { return e.filterFailures != nil }
+func (e *dummyExtender) SupportsScore() bool  { return e.scores != nil }
+
+func (e *dummyExtender) Filter(_ context.Context, _ placementv1beta1.PolicySnapshotObj, clusters []*clusterv1beta1.MemberCluster) ([]*clusterv1beta1.MemberCluster, map[string]string, error) {
+	if e.err != nil {
+		return nil, nil, e.err
+	}
+	passed := make([]*clusterv1beta1.MemberCluster, 0, len(clusters))
+	failed := map[string]string{}
+	for _, cluster := range clusters {
+		if reason, ok := e.filterFailures[cluster.Name]; ok {
+			failed[cluster.Name] = reason
+			continue
+		}
+		passed = append(passed, cluster)
+	}
+	return passed, failed, nil
+}
+
+func (e *dummyExtender) Score(_ context.Context, _ placementv1beta1.PolicySnapshotObj, _ []*clusterv1beta1.MemberCluster) (map[string]int32, error) {
+	if e.err != nil {
+		return nil, e.err
+	}
+	return e.scores, nil
+}
+
+// TestRunExtenderFilters tests the runExtenderFilters method.
+func TestRunExtenderFilters(t *testing.T) {
+	clusters := []*clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: anotherClusterName}},
+	}
+
+	testCases := []struct {
+		name         string
+		extenders    []Extender
+		wantPassed   []*clusterv1beta1.MemberCluster
+		wantFiltered filteredClusterWithStatusList
+		wantErr      bool
+	}{
+		{
+			name:       "no extenders",
+			wantPassed: clusters,
+		},
+		{
+			name: "multiple extenders",
+			extenders: []Extender{
+				&dummyExtender{
+					name:           dummyExtenderName,
+					filterFailures: map[string]string{clusterName: "not enough quota"},
+				},
+				// An extender that does not support filtering.
+				&dummyExtender{
+					name:   "scoreOnly",
+					scores: map[string]int32{},
+				},
+				&dummyExtender{
+					name:           altDummyExtenderName,
+					filterFailures: map[string]string{anotherClusterName: ""},
+				},
+			},
+			wantPassed: []*clusterv1beta1.MemberCluster{clusters[1]},
+			wantFiltered: filteredClusterWithStatusList{
+				{
+					cluster: clusters[0],
+					status:  NewNonErrorStatus(ClusterUnschedulable, dummyExtenderName, fmt.Sprintf(extenderFilteredReasonTemplate, dummyExtenderName, "not enough quota")),
+				},
+				{
+					cluster: clusters[2],
+					status:  NewNonErrorStatus(ClusterUnschedulable, altDummyExtenderName, fmt.Sprintf(extenderFilteredReasonTemplate, altDummyExtenderName, "no reason given")),
+				},
+			},
+		},
+		{
+			name: "ignorable extender failure",
+			extenders: []Extender{
+				&dummyExtender{
+					name:           dummyExtenderName,
+					ignorable:      true,
+					filterFailures: map[string]string{},
+					err:            fmt.Errorf("connection refused"),
+				},
+			},
+			wantPassed: clusters,
+		},
+		{
+			name: "extender failure",
+			extenders: []Extender{
+				&dummyExtender{
+					name:           dummyExtenderName,
+					filterFailures: map[string]string{},
+					err:            fmt.Errorf("connection refused"),
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := &framework{
+				extenders: tc.extenders,
+			}
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+			}
+
+			passed, filtered, err := f.runExtenderFilters(context.Background(), policy, clusters)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("runExtenderFilters() = %v, %v, want error", passed, filtered)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("runExtenderFilters() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(passed, tc.wantPassed); diff != "" {
+				t.Errorf("runExtenderFilters() passed diff (-got, +want): %s", diff)
+			}
+			if diff := cmp.Diff(filtered, tc.wantFiltered, cmp.AllowUnexported(filteredClusterWithStatus{}, Status{})); diff != "" {
+				t.Errorf("runExtenderFilters() filtered diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestRunExtenderScores tests the runExtenderScores method.
+func TestRunExtenderScores(t *testing.T) {
+	clusters := []*clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+	}
+
+	testCases := []struct {
+		name      string
+		extenders []Extender
+		want      ScoredClusters
+		wantErr   bool
+	}{
+		{
+			name: "multiple extenders",
+			extenders: []Extender{
+				&dummyExtender{
+					name:   dummyExtenderName,
+					scores: map[string]int32{clusterName: 10, altClusterName: 20},
+				},
+				// An extender that does not support scoring.
+				&dummyExtender{
+					name:           "filterOnly",
+					filterFailures: map[string]string{},
+				},
+				&dummyExtender{
+					name:   altDummyExtenderName,
+					scores: map[string]int32{clusterName: 5},
+				},
+			},
+			want: ScoredClusters{
+				{
+					Cluster: clusters[0],
+					Score: &ClusterScore{
+						AffinityScore:       16,
+						TopologySpreadScore: 1,
+						WeightedScore:       15,
+					},
+					ExtenderScores: map[string]int32{dummyExtenderName: 10, altDummyExtenderName: 5},
+				},
+				{
+					Cluster: clusters[1],
+					Score: &ClusterScore{
+						AffinityScore: 20,
+						WeightedScore: 20,
+					},
+					ExtenderScores: map[string]int32{dummyExtenderName: 20},
+				},
+			},
+		},
+		{
+			name: "ignorable extender failure",
+			extenders: []Extender{
+				&dummyExtender{
+					name:      dummyExtenderName,
+					ignorable: true,
+					scores:    map[string]int32{},
+					err:       fmt.Errorf("context deadline exceeded"),
+				},
+			},
+			want: ScoredClusters{
+				{
+					Cluster: clusters[0],
+					Score: &ClusterScore{
+						AffinityScore:       1,
+						TopologySpreadScore: 1,
+					},
+				},
+				{
+					Cluster: clusters[1],
+					Score:   &ClusterScore{},
+				},
+			},
+		},
+		{
+			name: "extender failure",
+			extenders: []Extender{
+				&dummyExtender{
+					name:   dummyExtenderName,
+					scores: map[string]int32{},
+					err:    fmt.Errorf("context deadline exceeded"),
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := &framework{
+				extenders: tc.extenders,
+			}
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+			}
+			scored := ScoredClusters{
+				{
+					Cluster: clusters[0],
+					Score: &ClusterScore{
+						AffinityScore:       1,
+						TopologySpreadScore: 1,
+					},
+				},
+				{
+					Cluster: clusters[1],
+					Score:   &ClusterScore{},
+				},
+			}
+
+			err := f.runExtenderScores(context.Background(), policy, scored)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("runExtenderScores() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("runExtenderScores() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(scored, tc.want); diff != "" {
+				t.Errorf("runExtenderScores() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestExtenderScoresReasonSuffix tests the extenderScoresReasonSuffix function.
+func TestExtenderScoresReasonSuffix(t *testing.T) {
+	testCases := []struct {
+		name   string
+		scores map[string]int32
+		want   string
+	}{
+		{
+			name: "no extender scores",
+			want: "",
+		},
+		{
+			name:   "multiple extender scores",
+			scores: map[string]int32{dummyExtenderName: 10, altDummyExtenderName: 5},
+			want:   "; scheduler extender scores: altDummyExtender: 5, dummyExtender: 10",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := extenderScoresReasonSuffix(tc.scores); got != tc.want {
+				t.Errorf("extenderScoresReasonSuffix() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
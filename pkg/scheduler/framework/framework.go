@@ -56,6 +56,9 @@ const (
 	FullyScheduledReason = "SchedulingPolicyFulfilled"
 	// NotFullyScheduledReason is the reason string of placement condition when the placement policy cannot be fully satisfied.
 	NotFullyScheduledReason = "SchedulingPolicyUnfulfilled"
+	// PlacementGroupUnsatisfiedReason is the reason string of placement condition when the placement group
+	// the placement belongs to cannot be satisfied as a whole.
+	PlacementGroupUnsatisfiedReason = "PlacementGroupUnsatisfied"
 
 	fullyScheduledMessage    = "found all cluster needed as specified by the scheduling policy, found %d cluster(s)"
 	notFullyScheduledMessage = "could not find all clusters needed as specified by the scheduling policy, found %d cluster(s) instead"
@@ -100,6 +103,18 @@ type Framework interface {
 	// RunSchedulingCycleFor performs scheduling for a resource placement, specifically
 	// its associated latest scheduling policy snapshot.
 	RunSchedulingCycleFor(ctx context.Context, placementKey queue.PlacementKey, policy placementv1beta1.PolicySnapshotObj) (result ctrl.Result, err error)
+
+	// RunEvaluationCycleFor re-evaluates the clusters a resource placement has been bound to
+	// against the other clusters in the fleet, without making any scheduling decision.
+	RunEvaluationCycleFor(ctx context.Context, placementKey queue.PlacementKey, policy placementv1beta1.PolicySnapshotObj) ([]*BindingEvaluation, error)
+
+	// RunSimulationCycleFor runs a simulated scheduling cycle for a resource placement with a
+	// scheduling policy against a list of clusters, without modifying any object.
+	RunSimulationCycleFor(ctx context.Context, placementKey queue.PlacementKey, policy placementv1beta1.PolicySnapshotObj, clusters []clusterv1beta1.MemberCluster) ([]*SimulatedClusterDecision, error)
+
+	// RunPlacementGroupSchedulingCycleFor picks a common set of clusters for all the members of a
+	// placement group, and commits the decision in the status of the placement group.
+	RunPlacementGroupSchedulingCycleFor(ctx context.Context, group *placementv1beta1.PlacementGroup) error
 }
 
 // framework implements the Framework interface.
@@ -131,6 +146,29 @@ type framework struct {
 	//
 	// Note that all picked clusters will always have their associated decisions written to the status.
 	maxUnselectedClusterDecisionCount int
+
+	// extenders are the scheduler extenders the scheduler framework consults with, in order, after
+	// the Filter and Score plugins have run.
+	extenders []Extender
+
+	// enablePreemption controls whether placements of a higher priority may preempt placements of
+	// a lower priority when they cannot find enough clusters.
+	enablePreemption bool
+	// preemptionCooldown is how long the scheduler waits, after the evictions it has issued on behalf
+	// of a placement complete, before preempting on behalf of the same placement again.
+	preemptionCooldown time.Duration
+
+	// enablePlacementGroups controls whether the scheduler framework places the members of a
+	// placement group onto a common set of clusters.
+	enablePlacementGroups bool
+
+	// enableSchedulingExplanations controls whether the scheduler framework writes a per-cluster
+	// explanation of the latest scheduling cycle of each placement to a scheduling explanation object.
+	enableSchedulingExplanations bool
+
+	// enableFleetResourceQuotas controls whether the scheduler framework enforces the fleet
+	// resource quotas in the namespaces of resource placements.
+	enableFleetResourceQuotas bool
 }
 
 var (
@@ -151,6 +189,23 @@ type frameworkOptions struct {
 	// checker is the cluster eligibility checker the scheduler framework will use to check
 	// if a cluster is eligibile for resource placement.
 	clusterEligibilityChecker *clustereligibilitychecker.ClusterEligibilityChecker
+
+	// extenders are the scheduler extenders the scheduler framework consults with.
+	extenders []Extender
+
+	// enablePreemption controls whether the scheduler framework preempts lower-priority placements.
+	enablePreemption bool
+	// preemptionCooldown is the cooldown period between two preemptions on behalf of the same placement.
+	preemptionCooldown time.Duration
+
+	// enablePlacementGroups controls whether the scheduler framework honors placement groups.
+	enablePlacementGroups bool
+
+	// enableSchedulingExplanations controls whether the scheduler framework writes scheduling explanations.
+	enableSchedulingExplanations bool
+
+	// enableFleetResourceQuotas controls whether the scheduler framework enforces fleet resource quotas.
+	enableFleetResourceQuotas bool
 }
 
 // Option is the function for configuring a scheduler framework.
@@ -184,6 +239,49 @@ func WithClusterEligibilityChecker(checker *clustereligibilitychecker.ClusterEli
 	}
 }
 
+// WithExtenders sets the scheduler extenders for a scheduler framework.
+func WithExtenders(extenders ...Extender) Option {
+	return func(fo *frameworkOptions) {
+		fo.extenders = extenders
+	}
+}
+
+// WithPreemption enables preemption for a scheduler framework, i.e., placements of the PickN
+// placement type which cannot find enough clusters may preempt placements of a lower priority;
+// the cooldown is the minimum amount of time between two preemptions on behalf of the same placement.
+func WithPreemption(cooldown time.Duration) Option {
+	return func(fo *frameworkOptions) {
+		fo.enablePreemption = true
+		fo.preemptionCooldown = cooldown
+	}
+}
+
+// WithPlacementGroups enables gang placement for a scheduler framework, i.e., the members of a
+// placement group are placed onto a common set of clusters, or not placed at all.
+func WithPlacementGroups() Option {
+	return func(fo *frameworkOptions) {
+		fo.enablePlacementGroups = true
+	}
+}
+
+// WithSchedulingExplanations enables scheduling explanations for a scheduler framework, i.e., the
+// outcome of the latest scheduling cycle of each placement is explained cluster by cluster in a
+// separate scheduling explanation object.
+func WithSchedulingExplanations() Option {
+	return func(fo *frameworkOptions) {
+		fo.enableSchedulingExplanations = true
+	}
+}
+
+// WithFleetResourceQuotas enables fleet resource quotas for a scheduler framework, i.e., the
+// scheduler keeps resource placements within the limits of the fleet resource quotas in
+// their namespaces.
+func WithFleetResourceQuotas() Option {
+	return func(fo *frameworkOptions) {
+		fo.enableFleetResourceQuotas = true
+	}
+}
+
 // NewFramework returns a new scheduler framework.
 func NewFramework(profile *Profile, manager ctrl.Manager, opts ...Option) Framework {
 	options := defaultFrameworkOptions
@@ -213,6 +311,12 @@ func NewFramework(profile *Profile, manager ctrl.Manager, opts ...Option) Framew
 		parallelizer:                      parallelizer.NewParallelizer(options.numOfWorkers),
 		maxUnselectedClusterDecisionCount: options.maxUnselectedClusterDecisionCount,
 		clusterEligibilityChecker:         options.clusterEligibilityChecker,
+		extenders:                         options.extenders,
+		enablePreemption:                  options.enablePreemption,
+		preemptionCooldown:                options.preemptionCooldown,
+		enablePlacementGroups:             options.enablePlacementGroups,
+		enableSchedulingExplanations:      options.enableSchedulingExplanations,
+		enableFleetResourceQuotas:         options.enableFleetResourceQuotas,
 	}
 	// initialize all the plugins
 	for _, plugin := range f.profile.registeredPlugins {
@@ -329,12 +433,59 @@ func (f *framework) RunSchedulingCycleFor(ctx context.Context, placementKey queu
 		return ctrl.Result{}, err
 	}
 
+	// Narrow down the clusters to the ones picked for the placement group the placement belongs to
+	// (if any), so that all the members of the group are placed onto the same set of clusters.
+	//
+	// Note that this happens after the bindings are classified, so that bindings associated with
+	// clusters that are not picked for the group are not considered dangling.
+	if f.enablePlacementGroups {
+		var satisfied bool
+		clusters, satisfied, err = f.runPlacementGroupSchedulingFor(ctx, placementKey, policy, clusters)
+		if err != nil {
+			klog.ErrorS(err, "Failed to run placement group scheduling", "policySnapshot", policyRef)
+			return ctrl.Result{}, err
+		}
+		if !satisfied {
+			// The placement group cannot be satisfied as a whole (or the decision for the group is
+			// yet to be made); no binding is created or updated for the placement. The placement
+			// will be requeued when its placement group changes.
+			return ctrl.Result{}, nil
+		}
+	}
+
+	// Collect the limits that fleet resource quotas (if any) impose on the placement.
+	quotaLimits, err := f.collectFleetResourceQuotaLimits(ctx, namespace)
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect fleet resource quota limits", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+	isPickFixed := policy.GetPolicySnapshotSpec().Policy != nil && policy.GetPolicySnapshotSpec().Policy.PlacementType == placementv1beta1.PickFixedPlacementType
+	if !isPickFixed {
+		// Narrow down the clusters to the ones the quotas allow the placement to target; for
+		// placements of the PickFixed placement type, clusters that are not allowed are reported
+		// as invalid targets instead.
+		clusters = quotaLimits.allowedClusters(clusters)
+	}
+
+	// Collect the clusters nominated for higher-priority placements, which the placement must not
+	// take; placements of the PickFixed placement type always bind to their target clusters.
+	var nominatedClusters map[string]string
+	if !isPickFixed {
+		nominatedClusters, err = f.collectClusterNominations(ctx, placementKey)
+		if err != nil {
+			klog.ErrorS(err, "Failed to collect cluster nominations", "policySnapshot", policyRef)
+			return ctrl.Result{}, err
+		}
+	}
+
 	// Prepare the cycle state for this run.
 	//
 	// Note that this state is shared between all plugins and the scheduler framework itself (though some fields are reserved by
 	// the framework). These reserved fields are never accessed concurrently, as each scheduling run has its own cycle and a run
 	// is always executed in one single goroutine; plugin access to the state is guarded by sync.Map.
 	state := NewCycleState(clusters, obsolete, bound, scheduled)
+	state.quotaLimits = quotaLimits
+	state.nominatedClusters = nominatedClusters
 
 	switch {
 	case policy.GetPolicySnapshotSpec().Policy == nil:
@@ -344,7 +495,7 @@ func (f *framework) RunSchedulingCycleFor(ctx context.Context, placementKey queu
 	case policy.GetPolicySnapshotSpec().Policy.PlacementType == placementv1beta1.PickFixedPlacementType:
 		// The placement policy features a fixed set of clusters to select; in such cases, the
 		// scheduler will bind to these clusters directly.
-		return f.runSchedulingCycleForPickFixedPlacementType(ctx, placementKey, policy, clusters, bound, scheduled, unscheduled, obsolete)
+		return f.runSchedulingCycleForPickFixedPlacementType(ctx, state, placementKey, policy, clusters, bound, scheduled, unscheduled, obsolete)
 	case policy.GetPolicySnapshotSpec().Policy.PlacementType == placementv1beta1.PickAllPlacementType:
 		// Run the scheduling cycle for policy of the PickAll placement type.
 		return f.runSchedulingCycleForPickAllPlacementType(ctx, state, placementKey, policy, clusters, bound, scheduled, unscheduled, obsolete)
@@ -447,6 +598,7 @@ func (f *framework) runSchedulingCycleForPickAllPlacementType(
 		klog.ErrorS(err, "Failed to run all plugins (pickAll placement type)", "policySnapshot", policyRef)
 		return ctrl.Result{}, err
 	}
+	filtered = append(filtered, state.quotaLimits.filteredClusters()...)
 
 	// Sort all the scored clusters.
 	//
@@ -472,6 +624,15 @@ func (f *framework) runSchedulingCycleForPickAllPlacementType(
 		return ctrl.Result{}, err
 	}
 
+	// Keep the placement within the limits of the fleet resource quotas, if any.
+	toCreate = state.quotaLimits.capNewBindings(toCreate, len(toPatch)+len(scheduled)+len(bound))
+
+	// Divide replicas across the selected clusters, if applicable.
+	if err := f.assignReplicas(ctx, policy, clusters, toCreate, toPatch, scheduled, bound); err != nil {
+		klog.ErrorS(err, "Failed to assign replicas to bindings", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+
 	// Manipulate bindings accordingly.
 	klog.V(2).InfoS("Manipulating bindings", "policySnapshot", policyRef)
 	if err := f.manipulateBindings(ctx, policy, toCreate, toDelete, toPatch); err != nil {
@@ -496,6 +657,13 @@ func (f *framework) runSchedulingCycleForPickAllPlacementType(
 		return ctrl.Result{}, err
 	}
 
+	// Explain the scheduling cycle cluster by cluster, if enabled.
+	explanations := newSchedulingExplanationEntries(nil, filtered, toCreate, patched, scheduled, bound)
+	if err := f.updateSchedulingExplanation(ctx, placementKey, policy, explanations); err != nil {
+		klog.ErrorS(err, "Failed to update scheduling explanation", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+
 	// The scheduling cycle has completed.
 	//
 	// Note that for CRPs of the PickAll type, no requeue check is needed.
@@ -542,6 +710,17 @@ func (f *framework) runAllPluginsForPickAllPlacementType(
 		return nil, nil, controller.NewUnexpectedBehaviorError(err)
 	}
 
+	// Run the filters of scheduler extenders, if any, on clusters that have passed the filter plugins.
+	//
+	// Note that extender failures are not considered as unexpected behaviors, as they are
+	// usually caused by connectivity issues; the scheduling cycle will be retried.
+	passed, extenderFiltered, err := f.runExtenderFilters(ctx, policy, passed)
+	if err != nil {
+		klog.ErrorS(err, "Failed to run scheduler extender filters", "policySnapshot", policyRef)
+		return nil, nil, err
+	}
+	filtered = append(filtered, extenderFiltered...)
+
 	// Wrap all clusters that have passed the Filter stage as scored clusters.
 	scored = make(ScoredClusters, 0, len(passed))
 	for _, cluster := range passed {
@@ -574,6 +753,10 @@ func (f *framework) runPreFilterPlugins(ctx context.Context, state *CycleState,
 
 // runFilterPluginsFor runs filter plugins for a single cluster.
 func (f *framework) runFilterPluginsFor(ctx context.Context, state *CycleState, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) *Status {
+	if preemptor, found := state.nominatedClusters[cluster.Name]; found {
+		// The cluster has been freed for a placement of a higher priority.
+		return NewNonErrorStatus(ClusterUnschedulable, preemptionSourceName, fmt.Sprintf(clusterNominatedReasonTemplate, preemptor))
+	}
 	for _, pl := range f.profile.filterPlugins {
 		// Skip the plugin if it is not needed.
 		if state.skippedFilterPlugins.Has(pl.Name()) {
@@ -891,6 +1074,12 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 			return ctrl.Result{}, err
 		}
 
+		// Divide replicas across the remaining clusters, if applicable.
+		if err := f.assignReplicas(ctx, policy, clusters, nil, nil, scheduled, bound); err != nil {
+			klog.ErrorS(err, "Failed to assign replicas to bindings when downscaling", "policySnapshot", policyRef)
+			return ctrl.Result{}, err
+		}
+
 		// Update the policy snapshot status with the latest scheduling decisions and condition.
 		//
 		// Note that since there is no reliable way to determine the validity of old decisions added
@@ -901,6 +1090,13 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 			return ctrl.Result{}, err
 		}
 
+		// Explain the selected clusters, in accordance with the policy snapshot status.
+		explanations := newSchedulingExplanationEntries(nil, nil, scheduled, bound)
+		if err := f.updateSchedulingExplanation(ctx, placementKey, policy, explanations); err != nil {
+			klog.ErrorS(err, "Failed to update scheduling explanation when downscaling", "policySnapshot", policyRef)
+			return ctrl.Result{}, err
+		}
+
 		// Return immediately as there are no more bindings for the scheduler to scheduler at this moment.
 		return ctrl.Result{}, nil
 	}
@@ -913,6 +1109,11 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 		// This is needed as a number of situations (e.g., POST/PUT failures) may lead to inconsistencies between
 		// the decisions added to the policy snapshot status and the actual list of bindings.
 		klog.V(2).InfoS("No scheduling is needed", "policySnapshot", policyRef)
+		// Make sure that the shares of replicas on the bindings are consistent with the policy, if applicable.
+		if err := f.assignReplicas(ctx, policy, clusters, nil, nil, scheduled, bound); err != nil {
+			klog.ErrorS(err, "Failed to assign replicas to bindings when no scheduling run is needed", "policySnapshot", policyRef)
+			return ctrl.Result{}, err
+		}
 		// Note that since there is no reliable way to determine the validity of old decisions added
 		// to the policy snapshot status, we will only update the status with the known facts, i.e.,
 		// the clusters that are currently selected.
@@ -921,6 +1122,13 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 			return ctrl.Result{}, err
 		}
 
+		// Explain the selected clusters, in accordance with the policy snapshot status.
+		explanations := newSchedulingExplanationEntries(nil, nil, bound, scheduled)
+		if err := f.updateSchedulingExplanation(ctx, placementKey, policy, explanations); err != nil {
+			klog.ErrorS(err, "Failed to update scheduling explanation when no scheduling run is needed", "policySnapshot", policyRef)
+			return ctrl.Result{}, err
+		}
+
 		// Return immediate as there no more bindings for the scheduler to schedule at this moment.
 		return ctrl.Result{}, nil
 	}
@@ -939,6 +1147,7 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 		klog.ErrorS(err, "Failed to run all plugins", "policySnapshot", policyRef)
 		return ctrl.Result{}, err
 	}
+	filtered = append(filtered, state.quotaLimits.filteredClusters()...)
 
 	// Pick the top scored clusters.
 	klog.V(2).InfoS("Picking clusters", "policySnapshot", policyRef, "filtered", filtered, "scored", scored)
@@ -978,6 +1187,15 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 		return ctrl.Result{}, err
 	}
 
+	// Keep the placement within the limits of the fleet resource quotas, if any.
+	toCreate = state.quotaLimits.capNewBindings(toCreate, len(toPatch)+len(scheduled)+len(bound))
+
+	// Divide replicas across the selected clusters, if applicable.
+	if err := f.assignReplicas(ctx, policy, clusters, toCreate, toPatch, scheduled, bound); err != nil {
+		klog.ErrorS(err, "Failed to assign replicas to bindings", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+
 	// Manipulate bindings accordingly.
 	klog.V(2).InfoS("Manipulating bindings", "policySnapshot", policyRef)
 	if err := f.manipulateBindings(ctx, policy, toCreate, toDelete, toPatch); err != nil {
@@ -1014,6 +1232,22 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 		return ctrl.Result{}, err
 	}
 
+	// Explain the scheduling cycle cluster by cluster, if enabled.
+	explanations := newSchedulingExplanationEntries(notPicked, filtered, toCreate, patched, scheduled, bound)
+	if err := f.updateSchedulingExplanation(ctx, placementKey, policy, explanations); err != nil {
+		klog.ErrorS(err, "Failed to update scheduling explanation", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+
+	// Preempt lower-priority placements if the scheduler cannot find enough clusters, and
+	// preemption is enabled.
+	//
+	// Note that at this point of the scheduling cycle, all the scored clusters have been picked.
+	if f.enablePreemption && numOfClustersToPick < state.batchSizeLimit {
+		klog.V(2).InfoS("Not enough clusters found; attempting preemption", "policySnapshot", policyRef, "shortage", state.batchSizeLimit-numOfClustersToPick)
+		return f.runPreemption(ctx, state, placementKey, policy, filtered, state.batchSizeLimit-numOfClustersToPick)
+	}
+
 	// The scheduling cycle has completed.
 	return ctrl.Result{}, nil
 }
@@ -1162,6 +1396,17 @@ func (f *framework) runAllPluginsForPickNPlacementType(
 		return nil, nil, controller.NewUnexpectedBehaviorError(err)
 	}
 
+	// Run the filters of scheduler extenders, if any, on clusters that have passed the filter plugins.
+	//
+	// Note that extender failures are not considered as unexpected behaviors, as they are
+	// usually caused by connectivity issues; the scheduling cycle will be retried.
+	passed, extenderFiltered, err := f.runExtenderFilters(ctx, policy, passed)
+	if err != nil {
+		klog.ErrorS(err, "Failed to run scheduler extender filters", "policySnapshot", policyRef)
+		return nil, nil, err
+	}
+	filtered = append(filtered, extenderFiltered...)
+
 	// Run pre-score plugins.
 	if status := f.runPreScorePlugins(ctx, state, policy); status.IsInteralError() {
 		klog.ErrorS(status.AsError(), "Failed ro run pre-score plugins", "policySnapshot", policyRef)
@@ -1181,6 +1426,12 @@ func (f *framework) runAllPluginsForPickNPlacementType(
 		return nil, nil, controller.NewUnexpectedBehaviorError(err)
 	}
 
+	// Run the scoring of scheduler extenders, if any.
+	if err := f.runExtenderScores(ctx, policy, scored); err != nil {
+		klog.ErrorS(err, "Failed to run scheduler extender scoring", "policySnapshot", policyRef)
+		return nil, nil, err
+	}
+
 	return scored, filtered, nil
 }
 
@@ -1250,7 +1501,8 @@ func (f *framework) runScorePluginsFor(ctx context.Context, state *CycleState, p
 	return scoreList, nil
 }
 
-// runScorePlugins runs score plugins on clusters in parallel.
+// runScorePlugins runs score plugins on clusters in parallel; the scores each plugin gives are
+// then summed up with the plugin weights.
 func (f *framework) runScorePlugins(ctx context.Context, state *CycleState, policy placementv1beta1.PolicySnapshotObj, clusters []*clusterv1beta1.MemberCluster) (ScoredClusters, error) {
 	// Pre-allocate slices to avoid races.
 	scoredClusters := make(ScoredClusters, len(clusters))
@@ -1272,15 +1524,12 @@ func (f *framework) runScorePlugins(ctx context.Context, state *CycleState, poli
 		scoreList, status := f.runScorePluginsFor(childCtx, state, policy, cluster)
 		switch {
 		case status.IsSuccess():
-			totalScore := &ClusterScore{}
-			for _, score := range scoreList {
-				totalScore.Add(score)
-			}
 			// Use atomic add to avoid races with minimum overhead.
 			newScoredClustersIdx := atomic.AddInt32(&scoredClustersIdx, 1)
 			scoredClusters[newScoredClustersIdx] = &ScoredCluster{
-				Cluster: cluster,
-				Score:   totalScore,
+				Cluster:      cluster,
+				Score:        &ClusterScore{},
+				PluginScores: scoreList,
 			}
 		default: // An error has occurred.
 			errFlag.Raise(status.AsError())
@@ -1300,9 +1549,67 @@ func (f *framework) runScorePlugins(ctx context.Context, state *CycleState, poli
 	// Trim the slice to its actual size.
 	scoredClusters = scoredClusters[:scoredClustersIdx+1]
 
+	if err := f.weighScores(ctx, state, policy, scoredClusters); err != nil {
+		return nil, err
+	}
 	return scoredClusters, nil
 }
 
+// weighScores sums up the scores each score plugin has given to the scored clusters with the
+// plugin weights.
+//
+// By default, the raw scores are used as they are. A score plugin may normalize its own scores
+// across all the clusters by implementing the ScoreNormalizer interface; alternatively, a profile
+// may opt in to have the framework normalize the scores of all the other plugins with min-max
+// scaling. Normalized scores must fall in the range [MinNormalizedScore, MaxNormalizedScore].
+//
+// On return, the plugin scores of each scored cluster have been weighted, with the (normalized,
+// if applicable) scores multiplied by the weights set as the weighted scores.
+func (f *framework) weighScores(ctx context.Context, state *CycleState, policy placementv1beta1.PolicySnapshotObj, scoredClusters ScoredClusters) error {
+	for _, pl := range f.profile.scorePlugins {
+		pluginName := pl.Name()
+		// Skip the plugin if it has not run.
+		if state.skippedScorePlugins.Has(pluginName) {
+			continue
+		}
+
+		scores := make(map[string]int64, len(scoredClusters))
+		for _, sc := range scoredClusters {
+			scores[sc.Cluster.Name] = sc.PluginScores[pluginName].rawScore()
+		}
+
+		isNormalized := true
+		if normalizer, ok := pl.(ScoreNormalizer); ok {
+			status := normalizer.NormalizeScores(ctx, state, policy, scores)
+			switch {
+			case status.IsSuccess(): // Do nothing.
+			case status.IsInteralError():
+				return status.AsError()
+			default:
+				// Any status that is not Success or InternalError is considered an error.
+				return fmt.Errorf("score plugin %s returned an unknown status %s when normalizing scores", pluginName, status)
+			}
+		} else if f.profile.scoreNormalization == ScoreNormalizationMinMax {
+			normalizeScores(scores)
+		} else {
+			isNormalized = false
+		}
+
+		weight := f.profile.scorePluginWeight(pluginName)
+		for _, sc := range scoredClusters {
+			score, ok := scores[sc.Cluster.Name]
+			if isNormalized && (!ok || score < MinNormalizedScore || score > MaxNormalizedScore) {
+				return fmt.Errorf("score plugin %s gave cluster %s a normalized score that is missing or out of range [%d, %d]", pluginName, sc.Cluster.Name, MinNormalizedScore, MaxNormalizedScore)
+			}
+			weighted := sc.PluginScores[pluginName].Multiply(weight)
+			weighted.WeightedScore = score * int64(weight)
+			sc.PluginScores[pluginName] = weighted
+			sc.Score.Add(weighted)
+		}
+	}
+	return nil
+}
+
 // invalidClusterWithReason is struct that documents a cluster that is, though present in
 // the list of current clusters, not valid for resource placement (e.g., it is experiencing
 // a network partition)
@@ -1427,6 +1734,7 @@ func (f *framework) updatePolicySnapshotStatusForPickFixedPlacementType(
 // set of clusters to select in the placement policy.
 func (f *framework) runSchedulingCycleForPickFixedPlacementType(
 	ctx context.Context,
+	state *CycleState,
 	placementKey queue.PlacementKey,
 	policy placementv1beta1.PolicySnapshotObj,
 	clusters []clusterv1beta1.MemberCluster,
@@ -1452,6 +1760,10 @@ func (f *framework) runSchedulingCycleForPickFixedPlacementType(
 	//   is not present in the list of current clusters in the fleet.
 	valid, invalid, notFound := f.crossReferenceClustersWithTargetNames(clusters, targetClusterNames)
 
+	// Report valid targets that the fleet resource quotas do not allow the placement to target
+	// as invalid ones.
+	valid, invalid = state.quotaLimits.partitionValidTargets(valid, invalid)
+
 	// Cross-reference the valid target clusters with obsolete bindings; find out
 	//
 	// * bindings that should be created, i.e., create a binding for every cluster that is a valid target
@@ -1469,6 +1781,15 @@ func (f *framework) runSchedulingCycleForPickFixedPlacementType(
 		return ctrl.Result{}, err
 	}
 
+	// Keep the placement within the limits of the fleet resource quotas, if any.
+	toCreate = state.quotaLimits.capNewBindings(toCreate, len(toPatch)+len(scheduled)+len(bound))
+
+	// Divide replicas across the selected clusters, if applicable.
+	if err := f.assignReplicas(ctx, policy, clusters, toCreate, toPatch, scheduled, bound); err != nil {
+		klog.ErrorS(err, "Failed to assign replicas to bindings", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+
 	// Manipulate bindings accordingly.
 	klog.V(2).InfoS("Manipulating bindings", "policySnapshot", policyRef)
 	if err := f.manipulateBindings(ctx, policy, toCreate, toDelete, toPatch); err != nil {
@@ -1482,6 +1803,13 @@ func (f *framework) runSchedulingCycleForPickFixedPlacementType(
 		return ctrl.Result{}, err
 	}
 
+	// Explain the scheduling cycle cluster by cluster, if enabled.
+	explanations := newSchedulingExplanationEntriesFromDecisions(newSchedulingDecisionsForPickFixedPlacementType(valid, invalid, notFound))
+	if err := f.updateSchedulingExplanation(ctx, placementKey, policy, explanations); err != nil {
+		klog.ErrorS(err, "Failed to update scheduling explanation", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+
 	// The scheduling cycle is completed.
 	return ctrl.Result{}, nil
 }
@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -71,7 +72,7 @@ var (
 	ignoredStatusFields                       = cmpopts.IgnoreFields(Status{}, "reasons", "err")
 	ignoredBindingWithPatchFields             = cmpopts.IgnoreFields(bindingWithPatch{}, "patch")
 	ignoredCondFields                         = cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
-	ignoreCycleStateFields                    = cmpopts.IgnoreFields(CycleState{}, "store", "clusters", "scheduledOrBoundBindings", "obsoleteBindings")
+	ignoreCycleStateFields                    = cmpopts.IgnoreFields(CycleState{}, "store", "clusters", "scheduledOrBoundBindings", "obsoleteBindings", "preemptedBindings")
 	ignoreClusterDecisionScoreAndReasonFields = cmpopts.IgnoreFields(placementv1beta1.ClusterDecision{}, "ClusterScore", "Reason")
 
 	lessFuncCluster = func(cluster1, cluster2 *clusterv1beta1.MemberCluster) bool {
@@ -718,6 +719,7 @@ func TestRunFilterPluginsFor(t *testing.T) {
 		name               string
 		filterPlugins      []FilterPlugin
 		skippedPluginNames []string
+		nominatedClusters  map[string]string
 		wantStatus         *Status
 	}{
 		{
@@ -809,6 +811,19 @@ func TestRunFilterPluginsFor(t *testing.T) {
 			},
 			wantStatus: NewNonErrorStatus(ClusterAlreadySelected, dummyFilterPluginNameA),
 		},
+		{
+			name: "single plugin, cluster nominated for another placement",
+			filterPlugins: []FilterPlugin{
+				&DummyAllPurposePlugin{
+					name: dummyFilterPluginNameA,
+					filterRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (status *Status) {
+						return nil
+					},
+				},
+			},
+			nominatedClusters: map[string]string{clusterName: "crp-high"},
+			wantStatus:        NewNonErrorStatus(ClusterUnschedulable, preemptionSourceName, fmt.Sprintf(clusterNominatedReasonTemplate, "crp-high")),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -827,6 +842,7 @@ func TestRunFilterPluginsFor(t *testing.T) {
 			for _, name := range tc.skippedPluginNames {
 				state.skippedFilterPlugins.Insert(name)
 			}
+			state.nominatedClusters = tc.nominatedClusters
 			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
 				ObjectMeta: metav1.ObjectMeta{
 					Name: policyName,
@@ -1456,6 +1472,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore1,
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
 						},
@@ -1479,6 +1496,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore2,
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
 						},
@@ -1502,6 +1520,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore3,
 								TopologySpreadScore: &topologySpreadScore3,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore3, topologySpreadScore3),
 						},
@@ -1569,6 +1588,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
 							},
@@ -1598,6 +1618,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore2,
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
 							},
@@ -1627,6 +1648,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore3,
 									TopologySpreadScore: &topologySpreadScore3,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore3, topologySpreadScore3),
 							},
@@ -1695,6 +1717,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore3,
 								TopologySpreadScore: &topologySpreadScore3,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore3, topologySpreadScore3),
 						},
@@ -1717,6 +1740,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
 							},
@@ -1746,6 +1770,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore2,
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
 							},
@@ -1805,6 +1830,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore1,
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
 						},
@@ -1828,6 +1854,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore2,
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
 						},
@@ -1851,6 +1878,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore3,
 								TopologySpreadScore: &topologySpreadScore3,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore3, topologySpreadScore3),
 						},
@@ -1895,6 +1923,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore1,
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
 						},
@@ -1918,6 +1947,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore3,
 								TopologySpreadScore: &topologySpreadScore3,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore3, topologySpreadScore3),
 						},
@@ -1941,6 +1971,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore2,
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
 							},
@@ -2004,6 +2035,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 							ClusterScore: &placementv1beta1.ClusterScore{
 								AffinityScore:       &affinityScore3,
 								TopologySpreadScore: &topologySpreadScore3,
+								WeightedScore:       ptr.To(int64(0)),
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore3, topologySpreadScore3),
 						},
@@ -2026,6 +2058,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
 							},
@@ -2056,6 +2089,7 @@ func TestCrossReferencePickedClustersAndDeDupBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore2,
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
 							},
@@ -2189,6 +2223,7 @@ func TestPatchBindings(t *testing.T) {
 				Selected:    true,
 				ClusterScore: &placementv1beta1.ClusterScore{
 					TopologySpreadScore: &topologySpreadScore,
+					WeightedScore:       ptr.To(int64(0)),
 					AffinityScore:       &affinityScore,
 				},
 				Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore, topologySpreadScore),
@@ -2257,6 +2292,7 @@ func TestManipulateBindings(t *testing.T) {
 				Selected:    true,
 				ClusterScore: &placementv1beta1.ClusterScore{
 					TopologySpreadScore: &topologySpreadScore,
+					WeightedScore:       ptr.To(int64(0)),
 					AffinityScore:       &affinityScore,
 				},
 				Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore, topologySpreadScore),
@@ -2375,6 +2411,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 			ClusterScore: &placementv1beta1.ClusterScore{
 				AffinityScore:       &affinityScore1,
 				TopologySpreadScore: &topologySpreadScore1,
+				WeightedScore:       ptr.To(int64(0)),
 			},
 			Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 		},
@@ -2384,6 +2421,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 			ClusterScore: &placementv1beta1.ClusterScore{
 				AffinityScore:       &affinityScore2,
 				TopologySpreadScore: &topologySpreadScore2,
+				WeightedScore:       ptr.To(int64(0)),
 			},
 			Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
 		},
@@ -2417,6 +2455,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 							},
@@ -2433,6 +2472,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore2,
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
 							},
@@ -2448,6 +2488,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore1,
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 				},
@@ -2457,6 +2498,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore2,
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
 				},
@@ -2480,6 +2522,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 							},
@@ -2496,6 +2539,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore2,
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
 							},
@@ -2521,6 +2565,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore1,
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 				},
@@ -2530,6 +2575,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore2,
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
 				},
@@ -2558,6 +2604,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 							},
@@ -2574,6 +2621,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore2,
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
 							},
@@ -2602,6 +2650,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore1,
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 				},
@@ -2611,6 +2660,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore2,
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
 				},
@@ -2621,6 +2671,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore3,
 						TopologySpreadScore: &topologySpreadScore3,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 				},
 			},
@@ -2643,6 +2694,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 							},
@@ -2681,6 +2733,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore1,
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 				},
@@ -2690,6 +2743,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore3,
 						TopologySpreadScore: &topologySpreadScore3,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(notPickedByScoreReasonTemplate, altClusterName, affinityScore3, topologySpreadScore3),
 				},
@@ -2725,6 +2779,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 							},
@@ -2758,6 +2813,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore1,
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 				},
@@ -2786,6 +2842,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 							},
@@ -2825,6 +2882,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore1,
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 				},
@@ -2835,6 +2893,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore2,
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 				},
 			},
@@ -2857,6 +2916,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore1,
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 							},
@@ -2873,6 +2933,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 								ClusterScore: &placementv1beta1.ClusterScore{
 									AffinityScore:       &affinityScore2,
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
 							},
@@ -2888,6 +2949,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore1,
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
 				},
@@ -2897,6 +2959,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 					ClusterScore: &placementv1beta1.ClusterScore{
 						AffinityScore:       &affinityScore2,
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
 				},
@@ -3099,6 +3162,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3131,6 +3195,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3150,6 +3215,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3164,6 +3230,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 						},
@@ -3178,6 +3245,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore3,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore3,
 							},
 						},
@@ -3194,6 +3262,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore3,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore3,
 							},
 						},
@@ -3208,6 +3277,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3222,6 +3292,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 						},
@@ -3241,6 +3312,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3255,6 +3327,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3269,6 +3342,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3285,6 +3359,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3299,6 +3374,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3313,6 +3389,7 @@ func TestSortByClusterScoreAndName(t *testing.T) {
 						ClusterDecision: placementv1beta1.ClusterDecision{
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 						},
@@ -3366,6 +3443,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore1,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3382,6 +3460,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore2,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3396,6 +3475,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3405,6 +3485,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore2,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3439,6 +3520,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore1,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3455,6 +3537,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore2,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3469,6 +3552,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3478,6 +3562,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore2,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3487,6 +3572,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    false,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore3,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore3,
 					},
 					Reason: fmt.Sprintf(notPickedByScoreReasonTemplate, anotherClusterName, affinityScore3, topologySpreadScore3),
@@ -3531,6 +3617,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore1,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3545,6 +3632,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3554,6 +3642,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    false,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore3,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore3,
 					},
 					Reason: fmt.Sprintf(notPickedByScoreReasonTemplate, anotherClusterName, affinityScore3, topologySpreadScore3),
@@ -3590,6 +3679,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore1,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3606,6 +3696,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore2,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3620,6 +3711,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3629,6 +3721,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore2,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3665,6 +3758,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore1,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3681,6 +3775,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore2,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3695,6 +3790,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3704,6 +3800,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore2,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3738,6 +3835,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore1,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3754,6 +3852,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore2,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3768,6 +3867,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3777,6 +3877,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore2,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3821,6 +3922,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore1,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore1,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3837,6 +3939,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 								Selected:    true,
 								ClusterScore: &placementv1beta1.ClusterScore{
 									TopologySpreadScore: &topologySpreadScore2,
+									WeightedScore:       ptr.To(int64(0)),
 									AffinityScore:       &affinityScore2,
 								},
 								Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3851,6 +3954,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3860,6 +3964,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore2,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, altClusterName, affinityScore2, topologySpreadScore2),
@@ -3982,6 +4087,7 @@ func TestEqualDecisions(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -3996,6 +4102,7 @@ func TestEqualDecisions(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore2,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore2,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -4007,6 +4114,7 @@ func TestEqualDecisions(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -4021,6 +4129,7 @@ func TestEqualDecisions(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore3,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore3,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -4032,6 +4141,7 @@ func TestEqualDecisions(t *testing.T) {
 					Selected:    true,
 					ClusterScore: &placementv1beta1.ClusterScore{
 						TopologySpreadScore: &topologySpreadScore1,
+						WeightedScore:       ptr.To(int64(0)),
 						AffinityScore:       &affinityScore1,
 					},
 					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName, affinityScore1, topologySpreadScore1),
@@ -4391,6 +4501,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore1, topologySpreadScore1),
@@ -4409,6 +4520,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
@@ -4427,6 +4539,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore1, topologySpreadScore1),
@@ -4458,6 +4571,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore1, topologySpreadScore1),
@@ -4488,6 +4602,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
@@ -4506,6 +4621,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore1, topologySpreadScore1),
@@ -4529,6 +4645,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore1, topologySpreadScore1),
@@ -4547,6 +4664,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
@@ -4565,6 +4683,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
@@ -4607,6 +4726,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore1, topologySpreadScore1),
@@ -4625,6 +4745,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
@@ -4643,6 +4764,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
@@ -4666,6 +4788,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore1, topologySpreadScore1),
@@ -4684,6 +4807,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
@@ -4702,6 +4826,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
@@ -4722,6 +4847,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName5, affinityScore1, topologySpreadScore1),
@@ -4740,6 +4866,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName6, affinityScore2, topologySpreadScore2),
@@ -4758,6 +4885,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName4, affinityScore1, topologySpreadScore1),
@@ -4780,6 +4908,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName4, affinityScore1, topologySpreadScore1),
@@ -4798,6 +4927,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName5, affinityScore1, topologySpreadScore1),
@@ -4818,6 +4948,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore1, topologySpreadScore1),
@@ -4836,6 +4967,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
@@ -4854,6 +4986,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore2, topologySpreadScore2),
@@ -4872,6 +5005,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore2,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore2,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName6, affinityScore2, topologySpreadScore2),
@@ -4895,6 +5029,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
@@ -4913,6 +5048,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore1, topologySpreadScore1),
@@ -4933,6 +5069,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore1, topologySpreadScore1),
@@ -4956,6 +5093,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName1, affinityScore1, topologySpreadScore1),
@@ -4974,6 +5112,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName2, affinityScore1, topologySpreadScore1),
@@ -4992,6 +5131,7 @@ func TestDownscale(t *testing.T) {
 							Selected:    true,
 							ClusterScore: &placementv1beta1.ClusterScore{
 								TopologySpreadScore: &topologySpreadScore1,
+								WeightedScore:       ptr.To(int64(0)),
 								AffinityScore:       &affinityScore1,
 							},
 							Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, clusterName3, affinityScore1, topologySpreadScore1),
@@ -5248,10 +5388,201 @@ func TestRunScorePlugins(t *testing.T) {
 	testCases := []struct {
 		name               string
 		scorePlugins       []ScorePlugin
+		scorePluginWeights map[string]int32
+		scoreNormalization ScoreNormalization
 		clusters           []*clusterv1beta1.MemberCluster
 		wantScoredClusters ScoredClusters
 		expectedToFail     bool
 	}{
+		{
+			name: "three clusters, two weighted score plugins, all scored",
+			scorePlugins: []ScorePlugin{
+				&DummyAllPurposePlugin{
+					name: dummyScorePluginNameA,
+					scoreRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (score *ClusterScore, status *Status) {
+						switch cluster.Name {
+						case clusterName:
+							return &ClusterScore{
+								TopologySpreadScore: 1,
+								AffinityScore:       1,
+							}, nil
+						case altClusterName:
+							return &ClusterScore{
+								AffinityScore:                  2,
+								ObsoletePlacementAffinityScore: 1,
+							}, nil
+						}
+						return &ClusterScore{}, nil
+					},
+				},
+				&DummyAllPurposePlugin{
+					name: dummyScorePluginNameB,
+					scoreRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (score *ClusterScore, status *Status) {
+						switch cluster.Name {
+						case clusterName:
+							return &ClusterScore{
+								AffinityScore: 10,
+							}, nil
+						case anotherClusterName:
+							return &ClusterScore{
+								AffinityScore: -5,
+							}, nil
+						}
+						return &ClusterScore{}, nil
+					},
+				},
+			},
+			scorePluginWeights: map[string]int32{
+				dummyScorePluginNameA: 3,
+			},
+			clusters: clusters,
+			wantScoredClusters: ScoredClusters{
+				{
+					Cluster: clusters[0],
+					Score: &ClusterScore{
+						TopologySpreadScore: 3,
+						AffinityScore:       13,
+						WeightedScore:       16,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							TopologySpreadScore: 3,
+							AffinityScore:       3,
+							WeightedScore:       6,
+						},
+						dummyScorePluginNameB: {
+							AffinityScore: 10,
+							WeightedScore: 10,
+						},
+					},
+				},
+				{
+					Cluster: clusters[1],
+					Score: &ClusterScore{
+						AffinityScore:                  6,
+						ObsoletePlacementAffinityScore: 1,
+						WeightedScore:                  6,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							AffinityScore:                  6,
+							ObsoletePlacementAffinityScore: 1,
+							WeightedScore:                  6,
+						},
+						dummyScorePluginNameB: {},
+					},
+				},
+				{
+					Cluster: clusters[2],
+					Score: &ClusterScore{
+						AffinityScore: -5,
+						WeightedScore: -5,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {},
+						dummyScorePluginNameB: {
+							AffinityScore: -5,
+							WeightedScore: -5,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "three clusters, two weighted score plugins, all scored, min-max normalization",
+			scorePlugins: []ScorePlugin{
+				&DummyAllPurposePlugin{
+					name: dummyScorePluginNameA,
+					scoreRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (score *ClusterScore, status *Status) {
+						switch cluster.Name {
+						case clusterName:
+							return &ClusterScore{
+								TopologySpreadScore: 1,
+								AffinityScore:       1,
+							}, nil
+						case altClusterName:
+							return &ClusterScore{
+								AffinityScore:                  2,
+								ObsoletePlacementAffinityScore: 1,
+							}, nil
+						}
+						return &ClusterScore{}, nil
+					},
+				},
+				&DummyAllPurposePlugin{
+					name: dummyScorePluginNameB,
+					scoreRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (score *ClusterScore, status *Status) {
+						switch cluster.Name {
+						case clusterName:
+							return &ClusterScore{
+								AffinityScore: 10,
+							}, nil
+						case anotherClusterName:
+							return &ClusterScore{
+								AffinityScore: -5,
+							}, nil
+						}
+						return &ClusterScore{}, nil
+					},
+				},
+			},
+			scorePluginWeights: map[string]int32{
+				dummyScorePluginNameA: 3,
+			},
+			scoreNormalization: ScoreNormalizationMinMax,
+			clusters:           clusters,
+			wantScoredClusters: ScoredClusters{
+				{
+					Cluster: clusters[0],
+					Score: &ClusterScore{
+						TopologySpreadScore: 3,
+						AffinityScore:       13,
+						WeightedScore:       400,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							TopologySpreadScore: 3,
+							AffinityScore:       3,
+							WeightedScore:       300,
+						},
+						dummyScorePluginNameB: {
+							AffinityScore: 10,
+							WeightedScore: 100,
+						},
+					},
+				},
+				{
+					Cluster: clusters[1],
+					Score: &ClusterScore{
+						AffinityScore:                  6,
+						ObsoletePlacementAffinityScore: 1,
+						WeightedScore:                  333,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							AffinityScore:                  6,
+							ObsoletePlacementAffinityScore: 1,
+							WeightedScore:                  300,
+						},
+						dummyScorePluginNameB: {
+							WeightedScore: 33,
+						},
+					},
+				},
+				{
+					Cluster: clusters[2],
+					Score: &ClusterScore{
+						AffinityScore: -5,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {},
+						dummyScorePluginNameB: {
+							AffinityScore: -5,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "three clusters, two score plugins, all scored",
 			scorePlugins: []ScorePlugin{
@@ -5303,6 +5634,17 @@ func TestRunScorePlugins(t *testing.T) {
 					Score: &ClusterScore{
 						TopologySpreadScore: 1,
 						AffinityScore:       10,
+						WeightedScore:       11,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							TopologySpreadScore: 1,
+							WeightedScore:       1,
+						},
+						dummyScorePluginNameB: {
+							AffinityScore: 10,
+							WeightedScore: 10,
+						},
 					},
 				},
 				{
@@ -5310,6 +5652,14 @@ func TestRunScorePlugins(t *testing.T) {
 					Score: &ClusterScore{
 						TopologySpreadScore: 0,
 						AffinityScore:       20,
+						WeightedScore:       20,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {},
+						dummyScorePluginNameB: {
+							AffinityScore: 20,
+							WeightedScore: 20,
+						},
 					},
 				},
 				{
@@ -5317,10 +5667,124 @@ func TestRunScorePlugins(t *testing.T) {
 					Score: &ClusterScore{
 						TopologySpreadScore: 2,
 						AffinityScore:       15,
+						WeightedScore:       17,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							TopologySpreadScore: 2,
+							WeightedScore:       2,
+						},
+						dummyScorePluginNameB: {
+							AffinityScore: 15,
+							WeightedScore: 15,
+						},
 					},
 				},
 			},
 		},
+		{
+			name: "three clusters, one score plugin which normalizes its own scores",
+			scorePlugins: []ScorePlugin{
+				&DummyScoreNormalizerPlugin{
+					DummyAllPurposePlugin: DummyAllPurposePlugin{
+						name: dummyScorePluginNameA,
+						scoreRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (score *ClusterScore, status *Status) {
+							if cluster.Name == clusterName {
+								return &ClusterScore{
+									AffinityScore: 1,
+								}, nil
+							}
+							return &ClusterScore{}, nil
+						},
+					},
+					normalizeRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, scores map[string]int64) (status *Status) {
+						for name, score := range scores {
+							scores[name] = score*50 + 10
+						}
+						return nil
+					},
+				},
+			},
+			scorePluginWeights: map[string]int32{
+				dummyScorePluginNameA: 2,
+			},
+			clusters: clusters,
+			wantScoredClusters: ScoredClusters{
+				{
+					Cluster: clusters[0],
+					Score: &ClusterScore{
+						AffinityScore: 2,
+						WeightedScore: 120,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							AffinityScore: 2,
+							WeightedScore: 120,
+						},
+					},
+				},
+				{
+					Cluster: clusters[1],
+					Score: &ClusterScore{
+						WeightedScore: 20,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							WeightedScore: 20,
+						},
+					},
+				},
+				{
+					Cluster: clusters[2],
+					Score: &ClusterScore{
+						WeightedScore: 20,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							WeightedScore: 20,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "three clusters, one score plugin which normalizes its own scores out of range",
+			scorePlugins: []ScorePlugin{
+				&DummyScoreNormalizerPlugin{
+					DummyAllPurposePlugin: DummyAllPurposePlugin{
+						name: dummyScorePluginNameA,
+						scoreRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (score *ClusterScore, status *Status) {
+							return &ClusterScore{
+								AffinityScore: 200,
+							}, nil
+						},
+					},
+					normalizeRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, scores map[string]int64) (status *Status) {
+						return nil
+					},
+				},
+			},
+			clusters:       clusters,
+			expectedToFail: true,
+		},
+		{
+			name: "three clusters, one score plugin which fails to normalize its own scores",
+			scorePlugins: []ScorePlugin{
+				&DummyScoreNormalizerPlugin{
+					DummyAllPurposePlugin: DummyAllPurposePlugin{
+						name: dummyScorePluginNameA,
+						scoreRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (score *ClusterScore, status *Status) {
+							return &ClusterScore{}, nil
+						},
+					},
+					normalizeRunner: func(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, scores map[string]int64) (status *Status) {
+						return FromError(fmt.Errorf("internal error"), dummyScorePluginNameA)
+					},
+				},
+			},
+			clusters:       clusters,
+			expectedToFail: true,
+		},
 		{
 			name: "three clusters, two score plugins, one internal error on specific cluster",
 			scorePlugins: []ScorePlugin{
@@ -5401,6 +5865,12 @@ func TestRunScorePlugins(t *testing.T) {
 			for _, p := range tc.scorePlugins {
 				profile.WithScorePlugin(p)
 			}
+			for name, weight := range tc.scorePluginWeights {
+				profile.WithScorePluginWeight(name, weight)
+			}
+			if len(tc.scoreNormalization) > 0 {
+				profile.WithScoreNormalization(tc.scoreNormalization)
+			}
 			f := &framework{
 				profile:      profile,
 				parallelizer: parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
@@ -6024,6 +6494,17 @@ func TestRunAllPluginsForPickNPlacementType(t *testing.T) {
 						TopologySpreadScore:            1,
 						AffinityScore:                  10,
 						ObsoletePlacementAffinityScore: 0,
+						WeightedScore:                  11,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							TopologySpreadScore: 1,
+							WeightedScore:       1,
+						},
+						dummyScorePluginNameB: {
+							AffinityScore: 10,
+							WeightedScore: 10,
+						},
 					},
 				},
 				{
@@ -6032,6 +6513,11 @@ func TestRunAllPluginsForPickNPlacementType(t *testing.T) {
 						TopologySpreadScore:            0,
 						AffinityScore:                  0,
 						ObsoletePlacementAffinityScore: 0,
+						WeightedScore:                  0,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {},
+						dummyScorePluginNameB: {},
 					},
 				},
 				{
@@ -6040,6 +6526,17 @@ func TestRunAllPluginsForPickNPlacementType(t *testing.T) {
 						TopologySpreadScore:            -1,
 						AffinityScore:                  50,
 						ObsoletePlacementAffinityScore: 0,
+						WeightedScore:                  49,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							TopologySpreadScore: -1,
+							WeightedScore:       -1,
+						},
+						dummyScorePluginNameB: {
+							AffinityScore: 50,
+							WeightedScore: 50,
+						},
 					},
 				},
 			},
@@ -6213,6 +6710,11 @@ func TestRunAllPluginsForPickNPlacementType(t *testing.T) {
 						TopologySpreadScore:            0,
 						AffinityScore:                  0,
 						ObsoletePlacementAffinityScore: 0,
+						WeightedScore:                  0,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {},
+						dummyScorePluginNameB: {},
 					},
 				},
 				{
@@ -6221,6 +6723,17 @@ func TestRunAllPluginsForPickNPlacementType(t *testing.T) {
 						TopologySpreadScore:            -1,
 						AffinityScore:                  50,
 						ObsoletePlacementAffinityScore: 0,
+						WeightedScore:                  49,
+					},
+					PluginScores: map[string]*ClusterScore{
+						dummyScorePluginNameA: {
+							TopologySpreadScore: -1,
+							WeightedScore:       -1,
+						},
+						dummyScorePluginNameB: {
+							AffinityScore: 50,
+							WeightedScore: 50,
+						},
 					},
 				},
 			},
@@ -18,7 +18,9 @@ package framework
 
 import (
 	"fmt"
+	"maps"
 	"reflect"
+	"slices"
 	"sort"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -187,8 +189,6 @@ func crossReferencePickedClustersAndDeDupBindings(
 	for _, scored := range picked {
 		if _, ok := checked[scored.Cluster.Name]; !ok {
 			// The cluster is newly picked in the current run; it does not have an associated binding in presence.
-			affinityScore := scored.Score.AffinityScore
-			topologySpreadScore := scored.Score.TopologySpreadScore
 			bindingSpec := placementv1beta1.ResourceBindingSpec{
 				State: placementv1beta1.BindingStateScheduled,
 				// Leave the associated resource snapshot name empty; it is up to another controller
@@ -196,13 +196,11 @@ func crossReferencePickedClustersAndDeDupBindings(
 				SchedulingPolicySnapshotName: policy.GetName(),
 				TargetCluster:                scored.Cluster.Name,
 				ClusterDecision: placementv1beta1.ClusterDecision{
-					ClusterName: scored.Cluster.Name,
-					Selected:    true,
-					ClusterScore: &placementv1beta1.ClusterScore{
-						AffinityScore:       &affinityScore,
-						TopologySpreadScore: &topologySpreadScore,
-					},
-					Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, scored.Cluster.Name, affinityScore, topologySpreadScore),
+					ClusterName:  scored.Cluster.Name,
+					Selected:     true,
+					ClusterScore: toAPIClusterScore(scored.Score),
+					PluginScores: toAPIPluginScores(scored),
+					Reason:       fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, scored.Cluster.Name, scored.Score.AffinityScore, scored.Score.TopologySpreadScore) + extenderScoresReasonSuffix(scored.ExtenderScores),
 				},
 			}
 			binding, err := generateBinding(placementKey, scored.Cluster.Name)
@@ -265,21 +263,17 @@ func patchBindingFromScoredCluster(binding placementv1beta1.BindingObj, desiredS
 
 	// Get the current spec and update it
 	spec := updated.GetBindingSpec()
-	affinityScore := scored.Score.AffinityScore
-	topologySpreadScore := scored.Score.TopologySpreadScore
 
 	// Update the binding so that it is associated with the latest scheduling policy.
 	spec.State = desiredState
 	spec.SchedulingPolicySnapshotName = policy.GetName()
 	// copy the scheduling decision
 	spec.ClusterDecision = placementv1beta1.ClusterDecision{
-		ClusterName: scored.Cluster.Name,
-		Selected:    true,
-		ClusterScore: &placementv1beta1.ClusterScore{
-			AffinityScore:       &affinityScore,
-			TopologySpreadScore: &topologySpreadScore,
-		},
-		Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, scored.Cluster.Name, affinityScore, topologySpreadScore),
+		ClusterName:  scored.Cluster.Name,
+		Selected:     true,
+		ClusterScore: toAPIClusterScore(scored.Score),
+		PluginScores: toAPIPluginScores(scored),
+		Reason:       fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, scored.Cluster.Name, scored.Score.AffinityScore, scored.Score.TopologySpreadScore) + extenderScoresReasonSuffix(scored.ExtenderScores),
 	}
 
 	// Prepare the patch using safeguard to ensure no update in between.
@@ -372,13 +366,11 @@ func newSchedulingDecisionsFromBindings(
 		}
 
 		newDecisions = append(newDecisions, placementv1beta1.ClusterDecision{
-			ClusterName: sc.Cluster.Name,
-			Selected:    false,
-			ClusterScore: &placementv1beta1.ClusterScore{
-				AffinityScore:       ptr.To(sc.Score.AffinityScore),
-				TopologySpreadScore: ptr.To(sc.Score.TopologySpreadScore),
-			},
-			Reason: fmt.Sprintf(notPickedByScoreReasonTemplate, sc.Cluster.Name, sc.Score.AffinityScore, sc.Score.TopologySpreadScore),
+			ClusterName:  sc.Cluster.Name,
+			Selected:     false,
+			ClusterScore: toAPIClusterScore(sc.Score),
+			PluginScores: toAPIPluginScores(sc),
+			Reason:       fmt.Sprintf(notPickedByScoreReasonTemplate, sc.Cluster.Name, sc.Score.AffinityScore, sc.Score.TopologySpreadScore) + extenderScoresReasonSuffix(sc.ExtenderScores),
 		})
 
 		slotsLeft--
@@ -544,13 +536,18 @@ func sortByClusterScoreAndName(bindings []placementv1beta1.BindingObj) (sorted [
 			return false
 		default:
 			// Both clusters have assigned cluster scores; compare their scores first.
+			//
+			// Note that bindings created before weighted scores were introduced do not have
+			// weighted scores; they are considered to be of a weighted score of 0.
 			clusterScoreA := ClusterScore{
 				AffinityScore:       *scoreA.AffinityScore,
 				TopologySpreadScore: *scoreA.TopologySpreadScore,
+				WeightedScore:       ptr.Deref(scoreA.WeightedScore, 0),
 			}
 			clusterScoreB := ClusterScore{
 				AffinityScore:       *scoreB.AffinityScore,
 				TopologySpreadScore: *scoreB.TopologySpreadScore,
+				WeightedScore:       ptr.Deref(scoreB.WeightedScore, 0),
 			}
 
 			if clusterScoreA.Equal(&clusterScoreB) {
@@ -771,3 +768,40 @@ func crossReferenceValidTargetsWithBindings(
 
 	return toCreate, toDelete, toPatch, nil
 }
+
+// toAPIClusterScore returns the API representation of a ClusterScore.
+func toAPIClusterScore(score *ClusterScore) *placementv1beta1.ClusterScore {
+	return &placementv1beta1.ClusterScore{
+		AffinityScore:       ptr.To(score.AffinityScore),
+		TopologySpreadScore: ptr.To(score.TopologySpreadScore),
+		WeightedScore:       ptr.To(score.WeightedScore),
+	}
+}
+
+// toAPIPluginScores returns the API representation of the scores each score plugin and each
+// scheduler extender has given to a scored cluster; the plugin scores are listed first, followed
+// by the extender scores, each sorted by their names.
+func toAPIPluginScores(sc *ScoredCluster) []placementv1beta1.PluginScore {
+	if len(sc.PluginScores) == 0 && len(sc.ExtenderScores) == 0 {
+		return nil
+	}
+
+	pluginScores := make([]placementv1beta1.PluginScore, 0, len(sc.PluginScores)+len(sc.ExtenderScores))
+	for _, name := range slices.Sorted(maps.Keys(sc.PluginScores)) {
+		pluginScores = append(pluginScores, placementv1beta1.PluginScore{
+			Name:         name,
+			ClusterScore: *toAPIClusterScore(sc.PluginScores[name]),
+		})
+	}
+	for _, name := range slices.Sorted(maps.Keys(sc.ExtenderScores)) {
+		score := sc.ExtenderScores[name]
+		pluginScores = append(pluginScores, placementv1beta1.PluginScore{
+			Name: name,
+			ClusterScore: placementv1beta1.ClusterScore{
+				AffinityScore: ptr.To(score),
+				WeightedScore: ptr.To(int64(score)),
+			},
+		})
+	}
+	return pluginScores
+}
@@ -20,6 +20,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
 )
@@ -165,3 +170,91 @@ func TestGenerateBinding(t *testing.T) {
 		})
 	}
 }
+
+// TestToAPIPluginScores tests the toAPIPluginScores function.
+func TestToAPIPluginScores(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+	}
+
+	testCases := []struct {
+		name string
+		sc   *ScoredCluster
+		want []placementv1beta1.PluginScore
+	}{
+		{
+			name: "no plugin or extender scores",
+			sc: &ScoredCluster{
+				Cluster: cluster,
+				Score:   &ClusterScore{},
+			},
+		},
+		{
+			name: "plugin and extender scores",
+			sc: &ScoredCluster{
+				Cluster: cluster,
+				Score: &ClusterScore{
+					TopologySpreadScore: -1,
+					AffinityScore:       25,
+					WeightedScore:       130,
+				},
+				PluginScores: map[string]*ClusterScore{
+					"TopologySpreadConstraints": {
+						TopologySpreadScore: -1,
+					},
+					"ClusterAffinity": {
+						AffinityScore: 20,
+						WeightedScore: 100,
+					},
+				},
+				ExtenderScores: map[string]int32{
+					"extender-b": 5,
+					"extender-a": 25,
+				},
+			},
+			want: []placementv1beta1.PluginScore{
+				{
+					Name: "ClusterAffinity",
+					ClusterScore: placementv1beta1.ClusterScore{
+						AffinityScore:       ptr.To(int32(20)),
+						TopologySpreadScore: ptr.To(int32(0)),
+						WeightedScore:       ptr.To(int64(100)),
+					},
+				},
+				{
+					Name: "TopologySpreadConstraints",
+					ClusterScore: placementv1beta1.ClusterScore{
+						AffinityScore:       ptr.To(int32(0)),
+						TopologySpreadScore: ptr.To(int32(-1)),
+						WeightedScore:       ptr.To(int64(0)),
+					},
+				},
+				{
+					Name: "extender-a",
+					ClusterScore: placementv1beta1.ClusterScore{
+						AffinityScore: ptr.To(int32(25)),
+						WeightedScore: ptr.To(int64(25)),
+					},
+				},
+				{
+					Name: "extender-b",
+					ClusterScore: placementv1beta1.ClusterScore{
+						AffinityScore: ptr.To(int32(5)),
+						WeightedScore: ptr.To(int64(5)),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := toAPIPluginScores(tc.sc)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("toAPIPluginScores() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
@@ -103,3 +103,44 @@ type ScorePlugin interface {
 	// * An InternalError status, if an expected error has occurred
 	Score(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (score *ClusterScore, status *Status)
 }
+
+// ScoreNormalizer is an optional interface which a ScorePlugin may implement to normalize the
+// raw scores it has given to the candidate clusters in a scheduling cycle by itself; plugins
+// that do not implement this interface have their raw scores used as they are, unless the
+// profile opts in to have the framework normalize them with min-max scaling.
+type ScoreNormalizer interface {
+	// NormalizeScores normalizes, in place, the raw scores the plugin has given to the candidate
+	// clusters, keyed by the cluster names, into the range [MinNormalizedScore, MaxNormalizedScore].
+	// A plugin which implements this interface must return one of the follows:
+	// * A Success status, if the scores have been normalized; or
+	// * An InternalError status, if an expected error has occurred
+	NormalizeScores(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, scores map[string]int64) (status *Status)
+}
+
+// Extender is the interface which all scheduler extenders should implement.
+//
+// An extender is an external process which the scheduler framework consults with at the Filter and/or
+// Score stage, in addition to the plugins in the profile; unlike plugins, an extender inspects all
+// candidate clusters in one call.
+type Extender interface {
+	// Name returns the name of the extender.
+	Name() string
+
+	// IsIgnorable returns true if the scheduling cycle should proceed when the extender fails
+	// (e.g., it is unreachable or times out), as if the extender were not present.
+	IsIgnorable() bool
+
+	// SupportsFilter returns true if the extender runs at the Filter stage.
+	SupportsFilter() bool
+
+	// SupportsScore returns true if the extender runs at the Score stage.
+	SupportsScore() bool
+
+	// Filter filters the candidate clusters; it returns the clusters that have passed the filter,
+	// and the reasons why the other clusters are filtered out, keyed by the cluster names.
+	Filter(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, clusters []*clusterv1beta1.MemberCluster) (passed []*clusterv1beta1.MemberCluster, failedWithReasons map[string]string, err error)
+
+	// Score scores the candidate clusters; it returns the (weighted) scores of the clusters, keyed
+	// by the cluster names. Clusters that are not present in the result are of the score 0.
+	Score(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, clusters []*clusterv1beta1.MemberCluster) (scores map[string]int32, err error)
+}
@@ -0,0 +1,422 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/annotations"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// placementGroupScheduledReason is the reason of the Scheduled condition of a placement group
+	// when a common set of clusters has been picked for all its members.
+	placementGroupScheduledReason = "CommonClustersPicked"
+	// placementGroupScheduledMessageFormat is the message of the Scheduled condition of a placement
+	// group when a common set of clusters has been picked for all its members.
+	placementGroupScheduledMessageFormat = "Picked %d cluster(s) for all the members of the placement group"
+
+	// The messages that explain why a placement group cannot be satisfied.
+	placementGroupMultipleGroupsMessageFormat      = "Placement %s belongs to multiple placement groups: %v"
+	placementGroupMemberNotFoundMessageFormat      = "Member %s of the placement group is not found"
+	placementGroupMemberDeletingMessageFormat      = "Member %s of the placement group is being deleted"
+	placementGroupMemberGatedMessageFormat         = "Member %s of the placement group is gated from scheduling"
+	placementGroupMemberNoPolicyMessageFormat      = "Member %s of the placement group does not have an active scheduling policy yet"
+	placementGroupPickFixedMessageFormat           = "Member %s of the placement group uses the PickFixed placement type, which is not supported"
+	placementGroupPlacementTypeMismatchFormat      = "Member %s of the placement group uses placement type %s, while other members use %s"
+	placementGroupNumOfClustersMismatchFormat      = "Member %s of the placement group asks for %d cluster(s), while other members ask for %d"
+	placementGroupNotEnoughCommonClustersMsgFormat = "Only %d cluster(s) can satisfy the scheduling policies of all the members of the placement group, while %d cluster(s) are needed"
+	placementGroupDecisionPendingMessageFormat     = "The scheduler has yet to pick clusters for the placement group with the current scheduling policy of member %s"
+)
+
+// placementGroupCandidate is a cluster that the scheduler may pick for a placement group.
+type placementGroupCandidate struct {
+	clusterName string
+	// feasibleFor is the number of members whose scheduling policies the cluster satisfies.
+	feasibleFor int
+	// placedFor is the number of members that have already been placed onto the cluster.
+	placedFor int
+	// weightedScore is the sum of the weighted scores the cluster receives for each member.
+	weightedScore int64
+}
+
+// RunPlacementGroupSchedulingCycleFor picks a common set of clusters for all the members of a
+// placement group, and commits the decision (or the reason why the group cannot be satisfied) in
+// the status of the placement group.
+//
+// The decision is made once for the group as a whole; the scheduling cycles of the members only
+// create bindings from the committed decision (see runPlacementGroupSchedulingFor).
+func (f *framework) RunPlacementGroupSchedulingCycleFor(ctx context.Context, group *placementv1beta1.PlacementGroup) error {
+	startTime := time.Now()
+	groupRef := klog.KObj(group)
+	klog.V(2).InfoS("Placement group scheduling cycle starts", "placementGroup", groupRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Placement group scheduling cycle ends", "placementGroup", groupRef, "latency", latency)
+	}()
+
+	clusters, err := f.collectClusters(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect clusters", "placementGroup", groupRef)
+		return err
+	}
+	picked, members, reason, err := f.pickClustersForPlacementGroup(ctx, group, clusters)
+	if err != nil {
+		klog.ErrorS(err, "Failed to pick clusters for placement group", "placementGroup", groupRef)
+		return err
+	}
+	if len(reason) > 0 {
+		klog.V(2).InfoS("Placement group cannot be satisfied", "placementGroup", groupRef, "reason", reason)
+	} else {
+		klog.V(2).InfoS("Placement group is satisfied", "placementGroup", groupRef, "pickedClusters", picked)
+	}
+	if err := f.updatePlacementGroupStatus(ctx, group, picked, members, reason); err != nil {
+		klog.ErrorS(err, "Failed to update placement group status", "placementGroup", groupRef)
+		return err
+	}
+	return nil
+}
+
+// runPlacementGroupSchedulingFor narrows down the given clusters to the ones picked for the
+// placement group the placement belongs to, so that all the members of the group are placed onto
+// the same set of clusters; the clusters are returned as they are if the placement does not belong
+// to any placement group.
+//
+// The clusters are picked from the decision committed in the status of the placement group, which
+// must have been made for the current generation of the group and the given policy snapshot of the
+// placement. If the placement group cannot be satisfied as a whole (or the decision is yet to be
+// made), the scheduler reports the reason in the status of the policy snapshot, and returns false,
+// in which case the scheduling cycle should stop without creating or updating any binding.
+func (f *framework) runPlacementGroupSchedulingFor(
+	ctx context.Context,
+	placementKey queue.PlacementKey,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []clusterv1beta1.MemberCluster,
+) ([]clusterv1beta1.MemberCluster, bool, error) {
+	policyRef := klog.KObj(policy)
+
+	groups, err := f.lookupPlacementGroupsFor(ctx, placementKey)
+	if err != nil {
+		klog.ErrorS(err, "Failed to look up placement groups", "policySnapshot", policyRef)
+		return nil, false, err
+	}
+	switch {
+	case len(groups) == 0:
+		// The placement does not belong to any placement group.
+		return clusters, true, nil
+	case len(groups) > 1:
+		groupNames := make([]string, 0, len(groups))
+		for _, group := range groups {
+			groupNames = append(groupNames, group.Name)
+		}
+		reason := fmt.Sprintf(placementGroupMultipleGroupsMessageFormat, placementKey, groupNames)
+		klog.V(2).InfoS("Placement belongs to multiple placement groups; skip scheduling", "policySnapshot", policyRef, "placementGroups", groupNames)
+		return nil, false, f.updatePolicySnapshotStatusForUnsatisfiedPlacementGroup(ctx, policy, reason)
+	}
+
+	group := groups[0]
+	picked, reason := committedClustersForPlacementGroupMember(group, placementKey, policy)
+	if len(reason) > 0 {
+		klog.V(2).InfoS("Placement group cannot be satisfied; skip scheduling", "policySnapshot", policyRef, "placementGroup", klog.KObj(group), "reason", reason)
+		return nil, false, f.updatePolicySnapshotStatusForUnsatisfiedPlacementGroup(ctx, policy, reason)
+	}
+
+	narrowed := make([]clusterv1beta1.MemberCluster, 0, len(picked))
+	for idx := range clusters {
+		if slices.Contains(picked, clusters[idx].Name) {
+			narrowed = append(narrowed, clusters[idx])
+		}
+	}
+	klog.V(2).InfoS("Placement group is satisfied", "policySnapshot", policyRef, "placementGroup", klog.KObj(group), "pickedClusters", picked)
+	return narrowed, true, nil
+}
+
+// committedClustersForPlacementGroupMember returns the clusters picked for a placement group in
+// the decision committed in its status, or a reason why a member of the group (with the given
+// policy snapshot) cannot be placed onto them.
+func committedClustersForPlacementGroupMember(
+	group *placementv1beta1.PlacementGroup,
+	placementKey queue.PlacementKey,
+	policy placementv1beta1.PolicySnapshotObj,
+) (picked []string, reason string) {
+	scheduledCondition := group.GetCondition(string(placementv1beta1.PlacementGroupConditionTypeScheduled))
+	switch {
+	case scheduledCondition == nil || scheduledCondition.ObservedGeneration != group.Generation:
+		// The decision has not been made for the current members of the group yet.
+		return nil, fmt.Sprintf(placementGroupDecisionPendingMessageFormat, placementKey)
+	case scheduledCondition.Status != metav1.ConditionTrue:
+		return nil, scheduledCondition.Message
+	}
+
+	for _, member := range group.Status.Members {
+		memberKey := queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(member.Namespace, member.Name))
+		if memberKey == placementKey && member.PolicySnapshotName == policy.GetName() {
+			return group.Status.SelectedClusters, ""
+		}
+	}
+	// The decision has been made for a different scheduling policy of the placement.
+	return nil, fmt.Sprintf(placementGroupDecisionPendingMessageFormat, placementKey)
+}
+
+// lookupPlacementGroupsFor returns the placement groups the placement belongs to, sorted by their names.
+func (f *framework) lookupPlacementGroupsFor(ctx context.Context, placementKey queue.PlacementKey) ([]*placementv1beta1.PlacementGroup, error) {
+	namespace, name, err := controller.ExtractNamespaceNameFromKey(placementKey)
+	if err != nil {
+		return nil, err
+	}
+
+	groupList := &placementv1beta1.PlacementGroupList{}
+	if err := f.client.List(ctx, groupList); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	var groups []*placementv1beta1.PlacementGroup
+	for idx := range groupList.Items {
+		group := &groupList.Items[idx]
+		if group.DeletionTimestamp != nil {
+			continue
+		}
+		for _, member := range group.Spec.Members {
+			if member.Namespace == namespace && member.Name == name {
+				groups = append(groups, group)
+				break
+			}
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+	return groups, nil
+}
+
+// pickClustersForPlacementGroup picks a common set of clusters for all the members of a placement
+// group; it returns the names of the picked clusters (sorted) along with the members and the policy
+// snapshots the clusters are picked for, or a reason that explains why the group cannot be satisfied.
+//
+// The scheduler runs a simulated scheduling cycle for each member against all the clusters, and
+// keeps the clusters that satisfy the scheduling policies of all the members. For the PickN
+// placement type, the common clusters are then ranked by the number of members that have already
+// been placed onto them (so as to minimize interruptions), the sum of the weighted scores they
+// receive for each member, and finally their names.
+func (f *framework) pickClustersForPlacementGroup(
+	ctx context.Context,
+	group *placementv1beta1.PlacementGroup,
+	clusters []clusterv1beta1.MemberCluster,
+) (picked []string, members []placementv1beta1.PlacementGroupMemberStatus, reason string, err error) {
+	var placementType placementv1beta1.PlacementType
+	var numOfClusters int
+	candidates := make(map[string]*placementGroupCandidate, len(clusters))
+	members = make([]placementv1beta1.PlacementGroupMemberStatus, 0, len(group.Spec.Members))
+	for idx, member := range group.Spec.Members {
+		memberKey := queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(member.Namespace, member.Name))
+
+		placement, err := controller.FetchPlacementFromKey(ctx, f.client, memberKey)
+		switch {
+		case apierrors.IsNotFound(err):
+			return nil, nil, fmt.Sprintf(placementGroupMemberNotFoundMessageFormat, memberKey), nil
+		case err != nil:
+			return nil, nil, "", controller.NewAPIServerError(true, err)
+		case placement.GetDeletionTimestamp() != nil:
+			return nil, nil, fmt.Sprintf(placementGroupMemberDeletingMessageFormat, memberKey), nil
+		case placement.GetPlacementSpec().IsSchedulingGated():
+			return nil, nil, fmt.Sprintf(placementGroupMemberGatedMessageFormat, memberKey), nil
+		}
+
+		policySnapshotList, err := controller.FetchLatestPolicySnapshot(ctx, f.client, types.NamespacedName{Namespace: member.Namespace, Name: member.Name})
+		if err != nil {
+			return nil, nil, "", controller.NewAPIServerError(true, err)
+		}
+		policySnapshots := policySnapshotList.GetPolicySnapshotObjs()
+		if len(policySnapshots) != 1 {
+			// The placement controller has not yet created the active policy snapshot for the member
+			// (or is in the middle of switching to a new one).
+			return nil, nil, fmt.Sprintf(placementGroupMemberNoPolicyMessageFormat, memberKey), nil
+		}
+		policy := policySnapshots[0]
+		members = append(members, placementv1beta1.PlacementGroupMemberStatus{
+			PlacementGroupMember: member,
+			PolicySnapshotName:   policy.GetName(),
+		})
+
+		memberPlacementType := placementv1beta1.PickAllPlacementType
+		if spec := policy.GetPolicySnapshotSpec(); spec.Policy != nil {
+			memberPlacementType = spec.Policy.PlacementType
+		}
+		memberNumOfClusters := 0
+		switch memberPlacementType {
+		case placementv1beta1.PickFixedPlacementType:
+			return nil, nil, fmt.Sprintf(placementGroupPickFixedMessageFormat, memberKey), nil
+		case placementv1beta1.PickNPlacementType:
+			if memberNumOfClusters, err = annotations.ExtractNumOfClustersFromPolicySnapshot(policy); err != nil {
+				return nil, nil, "", controller.NewUnexpectedBehaviorError(err)
+			}
+		}
+		if idx == 0 {
+			placementType, numOfClusters = memberPlacementType, memberNumOfClusters
+		}
+		if memberPlacementType != placementType {
+			return nil, nil, fmt.Sprintf(placementGroupPlacementTypeMismatchFormat, memberKey, memberPlacementType, placementType), nil
+		}
+		if memberNumOfClusters != numOfClusters {
+			return nil, nil, fmt.Sprintf(placementGroupNumOfClustersMismatchFormat, memberKey, memberNumOfClusters, numOfClusters), nil
+		}
+
+		decisions, err := f.RunSimulationCycleFor(ctx, memberKey, policy, clusters)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		for _, decision := range decisions {
+			// A cluster satisfies the scheduling policy of the member if it is picked, or it is
+			// scored (i.e., it has passed the Filter stage) but does not score high enough.
+			if !decision.Selected && decision.Score == nil {
+				continue
+			}
+			candidate, ok := candidates[decision.ClusterName]
+			if !ok {
+				candidate = &placementGroupCandidate{clusterName: decision.ClusterName}
+				candidates[decision.ClusterName] = candidate
+			}
+			candidate.feasibleFor++
+			if decision.Score != nil {
+				candidate.placedFor += decision.Score.ObsoletePlacementAffinityScore
+				candidate.weightedScore += decision.Score.WeightedScore
+			}
+		}
+	}
+
+	common := make([]*placementGroupCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.feasibleFor == len(group.Spec.Members) {
+			common = append(common, candidate)
+		}
+	}
+	sort.Slice(common, func(i, j int) bool {
+		if common[i].placedFor != common[j].placedFor {
+			return common[i].placedFor > common[j].placedFor
+		}
+		if common[i].weightedScore != common[j].weightedScore {
+			return common[i].weightedScore > common[j].weightedScore
+		}
+		return common[i].clusterName < common[j].clusterName
+	})
+
+	if placementType == placementv1beta1.PickNPlacementType {
+		if len(common) < numOfClusters {
+			return nil, nil, fmt.Sprintf(placementGroupNotEnoughCommonClustersMsgFormat, len(common), numOfClusters), nil
+		}
+		common = common[:numOfClusters]
+	}
+	picked = make([]string, 0, len(common))
+	for _, candidate := range common {
+		picked = append(picked, candidate.clusterName)
+	}
+	sort.Strings(picked)
+	return picked, members, "", nil
+}
+
+// updatePlacementGroupStatus updates the status of a placement group with the picked clusters and
+// the members they are picked for, or the reason why the group cannot be satisfied.
+//
+// Note that the status is updated with optimistic concurrency control, so that a decision made
+// from an out-of-date view of the group is never committed.
+func (f *framework) updatePlacementGroupStatus(
+	ctx context.Context,
+	group *placementv1beta1.PlacementGroup,
+	picked []string,
+	members []placementv1beta1.PlacementGroupMemberStatus,
+	reason string,
+) error {
+	newCondition := metav1.Condition{
+		Type:               string(placementv1beta1.PlacementGroupConditionTypeScheduled),
+		Status:             metav1.ConditionTrue,
+		Reason:             placementGroupScheduledReason,
+		Message:            fmt.Sprintf(placementGroupScheduledMessageFormat, len(picked)),
+		ObservedGeneration: group.Generation,
+	}
+	if len(reason) > 0 {
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = PlacementGroupUnsatisfiedReason
+		newCondition.Message = reason
+		picked, members = nil, nil
+	}
+
+	currentCondition := group.GetCondition(string(placementv1beta1.PlacementGroupConditionTypeScheduled))
+	if slices.Equal(group.Status.SelectedClusters, picked) &&
+		equality.Semantic.DeepEqual(group.Status.Members, members) &&
+		condition.EqualCondition(currentCondition, &newCondition) &&
+		currentCondition.Message == newCondition.Message {
+		// Skip if there is no change in the status.
+		return nil
+	}
+
+	// Do not modify the object in the cache.
+	group = group.DeepCopy()
+	group.Status.SelectedClusters = picked
+	group.Status.Members = members
+	group.SetConditions(newCondition)
+	if err := f.client.Status().Update(ctx, group, &client.SubResourceUpdateOptions{}); err != nil {
+		return controller.NewAPIServerError(false, err)
+	}
+	return nil
+}
+
+// updatePolicySnapshotStatusForUnsatisfiedPlacementGroup updates the Scheduled condition of a policy
+// snapshot when the placement group its placement belongs to cannot be satisfied; the existing
+// scheduling decisions are kept as they are.
+func (f *framework) updatePolicySnapshotStatusForUnsatisfiedPlacementGroup(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, reason string) error {
+	policyRef := klog.KObj(policy)
+
+	observedPlacementGeneration, err := annotations.ExtractObservedPlacementGenerationFromPolicySnapshot(policy)
+	if err != nil {
+		klog.ErrorS(err, "Failed to retrieve placement generation from annotation", "schedulingPolicySnapshot", policyRef)
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+
+	newCondition := newScheduledCondition(policy, metav1.ConditionFalse, PlacementGroupUnsatisfiedReason, reason)
+	policyStatus := policy.GetPolicySnapshotStatus()
+	currentCondition := meta.FindStatusCondition(policyStatus.Conditions, string(placementv1beta1.PolicySnapshotScheduled))
+	if observedPlacementGeneration == policyStatus.ObservedCRPGeneration &&
+		condition.EqualCondition(currentCondition, &newCondition) &&
+		currentCondition.Message == newCondition.Message {
+		// Skip if there is no change in the condition.
+		return nil
+	}
+
+	policyStatus.ObservedCRPGeneration = observedPlacementGeneration
+	meta.SetStatusCondition(&policyStatus.Conditions, newCondition)
+	if err := f.client.Status().Update(ctx, policy, &client.SubResourceUpdateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to update policy snapshot status", "schedulingPolicySnapshot", policyRef)
+		return controller.NewAPIServerError(false, err)
+	}
+	return nil
+}
@@ -0,0 +1,613 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+)
+
+const (
+	placementGroupName = "test-group"
+	memberAName        = "frontend"
+	memberBName        = "backend"
+	memberANamespace   = "app"
+	lonelyClusterName  = "lonelywolf"
+)
+
+// newPlacementGroupMember returns a ClusterResourcePlacement (or a ResourcePlacement, if a namespace
+// is specified) and its active policy snapshot.
+func newPlacementGroupMember(namespace, name string, placementType placementv1beta1.PlacementType, numOfClusters int, gated bool) []client.Object {
+	spec := placementv1beta1.PlacementSpec{
+		Policy: &placementv1beta1.PlacementPolicy{
+			PlacementType: placementType,
+		},
+	}
+	if gated {
+		spec.SchedulingGates = []placementv1beta1.PlacementSchedulingGate{{Name: "example.com/approval"}}
+	}
+	policySpec := placementv1beta1.SchedulingPolicySnapshotSpec{Policy: spec.Policy}
+	objectMeta := metav1.ObjectMeta{
+		Name: fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, name, 0),
+		Labels: map[string]string{
+			placementv1beta1.PlacementTrackingLabel: name,
+			placementv1beta1.IsLatestSnapshotLabel:  strconv.FormatBool(true),
+		},
+		Annotations: map[string]string{
+			placementv1beta1.NumberOfClustersAnnotation: strconv.Itoa(numOfClusters),
+		},
+	}
+	if len(namespace) > 0 {
+		objectMeta.Namespace = namespace
+		return []client.Object{
+			&placementv1beta1.ResourcePlacement{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}, Spec: spec},
+			&placementv1beta1.SchedulingPolicySnapshot{ObjectMeta: objectMeta, Spec: policySpec},
+		}
+	}
+	return []client.Object{
+		&placementv1beta1.ClusterResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec},
+		&placementv1beta1.ClusterSchedulingPolicySnapshot{ObjectMeta: objectMeta, Spec: policySpec},
+	}
+}
+
+// TestPickClustersForPlacementGroup tests the pickClustersForPlacementGroup method.
+func TestPickClustersForPlacementGroup(t *testing.T) {
+	filterPluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
+	scorePluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 1)
+
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: anotherClusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: lonelyClusterName}},
+	}
+	scores := map[string]int32{
+		clusterName:        1,
+		altClusterName:     5,
+		anotherClusterName: 3,
+		lonelyClusterName:  10,
+	}
+	// Member A cannot be placed onto the bravelion cluster; member B cannot be placed onto the
+	// lonelywolf cluster.
+	unschedulableClusters := map[string]string{
+		fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberAName, 0): clusterName,
+		fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberBName, 0): lonelyClusterName,
+	}
+
+	members := []placementv1beta1.PlacementGroupMember{
+		{Namespace: memberANamespace, Name: memberAName},
+		{Name: memberBName},
+	}
+	memberAKey := controller.GetObjectKeyFromNamespaceName(memberANamespace, memberAName)
+
+	testCases := []struct {
+		name       string
+		objects    [][]client.Object
+		wantPicked []string
+		wantReason string
+	}{
+		{
+			name: "PickN, satisfied",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickNPlacementType, 2, false),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickNPlacementType, 2, false),
+			},
+			wantPicked: []string{anotherClusterName, altClusterName},
+		},
+		{
+			name: "PickN, prefer clusters the members have been placed onto",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickNPlacementType, 1, false),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickNPlacementType, 1, false),
+				{
+					&placementv1beta1.ClusterResourceBinding{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "binding-1",
+							Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: memberBName},
+						},
+						Spec: placementv1beta1.ResourceBindingSpec{
+							State:                        placementv1beta1.BindingStateBound,
+							TargetCluster:                anotherClusterName,
+							SchedulingPolicySnapshotName: fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberBName, 0),
+						},
+					},
+				},
+			},
+			wantPicked: []string{anotherClusterName},
+		},
+		{
+			name: "PickN, not enough common clusters",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickNPlacementType, 3, false),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickNPlacementType, 3, false),
+			},
+			wantReason: fmt.Sprintf(placementGroupNotEnoughCommonClustersMsgFormat, 2, 3),
+		},
+		{
+			name: "PickAll",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickAllPlacementType, 0, false),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickAllPlacementType, 0, false),
+			},
+			wantPicked: []string{anotherClusterName, altClusterName},
+		},
+		{
+			name: "member not found",
+			objects: [][]client.Object{
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickNPlacementType, 2, false),
+			},
+			wantReason: fmt.Sprintf(placementGroupMemberNotFoundMessageFormat, memberAKey),
+		},
+		{
+			name: "member gated",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickNPlacementType, 2, true),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickNPlacementType, 2, false),
+			},
+			wantReason: fmt.Sprintf(placementGroupMemberGatedMessageFormat, memberAKey),
+		},
+		{
+			name: "member without active policy snapshot",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickNPlacementType, 2, false)[:1],
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickNPlacementType, 2, false),
+			},
+			wantReason: fmt.Sprintf(placementGroupMemberNoPolicyMessageFormat, memberAKey),
+		},
+		{
+			name: "PickFixed",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickFixedPlacementType, 0, false),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickFixedPlacementType, 0, false),
+			},
+			wantReason: fmt.Sprintf(placementGroupPickFixedMessageFormat, memberAKey),
+		},
+		{
+			name: "placement type mismatch",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickNPlacementType, 2, false),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickAllPlacementType, 0, false),
+			},
+			wantReason: fmt.Sprintf(placementGroupPlacementTypeMismatchFormat, memberBName, placementv1beta1.PickAllPlacementType, placementv1beta1.PickNPlacementType),
+		},
+		{
+			name: "number of clusters mismatch",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickNPlacementType, 2, false),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickNPlacementType, 1, false),
+			},
+			wantReason: fmt.Sprintf(placementGroupNumOfClustersMismatchFormat, memberBName, 1, 2),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var objects []client.Object
+			for _, objs := range tc.objects {
+				objects = append(objects, objs...)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(objects...).
+				Build()
+
+			profile := NewProfile(dummyProfileName)
+			profile.WithFilterPlugin(&DummyAllPurposePlugin{
+				name: filterPluginName,
+				filterRunner: func(_ context.Context, _ CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (status *Status) {
+					if unschedulableClusters[policy.GetName()] == cluster.Name {
+						return NewNonErrorStatus(ClusterUnschedulable, filterPluginName, "cluster is not a fit")
+					}
+					return nil
+				},
+			})
+			profile.WithScorePlugin(&DummyAllPurposePlugin{
+				name: scorePluginName,
+				scoreRunner: func(_ context.Context, state CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (*ClusterScore, *Status) {
+					score := &ClusterScore{AffinityScore: scores[cluster.Name]}
+					if state.HasObsoleteBindingFor(cluster.Name) {
+						score.ObsoletePlacementAffinityScore = 1
+					}
+					return score, nil
+				},
+			})
+			f := &framework{
+				profile:                   profile,
+				client:                    fakeClient,
+				parallelizer:              parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
+				clusterEligibilityChecker: clustereligibilitychecker.New(),
+			}
+
+			group := &placementv1beta1.PlacementGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: placementGroupName},
+				Spec:       placementv1beta1.PlacementGroupSpec{Members: members},
+			}
+			picked, gotMembers, reason, err := f.pickClustersForPlacementGroup(context.Background(), group, clusters)
+			if err != nil {
+				t.Fatalf("pickClustersForPlacementGroup() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantPicked, picked); diff != "" {
+				t.Errorf("pickClustersForPlacementGroup() picked clusters diff (-want, +got): %s", diff)
+			}
+			if len(tc.wantReason) == 0 {
+				wantMembers := []placementv1beta1.PlacementGroupMemberStatus{
+					{PlacementGroupMember: members[0], PolicySnapshotName: fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberAName, 0)},
+					{PlacementGroupMember: members[1], PolicySnapshotName: fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberBName, 0)},
+				}
+				if diff := cmp.Diff(wantMembers, gotMembers); diff != "" {
+					t.Errorf("pickClustersForPlacementGroup() members diff (-want, +got): %s", diff)
+				}
+			}
+			if reason != tc.wantReason {
+				t.Errorf("pickClustersForPlacementGroup() reason = %q, want %q", reason, tc.wantReason)
+			}
+		})
+	}
+}
+
+// TestLookupPlacementGroupsFor tests the lookupPlacementGroupsFor method.
+func TestLookupPlacementGroupsFor(t *testing.T) {
+	groups := []client.Object{
+		&placementv1beta1.PlacementGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "group-b"},
+			Spec: placementv1beta1.PlacementGroupSpec{
+				Members: []placementv1beta1.PlacementGroupMember{{Name: memberAName}, {Name: memberBName}},
+			},
+		},
+		&placementv1beta1.PlacementGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "group-a"},
+			Spec: placementv1beta1.PlacementGroupSpec{
+				Members: []placementv1beta1.PlacementGroupMember{{Namespace: memberANamespace, Name: memberAName}, {Name: memberBName}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		placementKey queue.PlacementKey
+		wantGroups   []string
+	}{
+		{
+			name:         "no group",
+			placementKey: queue.PlacementKey(crpName),
+		},
+		{
+			name:         "one group (ClusterResourcePlacement)",
+			placementKey: queue.PlacementKey(memberAName),
+			wantGroups:   []string{"group-b"},
+		},
+		{
+			name:         "one group (ResourcePlacement)",
+			placementKey: queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(memberANamespace, memberAName)),
+			wantGroups:   []string{"group-a"},
+		},
+		{
+			name:         "multiple groups",
+			placementKey: queue.PlacementKey(memberBName),
+			wantGroups:   []string{"group-a", "group-b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(groups...).
+				Build()
+			f := &framework{client: fakeClient}
+
+			got, err := f.lookupPlacementGroupsFor(context.Background(), tc.placementKey)
+			if err != nil {
+				t.Fatalf("lookupPlacementGroupsFor() = %v, want no error", err)
+			}
+			var gotGroups []string
+			for _, group := range got {
+				gotGroups = append(gotGroups, group.Name)
+			}
+			if diff := cmp.Diff(tc.wantGroups, gotGroups); diff != "" {
+				t.Errorf("lookupPlacementGroupsFor() groups diff (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+// TestRunPlacementGroupSchedulingCycleFor tests the RunPlacementGroupSchedulingCycleFor method.
+func TestRunPlacementGroupSchedulingCycleFor(t *testing.T) {
+	members := []placementv1beta1.PlacementGroupMember{
+		{Namespace: memberANamespace, Name: memberAName},
+		{Name: memberBName},
+	}
+	memberAKey := controller.GetObjectKeyFromNamespaceName(memberANamespace, memberAName)
+
+	testCases := []struct {
+		name       string
+		objects    [][]client.Object
+		wantStatus placementv1beta1.PlacementGroupStatus
+	}{
+		{
+			name: "satisfied",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickAllPlacementType, 0, false),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickAllPlacementType, 0, false),
+			},
+			wantStatus: placementv1beta1.PlacementGroupStatus{
+				SelectedClusters: []string{clusterName, altClusterName},
+				Members: []placementv1beta1.PlacementGroupMemberStatus{
+					{PlacementGroupMember: members[0], PolicySnapshotName: fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberAName, 0)},
+					{PlacementGroupMember: members[1], PolicySnapshotName: fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberBName, 0)},
+				},
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(placementv1beta1.PlacementGroupConditionTypeScheduled),
+						Status:             metav1.ConditionTrue,
+						Reason:             placementGroupScheduledReason,
+						Message:            fmt.Sprintf(placementGroupScheduledMessageFormat, 2),
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		},
+		{
+			name: "unsatisfied",
+			objects: [][]client.Object{
+				newPlacementGroupMember(memberANamespace, memberAName, placementv1beta1.PickAllPlacementType, 0, true),
+				newPlacementGroupMember("", memberBName, placementv1beta1.PickAllPlacementType, 0, false),
+			},
+			wantStatus: placementv1beta1.PlacementGroupStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(placementv1beta1.PlacementGroupConditionTypeScheduled),
+						Status:             metav1.ConditionFalse,
+						Reason:             PlacementGroupUnsatisfiedReason,
+						Message:            fmt.Sprintf(placementGroupMemberGatedMessageFormat, memberAKey),
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			group := &placementv1beta1.PlacementGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: placementGroupName, Generation: 1},
+				Spec:       placementv1beta1.PlacementGroupSpec{Members: members},
+				Status: placementv1beta1.PlacementGroupStatus{
+					// A decision made for an earlier generation of the group.
+					SelectedClusters: []string{anotherClusterName},
+				},
+			}
+			objects := []client.Object{
+				group,
+				&clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+				&clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+			}
+			for _, objs := range tc.objects {
+				objects = append(objects, objs...)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(objects...).
+				WithStatusSubresource(group).
+				Build()
+			f := &framework{
+				profile:                   NewProfile(dummyProfileName),
+				client:                    fakeClient,
+				parallelizer:              parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
+				clusterEligibilityChecker: clustereligibilitychecker.New(),
+			}
+
+			if err := f.RunPlacementGroupSchedulingCycleFor(context.Background(), group); err != nil {
+				t.Fatalf("RunPlacementGroupSchedulingCycleFor() = %v, want no error", err)
+			}
+			gotGroup := &placementv1beta1.PlacementGroup{}
+			if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: placementGroupName}, gotGroup); err != nil {
+				t.Fatalf("Get() placement group = %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantStatus, gotGroup.Status, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("RunPlacementGroupSchedulingCycleFor() status diff (-want, +got): %s", diff)
+			}
+		})
+	}
+}
+
+// TestRunPlacementGroupSchedulingFor tests the runPlacementGroupSchedulingFor method.
+func TestRunPlacementGroupSchedulingFor(t *testing.T) {
+	members := []placementv1beta1.PlacementGroupMember{
+		{Namespace: memberANamespace, Name: memberAName},
+		{Name: memberBName},
+	}
+	memberBKey := queue.PlacementKey(memberBName)
+	memberBPolicyName := fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberBName, 0)
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: anotherClusterName}},
+	}
+	committedStatus := placementv1beta1.PlacementGroupStatus{
+		SelectedClusters: []string{altClusterName, anotherClusterName},
+		Members: []placementv1beta1.PlacementGroupMemberStatus{
+			{PlacementGroupMember: members[0], PolicySnapshotName: fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberAName, 0)},
+			{PlacementGroupMember: members[1], PolicySnapshotName: memberBPolicyName},
+		},
+		Conditions: []metav1.Condition{
+			{
+				Type:               string(placementv1beta1.PlacementGroupConditionTypeScheduled),
+				Status:             metav1.ConditionTrue,
+				Reason:             placementGroupScheduledReason,
+				ObservedGeneration: 1,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		groups        []*placementv1beta1.PlacementGroup
+		policyName    string
+		wantClusters  []string
+		wantSatisfied bool
+		wantReason    string
+	}{
+		{
+			name:          "no group",
+			policyName:    memberBPolicyName,
+			wantClusters:  []string{clusterName, altClusterName, anotherClusterName},
+			wantSatisfied: true,
+		},
+		{
+			name: "committed decision",
+			groups: []*placementv1beta1.PlacementGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: placementGroupName, Generation: 1},
+					Spec:       placementv1beta1.PlacementGroupSpec{Members: members},
+					Status:     committedStatus,
+				},
+			},
+			policyName:    memberBPolicyName,
+			wantClusters:  []string{altClusterName, anotherClusterName},
+			wantSatisfied: true,
+		},
+		{
+			name: "decision made for an earlier generation of the group",
+			groups: []*placementv1beta1.PlacementGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: placementGroupName, Generation: 2},
+					Spec:       placementv1beta1.PlacementGroupSpec{Members: members},
+					Status:     committedStatus,
+				},
+			},
+			policyName: memberBPolicyName,
+			wantReason: fmt.Sprintf(placementGroupDecisionPendingMessageFormat, memberBKey),
+		},
+		{
+			name: "decision made for a different policy snapshot",
+			groups: []*placementv1beta1.PlacementGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: placementGroupName, Generation: 1},
+					Spec:       placementv1beta1.PlacementGroupSpec{Members: members},
+					Status:     committedStatus,
+				},
+			},
+			policyName: fmt.Sprintf(placementv1beta1.PolicySnapshotNameFmt, memberBName, 1),
+			wantReason: fmt.Sprintf(placementGroupDecisionPendingMessageFormat, memberBKey),
+		},
+		{
+			name: "group cannot be satisfied",
+			groups: []*placementv1beta1.PlacementGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: placementGroupName, Generation: 1},
+					Spec:       placementv1beta1.PlacementGroupSpec{Members: members},
+					Status: placementv1beta1.PlacementGroupStatus{
+						Conditions: []metav1.Condition{
+							{
+								Type:               string(placementv1beta1.PlacementGroupConditionTypeScheduled),
+								Status:             metav1.ConditionFalse,
+								Reason:             PlacementGroupUnsatisfiedReason,
+								Message:            fmt.Sprintf(placementGroupNotEnoughCommonClustersMsgFormat, 1, 2),
+								ObservedGeneration: 1,
+							},
+						},
+					},
+				},
+			},
+			policyName: memberBPolicyName,
+			wantReason: fmt.Sprintf(placementGroupNotEnoughCommonClustersMsgFormat, 1, 2),
+		},
+		{
+			name: "multiple groups",
+			groups: []*placementv1beta1.PlacementGroup{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "group-a", Generation: 1},
+					Spec:       placementv1beta1.PlacementGroupSpec{Members: members},
+					Status:     committedStatus,
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "group-b", Generation: 1},
+					Spec:       placementv1beta1.PlacementGroupSpec{Members: members[1:]},
+				},
+			},
+			policyName: memberBPolicyName,
+			wantReason: fmt.Sprintf(placementGroupMultipleGroupsMessageFormat, memberBKey, []string{"group-a", "group-b"}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        tc.policyName,
+					Labels:      map[string]string{placementv1beta1.PlacementTrackingLabel: memberBName},
+					Annotations: map[string]string{placementv1beta1.CRPGenerationAnnotation: "1"},
+				},
+			}
+			objects := []client.Object{policy}
+			for _, group := range tc.groups {
+				objects = append(objects, group)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(objects...).
+				WithStatusSubresource(policy).
+				Build()
+			f := &framework{client: fakeClient}
+
+			got, satisfied, err := f.runPlacementGroupSchedulingFor(context.Background(), memberBKey, policy, clusters)
+			if err != nil {
+				t.Fatalf("runPlacementGroupSchedulingFor() = %v, want no error", err)
+			}
+			if satisfied != tc.wantSatisfied {
+				t.Errorf("runPlacementGroupSchedulingFor() satisfied = %t, want %t", satisfied, tc.wantSatisfied)
+			}
+			var gotClusters []string
+			for _, cluster := range got {
+				gotClusters = append(gotClusters, cluster.Name)
+			}
+			if diff := cmp.Diff(tc.wantClusters, gotClusters); diff != "" {
+				t.Errorf("runPlacementGroupSchedulingFor() clusters diff (-want, +got): %s", diff)
+			}
+
+			gotPolicy := &placementv1beta1.ClusterSchedulingPolicySnapshot{}
+			if err := fakeClient.Get(context.Background(), client.ObjectKeyFromObject(policy), gotPolicy); err != nil {
+				t.Fatalf("Get() policy snapshot = %v, want no error", err)
+			}
+			scheduledCondition := meta.FindStatusCondition(gotPolicy.Status.Conditions, string(placementv1beta1.PolicySnapshotScheduled))
+			switch {
+			case len(tc.wantReason) == 0 && scheduledCondition != nil:
+				t.Errorf("runPlacementGroupSchedulingFor() set Scheduled condition %v, want none", scheduledCondition)
+			case len(tc.wantReason) > 0 && (scheduledCondition == nil || scheduledCondition.Message != tc.wantReason):
+				t.Errorf("runPlacementGroupSchedulingFor() Scheduled condition = %v, want message %q", scheduledCondition, tc.wantReason)
+			}
+		})
+	}
+}
@@ -19,16 +19,20 @@ package clusteraffinity
 import (
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/klog/v2"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/propertyexpression"
 )
 
 // clusterRequirement is a type alias for ClusterSelectorTerm in the API, which allows
@@ -119,6 +123,25 @@ func retrievePropertyValueFrom(cluster *clusterv1beta1.MemberCluster, name strin
 	return q, nil
 }
 
+// retrieveRawPropertyValueFrom retrieves the string form of a property value, resource or
+// non-resource, from a member cluster.
+//
+// Unlike retrievePropertyValueFrom, it does not require non-resource property values to be
+// valid quantities. The second return value reports whether the property is available for
+// the cluster.
+func retrieveRawPropertyValueFrom(cluster *clusterv1beta1.MemberCluster, name string) (string, bool, error) {
+	if strings.HasPrefix(name, propertyprovider.ResourcePropertyNamePrefix) {
+		q, err := retrievePropertyValueFrom(cluster, name)
+		if err != nil || q == nil {
+			return "", false, err
+		}
+		return q.String(), true, nil
+	}
+
+	v, found := cluster.Status.Properties[clusterv1beta1.PropertyName(name)]
+	return v.Value, found, nil
+}
+
 // Matches checks if the cluster matches a cluster requirement.
 //
 // This is an extended method for the ClusterSelectorTerm API.
@@ -136,80 +159,174 @@ func (c *clusterRequirement) Matches(cluster *clusterv1beta1.MemberCluster) (boo
 		}
 	}
 
-	// Match the cluster against the property selector.
-	if c.ClusterSelectorTerm.PropertySelector == nil || len(c.ClusterSelectorTerm.PropertySelector.MatchExpressions) == 0 {
-		// The term does not feature a property selector; no check is needed.
-		return true, nil
-	}
-
-	for _, exp := range c.ClusterSelectorTerm.PropertySelector.MatchExpressions {
-		// Compare the observed value with the expected one using the specified operator.
-		q, err := retrievePropertyValueFrom(cluster, exp.Name)
+	// Match the cluster against the property expression.
+	if len(c.ClusterSelectorTerm.PropertyExpression) > 0 {
+		matched, err := propertyexpression.Matches(c.ClusterSelectorTerm.PropertyExpression, cluster)
 		if err != nil {
-			return false, err
+			return false, fmt.Errorf("failed to evaluate property expression: %w", err)
 		}
-		if q == nil {
-			// The property is not available for the cluster.
+		if !matched {
+			// The cluster does not match with the property expression; it is ineligible for
+			// resource placement.
 			return false, nil
 		}
+	}
 
-		// With the current set of operators, only one expected value can be specified.
-		if len(exp.Values) != 1 {
-			// The property selector expression is invalid, as there are too many expected
-			// values.
-			//
-			// Normally this should never happen.
-			return false, fmt.Errorf("more than one value in the property selector expression")
-		}
-		expectedQ, err := resource.ParseQuantity(exp.Values[0])
-		if err != nil {
-			return false, fmt.Errorf("value specified in property selector %s is not a valid resource quantity: %w", exp.Values[0], err)
-		}
+	// Match the cluster against the property selector.
+	return matchesPropertySelector(cluster, c.ClusterSelectorTerm.PropertySelector)
+}
 
+// matchesPropertySelector checks if the cluster matches a property selector.
+func matchesPropertySelector(cluster *clusterv1beta1.MemberCluster, ps *placementv1beta1.PropertySelector) (bool, error) {
+	if ps == nil || len(ps.MatchExpressions) == 0 {
+		// The term does not feature a property selector; no check is needed.
+		return true, nil
+	}
+
+	for idx := range ps.MatchExpressions {
+		exp := &ps.MatchExpressions[idx]
+
+		var matched bool
+		var err error
 		switch exp.Operator {
-		case placementv1beta1.PropertySelectorEqualTo:
-			if !q.Equal(expectedQ) {
-				// The observed value is not equal to the expected one (equality is expected)
-				return false, nil
-			}
-		case placementv1beta1.PropertySelectorNotEqualTo:
-			if q.Equal(expectedQ) {
-				// The observed value is equal to the expected one (inequality is expected).
-				return false, nil
-			}
-		case placementv1beta1.PropertySelectorGreaterThan:
-			if q.Cmp(expectedQ) <= 0 {
-				// The observed value is less than or equal to the expected one (expected to be
-				// greater than the value).
-				return false, nil
-			}
-		case placementv1beta1.PropertySelectorGreaterThanOrEqualTo:
-			if q.Cmp(expectedQ) < 0 {
-				// The observed value is less than the expected one (expected to be greater
-				// than or equal to the value).
-				return false, nil
-			}
-		case placementv1beta1.PropertySelectorLessThan:
-			if q.Cmp(expectedQ) >= 0 {
-				// The observed value is greater than or equal to the expected one (expected to be
-				// less than the value).
-				return false, nil
-			}
-		case placementv1beta1.PropertySelectorLessThanOrEqualTo:
-			if q.Cmp(expectedQ) > 0 {
-				// The observed value is greater than the expected one (expected to be less than
-				// or equal to the value).
-				return false, nil
-			}
+		case placementv1beta1.PropertySelectorIn,
+			placementv1beta1.PropertySelectorNotIn,
+			placementv1beta1.PropertySelectorExists,
+			placementv1beta1.PropertySelectorDoesNotExist:
+			matched, err = matchesSetBasedRequirement(cluster, exp)
+		case placementv1beta1.PropertySelectorVersionGreaterThan,
+			placementv1beta1.PropertySelectorVersionLessThan:
+			matched, err = matchesVersionRequirement(cluster, exp)
 		default:
-			// The operator is not recognized; normally this should never happen.
-			return false, fmt.Errorf("invalid operator: %s", exp.Operator)
+			matched, err = matchesQuantityRequirement(cluster, exp)
+		}
+		if err != nil || !matched {
+			return false, err
 		}
 	}
 	// The cluster matches the property selector.
 	return true, nil
 }
 
+// matchesQuantityRequirement checks if the cluster matches a property selector requirement
+// that compares quantities, i.e., one with the Gt, Ge, Eq, Ne, Lt, or Le operator.
+func matchesQuantityRequirement(cluster *clusterv1beta1.MemberCluster, exp *placementv1beta1.PropertySelectorRequirement) (bool, error) {
+	// Compare the observed value with the expected one using the specified operator.
+	q, err := retrievePropertyValueFrom(cluster, exp.Name)
+	if err != nil {
+		return false, err
+	}
+	if q == nil {
+		// The property is not available for the cluster.
+		return false, nil
+	}
+
+	// With the quantity-based operators, only one expected value can be specified.
+	if len(exp.Values) != 1 {
+		// The property selector expression is invalid, as there are too many expected
+		// values.
+		//
+		// Normally this should never happen.
+		return false, fmt.Errorf("more than one value in the property selector expression")
+	}
+	expectedQ, err := resource.ParseQuantity(exp.Values[0])
+	if err != nil {
+		return false, fmt.Errorf("value specified in property selector %s is not a valid resource quantity: %w", exp.Values[0], err)
+	}
+
+	switch exp.Operator {
+	case placementv1beta1.PropertySelectorEqualTo:
+		// Check if the observed value is equal to the expected one.
+		return q.Equal(expectedQ), nil
+	case placementv1beta1.PropertySelectorNotEqualTo:
+		// Check if the observed value is not equal to the expected one.
+		return !q.Equal(expectedQ), nil
+	case placementv1beta1.PropertySelectorGreaterThan:
+		// Check if the observed value is greater than the expected one.
+		return q.Cmp(expectedQ) > 0, nil
+	case placementv1beta1.PropertySelectorGreaterThanOrEqualTo:
+		// Check if the observed value is greater than or equal to the expected one.
+		return q.Cmp(expectedQ) >= 0, nil
+	case placementv1beta1.PropertySelectorLessThan:
+		// Check if the observed value is less than the expected one.
+		return q.Cmp(expectedQ) < 0, nil
+	case placementv1beta1.PropertySelectorLessThanOrEqualTo:
+		// Check if the observed value is less than or equal to the expected one.
+		return q.Cmp(expectedQ) <= 0, nil
+	default:
+		// The operator is not recognized; normally this should never happen.
+		return false, fmt.Errorf("invalid operator: %s", exp.Operator)
+	}
+}
+
+// matchesSetBasedRequirement checks if the cluster matches a property selector requirement
+// with the In, NotIn, Exists, or DoesNotExist operator.
+//
+// Similar to Kubernetes label selectors, a cluster that does not report the property matches
+// requirements with the NotIn and DoesNotExist operators.
+func matchesSetBasedRequirement(cluster *clusterv1beta1.MemberCluster, exp *placementv1beta1.PropertySelectorRequirement) (bool, error) {
+	v, found, err := retrieveRawPropertyValueFrom(cluster, exp.Name)
+	if err != nil {
+		return false, err
+	}
+
+	switch exp.Operator {
+	case placementv1beta1.PropertySelectorExists:
+		return found, nil
+	case placementv1beta1.PropertySelectorDoesNotExist:
+		return !found, nil
+	case placementv1beta1.PropertySelectorIn:
+		return found && slices.Contains(exp.Values, v), nil
+	case placementv1beta1.PropertySelectorNotIn:
+		return !found || !slices.Contains(exp.Values, v), nil
+	default:
+		// The operator is not recognized; normally this should never happen.
+		return false, fmt.Errorf("invalid operator: %s", exp.Operator)
+	}
+}
+
+// matchesVersionRequirement checks if the cluster matches a property selector requirement
+// with the VersionGt or VersionLt operator.
+func matchesVersionRequirement(cluster *clusterv1beta1.MemberCluster, exp *placementv1beta1.PropertySelectorRequirement) (bool, error) {
+	v, found, err := retrieveRawPropertyValueFrom(cluster, exp.Name)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		// The property is not available for the cluster.
+		return false, nil
+	}
+
+	// With the version-based operators, only one expected value can be specified.
+	if len(exp.Values) != 1 {
+		// Normally this should never happen.
+		return false, fmt.Errorf("more than one value in the property selector expression")
+	}
+	expectedVer, err := version.ParseGeneric(exp.Values[0])
+	if err != nil {
+		return false, fmt.Errorf("value specified in property selector %s is not a valid version: %w", exp.Values[0], err)
+	}
+	observedVer, err := version.ParseGeneric(v)
+	if err != nil {
+		// The cluster reports a value that is not a version; consider the cluster as not matched,
+		// so that one cluster with a malformed property does not fail the scheduling of the
+		// placement.
+		klog.V(2).InfoS("Property value reported by the cluster is not a valid version; the cluster is not matched",
+			"memberCluster", klog.KObj(cluster), "property", exp.Name, "value", v, "err", err)
+		return false, nil
+	}
+
+	switch exp.Operator {
+	case placementv1beta1.PropertySelectorVersionGreaterThan:
+		return observedVer.GreaterThan(expectedVer), nil
+	case placementv1beta1.PropertySelectorVersionLessThan:
+		return observedVer.LessThan(expectedVer), nil
+	default:
+		// The operator is not recognized; normally this should never happen.
+		return false, fmt.Errorf("invalid operator: %s", exp.Operator)
+	}
+}
+
 // clusterPreference is a type alias for PreferredClusterSelector in the API, which allows
 // easy method extension.
 type clusterPreference placementv1beta1.PreferredClusterSelector
@@ -297,15 +414,29 @@ func (c *clusterPreference) Scores(state *pluginState, cluster *clusterv1beta1.M
 		}
 		matched = ls.Matches(labels.Set(cluster.Labels))
 	}
+	if matched && len(c.Preference.PropertyExpression) > 0 {
+		var err error
+		matched, err = propertyexpression.Matches(c.Preference.PropertyExpression, cluster)
+		if err != nil {
+			return 0, fmt.Errorf("failed to evaluate property expression: %w", err)
+		}
+	}
+	if matched && c.Preference.PropertySelector != nil {
+		var err error
+		matched, err = matchesPropertySelector(cluster, c.Preference.PropertySelector)
+		if err != nil {
+			return 0, fmt.Errorf("failed to match property selector: %w", err)
+		}
+	}
 
 	switch {
 	case c.Preference.PropertySorter == nil && matched:
-		// No sorting is needed; if the cluster can be selected by the label selector,
-		// assign the full weight.
+		// No sorting is needed; if the cluster can be selected by the selectors
+		// and the property expression, assign the full weight.
 		return c.Weight, nil
 	case !matched:
 		// Regardless of whether sorting is needed; if the cluster cannot be selected
-		// by the label selector, it will receive no weight.
+		// by the selectors and the property expression, it will receive no weight.
 		return 0, nil
 	default:
 		// Interpolate the weight based on the sorting result.
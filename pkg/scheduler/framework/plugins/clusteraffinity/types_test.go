@@ -35,6 +35,8 @@ import (
 const (
 	nonExistentNonResourcePropertyName = "non-existent-non-resource-property"
 	invalidNonResourcePropertyName     = "invalid-non-resource-property"
+	k8sVersionPropertyName             = "k8s.io/k8s-version"
+	skuPropertyName                    = "sku"
 )
 
 // TestRetrieveResourceUsageFrom tests the retrieveResourceUsageFrom function.
@@ -314,6 +316,77 @@ func TestClusterRequirementMatches(t *testing.T) {
 			cluster: cluster,
 			want:    true,
 		},
+		{
+			name: "label selector matches, property expression matches",
+			clusterRequirement: &clusterRequirement{
+				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							envLabelName: envLabelValue1,
+						},
+					},
+					PropertyExpression: `resourceUsage.available.cpu >= 0.2 * resourceUsage.capacity.cpu && properties["` + propertyprovider.NodeCountProperty + `"] > 3`,
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "label selector matches, property expression mismatches",
+			clusterRequirement: &clusterRequirement{
+				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							envLabelName: envLabelValue1,
+						},
+					},
+					PropertyExpression: `resourceUsage.available.memory > 0.5 * resourceUsage.capacity.memory`,
+				},
+			},
+			cluster: cluster,
+			want:    false,
+		},
+		{
+			name: "property expression refers to a missing property",
+			clusterRequirement: &clusterRequirement{
+				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
+					PropertyExpression: `properties["` + invalidNonResourcePropertyName + `"] > 1`,
+				},
+			},
+			cluster: cluster,
+			want:    false,
+		},
+		{
+			name: "property expression with labels, property selector matches",
+			clusterRequirement: &clusterRequirement{
+				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
+					PropertyExpression: `labels["` + regionLabelName + `"] == "` + regionLabelValue1 + `"`,
+					PropertySelector: &placementv1beta1.PropertySelector{
+						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+							{
+								Name:     propertyprovider.NodeCountProperty,
+								Operator: placementv1beta1.PropertySelectorEqualTo,
+								Values: []string{
+									"4",
+								},
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+			want:    true,
+		},
+		{
+			name: "invalid property expression",
+			clusterRequirement: &clusterRequirement{
+				ClusterSelectorTerm: placementv1beta1.ClusterSelectorTerm{
+					PropertyExpression: `properties["` + propertyprovider.NodeCountProperty + `"]`,
+				},
+			},
+			cluster:        cluster,
+			expectedToFail: true,
+		},
 		{
 			name: "label selector matches, no expressions in the property selector",
 			clusterRequirement: &clusterRequirement{
@@ -704,6 +777,228 @@ func TestClusterRequirementMatches(t *testing.T) {
 	}
 }
 
+// TestMatchesPropertySelector tests the matchesPropertySelector function with the set-based
+// and version-based operators.
+func TestMatchesPropertySelector(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName1,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("10"),
+				},
+			},
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				k8sVersionPropertyName: {
+					Value: "v1.30.2",
+				},
+				skuPropertyName: {
+					Value: "Standard_D4s_v3",
+				},
+				propertyprovider.NodeCountProperty: {
+					Value: "4",
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		requirement    placementv1beta1.PropertySelectorRequirement
+		want           bool
+		expectedToFail bool
+	}{
+		{
+			name: "op In, matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     skuPropertyName,
+				Operator: placementv1beta1.PropertySelectorIn,
+				Values:   []string{"Standard_D2s_v3", "Standard_D4s_v3"},
+			},
+			want: true,
+		},
+		{
+			name: "op In, not matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     skuPropertyName,
+				Operator: placementv1beta1.PropertySelectorIn,
+				Values:   []string{"Standard_D2s_v3"},
+			},
+		},
+		{
+			name: "op In, property not available",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     nonExistentNonResourcePropertyName,
+				Operator: placementv1beta1.PropertySelectorIn,
+				Values:   []string{"Standard_D4s_v3"},
+			},
+		},
+		{
+			name: "op In, resource property",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     propertyprovider.TotalCPUCapacityProperty,
+				Operator: placementv1beta1.PropertySelectorIn,
+				Values:   []string{"8", "10"},
+			},
+			want: true,
+		},
+		{
+			name: "op NotIn, matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     skuPropertyName,
+				Operator: placementv1beta1.PropertySelectorNotIn,
+				Values:   []string{"Standard_D2s_v3"},
+			},
+			want: true,
+		},
+		{
+			name: "op NotIn, not matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     skuPropertyName,
+				Operator: placementv1beta1.PropertySelectorNotIn,
+				Values:   []string{"Standard_D4s_v3"},
+			},
+		},
+		{
+			name: "op NotIn, property not available",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     nonExistentNonResourcePropertyName,
+				Operator: placementv1beta1.PropertySelectorNotIn,
+				Values:   []string{"Standard_D4s_v3"},
+			},
+			want: true,
+		},
+		{
+			name: "op Exists, matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     k8sVersionPropertyName,
+				Operator: placementv1beta1.PropertySelectorExists,
+			},
+			want: true,
+		},
+		{
+			name: "op Exists, not matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     propertyprovider.AvailableMemoryCapacityProperty,
+				Operator: placementv1beta1.PropertySelectorExists,
+			},
+		},
+		{
+			name: "op DoesNotExist, matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     nonExistentNonResourcePropertyName,
+				Operator: placementv1beta1.PropertySelectorDoesNotExist,
+			},
+			want: true,
+		},
+		{
+			name: "op DoesNotExist, not matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     skuPropertyName,
+				Operator: placementv1beta1.PropertySelectorDoesNotExist,
+			},
+		},
+		{
+			name: "op VersionGt, matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     k8sVersionPropertyName,
+				Operator: placementv1beta1.PropertySelectorVersionGreaterThan,
+				Values:   []string{"1.29"},
+			},
+			want: true,
+		},
+		{
+			name: "op VersionGt, not matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     k8sVersionPropertyName,
+				Operator: placementv1beta1.PropertySelectorVersionGreaterThan,
+				Values:   []string{"1.30.2"},
+			},
+		},
+		{
+			name: "op VersionLt, matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     k8sVersionPropertyName,
+				Operator: placementv1beta1.PropertySelectorVersionLessThan,
+				// 1.100 is a later minor version than 1.30, unlike the numeric comparison.
+				Values: []string{"1.100"},
+			},
+			want: true,
+		},
+		{
+			name: "op VersionLt, not matched",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     k8sVersionPropertyName,
+				Operator: placementv1beta1.PropertySelectorVersionLessThan,
+				Values:   []string{"v1.30.0"},
+			},
+		},
+		{
+			name: "op VersionLt, property not available",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     nonExistentNonResourcePropertyName,
+				Operator: placementv1beta1.PropertySelectorVersionLessThan,
+				Values:   []string{"1.31"},
+			},
+		},
+		{
+			name: "op VersionGt, observed value is not a version",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     skuPropertyName,
+				Operator: placementv1beta1.PropertySelectorVersionGreaterThan,
+				Values:   []string{"1.29"},
+			},
+		},
+		{
+			name: "op VersionLt, observed value is not a version",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     skuPropertyName,
+				Operator: placementv1beta1.PropertySelectorVersionLessThan,
+				Values:   []string{"1.29"},
+			},
+		},
+		{
+			name: "op VersionGt, expected value is not a version",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     k8sVersionPropertyName,
+				Operator: placementv1beta1.PropertySelectorVersionGreaterThan,
+				Values:   []string{"latest"},
+			},
+			expectedToFail: true,
+		},
+		{
+			name: "invalid operator",
+			requirement: placementv1beta1.PropertySelectorRequirement{
+				Name:     skuPropertyName,
+				Operator: placementv1beta1.PropertySelectorOperator("Like"),
+				Values:   []string{"1"},
+			},
+			expectedToFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ps := &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{tc.requirement},
+			}
+			matches, err := matchesPropertySelector(cluster, ps)
+			if tc.expectedToFail {
+				if err == nil {
+					t.Errorf("matchesPropertySelector(), want error, got nil")
+				}
+				return
+			}
+
+			if err != nil || matches != tc.want {
+				t.Errorf("matchesPropertySelector() = %v, %v, want %v, nil", matches, err, tc.want)
+			}
+		})
+	}
+}
+
 // TestClusterPreferenceScores tests the Scores method on clusterPreference pointers.
 func TestClusterPreferenceScores(t *testing.T) {
 	cluster := &clusterv1beta1.MemberCluster{
@@ -803,6 +1098,110 @@ func TestClusterPreferenceScores(t *testing.T) {
 			cluster: cluster,
 			want:    100,
 		},
+		{
+			name: "label selector matches, property expression mismatches",
+			clusterPreference: &clusterPreference{
+				Weight: 100,
+				Preference: placementv1beta1.ClusterSelectorTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							envLabelName: envLabelValue1,
+						},
+					},
+					PropertyExpression: `properties["` + propertyprovider.NodeCountProperty + `"] > 10`,
+				},
+			},
+			cluster: cluster,
+			want:    0,
+		},
+		{
+			name: "property expression matches, no property sorter",
+			clusterPreference: &clusterPreference{
+				Weight: 100,
+				Preference: placementv1beta1.ClusterSelectorTerm{
+					PropertyExpression: `resourceUsage.allocatable.cpu < resourceUsage.capacity.cpu`,
+				},
+			},
+			cluster: cluster,
+			want:    100,
+		},
+		{
+			name: "property expression matches, weight interpolation succeeds",
+			clusterPreference: &clusterPreference{
+				Weight: 100,
+				Preference: placementv1beta1.ClusterSelectorTerm{
+					PropertyExpression: `"` + regionLabelName + `" in labels`,
+					PropertySorter: &placementv1beta1.PropertySorter{
+						Name:      propertyprovider.NodeCountProperty,
+						SortOrder: placementv1beta1.Descending,
+					},
+				},
+			},
+			cluster: cluster,
+			state: &pluginState{
+				minMaxValuesByProperty: map[string]observedMinMaxValues{
+					propertyprovider.NodeCountProperty: {
+						min: ptr.To(resource.MustParse("2")),
+						max: ptr.To(resource.MustParse("10")),
+					},
+				},
+			},
+			want: 25,
+		},
+		{
+			name: "invalid property expression",
+			clusterPreference: &clusterPreference{
+				Weight: 100,
+				Preference: placementv1beta1.ClusterSelectorTerm{
+					PropertyExpression: `resourceUsage.available.cpu +`,
+				},
+			},
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+		{
+			name: "label selector matches, property selector mismatches",
+			clusterPreference: &clusterPreference{
+				Weight: 100,
+				Preference: placementv1beta1.ClusterSelectorTerm{
+					LabelSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{
+							envLabelName: envLabelValue1,
+						},
+					},
+					PropertySelector: &placementv1beta1.PropertySelector{
+						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+							{
+								Name:     propertyprovider.NodeCountProperty,
+								Operator: placementv1beta1.PropertySelectorNotIn,
+								Values:   []string{"4"},
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+			want:    0,
+		},
+		{
+			name: "property selector matches, no property sorter",
+			clusterPreference: &clusterPreference{
+				Weight: 100,
+				Preference: placementv1beta1.ClusterSelectorTerm{
+					PropertySelector: &placementv1beta1.PropertySelector{
+						MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+							{
+								Name:     propertyprovider.NodeCountProperty,
+								Operator: placementv1beta1.PropertySelectorIn,
+								Values:   []string{"2", "4"},
+							},
+						},
+					},
+				},
+			},
+			cluster: cluster,
+			want:    100,
+		},
 		{
 			name: "weight interpolation fails",
 			clusterPreference: &clusterPreference{
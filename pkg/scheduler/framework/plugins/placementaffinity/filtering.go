@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"fmt"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// PreFilter allows the plugin to connect to the PreFilter extension point in the scheduling framework.
+func (p *Plugin) PreFilter(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	affinity, antiAffinity := requiredTermsOf(policy)
+	if len(affinity) == 0 && len(antiAffinity) == 0 {
+		// There are no required placement affinity or anti-affinity terms to enforce; consider
+		// all clusters eligible for resource placement in the scope of this plugin.
+		//
+		// Note that this will set the cluster to skip the Filter stage for all clusters.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no required placement affinity or anti-affinity terms to enforce")
+	}
+
+	// Prepare the plugin state, i.e., find out the clusters that have been picked by the
+	// placements each term refers to.
+	ps, err := p.prepareRequiredPluginState(ctx, policy)
+	if err != nil {
+		return framework.FromError(err, p.Name(), "failed to prepare plugin state")
+	}
+
+	// Save the plugin state.
+	state.Write(p.filterStateKey(), ps)
+
+	// All done.
+	return nil
+}
+
+// Filter allows the plugin to connect to the Filter extension point in the scheduling framework.
+func (p *Plugin) Filter(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state, p.filterStateKey())
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreFilter stage.
+		return framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	// Note that the required placement affinity terms are AND'd.
+	for idx, picked := range ps.requiredAffinity {
+		if !picked.Has(cluster.Name) {
+			reason := fmt.Sprintf("cluster has not been picked by any placement matching required placement affinity term %d", idx)
+			return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), reason)
+		}
+	}
+
+	if ps.requiredAntiAffinity.Has(cluster.Name) {
+		reason := "cluster has been picked by a placement matching a required placement anti-affinity term"
+		return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), reason)
+	}
+
+	// All done.
+	return nil
+}
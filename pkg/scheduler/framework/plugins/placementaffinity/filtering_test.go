@@ -0,0 +1,342 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	clusterName1 = "cluster-1"
+	clusterName2 = "cluster-2"
+	clusterName3 = "cluster-3"
+	clusterName4 = "cluster-4"
+
+	selfCRPName     = "crp-self"
+	databaseCRPName = "crp-database"
+	batchCRPName    = "crp-batch"
+
+	appLabelName          = "app"
+	appLabelValueDatabase = "database"
+	appLabelValueBatch    = "batch"
+	appLabelValueWeb      = "web"
+
+	policyName = "crp-self-1"
+)
+
+var (
+	ignoreStatusErrorField = cmpopts.IgnoreFields(framework.Status{}, "err")
+)
+
+// Mock framework.Handle interface for set up the plugin.
+type MockHandle struct {
+	client client.Client
+}
+
+var (
+	_ framework.Handle = &MockHandle{}
+)
+
+func (mh *MockHandle) Client() client.Client               { return mh.client }
+func (mh *MockHandle) Manager() ctrl.Manager               { return nil }
+func (mh *MockHandle) UncachedReader() client.Reader       { return nil }
+func (mh *MockHandle) EventRecorder() record.EventRecorder { return nil }
+func (mh *MockHandle) ClusterEligibilityChecker() *clustereligibilitychecker.ClusterEligibilityChecker {
+	return nil
+}
+
+func newCRP(name, app string) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{appLabelName: app},
+		},
+	}
+}
+
+func newCRB(crpName, clusterName string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   fmt.Sprintf("%s-%s", crpName, clusterName),
+			Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         state,
+			TargetCluster: clusterName,
+		},
+	}
+}
+
+// newTestPlugin returns a plugin set up with a fake client, which features:
+//
+// * a placement (database) that has picked cluster 1 (bound) and cluster 2 (scheduled), and
+// has unscheduled from cluster 3;
+// * a placement (batch) that has picked cluster 2 (bound);
+// * the placement being scheduled (self), which shares the same labels as the database
+// placement and has picked cluster 4 (bound).
+func newTestPlugin(t *testing.T) Plugin {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			newCRP(selfCRPName, appLabelValueDatabase),
+			newCRP(databaseCRPName, appLabelValueDatabase),
+			newCRP(batchCRPName, appLabelValueBatch),
+			newCRB(selfCRPName, clusterName4, placementv1beta1.BindingStateBound),
+			newCRB(databaseCRPName, clusterName1, placementv1beta1.BindingStateBound),
+			newCRB(databaseCRPName, clusterName2, placementv1beta1.BindingStateScheduled),
+			newCRB(databaseCRPName, clusterName3, placementv1beta1.BindingStateUnscheduled),
+			newCRB(batchCRPName, clusterName2, placementv1beta1.BindingStateBound),
+		).
+		Build()
+
+	p := New()
+	p.SetUpWithFramework(&MockHandle{client: fakeClient})
+	return p
+}
+
+func newPolicySnapshot(affinity *placementv1beta1.Affinity) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+	return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: selfCRPName,
+			},
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+				Affinity:      affinity,
+			},
+		},
+	}
+}
+
+func newTerm(app string) placementv1beta1.PlacementAffinityTerm {
+	return placementv1beta1.PlacementAffinityTerm{
+		LabelSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{appLabelName: app},
+		},
+	}
+}
+
+// TestPreFilter tests the PreFilter extension point of the plugin.
+func TestPreFilter(t *testing.T) {
+	p := newTestPlugin(t)
+
+	testCases := []struct {
+		name       string
+		ps         *placementv1beta1.ClusterSchedulingPolicySnapshot
+		wantStatus *framework.Status
+	}{
+		{
+			name: "has no scheduling policy",
+			ps: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: nil,
+				},
+			},
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no required placement affinity or anti-affinity terms to enforce"),
+		},
+		{
+			name:       "has no affinity",
+			ps:         newPolicySnapshot(nil),
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no required placement affinity or anti-affinity terms to enforce"),
+		},
+		{
+			name: "has only preferred terms",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+						{
+							Weight:                10,
+							PlacementAffinityTerm: newTerm(appLabelValueDatabase),
+						},
+					},
+				},
+			}),
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no required placement affinity or anti-affinity terms to enforce"),
+		},
+		{
+			name: "has required placement affinity term",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						newTerm(appLabelValueDatabase),
+					},
+				},
+			}),
+		},
+		{
+			name: "has required placement anti-affinity term",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						newTerm(appLabelValueBatch),
+					},
+				},
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := framework.NewCycleState([]clusterv1beta1.MemberCluster{}, []placementv1beta1.BindingObj{})
+			status := p.PreFilter(ctx, state, tc.ps)
+
+			if diff := cmp.Diff(status, tc.wantStatus, cmp.AllowUnexported(framework.Status{}), ignoreStatusErrorField); diff != "" {
+				t.Errorf("PreFilter() unexpected status (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestFilter tests the Filter extension point of the plugin.
+func TestFilter(t *testing.T) {
+	p := newTestPlugin(t)
+
+	testCases := []struct {
+		name         string
+		ps           *placementv1beta1.ClusterSchedulingPolicySnapshot
+		wantStatuses map[string]*framework.Status
+	}{
+		{
+			name: "single required placement affinity term",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						newTerm(appLabelValueDatabase),
+					},
+				},
+			}),
+			wantStatuses: map[string]*framework.Status{
+				clusterName1: nil,
+				clusterName2: nil,
+				// The database placement has unscheduled from the cluster.
+				clusterName3: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster has not been picked by any placement matching required placement affinity term 0"),
+				// The placement itself is never selected.
+				clusterName4: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster has not been picked by any placement matching required placement affinity term 0"),
+			},
+		},
+		{
+			name: "multiple required placement affinity terms",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						newTerm(appLabelValueDatabase),
+						newTerm(appLabelValueBatch),
+					},
+				},
+			}),
+			wantStatuses: map[string]*framework.Status{
+				clusterName1: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster has not been picked by any placement matching required placement affinity term 1"),
+				clusterName2: nil,
+				clusterName3: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster has not been picked by any placement matching required placement affinity term 0"),
+			},
+		},
+		{
+			name: "required placement affinity term matching no placements",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						newTerm(appLabelValueWeb),
+					},
+				},
+			}),
+			wantStatuses: map[string]*framework.Status{
+				clusterName1: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster has not been picked by any placement matching required placement affinity term 0"),
+			},
+		},
+		{
+			name: "required placement anti-affinity term",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						newTerm(appLabelValueBatch),
+					},
+				},
+			}),
+			wantStatuses: map[string]*framework.Status{
+				clusterName1: nil,
+				clusterName2: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster has been picked by a placement matching a required placement anti-affinity term"),
+				clusterName3: nil,
+			},
+		},
+		{
+			name: "required placement affinity and anti-affinity terms",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						newTerm(appLabelValueDatabase),
+					},
+				},
+				PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						newTerm(appLabelValueBatch),
+					},
+				},
+			}),
+			wantStatuses: map[string]*framework.Status{
+				clusterName1: nil,
+				clusterName2: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster has been picked by a placement matching a required placement anti-affinity term"),
+				clusterName3: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), "cluster has not been picked by any placement matching required placement affinity term 0"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := framework.NewCycleState([]clusterv1beta1.MemberCluster{}, []placementv1beta1.BindingObj{})
+			if status := p.PreFilter(ctx, state, tc.ps); !status.IsSuccess() {
+				t.Fatalf("PreFilter() = %v, want success", status)
+			}
+
+			for name, wantStatus := range tc.wantStatuses {
+				cluster := &clusterv1beta1.MemberCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: name,
+					},
+				}
+				status := p.Filter(ctx, state, tc.ps, cluster)
+				if diff := cmp.Diff(status, wantStatus, cmp.AllowUnexported(framework.Status{}), ignoreStatusErrorField); diff != "" {
+					t.Errorf("Filter(%s) unexpected status (-got, +want):\n%s", name, diff)
+				}
+			}
+		})
+	}
+}
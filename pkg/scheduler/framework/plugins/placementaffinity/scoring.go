@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// PreScore allows the plugin to connect to the PreScore extension point in the scheduling
+// framework.
+func (p *Plugin) PreScore(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	affinity, antiAffinity := preferredTermsOf(policy)
+	if len(affinity) == 0 && len(antiAffinity) == 0 {
+		// There are no preferred placement affinity or anti-affinity terms specified in the
+		// scheduling policy; skip the step.
+		//
+		// Note that this will also skip the Score() extension point for the plugin.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no preferred placement affinity or anti-affinity terms specified")
+	}
+
+	// Prepare the plugin state, i.e., find out the clusters that have been picked by the
+	// placements each term refers to.
+	ps, err := p.preparePreferredPluginState(ctx, policy)
+	if err != nil {
+		return framework.FromError(err, p.Name(), "failed to prepare plugin state")
+	}
+
+	// Save the plugin state.
+	state.Write(p.scoreStateKey(), ps)
+
+	// All done.
+	return nil
+}
+
+// Score allows the plugin to connect to the Score extension point in the scheduling framework.
+func (p *Plugin) Score(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (score *framework.ClusterScore, status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state, p.scoreStateKey())
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreScore stage.
+		return nil, framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	score = &framework.ClusterScore{}
+	for _, wc := range ps.preferredAffinity {
+		if wc.clusters.Has(cluster.Name) {
+			score.AffinityScore += wc.weight
+		}
+	}
+	for _, wc := range ps.preferredAntiAffinity {
+		if wc.clusters.Has(cluster.Name) {
+			score.AffinityScore -= wc.weight
+		}
+	}
+
+	// All done.
+	return score, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// TestPreScore tests the PreScore extension point of the plugin.
+func TestPreScore(t *testing.T) {
+	p := newTestPlugin(t)
+
+	testCases := []struct {
+		name       string
+		ps         *placementv1beta1.ClusterSchedulingPolicySnapshot
+		wantStatus *framework.Status
+	}{
+		{
+			name:       "has no affinity",
+			ps:         newPolicySnapshot(nil),
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no preferred placement affinity or anti-affinity terms specified"),
+		},
+		{
+			name: "has only required terms",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+						newTerm(appLabelValueDatabase),
+					},
+				},
+			}),
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no preferred placement affinity or anti-affinity terms specified"),
+		},
+		{
+			name: "has preferred placement anti-affinity term",
+			ps: newPolicySnapshot(&placementv1beta1.Affinity{
+				PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+					PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+						{
+							Weight:                10,
+							PlacementAffinityTerm: newTerm(appLabelValueBatch),
+						},
+					},
+				},
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := framework.NewCycleState([]clusterv1beta1.MemberCluster{}, []placementv1beta1.BindingObj{})
+			status := p.PreScore(ctx, state, tc.ps)
+
+			if diff := cmp.Diff(status, tc.wantStatus, cmp.AllowUnexported(framework.Status{}), ignoreStatusErrorField); diff != "" {
+				t.Errorf("PreScore() unexpected status (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestScore tests the Score extension point of the plugin.
+func TestScore(t *testing.T) {
+	p := newTestPlugin(t)
+
+	ps := newPolicySnapshot(&placementv1beta1.Affinity{
+		PlacementAffinity: &placementv1beta1.PlacementAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+				{
+					Weight:                20,
+					PlacementAffinityTerm: newTerm(appLabelValueDatabase),
+				},
+			},
+		},
+		PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+				{
+					Weight:                50,
+					PlacementAffinityTerm: newTerm(appLabelValueBatch),
+				},
+			},
+		},
+	})
+
+	wantScores := map[string]*framework.ClusterScore{
+		clusterName1: {AffinityScore: 20},
+		clusterName2: {AffinityScore: -30},
+		clusterName3: {AffinityScore: 0},
+		clusterName4: {AffinityScore: 0},
+	}
+
+	ctx := context.Background()
+	state := framework.NewCycleState([]clusterv1beta1.MemberCluster{}, []placementv1beta1.BindingObj{})
+	if status := p.PreScore(ctx, state, ps); !status.IsSuccess() {
+		t.Fatalf("PreScore() = %v, want success", status)
+	}
+	for name, wantScore := range wantScores {
+		cluster := &clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+		}
+		score, status := p.Score(ctx, state, ps, cluster)
+		if !status.IsSuccess() {
+			t.Fatalf("Score(%s) = %v, want success", name, status)
+		}
+		if diff := cmp.Diff(score, wantScore); diff != "" {
+			t.Errorf("Score(%s) unexpected score (-got, +want):\n%s", name, diff)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// weightedClusters is a set of clusters picked by placements matching a weighted term, along
+// with the weight of the term.
+type weightedClusters struct {
+	weight   int32
+	clusters sets.Set[string]
+}
+
+type pluginState struct {
+	// requiredAffinity keeps, for each required placement affinity term, the set of clusters
+	// that have been picked by placements matching the term.
+	requiredAffinity []sets.Set[string]
+	// requiredAntiAffinity is the set of clusters that have been picked by placements matching
+	// any of the required placement anti-affinity terms.
+	requiredAntiAffinity sets.Set[string]
+
+	// preferredAffinity keeps, for each preferred placement affinity term, the set of clusters
+	// that have been picked by placements matching the term.
+	preferredAffinity []weightedClusters
+	// preferredAntiAffinity keeps, for each preferred placement anti-affinity term, the set of
+	// clusters that have been picked by placements matching the term.
+	preferredAntiAffinity []weightedClusters
+}
+
+// requiredTermsOf returns the required placement affinity and anti-affinity terms in a policy.
+func requiredTermsOf(policy placementv1beta1.PolicySnapshotObj) (affinity, antiAffinity []placementv1beta1.PlacementAffinityTerm) {
+	spec := policy.GetPolicySnapshotSpec()
+	if spec.Policy == nil || spec.Policy.Affinity == nil {
+		return nil, nil
+	}
+	if pa := spec.Policy.Affinity.PlacementAffinity; pa != nil {
+		affinity = pa.RequiredDuringSchedulingIgnoredDuringExecution
+	}
+	if paa := spec.Policy.Affinity.PlacementAntiAffinity; paa != nil {
+		antiAffinity = paa.RequiredDuringSchedulingIgnoredDuringExecution
+	}
+	return affinity, antiAffinity
+}
+
+// preferredTermsOf returns the preferred placement affinity and anti-affinity terms in a policy.
+func preferredTermsOf(policy placementv1beta1.PolicySnapshotObj) (affinity, antiAffinity []placementv1beta1.WeightedPlacementAffinityTerm) {
+	spec := policy.GetPolicySnapshotSpec()
+	if spec.Policy == nil || spec.Policy.Affinity == nil {
+		return nil, nil
+	}
+	if pa := spec.Policy.Affinity.PlacementAffinity; pa != nil {
+		affinity = pa.PreferredDuringSchedulingIgnoredDuringExecution
+	}
+	if paa := spec.Policy.Affinity.PlacementAntiAffinity; paa != nil {
+		antiAffinity = paa.PreferredDuringSchedulingIgnoredDuringExecution
+	}
+	return affinity, antiAffinity
+}
+
+// prepareRequiredPluginState prepares the plugin state for the Filter stage.
+func (p *Plugin) prepareRequiredPluginState(ctx context.Context, policy placementv1beta1.PolicySnapshotObj) (*pluginState, error) {
+	affinity, antiAffinity := requiredTermsOf(policy)
+	ps := &pluginState{
+		requiredAffinity:     make([]sets.Set[string], 0, len(affinity)),
+		requiredAntiAffinity: sets.New[string](),
+	}
+
+	for idx := range affinity {
+		picked, err := p.listClustersPickedBy(ctx, policy, &affinity[idx])
+		if err != nil {
+			return nil, err
+		}
+		ps.requiredAffinity = append(ps.requiredAffinity, picked)
+	}
+	for idx := range antiAffinity {
+		picked, err := p.listClustersPickedBy(ctx, policy, &antiAffinity[idx])
+		if err != nil {
+			return nil, err
+		}
+		ps.requiredAntiAffinity = ps.requiredAntiAffinity.Union(picked)
+	}
+	return ps, nil
+}
+
+// preparePreferredPluginState prepares the plugin state for the Score stage.
+func (p *Plugin) preparePreferredPluginState(ctx context.Context, policy placementv1beta1.PolicySnapshotObj) (*pluginState, error) {
+	affinity, antiAffinity := preferredTermsOf(policy)
+	ps := &pluginState{
+		preferredAffinity:     make([]weightedClusters, 0, len(affinity)),
+		preferredAntiAffinity: make([]weightedClusters, 0, len(antiAffinity)),
+	}
+
+	for idx := range affinity {
+		t := &affinity[idx]
+		picked, err := p.listClustersPickedBy(ctx, policy, &t.PlacementAffinityTerm)
+		if err != nil {
+			return nil, err
+		}
+		ps.preferredAffinity = append(ps.preferredAffinity, weightedClusters{weight: t.Weight, clusters: picked})
+	}
+	for idx := range antiAffinity {
+		t := &antiAffinity[idx]
+		picked, err := p.listClustersPickedBy(ctx, policy, &t.PlacementAffinityTerm)
+		if err != nil {
+			return nil, err
+		}
+		ps.preferredAntiAffinity = append(ps.preferredAntiAffinity, weightedClusters{weight: t.Weight, clusters: picked})
+	}
+	return ps, nil
+}
+
+// listClustersPickedBy lists the clusters that have been picked by placements matching a
+// placement affinity term.
+//
+// A ClusterResourcePlacement can only refer to other ClusterResourcePlacements, and a
+// ResourcePlacement can only refer to other ResourcePlacements in the same namespace. A cluster
+// is considered picked if a placement has a scheduled or bound binding associated with it.
+func (p *Plugin) listClustersPickedBy(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, term *placementv1beta1.PlacementAffinityTerm) (sets.Set[string], error) {
+	selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse label selector: %w", err)
+	}
+
+	namespace := policy.GetNamespace()
+	self := policy.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+
+	var placementList placementv1beta1.PlacementObjList
+	listOptions := []client.ListOption{client.MatchingLabelsSelector{Selector: selector}}
+	if namespace == "" {
+		placementList = &placementv1beta1.ClusterResourcePlacementList{}
+	} else {
+		placementList = &placementv1beta1.ResourcePlacementList{}
+		listOptions = append(listOptions, client.InNamespace(namespace))
+	}
+	if err := p.handle.Client().List(ctx, placementList, listOptions...); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+
+	picked := sets.New[string]()
+	for _, placement := range placementList.GetPlacementObjs() {
+		if placement.GetName() == self {
+			// A placement never has affinity (or anti-affinity) with itself.
+			continue
+		}
+		bindings, err := controller.ListBindingsFromKey(ctx, p.handle.Client(), types.NamespacedName{Namespace: namespace, Name: placement.GetName()}, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, binding := range bindings {
+			if binding.GetDeletionTimestamp() != nil {
+				continue
+			}
+			spec := binding.GetBindingSpec()
+			if spec.State == placementv1beta1.BindingStateScheduled || spec.State == placementv1beta1.BindingStateBound {
+				picked.Insert(spec.TargetCluster)
+			}
+		}
+	}
+	return picked, nil
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcefit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// PreFilter allows the plugin to connect to the PreFilter extension point in the scheduling framework.
+func (p *Plugin) PreFilter(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	requests := resourceRequestsOf(policy)
+	if len(requests) == 0 {
+		// There are no resource requests to enforce; consider all clusters eligible for resource
+		// placement in the scope of this plugin.
+		//
+		// Note that this will set the cluster to skip the Filter stage for all clusters.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no resource requests to enforce")
+	}
+
+	// Prepare the plugin state, i.e., find out the capacity other placements have reserved on
+	// each cluster.
+	ps, err := p.preparePluginState(ctx, policy, requests)
+	if err != nil {
+		return framework.FromError(err, p.Name(), "failed to prepare plugin state")
+	}
+
+	// Save the plugin state.
+	state.Write(framework.StateKey(p.Name()), ps)
+
+	// All done.
+	return nil
+}
+
+// Filter allows the plugin to connect to the Filter extension point in the scheduling framework.
+func (p *Plugin) Filter(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state)
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreFilter stage.
+		return framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	usage := cluster.Status.ResourceUsage
+	reserved := ps.reservedOn(cluster.Name, usage.ObservationTime.Time, p.reservationWindow, state.IsBindingPreempted)
+	// Capacity taken by bindings that the scheduler is considering preempting is considered free.
+	released := ps.releasedOn(cluster.Name, usage.ObservationTime.Time, p.reservationWindow, state.IsBindingPreempted)
+
+	// Check the resources in a deterministic order so that the reported reason is stable.
+	names := make([]string, 0, len(ps.requests))
+	for name := range ps.requests {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		name := corev1.ResourceName(n)
+		requested := ps.requests[name]
+		available, found := usage.Available[name]
+		if !found {
+			reason := fmt.Sprintf("cluster does not report available capacity for resource %s", name)
+			return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), reason)
+		}
+		free := available.DeepCopy()
+		if r, ok := reserved[name]; ok {
+			free.Sub(r)
+		}
+		if r, ok := released[name]; ok {
+			free.Add(r)
+		}
+		if free.Cmp(requested) < 0 {
+			reason := fmt.Sprintf("insufficient %s: requested %s, available %s, reserved by recently scheduled placements %s",
+				name, requested.String(), available.String(), printQuantity(reserved, name))
+			return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), reason)
+		}
+	}
+
+	// All done.
+	return nil
+}
+
+// printQuantity returns the string form of a quantity in a resource list, or "0" if the resource
+// is absent.
+func printQuantity(list corev1.ResourceList, name corev1.ResourceName) string {
+	q, ok := list[name]
+	if !ok {
+		return "0"
+	}
+	return q.String()
+}
@@ -0,0 +1,473 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcefit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	clusterName1 = "cluster-1"
+	clusterName2 = "cluster-2"
+
+	selfCRPName  = "crp-self"
+	otherCRPName = "crp-other"
+	plainCRPName = "crp-plain"
+
+	selfPolicyName  = "crp-self-1"
+	otherPolicyName = "crp-other-1"
+	plainPolicyName = "crp-plain-1"
+)
+
+var (
+	ignoreStatusErrorField = cmpopts.IgnoreFields(framework.Status{}, "err")
+
+	observedAt = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+)
+
+// Mock framework.Handle interface for set up the plugin.
+type MockHandle struct {
+	client client.Client
+}
+
+var (
+	_ framework.Handle = &MockHandle{}
+)
+
+func (mh *MockHandle) Client() client.Client               { return mh.client }
+func (mh *MockHandle) Manager() ctrl.Manager               { return nil }
+func (mh *MockHandle) UncachedReader() client.Reader       { return nil }
+func (mh *MockHandle) EventRecorder() record.EventRecorder { return nil }
+func (mh *MockHandle) ClusterEligibilityChecker() *clustereligibilitychecker.ClusterEligibilityChecker {
+	return nil
+}
+
+func newPolicySnapshot(name, crpName string, requests corev1.ResourceList) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+	return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+			},
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				ResourceRequests: requests,
+			},
+		},
+	}
+}
+
+func newCRB(crpName, policyName, clusterName string, state placementv1beta1.BindingState, createdAt time.Time) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              fmt.Sprintf("%s-%s", crpName, clusterName),
+			Labels:            map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+			CreationTimestamp: metav1.NewTime(createdAt),
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:                        state,
+			TargetCluster:                clusterName,
+			SchedulingPolicySnapshotName: policyName,
+		},
+	}
+}
+
+func newCluster(name string, available corev1.ResourceList) *clusterv1beta1.MemberCluster {
+	return &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Available:       available,
+				ObservationTime: metav1.NewTime(observedAt),
+			},
+		},
+	}
+}
+
+// newTestPlugin returns a plugin set up with a fake client, which features:
+//
+// * a placement (other) that requests 2 CPUs and has been scheduled to cluster 1 after its
+// resource usage was last observed, and to cluster 2 long before that;
+// * a placement (plain) that declares no resource requests and has been scheduled to cluster 1
+// recently;
+// * the placement being scheduled (self), which has been scheduled to cluster 1 recently.
+func newTestPlugin(t *testing.T) Plugin {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	cpu2 := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			newPolicySnapshot(selfPolicyName, selfCRPName, cpu2),
+			newPolicySnapshot(otherPolicyName, otherCRPName, cpu2),
+			newPolicySnapshot(plainPolicyName, plainCRPName, nil),
+			newCRB(selfCRPName, selfPolicyName, clusterName1, placementv1beta1.BindingStateBound, observedAt),
+			newCRB(otherCRPName, otherPolicyName, clusterName1, placementv1beta1.BindingStateScheduled, observedAt.Add(time.Second*10)),
+			newCRB(otherCRPName, otherPolicyName, clusterName2, placementv1beta1.BindingStateBound, observedAt.Add(-time.Hour)),
+			newCRB(plainCRPName, plainPolicyName, clusterName1, placementv1beta1.BindingStateBound, observedAt),
+		).
+		Build()
+
+	p := New()
+	p.SetUpWithFramework(&MockHandle{client: fakeClient})
+	return p
+}
+
+// TestPreFilter tests the PreFilter extension point of the plugin.
+func TestPreFilter(t *testing.T) {
+	p := newTestPlugin(t)
+
+	testCases := []struct {
+		name       string
+		ps         *placementv1beta1.ClusterSchedulingPolicySnapshot
+		wantStatus *framework.Status
+	}{
+		{
+			name: "has no scheduling policy",
+			ps: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: nil,
+				},
+			},
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no resource requests to enforce"),
+		},
+		{
+			name:       "has no resource requests",
+			ps:         newPolicySnapshot(selfPolicyName, selfCRPName, nil),
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no resource requests to enforce"),
+		},
+		{
+			name:       "has resource requests",
+			ps:         newPolicySnapshot(selfPolicyName, selfCRPName, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}),
+			wantStatus: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := framework.NewCycleState(nil, nil)
+			status := p.PreFilter(ctx, state, tc.ps)
+			if diff := cmp.Diff(status, tc.wantStatus, cmp.AllowUnexported(framework.Status{}), ignoreStatusErrorField); diff != "" {
+				t.Errorf("PreFilter() status diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestFilter tests the Filter extension point of the plugin.
+func TestFilter(t *testing.T) {
+	p := newTestPlugin(t)
+
+	testCases := []struct {
+		name       string
+		requests   corev1.ResourceList
+		cluster    *clusterv1beta1.MemberCluster
+		wantStatus *framework.Status
+	}{
+		{
+			name:     "enough capacity",
+			requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			cluster:  newCluster(clusterName2, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}),
+		},
+		{
+			name: "insufficient capacity",
+			requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("2"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+			cluster: newCluster(clusterName2, corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+			}),
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(),
+				"insufficient memory: requested 8Gi, available 4Gi, reserved by recently scheduled placements 0"),
+		},
+		{
+			name:     "requested resource not reported",
+			requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			cluster:  newCluster(clusterName2, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}),
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(),
+				"cluster does not report available capacity for resource nvidia.com/gpu"),
+		},
+		{
+			name:     "enough capacity after deducting reservations",
+			requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			cluster:  newCluster(clusterName1, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}),
+		},
+		{
+			name:     "insufficient capacity after deducting reservations",
+			requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3")},
+			cluster:  newCluster(clusterName1, corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")}),
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(),
+				"insufficient cpu: requested 3, available 4, reserved by recently scheduled placements 2"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := framework.NewCycleState(nil, nil)
+			ps := newPolicySnapshot(selfPolicyName, selfCRPName, tc.requests)
+			if status := p.PreFilter(ctx, state, ps); !status.IsSuccess() {
+				t.Fatalf("PreFilter() = %v, want success", status)
+			}
+
+			status := p.Filter(ctx, state, ps, tc.cluster)
+			if diff := cmp.Diff(status, tc.wantStatus, cmp.AllowUnexported(framework.Status{}), ignoreStatusErrorField); diff != "" {
+				t.Errorf("Filter() status diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestReservedOn tests the reservedOn method.
+func TestReservedOn(t *testing.T) {
+	cpu1 := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+	ps := &pluginState{
+		reservations: map[string][]reservation{
+			clusterName1: {
+				{binding: types.NamespacedName{Name: "binding-1"}, scheduledAt: observedAt.Add(-time.Minute * 10), requests: cpu1},
+				{binding: types.NamespacedName{Name: "binding-2"}, scheduledAt: observedAt.Add(-time.Minute), requests: cpu1},
+				{binding: types.NamespacedName{Name: "binding-3"}, scheduledAt: observedAt.Add(time.Minute), requests: cpu1},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		clusterName string
+		window      time.Duration
+		preempted   sets.Set[types.NamespacedName]
+		want        corev1.ResourceList
+	}{
+		{
+			name:        "no reservations",
+			clusterName: clusterName2,
+			window:      time.Minute * 2,
+			want:        corev1.ResourceList{},
+		},
+		{
+			name:        "reservations within the window",
+			clusterName: clusterName1,
+			window:      time.Minute * 2,
+			want:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		},
+		{
+			name:        "zero window",
+			clusterName: clusterName1,
+			window:      0,
+			want:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+		{
+			name:        "reservations within the window, one preempted",
+			clusterName: clusterName1,
+			window:      time.Minute * 2,
+			preempted:   sets.New(types.NamespacedName{Name: "binding-2"}),
+			want:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ps.reservedOn(tc.clusterName, observedAt, tc.window, tc.preempted.Has)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("reservedOn() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestReleasedOn tests the releasedOn method.
+func TestReleasedOn(t *testing.T) {
+	cpu1 := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}
+	ps := &pluginState{
+		reservations: map[string][]reservation{
+			clusterName1: {
+				{binding: types.NamespacedName{Name: "binding-1"}, scheduledAt: observedAt.Add(-time.Minute * 10), requests: cpu1},
+				{binding: types.NamespacedName{Name: "binding-2"}, scheduledAt: observedAt.Add(-time.Minute * 5), requests: cpu1},
+				{binding: types.NamespacedName{Name: "binding-3"}, scheduledAt: observedAt.Add(time.Minute), requests: cpu1},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		preempted sets.Set[types.NamespacedName]
+		want      corev1.ResourceList
+	}{
+		{
+			name: "no preempted bindings",
+			want: corev1.ResourceList{},
+		},
+		{
+			name:      "preempted bindings outside the window",
+			preempted: sets.New(types.NamespacedName{Name: "binding-1"}, types.NamespacedName{Name: "binding-2"}),
+			want:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+		},
+		{
+			// The capacity of a binding within the window has not been accounted for in the
+			// reported usage yet; it is covered by reservedOn instead.
+			name:      "preempted binding within the window",
+			preempted: sets.New(types.NamespacedName{Name: "binding-3"}),
+			want:      corev1.ResourceList{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ps.releasedOn(clusterName1, observedAt, time.Minute*2, tc.preempted.Has)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("releasedOn() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestPreparePluginState_Nominations tests how the plugin reserves capacity for placements on whose
+// behalf the scheduler has preempted other placements.
+func TestPreparePluginState_Nominations(t *testing.T) {
+	preemptorCRPName := "crp-preemptor"
+	preemptorPolicyName := "crp-preemptor-1"
+	cpu2 := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+
+	newEviction := func(name, clusterName string, executed *metav1.ConditionStatus) *placementv1beta1.ClusterResourcePlacementEviction {
+		eviction := &placementv1beta1.ClusterResourcePlacementEviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Labels:            map[string]string{placementv1beta1.PreemptorPlacementLabel: preemptorCRPName},
+				CreationTimestamp: metav1.NewTime(observedAt.Add(time.Second * 10)),
+			},
+			Spec: placementv1beta1.PlacementEvictionSpec{
+				PlacementName: otherCRPName,
+				ClusterName:   clusterName,
+			},
+		}
+		if executed != nil {
+			eviction.Status.Conditions = []metav1.Condition{
+				{Type: string(placementv1beta1.PlacementEvictionConditionTypeExecuted), Status: *executed},
+			}
+		}
+		return eviction
+	}
+	executed, notExecuted := metav1.ConditionTrue, metav1.ConditionFalse
+
+	preemptorPolicy := newPolicySnapshot(preemptorPolicyName, preemptorCRPName, cpu2)
+	preemptorPolicy.Labels[placementv1beta1.IsLatestSnapshotLabel] = "true"
+
+	testCases := []struct {
+		name             string
+		selfCRPName      string
+		objects          []client.Object
+		wantNominatedCPU map[string]string
+	}{
+		{
+			name:        "nominated on clusters with executed or in-flight evictions",
+			selfCRPName: selfCRPName,
+			objects: []client.Object{
+				preemptorPolicy,
+				newEviction("eviction-1", clusterName1, &executed),
+				newEviction("eviction-2", clusterName1, nil),
+				newEviction("eviction-3", clusterName2, nil),
+			},
+			wantNominatedCPU: map[string]string{clusterName1: "2", clusterName2: "2"},
+		},
+		{
+			name:        "not nominated when the eviction is blocked",
+			selfCRPName: selfCRPName,
+			objects: []client.Object{
+				preemptorPolicy,
+				newEviction("eviction-1", clusterName1, &notExecuted),
+			},
+			wantNominatedCPU: map[string]string{},
+		},
+		{
+			name:        "not nominated when the preemptor has been scheduled to the cluster",
+			selfCRPName: selfCRPName,
+			objects: []client.Object{
+				preemptorPolicy,
+				newCRB(preemptorCRPName, preemptorPolicyName, clusterName1, placementv1beta1.BindingStateScheduled, observedAt.Add(time.Second*20)),
+				newEviction("eviction-1", clusterName1, &executed),
+			},
+			// The reservation comes from the binding instead.
+			wantNominatedCPU: map[string]string{clusterName1: "2"},
+		},
+		{
+			name:        "not nominated for the preemptor itself",
+			selfCRPName: preemptorCRPName,
+			objects: []client.Object{
+				preemptorPolicy,
+				newEviction("eviction-1", clusterName1, &executed),
+			},
+			wantNominatedCPU: map[string]string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := placementv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build()
+			p := New()
+			p.SetUpWithFramework(&MockHandle{client: fakeClient})
+
+			ps, err := p.preparePluginState(context.Background(), newPolicySnapshot(selfPolicyName, tc.selfCRPName, cpu2), cpu2)
+			if err != nil {
+				t.Fatalf("preparePluginState() = %v, want no error", err)
+			}
+			got := map[string]string{}
+			for clusterName := range ps.reservations {
+				reserved := ps.reservedOn(clusterName, observedAt, time.Minute*2, sets.New[types.NamespacedName]().Has)
+				if q, ok := reserved[corev1.ResourceCPU]; ok {
+					got[clusterName] = q.String()
+				}
+			}
+			if diff := cmp.Diff(got, tc.wantNominatedCPU); diff != "" {
+				t.Errorf("preparePluginState() reserved CPU diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourcefit features a scheduler plugin that filters out clusters which do not have enough
+// available capacity for the resource requests (if any) declared on a RP/CRP.
+package resourcefit
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// Plugin is the scheduler plugin that enforces the resource requests (if any) declared on a RP/CRP.
+//
+// A cluster fits a placement if, for every requested resource, the available capacity reported
+// by the cluster, minus the capacity reserved by other placements that have been scheduled to the
+// cluster recently, is no less than the requested amount.
+//
+// Capacity reservation is needed as the resource usage of a member cluster is only refreshed
+// periodically; without it, placements scheduled in the same (or close) cycles could all land on
+// the same cluster before the cluster reports that its capacity has been consumed.
+type Plugin struct {
+	// The name of the plugin.
+	name string
+
+	// reservationWindow is how long the capacity requested by a placement stays reserved on a
+	// cluster after the placement has been scheduled to it, as measured against the time the
+	// resource usage of the cluster was last observed.
+	reservationWindow time.Duration
+
+	// The framework handle.
+	handle framework.Handle
+}
+
+var (
+	// Verify that Plugin can connect to relevant extension points at compile time.
+	//
+	// This plugin leverages the following the extension points:
+	// * PreFilter
+	// * Filter
+	//
+	// Note that successful connection to any of the extension points implies that the
+	// plugin already implements the Plugin interface.
+	_ framework.PreFilterPlugin = &Plugin{}
+	_ framework.FilterPlugin    = &Plugin{}
+)
+
+type resourceFitPluginOptions struct {
+	// The name of the plugin.
+	name string
+
+	// The capacity reservation window.
+	reservationWindow time.Duration
+}
+
+type Option func(*resourceFitPluginOptions)
+
+var defaultPluginOptions = resourceFitPluginOptions{
+	name:              "ResourceFit",
+	reservationWindow: time.Minute * 2,
+}
+
+// WithName sets the name of the plugin.
+func WithName(name string) Option {
+	return func(o *resourceFitPluginOptions) {
+		o.name = name
+	}
+}
+
+// WithReservationWindow sets the capacity reservation window of the plugin.
+func WithReservationWindow(window time.Duration) Option {
+	return func(o *resourceFitPluginOptions) {
+		o.reservationWindow = window
+	}
+}
+
+// New returns a new Plugin.
+func New(opts ...Option) Plugin {
+	options := defaultPluginOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return Plugin{
+		name:              options.name,
+		reservationWindow: options.reservationWindow,
+	}
+}
+
+// Name returns the name of the plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// SetUpWithFramework sets up this plugin with a scheduler framework.
+func (p *Plugin) SetUpWithFramework(handle framework.Handle) {
+	p.handle = handle
+}
+
+// readPluginState reads the plugin state from the cycle state.
+func (p *Plugin) readPluginState(state framework.CycleStatePluginReadWriter) (*pluginState, error) {
+	// Read from the cycle state.
+	val, err := state.Read(framework.StateKey(p.Name()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read value from the cycle state: %w", err)
+	}
+
+	// Cast the value to the right type.
+	ps, ok := val.(*pluginState)
+	if !ok {
+		return nil, fmt.Errorf("failed to cast value %v to the right type", val)
+	}
+	if ps == nil {
+		return nil, errors.New("plugin state is nil")
+	}
+	return ps, nil
+}
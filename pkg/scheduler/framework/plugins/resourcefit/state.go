@@ -0,0 +1,279 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcefit
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// reservation is the capacity a placement reserves on a cluster it has been scheduled to.
+type reservation struct {
+	// binding is the binding through which the placement has been scheduled to the cluster.
+	binding types.NamespacedName
+	// scheduledAt is the time the placement was scheduled to the cluster.
+	scheduledAt time.Time
+	// requests is the capacity the placement has requested.
+	requests corev1.ResourceList
+}
+
+type pluginState struct {
+	// requests is the capacity the placement being scheduled requests in each cluster.
+	requests corev1.ResourceList
+	// reservations keeps, for each cluster, the capacity reserved by other placements that
+	// have been scheduled to the cluster.
+	reservations map[string][]reservation
+}
+
+// resourceRequestsOf returns the resource requests declared in a policy.
+func resourceRequestsOf(policy placementv1beta1.PolicySnapshotObj) corev1.ResourceList {
+	spec := policy.GetPolicySnapshotSpec()
+	if spec.Policy == nil || spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType {
+		return nil
+	}
+	return spec.Policy.ResourceRequests
+}
+
+// reservedOn sums up the capacity reserved on a cluster which is not yet reflected in the
+// resource usage the cluster has reported at the given observation time.
+//
+// Reservations made through bindings that are being preempted are not counted.
+func (ps *pluginState) reservedOn(clusterName string, observedAt time.Time, window time.Duration, isPreempted func(types.NamespacedName) bool) corev1.ResourceList {
+	reserved := corev1.ResourceList{}
+	for _, r := range ps.reservations[clusterName] {
+		// A placement scheduled long enough before the last observation should have its
+		// resources running on the cluster already, i.e., its consumption has been
+		// accounted for in the reported available capacity.
+		if !observedAt.Before(r.scheduledAt.Add(window)) || isPreempted(r.binding) {
+			continue
+		}
+		addTo(reserved, r.requests)
+	}
+	return reserved
+}
+
+// releasedOn sums up the capacity that bindings being preempted would release on a cluster,
+// i.e., the capacity which has been accounted for in the resource usage the cluster has reported
+// at the given observation time, but would become available once the bindings are gone.
+func (ps *pluginState) releasedOn(clusterName string, observedAt time.Time, window time.Duration, isPreempted func(types.NamespacedName) bool) corev1.ResourceList {
+	released := corev1.ResourceList{}
+	for _, r := range ps.reservations[clusterName] {
+		if observedAt.Before(r.scheduledAt.Add(window)) || !isPreempted(r.binding) {
+			continue
+		}
+		addTo(released, r.requests)
+	}
+	return released
+}
+
+// addTo adds the quantities in a resource list to another.
+func addTo(total, list corev1.ResourceList) {
+	for name, q := range list {
+		sum := total[name]
+		sum.Add(q)
+		total[name] = sum
+	}
+}
+
+// preparePluginState prepares the plugin state for the Filter stage.
+func (p *Plugin) preparePluginState(ctx context.Context, policy placementv1beta1.PolicySnapshotObj, requests corev1.ResourceList) (*pluginState, error) {
+	ps := &pluginState{
+		requests:     requests,
+		reservations: make(map[string][]reservation),
+	}
+
+	bindings, err := p.listActiveBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	self := types.NamespacedName{Namespace: policy.GetNamespace(), Name: policy.GetLabels()[placementv1beta1.PlacementTrackingLabel]}
+	// Multiple bindings usually share the same policy snapshot; cache the lookup results.
+	requestsBySnapshot := make(map[types.NamespacedName]corev1.ResourceList)
+	for _, binding := range bindings {
+		placementKey := types.NamespacedName{Namespace: binding.GetNamespace(), Name: binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]}
+		if placementKey == self {
+			// The placement being scheduled never competes with itself for capacity.
+			continue
+		}
+
+		spec := binding.GetBindingSpec()
+		snapshotKey := types.NamespacedName{Namespace: binding.GetNamespace(), Name: spec.SchedulingPolicySnapshotName}
+		reqs, found := requestsBySnapshot[snapshotKey]
+		if !found {
+			reqs, err = p.lookupResourceRequests(ctx, snapshotKey)
+			if err != nil {
+				return nil, err
+			}
+			requestsBySnapshot[snapshotKey] = reqs
+		}
+		if len(reqs) == 0 {
+			continue
+		}
+		ps.reservations[spec.TargetCluster] = append(ps.reservations[spec.TargetCluster], reservation{
+			binding:     types.NamespacedName{Namespace: binding.GetNamespace(), Name: binding.GetName()},
+			scheduledAt: binding.GetCreationTimestamp().Time,
+			requests:    reqs,
+		})
+	}
+
+	if err := p.addNominations(ctx, ps, self, bindings); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// addNominations reserves, on clusters where the scheduler has preempted other placements on behalf
+// of a placement, the capacity the placement requests, so that the capacity released by the
+// preemption is not taken by other placements (e.g., the preempted ones) before the placement
+// is scheduled to the clusters.
+//
+// A nomination is not added for the placement being scheduled itself, or if the placement has been
+// scheduled to the cluster already.
+func (p *Plugin) addNominations(ctx context.Context, ps *pluginState, self types.NamespacedName, bindings []placementv1beta1.BindingObj) error {
+	evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+	switch err := p.handle.Client().List(ctx, evictionList, client.HasLabels{placementv1beta1.PreemptorPlacementLabel}); {
+	case meta.IsNoMatchError(err):
+		// The eviction APIs are not enabled in the fleet.
+		return nil
+	case err != nil:
+		return controller.NewAPIServerError(true, err)
+	}
+
+	scheduled := make(map[nomination]bool, len(bindings))
+	for _, binding := range bindings {
+		placementKey := types.NamespacedName{Namespace: binding.GetNamespace(), Name: binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]}
+		scheduled[nomination{placement: placementKey, cluster: binding.GetBindingSpec().TargetCluster}] = true
+	}
+
+	for idx := range evictionList.Items {
+		eviction := &evictionList.Items[idx]
+		preemptor := eviction.Labels[placementv1beta1.PreemptorPlacementLabel]
+		if self.Namespace == "" && preemptor == self.Name {
+			continue
+		}
+		validCondition := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeValid))
+		executedCondition := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeExecuted))
+		if condition.IsConditionStatusFalse(validCondition, eviction.GetGeneration()) || condition.IsConditionStatusFalse(executedCondition, eviction.GetGeneration()) {
+			// The eviction has not released any capacity.
+			continue
+		}
+		key := nomination{placement: types.NamespacedName{Name: preemptor}, cluster: eviction.Spec.ClusterName}
+		if scheduled[key] {
+			// The preemptor has been scheduled to the cluster, or has been nominated already.
+			continue
+		}
+		scheduled[key] = true
+
+		reqs, err := p.lookupLatestResourceRequests(ctx, preemptor)
+		if err != nil {
+			return err
+		}
+		if len(reqs) == 0 {
+			continue
+		}
+		ps.reservations[eviction.Spec.ClusterName] = append(ps.reservations[eviction.Spec.ClusterName], reservation{
+			scheduledAt: eviction.CreationTimestamp.Time,
+			requests:    reqs,
+		})
+	}
+	return nil
+}
+
+// nomination is a placement nominated to a cluster.
+type nomination struct {
+	placement types.NamespacedName
+	cluster   string
+}
+
+// lookupLatestResourceRequests returns the resource requests declared in the latest policy snapshot
+// of a ClusterResourcePlacement.
+func (p *Plugin) lookupLatestResourceRequests(ctx context.Context, crpName string) (corev1.ResourceList, error) {
+	policySnapshotList, err := controller.FetchLatestPolicySnapshot(ctx, p.handle.Client(), types.NamespacedName{Name: crpName})
+	if err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	policySnapshots := policySnapshotList.GetPolicySnapshotObjs()
+	if len(policySnapshots) != 1 {
+		// The placement is gone, or does not have exactly one latest policy snapshot, which is
+		// usually a transient state the scheduler will resolve.
+		return nil, nil
+	}
+	return resourceRequestsOf(policySnapshots[0]), nil
+}
+
+// listActiveBindings lists all the scheduled or bound bindings, of both ClusterResourcePlacements
+// and ResourcePlacements, in the fleet.
+func (p *Plugin) listActiveBindings(ctx context.Context) ([]placementv1beta1.BindingObj, error) {
+	crbList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := p.handle.Client().List(ctx, crbList); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	bindings := crbList.GetBindingObjs()
+
+	rbList := &placementv1beta1.ResourceBindingList{}
+	switch err := p.handle.Client().List(ctx, rbList); {
+	case meta.IsNoMatchError(err):
+		// The ResourcePlacement APIs are not enabled in the fleet.
+	case err != nil:
+		return nil, controller.NewAPIServerError(true, err)
+	default:
+		bindings = append(bindings, rbList.GetBindingObjs()...)
+	}
+
+	active := make([]placementv1beta1.BindingObj, 0, len(bindings))
+	for _, binding := range bindings {
+		if binding.GetDeletionTimestamp() != nil {
+			continue
+		}
+		state := binding.GetBindingSpec().State
+		if state == placementv1beta1.BindingStateScheduled || state == placementv1beta1.BindingStateBound {
+			active = append(active, binding)
+		}
+	}
+	return active, nil
+}
+
+// lookupResourceRequests returns the resource requests declared in a policy snapshot; for
+// ClusterResourceBindings, the namespace in the key is always empty.
+func (p *Plugin) lookupResourceRequests(ctx context.Context, snapshotKey types.NamespacedName) (corev1.ResourceList, error) {
+	var snapshot placementv1beta1.PolicySnapshotObj
+	if snapshotKey.Namespace == "" {
+		snapshot = &placementv1beta1.ClusterSchedulingPolicySnapshot{}
+	} else {
+		snapshot = &placementv1beta1.SchedulingPolicySnapshot{}
+	}
+	if err := p.handle.Client().Get(ctx, snapshotKey, snapshot); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The policy snapshot has been garbage collected; the binding will soon be
+			// re-associated with a newer snapshot.
+			return nil, nil
+		}
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	return resourceRequestsOf(snapshot), nil
+}
@@ -3,6 +3,7 @@ package tainttoleration
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
@@ -10,6 +11,7 @@ import (
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	taintutils "github.com/kubefleet-dev/kubefleet/pkg/utils/taint"
 )
 
 var (
@@ -23,7 +25,7 @@ func (p *Plugin) Filter(
 	policy placementv1beta1.PolicySnapshotObj,
 	cluster *clusterv1beta1.MemberCluster,
 ) (status *framework.Status) {
-	taint, isUntolerated := findUntoleratedTaint(cluster.Spec.Taints, policy.GetPolicySnapshotSpec().Tolerations())
+	taint, isUntolerated := findUntoleratedTaint(cluster.Spec.Taints, policy.GetPolicySnapshotSpec().Tolerations(), time.Now())
 	if !isUntolerated {
 		return nil
 	}
@@ -32,34 +34,28 @@ func (p *Plugin) Filter(
 	return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), fmt.Sprintf(reasonFmt, taint))
 }
 
-func findUntoleratedTaint(taints []clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration) (*clusterv1beta1.Taint, bool) {
-	for _, taint := range taints {
-		if !tolerationsTolerateTaint(taint, tolerations) {
+// findUntoleratedTaint returns the first taint that the tolerations do not tolerate.
+//
+// A NoExecute taint whose toleration period has expired is considered untolerated, so that the
+// scheduler does not place resources back onto a cluster they have been evicted from.
+func findUntoleratedTaint(taints []clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration, now time.Time) (*clusterv1beta1.Taint, bool) {
+	for idx := range taints {
+		if !taintutils.TolerationsTolerateTaint(tolerations, &taints[idx]) || isTolerationPeriodExpired(&taints[idx], tolerations, now) {
+			// Leave out the time the taint was added, so that the reason stays stable.
+			taint := taints[idx]
+			taint.TimeAdded = nil
 			return &taint, true
 		}
 	}
 	return nil, false
 }
 
-func tolerationsTolerateTaint(taint clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration) bool {
-	for _, toleration := range tolerations {
-		if canTolerationTolerateTaint(taint, toleration) {
-			return true
-		}
-	}
-	return false
-}
-
-func canTolerationTolerateTaint(taint clusterv1beta1.Taint, toleration placementv1beta1.Toleration) bool {
-	if toleration.Operator == corev1.TolerationOpExists {
-		if toleration.Key == "" || toleration.Key == taint.Key {
-			return toleration.Effect == taint.Effect || toleration.Effect == ""
-		}
-	}
-	if toleration.Operator == corev1.TolerationOpEqual {
-		if toleration.Key == taint.Key && toleration.Value == taint.Value {
-			return toleration.Effect == taint.Effect || toleration.Effect == ""
-		}
+// isTolerationPeriodExpired returns if the tolerations have stopped tolerating a NoExecute taint,
+// i.e., the shortest toleration period among the matching tolerations has elapsed.
+func isTolerationPeriodExpired(taint *clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration, now time.Time) bool {
+	if taint.Effect != corev1.TaintEffectNoExecute {
+		return false
 	}
-	return false
+	evictAt, shouldEvict := taintutils.NoExecuteEvictionTime([]clusterv1beta1.Taint{*taint}, tolerations, now)
+	return shouldEvict && !evictAt.After(now)
 }
@@ -47,6 +47,7 @@ const (
 
 var (
 	doNotScheduleConstraintViolationReasonTemplate = "violated doNotSchedule topology spread constraint %q (max skew %d)"
+	doNotScheduleMinDomainsViolationReasonTemplate = "violated doNotSchedule topology spread constraint %q (max skew %d, min domains %d, found %d domain(s))"
 )
 
 // Plugin is the scheduler plugin that enforces the
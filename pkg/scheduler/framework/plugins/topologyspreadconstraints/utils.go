@@ -18,7 +18,9 @@ package topologyspreadconstraints
 
 import (
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -178,77 +180,129 @@ func classifyConstraints(policy placementv1beta1.PolicySnapshotObj) (doNotSchedu
 	return doNotSchedule, scheduleAnyway
 }
 
-// evaluateAllConstraints evaluates all topology spread constraints in a policy againsts all
-// clusters being inspected in the current scheduling cycle.
-//
-// Note that every cluster that does not lead to violations will be assigned a score, even if
-// the cluster does not concern any of the topology spread constraints.
-func evaluateAllConstraints(
-	state framework.CycleStatePluginReadWriter,
-	doNotSchedule, scheduleAnyway []*placementv1beta1.TopologySpreadConstraint,
-) (violations doNotScheduleViolations, scores topologySpreadScores, err error) {
-	violations = make(doNotScheduleViolations)
-	// Note that this function guarantees that all clusters that do not lead to violations of
-	// DoNotSchedule topology spread constraints will be assigned a score, even if none of the
-	// topology spread constraints concerns these clusters.
-	scores = make(topologySpreadScores)
-
-	clusters := state.ListClusters()
-
-	for _, constraint := range doNotSchedule {
-		domainCounter := countByDomain(clusters, state, constraint.TopologyKey)
+// willViolateWithMinDomains is a variant of willViolate that honors the minimum number of
+// domains: if there are fewer domains in the counter than required, the global minimum is
+// considered to be zero, as if there were empty domains yet to be used.
+func willViolateWithMinDomains(counter *bindingCounterByDomain, name domainName, maxSkew, minDomains int) (violated bool, skewChange int32, err error) {
+	if len(counter.counter) >= minDomains {
+		return willViolate(counter, name, maxSkew)
+	}
 
-		for _, cluster := range clusters {
-			if _, ok := violations[clusterName(cluster.Name)]; ok {
-				// The cluster has violated a DoNotSchedule topology spread constraint; no need
-				// evaluate other constraints for this cluster.
-				continue
-			}
+	count, ok := counter.Count(name)
+	if !ok {
+		// The domain is not registered in the counter; normally this would never
+		// happen as the state being evaluated is consistent and the counter tracks
+		// all domains.
+		return false, 0, fmt.Errorf("domain %s is not registered in the counter", name)
+	}
+	// Note if the earlier check passes, all special counts must be present.
+	largest, _ := counter.Largest()
 
-			val, ok := cluster.Labels[constraint.TopologyKey]
-			if !ok {
-				// The cluster under inspection does not have the topology key and thus is not part
-				// of the spread.
-				//
-				// Placing resources on such clusters will not lead to topology spread constraint
-				// violations.
-				//
-				// Assign a score even if the constraint does not concern the cluster.
-				scores[clusterName(cluster.Name)] += 0
-				continue
-			}
+	// With a global minimum of zero, the skew is the largest count; the placement increases
+	// the skew only if it makes the domain the (new) largest one.
+	currentSkew := int(largest)
+	newSkew := max(currentSkew, int(count)+1)
+	return newSkew > maxSkew, int32(newSkew - currentSkew), nil
+}
 
-			// The cluster under inspection is part of the spread.
+// spreadLevel is a topology level of a topology spread constraint; a constraint with nested
+// topology keys has one level per topology key.
+type spreadLevel struct {
+	// topologyKey is the topology key of the level.
+	topologyKey string
+	// groupKeys are the label keys whose values a cluster must share with other clusters to be
+	// spread against them, i.e., the match label keys of the constraint and the topology keys of
+	// all the enclosing levels.
+	groupKeys []string
+	// maxSkew is the max skew of the level.
+	maxSkew int
+	// minDomains is the minimum number of domains of the level.
+	minDomains int
+}
 
-			// Verify if the placement will violate the constraint.
+// levelsOf returns the topology levels of a topology spread constraint, from the outermost to
+// the innermost.
+func levelsOf(constraint *placementv1beta1.TopologySpreadConstraint) []spreadLevel {
+	// The default value for maxSkew is 1.
+	maxSkew := 1
+	if constraint.MaxSkew != nil {
+		maxSkew = int(*constraint.MaxSkew)
+	}
+	// The default value for minDomains is 1; minDomains applies to the outermost level only.
+	minDomains := 1
+	if constraint.MinDomains != nil {
+		minDomains = int(*constraint.MinDomains)
+	}
 
-			// The default value for maxSkew is 1.
-			maxSkew := 1
-			if constraint.MaxSkew != nil {
-				maxSkew = int(*constraint.MaxSkew)
-			}
-			violated, skewChange, err := willViolate(domainCounter, domainName(val), maxSkew)
-			if err != nil {
-				return nil, nil, fmt.Errorf("failed to evaluate DoNotSchedule topology spread constraints: %w", err)
-			}
-			if violated {
-				// A violation happens.
-				reasons := violationReasons{
-					fmt.Sprintf(doNotScheduleConstraintViolationReasonTemplate, constraint.TopologyKey, *constraint.MaxSkew),
-				}
-				violations[clusterName(cluster.Name)] = reasons
+	levels := make([]spreadLevel, 0, len(constraint.NestedTopologyKeys)+1)
+	levels = append(levels, spreadLevel{
+		topologyKey: constraint.TopologyKey,
+		groupKeys:   constraint.MatchLabelKeys,
+		maxSkew:     maxSkew,
+		minDomains:  minDomains,
+	})
+	enclosingKeys := append(slices.Clone(constraint.MatchLabelKeys), constraint.TopologyKey)
+	for _, key := range constraint.NestedTopologyKeys {
+		levels = append(levels, spreadLevel{
+			topologyKey: key,
+			groupKeys:   slices.Clone(enclosingKeys),
+			maxSkew:     maxSkew,
+			minDomains:  1,
+		})
+		enclosingKeys = append(enclosingKeys, key)
+	}
+	return levels
+}
 
-				// Untrack the cluster's score.
-				delete(scores, clusterName(cluster.Name))
+// groupOf returns the group a cluster belongs to per a list of group keys, i.e., the values
+// of these keys on the cluster; it returns false if the cluster does not have all the keys.
+func groupOf(cluster *clusterv1beta1.MemberCluster, groupKeys []string) (string, bool) {
+	vals := make([]string, 0, len(groupKeys))
+	for _, key := range groupKeys {
+		val, ok := cluster.Labels[key]
+		if !ok {
+			return "", false
+		}
+		vals = append(vals, val)
+	}
+	// Label values cannot contain slashes.
+	return strings.Join(vals, "/"), true
+}
 
-				continue
-			}
-			scores[clusterName(cluster.Name)] += skewChange * int32(skewChangeScoreFactor)
+// countByGroupAndDomain counts the number of scheduled or bound bindings in each domain of
+// each group at a topology level.
+func countByGroupAndDomain(clusters []clusterv1beta1.MemberCluster, state framework.CycleStatePluginReadWriter, level *spreadLevel) map[string]*bindingCounterByDomain {
+	grouped := make(map[string][]clusterv1beta1.MemberCluster)
+	for idx := range clusters {
+		group, ok := groupOf(&clusters[idx], level.groupKeys)
+		if !ok {
+			// The cluster under inspection is not part of the spread at this level.
+			continue
 		}
+		grouped[group] = append(grouped[group], clusters[idx])
 	}
 
-	for _, constraint := range scheduleAnyway {
-		domainCounter := countByDomain(clusters, state, constraint.TopologyKey)
+	counters := make(map[string]*bindingCounterByDomain, len(grouped))
+	for group, members := range grouped {
+		counters[group] = countByDomain(members, state, level.topologyKey)
+	}
+	return counters
+}
+
+// evaluateConstraint evaluates a topology spread constraint, level by level, against all
+// clusters being inspected in the current scheduling cycle; violations (if the constraint
+// is a DoNotSchedule one) and scores are added to the given maps.
+func evaluateConstraint(
+	clusters []clusterv1beta1.MemberCluster,
+	state framework.CycleStatePluginReadWriter,
+	constraint *placementv1beta1.TopologySpreadConstraint,
+	violations doNotScheduleViolations,
+	scores topologySpreadScores,
+) error {
+	levels := levelsOf(constraint)
+	for idx := range levels {
+		level := &levels[idx]
+		counters := countByGroupAndDomain(clusters, state, level)
 
 		for _, cluster := range clusters {
 			if _, ok := violations[clusterName(cluster.Name)]; ok {
@@ -257,10 +311,11 @@ func evaluateAllConstraints(
 				continue
 			}
 
-			val, ok := cluster.Labels[constraint.TopologyKey]
-			if !ok {
-				// The cluster under inspection does not have the topology key and thus is not part
-				// of the spread.
+			group, inGroup := groupOf(&cluster, level.groupKeys)
+			val, ok := cluster.Labels[level.topologyKey]
+			if !inGroup || !ok {
+				// The cluster under inspection does not have the topology key (or the group keys)
+				// and thus is not part of the spread at this level.
 				//
 				// Placing resources on such clusters will not lead to topology spread constraint
 				// violations.
@@ -273,23 +328,60 @@ func evaluateAllConstraints(
 			// The cluster under inspection is part of the spread.
 
 			// Verify if the placement will violate the constraint.
-
-			// The default value for maxSkew is 1.
-			maxSkew := 1
-			if constraint.MaxSkew != nil {
-				maxSkew = int(*constraint.MaxSkew)
-			}
-			violated, skewChange, err := willViolate(domainCounter, domainName(val), maxSkew)
+			counter := counters[group]
+			violated, skewChange, err := willViolateWithMinDomains(counter, domainName(val), level.maxSkew, level.minDomains)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to evaluate ScheduleAnyway topology spread constraints: %w", err)
+				return err
 			}
-			if violated {
+			switch {
+			case violated && constraint.WhenUnsatisfiable == placementv1beta1.ScheduleAnyway:
 				// A violation happens; since this is a ScheduleAnyway topology spread constraint,
 				// a violation score penalty is applied to the score.
 				scores[clusterName(cluster.Name)] -= maxSkewViolationPenality
-				continue
+			case violated:
+				// A violation happens.
+				reason := fmt.Sprintf(doNotScheduleConstraintViolationReasonTemplate, level.topologyKey, level.maxSkew)
+				if len(counter.counter) < level.minDomains {
+					reason = fmt.Sprintf(doNotScheduleMinDomainsViolationReasonTemplate, level.topologyKey, level.maxSkew, level.minDomains, len(counter.counter))
+				}
+				violations[clusterName(cluster.Name)] = violationReasons{reason}
+
+				// Untrack the cluster's score.
+				delete(scores, clusterName(cluster.Name))
+			default:
+				scores[clusterName(cluster.Name)] += skewChange * int32(skewChangeScoreFactor)
 			}
-			scores[clusterName(cluster.Name)] += skewChange * int32(skewChangeScoreFactor)
+		}
+	}
+	return nil
+}
+
+// evaluateAllConstraints evaluates all topology spread constraints in a policy againsts all
+// clusters being inspected in the current scheduling cycle.
+//
+// Note that every cluster that does not lead to violations will be assigned a score, even if
+// the cluster does not concern any of the topology spread constraints.
+func evaluateAllConstraints(
+	state framework.CycleStatePluginReadWriter,
+	doNotSchedule, scheduleAnyway []*placementv1beta1.TopologySpreadConstraint,
+) (violations doNotScheduleViolations, scores topologySpreadScores, err error) {
+	violations = make(doNotScheduleViolations)
+	// Note that this function guarantees that all clusters that do not lead to violations of
+	// DoNotSchedule topology spread constraints will be assigned a score, even if none of the
+	// topology spread constraints concerns these clusters.
+	scores = make(topologySpreadScores)
+
+	clusters := state.ListClusters()
+
+	for _, constraint := range doNotSchedule {
+		if err := evaluateConstraint(clusters, state, constraint, violations, scores); err != nil {
+			return nil, nil, fmt.Errorf("failed to evaluate DoNotSchedule topology spread constraints: %w", err)
+		}
+	}
+
+	for _, constraint := range scheduleAnyway {
+		if err := evaluateConstraint(clusters, state, constraint, violations, scores); err != nil {
+			return nil, nil, fmt.Errorf("failed to evaluate ScheduleAnyway topology spread constraints: %w", err)
 		}
 	}
 
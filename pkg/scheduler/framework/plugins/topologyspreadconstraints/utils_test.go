@@ -22,6 +22,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -964,6 +965,152 @@ func TestWillViolate(t *testing.T) {
 	}
 }
 
+// TestWillViolateWithMinDomains tests the willViolateWithMinDomains function.
+func TestWillViolateWithMinDomains(t *testing.T) {
+	counter := &bindingCounterByDomain{
+		counter: map[domainName]count{
+			topologyValue1: 1,
+			topologyValue2: 2,
+		},
+		smallest:       1,
+		secondSmallest: 2,
+		largest:        2,
+	}
+
+	testCases := []struct {
+		name           string
+		dn             domainName
+		maxSkew        int
+		minDomains     int
+		wantViolated   bool
+		wantSkewChange int32
+		expectedToFail bool
+	}{
+		{
+			name:           "enough domains, pick smallest",
+			dn:             topologyValue1,
+			maxSkew:        1,
+			minDomains:     2,
+			wantViolated:   false,
+			wantSkewChange: -1,
+		},
+		{
+			name:           "not enough domains, pick smallest, no violation",
+			dn:             topologyValue1,
+			maxSkew:        2,
+			minDomains:     3,
+			wantViolated:   false,
+			wantSkewChange: 0,
+		},
+		{
+			name:           "not enough domains, pick smallest, violated",
+			dn:             topologyValue1,
+			maxSkew:        1,
+			minDomains:     3,
+			wantViolated:   true,
+			wantSkewChange: 0,
+		},
+		{
+			name:           "not enough domains, pick largest, violated",
+			dn:             topologyValue2,
+			maxSkew:        2,
+			minDomains:     3,
+			wantViolated:   true,
+			wantSkewChange: 1,
+		},
+		{
+			name:           "not enough domains, unknown domain",
+			dn:             topologyValue3,
+			maxSkew:        2,
+			minDomains:     3,
+			expectedToFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			violated, skewChange, err := willViolateWithMinDomains(counter, tc.dn, tc.maxSkew, tc.minDomains)
+			if tc.expectedToFail {
+				if err == nil {
+					t.Errorf("willViolateWithMinDomains(), want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("willViolateWithMinDomains() = %v, want no error", err)
+			}
+			if violated != tc.wantViolated {
+				t.Errorf("willViolateWithMinDomains() violated = %t, want %t", violated, tc.wantViolated)
+			}
+			if skewChange != tc.wantSkewChange {
+				t.Errorf("willViolateWithMinDomains() skewChange: got %v, want %v", skewChange, tc.wantSkewChange)
+			}
+		})
+	}
+}
+
+// TestLevelsOf tests the levelsOf function.
+func TestLevelsOf(t *testing.T) {
+	testCases := []struct {
+		name       string
+		constraint *placementv1beta1.TopologySpreadConstraint
+		want       []spreadLevel
+	}{
+		{
+			name: "single level, defaults",
+			constraint: &placementv1beta1.TopologySpreadConstraint{
+				TopologyKey: topologyKey1,
+			},
+			want: []spreadLevel{
+				{
+					topologyKey: topologyKey1,
+					maxSkew:     1,
+					minDomains:  1,
+				},
+			},
+		},
+		{
+			name: "nested levels with match label keys",
+			constraint: &placementv1beta1.TopologySpreadConstraint{
+				MaxSkew:            ptr.To(int32(2)),
+				MinDomains:         ptr.To(int32(3)),
+				TopologyKey:        topologyKey1,
+				MatchLabelKeys:     []string{topologyKey3},
+				NestedTopologyKeys: []string{topologyKey2, topologyValue1},
+			},
+			want: []spreadLevel{
+				{
+					topologyKey: topologyKey1,
+					groupKeys:   []string{topologyKey3},
+					maxSkew:     2,
+					minDomains:  3,
+				},
+				{
+					topologyKey: topologyKey2,
+					groupKeys:   []string{topologyKey3, topologyKey1},
+					maxSkew:     2,
+					minDomains:  1,
+				},
+				{
+					topologyKey: topologyValue1,
+					groupKeys:   []string{topologyKey3, topologyKey1, topologyKey2},
+					maxSkew:     2,
+					minDomains:  1,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := levelsOf(tc.constraint)
+			if diff := cmp.Diff(got, tc.want, cmp.AllowUnexported(spreadLevel{})); diff != "" {
+				t.Errorf("levelsOf() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
 // TestEvaluateAllConstraints tests the evaluateAllConstraints function.
 func TestEvaluateAllConstraints(t *testing.T) {
 	maxSkew1 := int32(2)
@@ -1611,6 +1758,275 @@ func TestEvaluateAllConstraints(t *testing.T) {
 				clusterName5: -maxSkewViolationPenality,
 			},
 		},
+		{
+			name: "doNotSchedule topology spread constraint with min domains, not enough domains",
+			// Topology key 1:
+			// * Domain 1 (topology value 1): 1 binding
+			// * Domain 2 (topology value 2): 1 binding
+			//
+			// With 2 domains only (3 required), the global minimum is considered to be 0.
+			clusters: []clusterv1beta1.MemberCluster{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   clusterName1,
+						Labels: map[string]string{topologyKey1: topologyValue1},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   clusterName2,
+						Labels: map[string]string{topologyKey1: topologyValue1},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   clusterName3,
+						Labels: map[string]string{topologyKey1: topologyValue2},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   clusterName4,
+						Labels: map[string]string{topologyKey1: topologyValue2},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName5,
+					},
+				},
+			},
+			bindings: []*placementv1beta1.ClusterResourceBinding{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bindingName1,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: clusterName1,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bindingName2,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: clusterName3,
+					},
+				},
+			},
+			doNotSchedule: []*placementv1beta1.TopologySpreadConstraint{
+				{
+					MaxSkew:           &maxSkew2,
+					MinDomains:        ptr.To(int32(3)),
+					TopologyKey:       topologyKey1,
+					WhenUnsatisfiable: placementv1beta1.DoNotSchedule,
+				},
+			},
+			scheduleAnyway: []*placementv1beta1.TopologySpreadConstraint{},
+			wantViolations: doNotScheduleViolations{
+				clusterName1: violationReasons{
+					fmt.Sprintf(doNotScheduleMinDomainsViolationReasonTemplate, topologyKey1, maxSkew2, 3, 2),
+				},
+				clusterName2: violationReasons{
+					fmt.Sprintf(doNotScheduleMinDomainsViolationReasonTemplate, topologyKey1, maxSkew2, 3, 2),
+				},
+				clusterName3: violationReasons{
+					fmt.Sprintf(doNotScheduleMinDomainsViolationReasonTemplate, topologyKey1, maxSkew2, 3, 2),
+				},
+				clusterName4: violationReasons{
+					fmt.Sprintf(doNotScheduleMinDomainsViolationReasonTemplate, topologyKey1, maxSkew2, 3, 2),
+				},
+			},
+			wantScores: topologySpreadScores{
+				clusterName5: 0,
+			},
+		},
+		{
+			name: "doNotSchedule topology spread constraint with nested topology keys",
+			// Topology key 1:
+			// * Domain 1 (topology value 1): 1 binding
+			// * Domain 2 (topology value 2): 1 binding
+			//
+			// Topology key 2, within domain 1 of topology key 1:
+			// * Domain 1 (topology value 1): 1 binding
+			// * Domain 2 (topology value 2): 0 binding
+			//
+			// Topology key 2, within domain 2 of topology key 1:
+			// * Domain 1 (topology value 1): 1 binding
+			clusters: []clusterv1beta1.MemberCluster{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName1,
+						Labels: map[string]string{
+							topologyKey1: topologyValue1,
+							topologyKey2: topologyValue1,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName2,
+						Labels: map[string]string{
+							topologyKey1: topologyValue1,
+							topologyKey2: topologyValue1,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName3,
+						Labels: map[string]string{
+							topologyKey1: topologyValue1,
+							topologyKey2: topologyValue2,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName4,
+						Labels: map[string]string{
+							topologyKey1: topologyValue2,
+							topologyKey2: topologyValue1,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName5,
+						Labels: map[string]string{
+							topologyKey1: topologyValue2,
+						},
+					},
+				},
+			},
+			bindings: []*placementv1beta1.ClusterResourceBinding{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bindingName1,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: clusterName1,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bindingName2,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: clusterName4,
+					},
+				},
+			},
+			doNotSchedule: []*placementv1beta1.TopologySpreadConstraint{
+				{
+					MaxSkew:            &maxSkew2,
+					TopologyKey:        topologyKey1,
+					NestedTopologyKeys: []string{topologyKey2},
+					WhenUnsatisfiable:  placementv1beta1.DoNotSchedule,
+				},
+			},
+			scheduleAnyway: []*placementv1beta1.TopologySpreadConstraint{},
+			wantViolations: doNotScheduleViolations{
+				clusterName1: violationReasons{
+					fmt.Sprintf(doNotScheduleConstraintViolationReasonTemplate, topologyKey2, maxSkew2),
+				},
+				clusterName2: violationReasons{
+					fmt.Sprintf(doNotScheduleConstraintViolationReasonTemplate, topologyKey2, maxSkew2),
+				},
+			},
+			wantScores: topologySpreadScores{
+				clusterName3: 0,
+				clusterName4: 2 * skewChangeScoreFactor,
+				clusterName5: skewChangeScoreFactor,
+			},
+		},
+		{
+			name: "doNotSchedule topology spread constraint with match label keys",
+			// Topology key 1, among clusters with topology value 1 for topology key 3:
+			// * Domain 1 (topology value 1): 1 binding
+			// * Domain 2 (topology value 2): 0 binding
+			//
+			// Topology key 1, among clusters with topology value 2 for topology key 3:
+			// * Domain 1 (topology value 1): 0 binding
+			clusters: []clusterv1beta1.MemberCluster{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName1,
+						Labels: map[string]string{
+							topologyKey1: topologyValue1,
+							topologyKey3: topologyValue1,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName2,
+						Labels: map[string]string{
+							topologyKey1: topologyValue1,
+							topologyKey3: topologyValue1,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName3,
+						Labels: map[string]string{
+							topologyKey1: topologyValue2,
+							topologyKey3: topologyValue1,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName4,
+						Labels: map[string]string{
+							topologyKey1: topologyValue1,
+							topologyKey3: topologyValue2,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName5,
+						Labels: map[string]string{
+							topologyKey1: topologyValue2,
+						},
+					},
+				},
+			},
+			bindings: []*placementv1beta1.ClusterResourceBinding{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: bindingName1,
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						TargetCluster: clusterName1,
+					},
+				},
+			},
+			doNotSchedule: []*placementv1beta1.TopologySpreadConstraint{
+				{
+					MaxSkew:           &maxSkew2,
+					TopologyKey:       topologyKey1,
+					MatchLabelKeys:    []string{topologyKey3},
+					WhenUnsatisfiable: placementv1beta1.DoNotSchedule,
+				},
+			},
+			scheduleAnyway: []*placementv1beta1.TopologySpreadConstraint{},
+			wantViolations: doNotScheduleViolations{
+				clusterName1: violationReasons{
+					fmt.Sprintf(doNotScheduleConstraintViolationReasonTemplate, topologyKey1, maxSkew2),
+				},
+				clusterName2: violationReasons{
+					fmt.Sprintf(doNotScheduleConstraintViolationReasonTemplate, topologyKey1, maxSkew2),
+				},
+			},
+			wantScores: topologySpreadScores{
+				clusterName3: -skewChangeScoreFactor,
+				clusterName4: skewChangeScoreFactor,
+				clusterName5: 0,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,555 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+)
+
+const (
+	// preemptingReason is the reason of the event the scheduler emits when it preempts
+	// lower-priority placements on behalf of a placement.
+	preemptingReason = "PreemptingLowerPriorityPlacements"
+	// preemptingMessageFormat is the message format of the event the scheduler emits when it
+	// preempts lower-priority placements on behalf of a placement.
+	preemptingMessageFormat = "Preempting %d lower-priority placement(s) on cluster(s) %s"
+
+	// preemptionSourceName is the source name the scheduler framework reports when a cluster is
+	// filtered out because it has been nominated for a higher-priority placement.
+	preemptionSourceName = "Preemption"
+	// clusterNominatedReasonTemplate is the reason the scheduler framework reports when a cluster
+	// has been nominated for a higher-priority placement.
+	clusterNominatedReasonTemplate = "cluster is nominated for higher-priority placement %s"
+)
+
+// preemptionCandidate is a binding of another placement which the scheduler may preempt.
+type preemptionCandidate struct {
+	binding       placementv1beta1.BindingObj
+	placementName string
+	priority      int32
+}
+
+// runPreemption attempts to make room for a placement of the PickN placement type which cannot
+// find enough clusters, by preempting bindings of lower-priority placements from clusters that
+// have been filtered out.
+//
+// For each filtered cluster (in the order of their names), the scheduler adds the bindings on the
+// cluster as victims one by one, lowest priority (and then most recently created) first, and
+// re-runs the Filter plugins with the victims considered gone, until the cluster passes; the victims
+// that prove unnecessary are then spared, highest priority first. A cluster that never passes is
+// left alone. The scheduler stops once it has found enough clusters to cover the shortage.
+//
+// Victims are preempted through ClusterResourcePlacementEviction objects, which are subject to the
+// disruption budgets of their placements. While the evictions a placement has issued are in
+// progress, or for a cooldown period after they complete, the scheduler does not preempt on behalf
+// of the placement again; this allows the member clusters to report the released capacity. The
+// clusters are nominated for the placement in the meantime, i.e., they are filtered out for
+// placements of a lower priority (see collectClusterNominations), so that the victims do not land
+// back on them.
+//
+// At this moment preemption is supported for ClusterResourcePlacements only, as the eviction API
+// is only available for ClusterResourcePlacements.
+func (f *framework) runPreemption(
+	ctx context.Context,
+	state *CycleState,
+	placementKey queue.PlacementKey,
+	policy placementv1beta1.PolicySnapshotObj,
+	filtered filteredClusterWithStatusList,
+	shortage int,
+) (ctrl.Result, error) {
+	policyRef := klog.KObj(policy)
+
+	namespace, name, err := controller.ExtractNamespaceNameFromKey(placementKey)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(namespace) > 0 || shortage <= 0 || len(filtered) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	placement, err := controller.FetchPlacementFromKey(ctx, f.client, placementKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	priorityClass, err := controller.FetchPlacementPriorityClass(ctx, f.client, placement)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if priorityClass == nil || priorityClass.PreemptionPolicy == placementv1beta1.PreemptNever {
+		// The placement is not allowed to preempt other placements.
+		return ctrl.Result{}, nil
+	}
+
+	wait, err := f.cleanUpPreemptionEvictions(ctx, name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to clean up evictions issued for preemption", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+	if wait > 0 {
+		klog.V(2).InfoS("Preemption on behalf of the placement is in progress or cooling down; will retry later", "policySnapshot", policyRef, "wait", wait)
+		//lint:ignore SA1019 we need more time to fully migrate to RequeueAfter as we used these two fields separately.
+		return ctrl.Result{Requeue: true, RequeueAfter: wait}, nil
+	}
+
+	candidatesByCluster, err := f.listPreemptionCandidates(ctx, name, priorityClass.Value)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list preemption candidates", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+	if len(candidatesByCluster) == 0 {
+		klog.V(2).InfoS("No lower-priority placement to preempt", "policySnapshot", policyRef)
+		return ctrl.Result{}, nil
+	}
+
+	// Inspect the filtered clusters in a deterministic order.
+	sorted := make(filteredClusterWithStatusList, len(filtered))
+	copy(sorted, filtered)
+	sort.Sort(sorted)
+
+	victims := make([]*preemptionCandidate, 0)
+	nominated := make([]string, 0, shortage)
+	for _, fc := range sorted {
+		if len(nominated) >= shortage {
+			break
+		}
+		candidates := candidatesByCluster[fc.cluster.Name]
+		if len(candidates) == 0 {
+			continue
+		}
+		if _, found := state.nominatedClusters[fc.cluster.Name]; found {
+			// The cluster has been nominated for a placement of a higher priority; preempting
+			// placements on it would not make room for this placement.
+			continue
+		}
+
+		// Skip clusters that already pass the Filter plugins, i.e., clusters filtered out by
+		// scheduler extenders; preemption does not help with such clusters.
+		status := f.runFilterPluginsFor(ctx, state, policy, fc.cluster)
+		if status.IsInteralError() {
+			return ctrl.Result{}, status.AsError()
+		}
+		if status.IsSuccess() {
+			continue
+		}
+
+		clusterVictims, err := f.selectVictimsOn(ctx, state, policy, fc.cluster, candidates)
+		if err != nil {
+			klog.ErrorS(err, "Failed to select victims", "policySnapshot", policyRef, "cluster", fc.cluster.Name)
+			return ctrl.Result{}, err
+		}
+		if len(clusterVictims) == 0 {
+			continue
+		}
+		victims = append(victims, clusterVictims...)
+		nominated = append(nominated, fc.cluster.Name)
+	}
+
+	if len(victims) == 0 {
+		klog.V(2).InfoS("Preemption cannot make room for the placement on any cluster", "policySnapshot", policyRef)
+		return ctrl.Result{}, nil
+	}
+
+	for _, v := range victims {
+		if err := f.createPreemptionEviction(ctx, name, v); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	klog.V(2).InfoS("Preempted lower-priority placements", "policySnapshot", policyRef, "clusters", nominated, "victimCount", len(victims))
+	if f.eventRecorder != nil {
+		f.eventRecorder.Eventf(placement, corev1.EventTypeNormal, preemptingReason, preemptingMessageFormat, len(victims), strings.Join(nominated, ", "))
+	}
+
+	// Retry after the cooldown period, by which time the member clusters should have reported the
+	// capacity released by the preempted placements.
+	//lint:ignore SA1019 we need more time to fully migrate to RequeueAfter as we used these two fields separately.
+	return ctrl.Result{Requeue: true, RequeueAfter: f.preemptionCooldown}, nil
+}
+
+// selectVictimsOn finds the bindings to preempt on a cluster so that the cluster passes the Filter
+// plugins; it returns nil if no such set can be found. The victims found are kept in the cycle state
+// as preempted bindings.
+//
+// The candidates are expected to be sorted in the order the scheduler prefers preempting them.
+func (f *framework) selectVictimsOn(
+	ctx context.Context,
+	state *CycleState,
+	policy placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+	candidates []*preemptionCandidate,
+) ([]*preemptionCandidate, error) {
+	fits := func() (bool, error) {
+		status := f.runFilterPluginsFor(ctx, state, policy, cluster)
+		if status.IsInteralError() {
+			return false, status.AsError()
+		}
+		return status.IsSuccess(), nil
+	}
+
+	// Add candidates as victims until the cluster passes the Filter plugins.
+	var victims []*preemptionCandidate
+	for idx, c := range candidates {
+		state.preemptedBindings.Insert(bindingKeyOf(c.binding))
+		ok, err := fits()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			victims = candidates[:idx+1]
+			break
+		}
+	}
+	if victims == nil {
+		// Preempting all the candidates does not help; roll back.
+		for _, c := range candidates {
+			state.preemptedBindings.Delete(bindingKeyOf(c.binding))
+		}
+		return nil, nil
+	}
+
+	// Spare the victims whose preemption proves unnecessary, highest priority first.
+	spared := make(map[int]bool)
+	for idx := len(victims) - 1; idx >= 0; idx-- {
+		key := bindingKeyOf(victims[idx].binding)
+		state.preemptedBindings.Delete(key)
+		ok, err := fits()
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			spared[idx] = true
+			continue
+		}
+		state.preemptedBindings.Insert(key)
+	}
+
+	selected := make([]*preemptionCandidate, 0, len(victims)-len(spared))
+	for idx, v := range victims {
+		if !spared[idx] {
+			selected = append(selected, v)
+		}
+	}
+	return selected, nil
+}
+
+// listPreemptionCandidates lists the bindings that a placement of the given priority may preempt,
+// grouped by their target clusters; the bindings on each cluster are sorted in the order the
+// scheduler prefers preempting them, i.e., lowest priority first, and then most recently created
+// first.
+//
+// Bindings of placements of the PickFixed placement type are never preempted, as the eviction API
+// does not support such placements.
+func (f *framework) listPreemptionCandidates(ctx context.Context, preemptor string, priority int32) (map[string][]*preemptionCandidate, error) {
+	bindingList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := f.client.List(ctx, bindingList); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+
+	// Cache the priority of each placement, as a placement usually has multiple bindings; a
+	// placement that cannot be preempted has its entry set to nil.
+	priorityByPlacement := make(map[string]*int32)
+	priorityOf := func(placementName string) (*int32, error) {
+		if p, found := priorityByPlacement[placementName]; found {
+			return p, nil
+		}
+		crp := &placementv1beta1.ClusterResourcePlacement{}
+		if err := f.client.Get(ctx, types.NamespacedName{Name: placementName}, crp); err != nil {
+			if apierrors.IsNotFound(err) {
+				priorityByPlacement[placementName] = nil
+				return nil, nil
+			}
+			return nil, controller.NewAPIServerError(true, err)
+		}
+		if crp.GetDeletionTimestamp() != nil || (crp.Spec.Policy != nil && crp.Spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType) {
+			priorityByPlacement[placementName] = nil
+			return nil, nil
+		}
+		priorityClass, err := controller.FetchPlacementPriorityClass(ctx, f.client, crp)
+		if err != nil {
+			return nil, err
+		}
+		p := controller.PlacementPriorityOf(priorityClass)
+		priorityByPlacement[placementName] = &p
+		return &p, nil
+	}
+
+	candidatesByCluster := make(map[string][]*preemptionCandidate)
+	for idx := range bindingList.Items {
+		binding := &bindingList.Items[idx]
+		placementName := binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+		if placementName == preemptor || len(placementName) == 0 || binding.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if binding.Spec.State != placementv1beta1.BindingStateScheduled && binding.Spec.State != placementv1beta1.BindingStateBound {
+			continue
+		}
+		p, err := priorityOf(placementName)
+		if err != nil {
+			return nil, err
+		}
+		if p == nil || *p >= priority {
+			continue
+		}
+		cluster := binding.Spec.TargetCluster
+		candidatesByCluster[cluster] = append(candidatesByCluster[cluster], &preemptionCandidate{
+			binding:       binding,
+			placementName: placementName,
+			priority:      *p,
+		})
+	}
+
+	for _, candidates := range candidatesByCluster {
+		sort.Slice(candidates, func(i, j int) bool {
+			a, b := candidates[i], candidates[j]
+			if a.priority != b.priority {
+				return a.priority < b.priority
+			}
+			aCreatedAt, bCreatedAt := a.binding.GetCreationTimestamp(), b.binding.GetCreationTimestamp()
+			if !aCreatedAt.Equal(&bCreatedAt) {
+				return bCreatedAt.Before(&aCreatedAt)
+			}
+			return a.binding.GetName() < b.binding.GetName()
+		})
+	}
+	return candidatesByCluster, nil
+}
+
+// cleanUpPreemptionEvictions deletes the evictions issued on behalf of a placement which have
+// completed for longer than the cooldown period; it returns how long the scheduler should wait
+// before preempting on behalf of the placement again.
+func (f *framework) cleanUpPreemptionEvictions(ctx context.Context, preemptor string) (time.Duration, error) {
+	evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+	if err := f.client.List(ctx, evictionList, client.MatchingLabels{placementv1beta1.PreemptorPlacementLabel: preemptor}); err != nil {
+		return 0, controller.NewAPIServerError(true, err)
+	}
+
+	var wait time.Duration
+	for idx := range evictionList.Items {
+		eviction := &evictionList.Items[idx]
+		if !evictionutils.IsEvictionInTerminalState(eviction) {
+			// Check back after a cooldown period.
+			wait = max(wait, f.preemptionCooldown)
+			continue
+		}
+		if remaining := time.Until(eviction.CreationTimestamp.Add(f.preemptionCooldown)); remaining > 0 {
+			wait = max(wait, remaining)
+			continue
+		}
+		if err := f.client.Delete(ctx, eviction); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete a completed eviction", "clusterResourcePlacementEviction", klog.KObj(eviction))
+			return 0, controller.NewAPIServerError(false, err)
+		}
+	}
+	return wait, nil
+}
+
+// createPreemptionEviction creates an eviction that preempts a binding on behalf of a placement.
+func (f *framework) createPreemptionEviction(ctx context.Context, preemptor string, victim *preemptionCandidate) error {
+	clusterName := victim.binding.GetBindingSpec().TargetCluster
+	eviction := &placementv1beta1.ClusterResourcePlacementEviction{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-%s-", victim.placementName, clusterName),
+			Labels: map[string]string{
+				placementv1beta1.PreemptorPlacementLabel: preemptor,
+			},
+		},
+		Spec: placementv1beta1.PlacementEvictionSpec{
+			PlacementName: victim.placementName,
+			ClusterName:   clusterName,
+		},
+	}
+	if err := f.client.Create(ctx, eviction); err != nil {
+		klog.ErrorS(err, "Failed to create eviction for preemption", "preemptor", preemptor, "clusterResourcePlacement", victim.placementName, "cluster", clusterName)
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Issued an eviction to preempt a lower-priority placement",
+		"preemptor", preemptor, "clusterResourcePlacement", victim.placementName,
+		"clusterResourcePlacementEviction", klog.KObj(eviction), "cluster", clusterName, "priority", victim.priority)
+	return nil
+}
+
+// collectClusterNominations collects the clusters that the scheduler has nominated, by preempting
+// other placements on them, for placements of a higher priority than the placement being scheduled;
+// it returns the names of the nominated clusters, each mapped to the placement it is nominated for.
+//
+// A nomination lasts until the preemptor is scheduled to the cluster, no longer needs more clusters
+// (i.e., its latest policy snapshot has been fully scheduled), or is deleted; while it lasts, the
+// cluster is filtered out for placements of a lower priority, so that the preempted placements do
+// not take back the cluster that has been freed for the preemptor.
+//
+// It returns nil if preemption is not enabled, or no cluster has been nominated.
+func (f *framework) collectClusterNominations(ctx context.Context, placementKey queue.PlacementKey) (map[string]string, error) {
+	if !f.enablePreemption {
+		return nil, nil
+	}
+
+	evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+	switch err := f.client.List(ctx, evictionList, client.HasLabels{placementv1beta1.PreemptorPlacementLabel}); {
+	case meta.IsNoMatchError(err):
+		// The eviction APIs are not enabled in the fleet.
+		return nil, nil
+	case err != nil:
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	if len(evictionList.Items) == 0 {
+		return nil, nil
+	}
+
+	placement, err := controller.FetchPlacementFromKey(ctx, f.client, placementKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	priorityClass, err := controller.FetchPlacementPriorityClass(ctx, f.client, placement)
+	if err != nil {
+		return nil, err
+	}
+	priority := controller.PlacementPriorityOf(priorityClass)
+
+	// Multiple evictions are usually issued on behalf of the same preemptor; cache the lookup results.
+	nominationsByPreemptor := make(map[string]*preemptorNominations)
+	nominationsOf := func(preemptor string) (*preemptorNominations, error) {
+		if n, found := nominationsByPreemptor[preemptor]; found {
+			return n, nil
+		}
+		n, err := f.lookupPreemptorNominations(ctx, preemptor, priority)
+		if err != nil {
+			return nil, err
+		}
+		nominationsByPreemptor[preemptor] = n
+		return n, nil
+	}
+
+	nominated := make(map[string]string)
+	for idx := range evictionList.Items {
+		eviction := &evictionList.Items[idx]
+		preemptor := eviction.Labels[placementv1beta1.PreemptorPlacementLabel]
+		clusterName := eviction.Spec.ClusterName
+		if (placement.GetNamespace() == "" && preemptor == placement.GetName()) || len(nominated[clusterName]) > 0 {
+			continue
+		}
+		validCondition := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeValid))
+		executedCondition := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeExecuted))
+		if condition.IsConditionStatusFalse(validCondition, eviction.GetGeneration()) || condition.IsConditionStatusFalse(executedCondition, eviction.GetGeneration()) {
+			// The eviction has not freed the cluster.
+			continue
+		}
+		n, err := nominationsOf(preemptor)
+		if err != nil {
+			return nil, err
+		}
+		if n.holds(clusterName) {
+			nominated[clusterName] = preemptor
+		}
+	}
+	if len(nominated) == 0 {
+		return nil, nil
+	}
+	klog.V(2).InfoS("Found clusters nominated for higher-priority placements", "placement", placementKey, "nominatedClusters", nominated)
+	return nominated, nil
+}
+
+// preemptorNominations describes the nominations a preemptor holds.
+type preemptorNominations struct {
+	// scheduled is the set of clusters the preemptor has been scheduled to.
+	scheduled sets.Set[string]
+}
+
+// holds returns whether the preemptor still holds the nomination of a cluster it has freed, i.e.,
+// it has not been scheduled to the cluster yet.
+func (n *preemptorNominations) holds(clusterName string) bool {
+	return n != nil && !n.scheduled.Has(clusterName)
+}
+
+// lookupPreemptorNominations looks up the nominations a preemptor holds, for placements of the
+// given priority; it returns nil if the preemptor does not hold any nomination, i.e., it is gone,
+// being deleted, not of a higher priority, or has been fully scheduled.
+func (f *framework) lookupPreemptorNominations(ctx context.Context, preemptor string, priority int32) (*preemptorNominations, error) {
+	crp := &placementv1beta1.ClusterResourcePlacement{}
+	if err := f.client.Get(ctx, types.NamespacedName{Name: preemptor}, crp); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	if crp.GetDeletionTimestamp() != nil {
+		return nil, nil
+	}
+	priorityClass, err := controller.FetchPlacementPriorityClass(ctx, f.client, crp)
+	if err != nil {
+		return nil, err
+	}
+	if controller.PlacementPriorityOf(priorityClass) <= priority {
+		return nil, nil
+	}
+
+	policySnapshotList, err := controller.FetchLatestPolicySnapshot(ctx, f.client, types.NamespacedName{Name: preemptor})
+	if err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	policySnapshots := policySnapshotList.GetPolicySnapshotObjs()
+	if len(policySnapshots) == 1 {
+		scheduledCondition := policySnapshots[0].GetCondition(string(placementv1beta1.PolicySnapshotScheduled))
+		if condition.IsConditionStatusTrue(scheduledCondition, policySnapshots[0].GetGeneration()) {
+			// The preemptor has found all the clusters it needs.
+			return nil, nil
+		}
+	}
+
+	bindingList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := f.client.List(ctx, bindingList, client.MatchingLabels{placementv1beta1.PlacementTrackingLabel: preemptor}); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	scheduledClusters := sets.New[string]()
+	for idx := range bindingList.Items {
+		binding := &bindingList.Items[idx]
+		if binding.DeletionTimestamp == nil && (binding.Spec.State == placementv1beta1.BindingStateScheduled || binding.Spec.State == placementv1beta1.BindingStateBound) {
+			scheduledClusters.Insert(binding.Spec.TargetCluster)
+		}
+	}
+	return &preemptorNominations{scheduled: scheduledClusters}, nil
+}
+
+// bindingKeyOf returns the namespaced name of a binding.
+func bindingKeyOf(binding placementv1beta1.BindingObj) types.NamespacedName {
+	return types.NamespacedName{Namespace: binding.GetNamespace(), Name: binding.GetName()}
+}
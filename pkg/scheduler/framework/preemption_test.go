@@ -0,0 +1,453 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+)
+
+// TestRunPreemption tests the runPreemption method.
+func TestRunPreemption(t *testing.T) {
+	filterPluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
+	lowCRPName, midCRPName, fixedCRPName := "crp-low", "crp-mid", "crp-fixed"
+	cooldown := time.Minute * 5
+	now := time.Now()
+
+	newPriorityClass := func(name string, value int32, policy placementv1beta1.PreemptionPolicy) *placementv1beta1.PlacementPriorityClass {
+		return &placementv1beta1.PlacementPriorityClass{
+			ObjectMeta:       metav1.ObjectMeta{Name: name},
+			Value:            value,
+			PreemptionPolicy: policy,
+		}
+	}
+	newCRP := func(name, priorityClassName string, placementType placementv1beta1.PlacementType) *placementv1beta1.ClusterResourcePlacement {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: placementv1beta1.PlacementSpec{
+				Policy:            &placementv1beta1.PlacementPolicy{PlacementType: placementType},
+				PriorityClassName: priorityClassName,
+			},
+		}
+	}
+	newCRB := func(crpName, clusterName string, createdAt time.Time) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("%s-%s", crpName, clusterName),
+				Labels:            map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+				CreationTimestamp: metav1.NewTime(createdAt),
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:         placementv1beta1.BindingStateBound,
+				TargetCluster: clusterName,
+			},
+		}
+	}
+	newEviction := func(crpName, clusterName string, createdAt time.Time, executed bool) *placementv1beta1.ClusterResourcePlacementEviction {
+		eviction := &placementv1beta1.ClusterResourcePlacementEviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("%s-%s", crpName, clusterName),
+				Labels:            map[string]string{placementv1beta1.PreemptorPlacementLabel: crpName},
+				CreationTimestamp: metav1.NewTime(createdAt),
+			},
+			Spec: placementv1beta1.PlacementEvictionSpec{
+				PlacementName: lowCRPName,
+				ClusterName:   clusterName,
+			},
+		}
+		if executed {
+			eviction.Status.Conditions = []metav1.Condition{
+				{Type: string(placementv1beta1.PlacementEvictionConditionTypeExecuted), Status: metav1.ConditionTrue},
+			}
+		}
+		return eviction
+	}
+
+	priorityClasses := []client.Object{
+		newPriorityClass("high", 1000, placementv1beta1.PreemptLowerPriority),
+		newPriorityClass("high-never", 1000, placementv1beta1.PreemptNever),
+		newPriorityClass("mid", 100, placementv1beta1.PreemptLowerPriority),
+	}
+	victims := []client.Object{
+		newCRP(lowCRPName, "", placementv1beta1.PickNPlacementType),
+		newCRP(midCRPName, "mid", placementv1beta1.PickNPlacementType),
+		newCRP(fixedCRPName, "", placementv1beta1.PickFixedPlacementType),
+		newCRB(lowCRPName, clusterName, now.Add(-time.Hour)),
+		newCRB(midCRPName, clusterName, now.Add(-time.Hour)),
+		newCRB(fixedCRPName, altClusterName, now.Add(-time.Hour)),
+	}
+	lowBindingKey := types.NamespacedName{Name: fmt.Sprintf("%s-%s", lowCRPName, clusterName)}
+	midBindingKey := types.NamespacedName{Name: fmt.Sprintf("%s-%s", midCRPName, clusterName)}
+	fixedBindingKey := types.NamespacedName{Name: fmt.Sprintf("%s-%s", fixedCRPName, altClusterName)}
+
+	testCases := []struct {
+		name string
+		// fitsIfPreempted returns whether a cluster passes the Filter stage given the set of
+		// preempted bindings.
+		fitsIfPreempted  func(cluster string, state CycleStatePluginReadWriter) bool
+		priorityClass    string
+		objects          []client.Object
+		shortage         int
+		wantRequeueAfter bool
+		// wantEvictions are the evictions issued, in the form of placement/cluster.
+		wantEvictions []string
+	}{
+		{
+			name:          "preempts the lowest-priority placement",
+			priorityClass: "high",
+			fitsIfPreempted: func(_ string, state CycleStatePluginReadWriter) bool {
+				return state.IsBindingPreempted(lowBindingKey) || state.IsBindingPreempted(midBindingKey)
+			},
+			shortage:         1,
+			wantRequeueAfter: true,
+			wantEvictions:    []string{fmt.Sprintf("%s/%s", lowCRPName, clusterName)},
+		},
+		{
+			name:          "spares placements whose preemption does not help",
+			priorityClass: "high",
+			fitsIfPreempted: func(_ string, state CycleStatePluginReadWriter) bool {
+				return state.IsBindingPreempted(midBindingKey)
+			},
+			shortage:         1,
+			wantRequeueAfter: true,
+			wantEvictions:    []string{fmt.Sprintf("%s/%s", midCRPName, clusterName)},
+		},
+		{
+			name:          "never preempts placements of the same or a higher priority",
+			priorityClass: "mid",
+			fitsIfPreempted: func(_ string, state CycleStatePluginReadWriter) bool {
+				return state.IsBindingPreempted(midBindingKey)
+			},
+			shortage: 1,
+		},
+		{
+			name:          "never preempts placements of the PickFixed placement type",
+			priorityClass: "high",
+			fitsIfPreempted: func(_ string, state CycleStatePluginReadWriter) bool {
+				return state.IsBindingPreempted(fixedBindingKey)
+			},
+			shortage: 1,
+		},
+		{
+			name:          "preemption policy is Never",
+			priorityClass: "high-never",
+			fitsIfPreempted: func(_ string, state CycleStatePluginReadWriter) bool {
+				return state.IsBindingPreempted(lowBindingKey)
+			},
+			shortage: 1,
+		},
+		{
+			name: "no priority class",
+			fitsIfPreempted: func(_ string, state CycleStatePluginReadWriter) bool {
+				return state.IsBindingPreempted(lowBindingKey)
+			},
+			shortage: 1,
+		},
+		{
+			name:          "preemption does not help",
+			priorityClass: "high",
+			fitsIfPreempted: func(_ string, _ CycleStatePluginReadWriter) bool {
+				return false
+			},
+			shortage: 1,
+		},
+		{
+			name:          "evictions in flight",
+			priorityClass: "high",
+			fitsIfPreempted: func(_ string, state CycleStatePluginReadWriter) bool {
+				return state.IsBindingPreempted(lowBindingKey)
+			},
+			objects:          []client.Object{newEviction(crpName, clusterName, now.Add(-time.Hour), false)},
+			shortage:         1,
+			wantRequeueAfter: true,
+			wantEvictions:    []string{fmt.Sprintf("%s/%s", lowCRPName, clusterName)},
+		},
+		{
+			name:          "cooling down",
+			priorityClass: "high",
+			fitsIfPreempted: func(_ string, state CycleStatePluginReadWriter) bool {
+				return state.IsBindingPreempted(lowBindingKey)
+			},
+			objects:          []client.Object{newEviction(crpName, clusterName, now.Add(-time.Minute), true)},
+			shortage:         1,
+			wantRequeueAfter: true,
+			wantEvictions:    []string{fmt.Sprintf("%s/%s", lowCRPName, clusterName)},
+		},
+		{
+			name:          "cleans up completed evictions",
+			priorityClass: "high",
+			fitsIfPreempted: func(_ string, state CycleStatePluginReadWriter) bool {
+				return state.IsBindingPreempted(lowBindingKey)
+			},
+			objects:          []client.Object{newEviction(crpName, clusterName, now.Add(-time.Hour), true)},
+			shortage:         1,
+			wantRequeueAfter: true,
+			wantEvictions:    []string{fmt.Sprintf("%s/%s", lowCRPName, clusterName)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := append([]client.Object{newCRP(crpName, tc.priorityClass, placementv1beta1.PickNPlacementType)}, priorityClasses...)
+			objects = append(objects, victims...)
+			objects = append(objects, tc.objects...)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(objects...).
+				Build()
+
+			profile := NewProfile(dummyProfileName)
+			profile.WithFilterPlugin(&DummyAllPurposePlugin{
+				name: filterPluginName,
+				filterRunner: func(_ context.Context, state CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (status *Status) {
+					if tc.fitsIfPreempted(cluster.Name, state) {
+						return nil
+					}
+					return NewNonErrorStatus(ClusterUnschedulable, filterPluginName, "cluster is full")
+				},
+			})
+			f := &framework{
+				profile:            profile,
+				client:             fakeClient,
+				parallelizer:       parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
+				enablePreemption:   true,
+				preemptionCooldown: cooldown,
+			}
+
+			filtered := filteredClusterWithStatusList{
+				{cluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}, status: defaultFilteredStatus},
+				{cluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}}, status: defaultFilteredStatus},
+			}
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{ObjectMeta: metav1.ObjectMeta{Name: policyName}}
+			state := NewCycleState(nil, nil)
+			res, err := f.runPreemption(context.Background(), state, queue.PlacementKey(crpName), policy, filtered, tc.shortage)
+			if err != nil {
+				t.Fatalf("runPreemption() = %v, want no error", err)
+			}
+			if gotRequeueAfter := res.RequeueAfter > 0; gotRequeueAfter != tc.wantRequeueAfter {
+				t.Errorf("runPreemption() requeue after = %v, want requeue after %v", res.RequeueAfter, tc.wantRequeueAfter)
+			}
+
+			evictionList := &placementv1beta1.ClusterResourcePlacementEvictionList{}
+			if err := fakeClient.List(context.Background(), evictionList); err != nil {
+				t.Fatalf("List() evictions = %v, want no error", err)
+			}
+			gotEvictions := []string{}
+			for _, eviction := range evictionList.Items {
+				if eviction.Labels[placementv1beta1.PreemptorPlacementLabel] != crpName {
+					t.Errorf("eviction %s has preemptor label %q, want %q", eviction.Name, eviction.Labels[placementv1beta1.PreemptorPlacementLabel], crpName)
+				}
+				gotEvictions = append(gotEvictions, fmt.Sprintf("%s/%s", eviction.Spec.PlacementName, eviction.Spec.ClusterName))
+			}
+			sort.Strings(gotEvictions)
+			wantEvictions := tc.wantEvictions
+			if wantEvictions == nil {
+				wantEvictions = []string{}
+			}
+			if diff := cmp.Diff(gotEvictions, wantEvictions); diff != "" {
+				t.Errorf("evictions diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestCollectClusterNominations tests the collectClusterNominations method.
+func TestCollectClusterNominations(t *testing.T) {
+	highCRPName, lowCRPName := "crp-high", "crp-low"
+
+	newCRP := func(name, priorityClassName string) *placementv1beta1.ClusterResourcePlacement {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: placementv1beta1.PlacementSpec{
+				Policy:            &placementv1beta1.PlacementPolicy{PlacementType: placementv1beta1.PickNPlacementType},
+				PriorityClassName: priorityClassName,
+			},
+		}
+	}
+	newEviction := func(preemptor, clusterName string, valid bool) *placementv1beta1.ClusterResourcePlacementEviction {
+		status := metav1.ConditionTrue
+		if !valid {
+			status = metav1.ConditionFalse
+		}
+		return &placementv1beta1.ClusterResourcePlacementEviction{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("%s-%s", lowCRPName, clusterName),
+				Labels: map[string]string{placementv1beta1.PreemptorPlacementLabel: preemptor},
+			},
+			Spec: placementv1beta1.PlacementEvictionSpec{
+				PlacementName: lowCRPName,
+				ClusterName:   clusterName,
+			},
+			Status: placementv1beta1.PlacementEvictionStatus{
+				Conditions: []metav1.Condition{
+					{Type: string(placementv1beta1.PlacementEvictionConditionTypeValid), Status: status},
+				},
+			},
+		}
+	}
+	newCRB := func(crpName, clusterName string) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   fmt.Sprintf("%s-%s", crpName, clusterName),
+				Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:         placementv1beta1.BindingStateScheduled,
+				TargetCluster: clusterName,
+			},
+		}
+	}
+	newPolicySnapshot := func(crpName string, scheduled bool) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+		status := metav1.ConditionFalse
+		if scheduled {
+			status = metav1.ConditionTrue
+		}
+		return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("%s-0", crpName),
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: crpName,
+					placementv1beta1.IsLatestSnapshotLabel:  "true",
+				},
+			},
+			Status: placementv1beta1.SchedulingPolicySnapshotStatus{
+				Conditions: []metav1.Condition{
+					{Type: string(placementv1beta1.PolicySnapshotScheduled), Status: status},
+				},
+			},
+		}
+	}
+
+	priorityClasses := []client.Object{
+		&placementv1beta1.PlacementPriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "high"}, Value: 1000},
+		&placementv1beta1.PlacementPriorityClass{ObjectMeta: metav1.ObjectMeta{Name: "mid"}, Value: 100},
+	}
+
+	testCases := []struct {
+		name              string
+		disablePreemption bool
+		priorityClass     string
+		objects           []client.Object
+		want              map[string]string
+	}{
+		{
+			name:          "cluster nominated for a higher-priority placement",
+			priorityClass: "mid",
+			objects: []client.Object{
+				newCRP(highCRPName, "high"),
+				newPolicySnapshot(highCRPName, false),
+				newCRB(highCRPName, altClusterName),
+				newEviction(highCRPName, clusterName, true),
+			},
+			want: map[string]string{clusterName: highCRPName},
+		},
+		{
+			name:              "preemption disabled",
+			disablePreemption: true,
+			objects: []client.Object{
+				newCRP(highCRPName, "high"),
+				newEviction(highCRPName, clusterName, true),
+			},
+		},
+		{
+			name:          "no eviction",
+			priorityClass: "mid",
+			objects:       []client.Object{newCRP(highCRPName, "high")},
+		},
+		{
+			name:          "preemptor of the same priority",
+			priorityClass: "high",
+			objects: []client.Object{
+				newCRP(highCRPName, "high"),
+				newEviction(highCRPName, clusterName, true),
+			},
+		},
+		{
+			name: "placement is the preemptor",
+			objects: []client.Object{
+				newEviction(crpName, clusterName, true),
+			},
+		},
+		{
+			name: "preemptor is gone",
+			objects: []client.Object{
+				newEviction(highCRPName, clusterName, true),
+			},
+		},
+		{
+			name: "eviction is invalid",
+			objects: []client.Object{
+				newCRP(highCRPName, "high"),
+				newEviction(highCRPName, clusterName, false),
+			},
+		},
+		{
+			name: "preemptor has been scheduled to the cluster",
+			objects: []client.Object{
+				newCRP(highCRPName, "high"),
+				newCRB(highCRPName, clusterName),
+				newEviction(highCRPName, clusterName, true),
+			},
+		},
+		{
+			name: "preemptor has been fully scheduled",
+			objects: []client.Object{
+				newCRP(highCRPName, "high"),
+				newPolicySnapshot(highCRPName, true),
+				newEviction(highCRPName, clusterName, true),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := append([]client.Object{newCRP(crpName, tc.priorityClass)}, priorityClasses...)
+			objects = append(objects, tc.objects...)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(objects...).
+				Build()
+			f := &framework{
+				client:           fakeClient,
+				enablePreemption: !tc.disablePreemption,
+			}
+
+			got, err := f.collectClusterNominations(context.Background(), queue.PlacementKey(crpName))
+			if err != nil {
+				t.Fatalf("collectClusterNominations() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("collectClusterNominations() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
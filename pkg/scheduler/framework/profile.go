@@ -16,12 +16,34 @@ limitations under the License.
 
 package framework
 
+const (
+	// defaultScorePluginWeight is the weight of a ScorePlugin if no weight has been set explicitly.
+	defaultScorePluginWeight int32 = 1
+)
+
+// ScoreNormalization describes how the framework normalizes the raw scores score plugins give
+// before they are weighted and summed up.
+type ScoreNormalization string
+
+const (
+	// ScoreNormalizationNone instructs the framework to use the raw scores as they are; this is
+	// the default.
+	ScoreNormalizationNone ScoreNormalization = "None"
+	// ScoreNormalizationMinMax instructs the framework to normalize the raw scores each score plugin
+	// gives across all the candidate clusters into the range [MinNormalizedScore, MaxNormalizedScore]
+	// with min-max scaling.
+	//
+	// Note that this might change how clusters are ranked, as the differences between raw scores
+	// are scaled per plugin.
+	ScoreNormalizationMinMax ScoreNormalization = "MinMax"
+)
+
 // Profile specifies the scheduling profile a framework uses; it includes the plugins in use
 // by the framework at each extension point in order.
 //
-// At this moment, since Fleet does not support runtime profiles, all plugins are registered
-// directly to one universal profile, in their instantiated forms, rather than decoupled using
-// a factory registry and instantiated along with the profile's associated framework.
+// Plugins are registered to a profile in their instantiated forms, rather than decoupled using
+// a factory registry and instantiated along with the profile's associated framework; as a result,
+// each profile must have its own plugin instances.
 type Profile struct {
 	name string
 
@@ -35,6 +57,15 @@ type Profile struct {
 	// This helps to avoid setting up same plugin multiple times with the framework if the plugin
 	// registers at multiple extension points.
 	registeredPlugins map[string]Plugin
+
+	// scorePluginWeights is a map of the weights of score plugins, keyed by their names; the scores
+	// a plugin reports are multiplied by its weight before being summed up. Plugins that do not
+	// have a weight set are of the default weight, 1.
+	scorePluginWeights map[string]int32
+
+	// scoreNormalization is how the raw scores of score plugins are normalized; score plugins that
+	// implement the ScoreNormalizer interface always normalize their own scores.
+	scoreNormalization ScoreNormalization
 }
 
 // WithPostBatchPlugin registers a PostBatchPlugin to the profile.
@@ -72,6 +103,26 @@ func (profile *Profile) WithScorePlugin(plugin ScorePlugin) *Profile {
 	return profile
 }
 
+// WithScorePluginWeight sets the weight of a ScorePlugin in the profile.
+func (profile *Profile) WithScorePluginWeight(pluginName string, weight int32) *Profile {
+	profile.scorePluginWeights[pluginName] = weight
+	return profile
+}
+
+// scorePluginWeight returns the weight of a ScorePlugin in the profile.
+func (profile *Profile) scorePluginWeight(pluginName string) int32 {
+	if weight, ok := profile.scorePluginWeights[pluginName]; ok {
+		return weight
+	}
+	return defaultScorePluginWeight
+}
+
+// WithScoreNormalization sets how the raw scores of score plugins are normalized in the profile.
+func (profile *Profile) WithScoreNormalization(normalization ScoreNormalization) *Profile {
+	profile.scoreNormalization = normalization
+	return profile
+}
+
 // Name returns the name of the profile.
 func (profile *Profile) Name() string {
 	return profile.name
@@ -80,7 +131,9 @@ func (profile *Profile) Name() string {
 // NewProfile creates scheduling profile.
 func NewProfile(name string) *Profile {
 	return &Profile{
-		name:              name,
-		registeredPlugins: map[string]Plugin{},
+		name:               name,
+		registeredPlugins:  map[string]Plugin{},
+		scorePluginWeights: map[string]int32{},
+		scoreNormalization: ScoreNormalizationNone,
 	}
 }
@@ -0,0 +1,263 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// fleetResourceQuotaSourceName is the source name the scheduler framework reports when a
+	// cluster is filtered out because of fleet resource quotas.
+	fleetResourceQuotaSourceName = "FleetResourceQuota"
+
+	// clusterNotAllowedByQuotaReasonTemplate is the reason the scheduler framework reports when a
+	// cluster is not allowed by a fleet resource quota.
+	clusterNotAllowedByQuotaReasonTemplate = "cluster is not allowed by fleet resource quota %s"
+	// invalidQuotaSelectorReasonTemplate is the reason the scheduler framework reports when a
+	// fleet resource quota has an invalid allowed cluster selector, which allows no cluster.
+	invalidQuotaSelectorReasonTemplate = "fleet resource quota %s has an invalid allowed cluster selector, which allows no cluster"
+	// notPickedByQuotaReasonTemplate is the reason the scheduler framework reports in simulations
+	// when a cluster is not picked as the placement has selected as many clusters as the fleet
+	// resource quotas allow.
+	notPickedByQuotaReasonTemplate = "cluster %s is not picked as the placement has reached the maximum number of clusters (%d) that fleet resource quotas allow"
+)
+
+// fleetResourceQuotaLimits is the limits that all the fleet resource quotas in a namespace impose
+// on a placement in the namespace, in the current scheduling cycle.
+type fleetResourceQuotaLimits struct {
+	// maxClustersPerPlacement is the maximum number of clusters the placement can select; a
+	// negative value signals that the number is not limited.
+	maxClustersPerPlacement int
+	// remainingBindings is the number of bindings that can still be created in the namespace; a
+	// negative value signals that the number is not limited.
+	remainingBindings int
+	// clusterSelectors are the selectors (keyed by the names of their quotas) that a cluster
+	// must match for the placement to target it.
+	clusterSelectors map[string]labels.Selector
+	// invalidSelectorQuotas are the names of the quotas whose allowed cluster selectors are
+	// invalid; such quotas allow no cluster.
+	invalidSelectorQuotas []string
+
+	// disallowed is the list of clusters that are not allowed by the quotas.
+	disallowed []*filteredClusterWithStatus
+}
+
+// collectFleetResourceQuotaLimits collects the limits that the fleet resource quotas in the
+// namespace of a placement impose on the placement.
+//
+// It returns nil if fleet resource quotas are not enabled, the placement is cluster-scoped, or
+// there is no fleet resource quota in the namespace.
+//
+// Note that the number of bindings in the namespace is counted in each scheduling cycle on its own;
+// as the scheduler might run cycles for multiple placements in the same namespace concurrently, the
+// maximum number of bindings is enforced on a best-effort basis, i.e., concurrent cycles may
+// together create a few more bindings than the quotas allow.
+func (f *framework) collectFleetResourceQuotaLimits(ctx context.Context, namespace string) (*fleetResourceQuotaLimits, error) {
+	if !f.enableFleetResourceQuotas || namespace == "" {
+		return nil, nil
+	}
+
+	// Quotas are read directly from the API server for consistency reasons.
+	quotaList := &placementv1beta1.FleetResourceQuotaList{}
+	if err := f.uncachedReader.List(ctx, quotaList, client.InNamespace(namespace)); err != nil {
+		return nil, controller.NewAPIServerError(false, err)
+	}
+	if len(quotaList.Items) == 0 {
+		return nil, nil
+	}
+
+	limits := &fleetResourceQuotaLimits{
+		maxClustersPerPlacement: -1,
+		remainingBindings:       -1,
+		clusterSelectors:        make(map[string]labels.Selector),
+	}
+	maxBindings := -1
+	for idx := range quotaList.Items {
+		quota := &quotaList.Items[idx]
+		if quota.Spec.MaxClustersPerPlacement != nil {
+			limits.maxClustersPerPlacement = minLimit(limits.maxClustersPerPlacement, int(*quota.Spec.MaxClustersPerPlacement))
+		}
+		if quota.Spec.MaxBindings != nil {
+			maxBindings = minLimit(maxBindings, int(*quota.Spec.MaxBindings))
+		}
+		if quota.Spec.AllowedClusterSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(quota.Spec.AllowedClusterSelector)
+			if err != nil {
+				// The webhook rejects invalid selectors, but quotas created while the webhook was
+				// not in place might still have them; such a quota is a user error, and allows no
+				// cluster until it is fixed, which the scheduler reports as the reason why the
+				// clusters are filtered out.
+				klog.V(2).InfoS("Found an invalid allowed cluster selector in a fleet resource quota", "fleetResourceQuota", klog.KObj(quota), "err", err)
+				selector = labels.Nothing()
+				limits.invalidSelectorQuotas = append(limits.invalidSelectorQuotas, quota.Name)
+			}
+			limits.clusterSelectors[quota.Name] = selector
+		}
+	}
+	sort.Strings(limits.invalidSelectorQuotas)
+
+	if maxBindings >= 0 {
+		// Count all the scheduled or bound bindings in the namespace; bindings are read directly
+		// from the API server to avoid over-scheduling.
+		bindingList := &placementv1beta1.ResourceBindingList{}
+		if err := f.uncachedReader.List(ctx, bindingList, client.InNamespace(namespace)); err != nil {
+			return nil, controller.NewAPIServerError(false, err)
+		}
+		used := 0
+		for idx := range bindingList.Items {
+			binding := &bindingList.Items[idx]
+			if binding.DeletionTimestamp != nil {
+				continue
+			}
+			if binding.Spec.State == placementv1beta1.BindingStateScheduled || binding.Spec.State == placementv1beta1.BindingStateBound {
+				used++
+			}
+		}
+		limits.remainingBindings = max(maxBindings-used, 0)
+	}
+	return limits, nil
+}
+
+// allowedClusters returns the clusters that the quotas allow the placement to target; the
+// clusters that are not allowed are kept in the limits as filtered clusters.
+func (l *fleetResourceQuotaLimits) allowedClusters(clusters []clusterv1beta1.MemberCluster) []clusterv1beta1.MemberCluster {
+	if l == nil || len(l.clusterSelectors) == 0 {
+		return clusters
+	}
+
+	allowed := make([]clusterv1beta1.MemberCluster, 0, len(clusters))
+	for idx := range clusters {
+		cluster := &clusters[idx]
+		if reason, ok := l.allows(cluster); !ok {
+			l.disallowed = append(l.disallowed, &filteredClusterWithStatus{
+				cluster: cluster,
+				status:  NewNonErrorStatus(ClusterUnschedulable, fleetResourceQuotaSourceName, reason),
+			})
+			continue
+		}
+		allowed = append(allowed, *cluster)
+	}
+	return allowed
+}
+
+// allows returns whether the quotas allow the placement to target a cluster; if not, it also
+// returns the reason.
+func (l *fleetResourceQuotaLimits) allows(cluster *clusterv1beta1.MemberCluster) (string, bool) {
+	if l == nil {
+		return "", true
+	}
+	if len(l.invalidSelectorQuotas) > 0 {
+		return fmt.Sprintf(invalidQuotaSelectorReasonTemplate, strings.Join(l.invalidSelectorQuotas, ", ")), false
+	}
+	notAllowedBy := make([]string, 0, len(l.clusterSelectors))
+	for name, selector := range l.clusterSelectors {
+		if !selector.Matches(labels.Set(cluster.Labels)) {
+			notAllowedBy = append(notAllowedBy, name)
+		}
+	}
+	if len(notAllowedBy) == 0 {
+		return "", true
+	}
+	sort.Strings(notAllowedBy)
+	return fmt.Sprintf(clusterNotAllowedByQuotaReasonTemplate, strings.Join(notAllowedBy, ", ")), false
+}
+
+// partitionValidTargets moves the valid targets of a placement of the PickFixed placement type
+// that the quotas do not allow the placement to target to the list of invalid targets.
+func (l *fleetResourceQuotaLimits) partitionValidTargets(
+	valid []*clusterv1beta1.MemberCluster,
+	invalid []*invalidClusterWithReason,
+) ([]*clusterv1beta1.MemberCluster, []*invalidClusterWithReason) {
+	if l == nil || len(l.clusterSelectors) == 0 {
+		return valid, invalid
+	}
+
+	allowed := make([]*clusterv1beta1.MemberCluster, 0, len(valid))
+	for _, cluster := range valid {
+		if reason, ok := l.allows(cluster); !ok {
+			invalid = append(invalid, &invalidClusterWithReason{cluster: cluster, reason: reason})
+			continue
+		}
+		allowed = append(allowed, cluster)
+	}
+	return allowed, invalid
+}
+
+// filteredClusters returns the clusters that are not allowed by the quotas.
+func (l *fleetResourceQuotaLimits) filteredClusters() []*filteredClusterWithStatus {
+	if l == nil {
+		return nil
+	}
+	return l.disallowed
+}
+
+// capNewBindings trims the list of bindings to create so that the placement stays within the
+// quotas, given the number of bindings the placement keeps in the current scheduling cycle.
+//
+// Bindings at the front of the list are kept; callers should order the list by preference.
+func (l *fleetResourceQuotaLimits) capNewBindings(toCreate []placementv1beta1.BindingObj, kept int) []placementv1beta1.BindingObj {
+	if l == nil {
+		return toCreate
+	}
+	allowed := len(toCreate)
+	if l.maxClustersPerPlacement >= 0 {
+		allowed = min(allowed, max(l.maxClustersPerPlacement-kept, 0))
+	}
+	if l.remainingBindings >= 0 {
+		allowed = min(allowed, l.remainingBindings)
+	}
+	if allowed < len(toCreate) {
+		klog.V(2).InfoS("Trimmed the bindings to create per fleet resource quotas", "bindingCount", len(toCreate), "allowedCount", allowed)
+		return toCreate[:allowed]
+	}
+	return toCreate
+}
+
+// maxSelectedClusters returns the maximum number of clusters the quotas allow the placement to
+// select, given the number of scheduled or bound bindings the placement already has, which it can
+// keep; a negative value signals that the number is not limited.
+func (l *fleetResourceQuotaLimits) maxSelectedClusters(kept int) int {
+	if l == nil {
+		return -1
+	}
+	limit := l.maxClustersPerPlacement
+	if l.remainingBindings >= 0 {
+		limit = minLimit(limit, kept+l.remainingBindings)
+	}
+	return limit
+}
+
+// minLimit returns the smaller of two limits, where a negative limit signals no limit.
+func minLimit(a, b int) int {
+	if a < 0 {
+		return b
+	}
+	return min(a, b)
+}
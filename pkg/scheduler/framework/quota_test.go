@@ -0,0 +1,361 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	quotaNamespace = "work"
+	quotaName      = "quota"
+	altQuotaName   = "alt-quota"
+)
+
+// TestCollectFleetResourceQuotaLimits tests the collectFleetResourceQuotaLimits method.
+func TestCollectFleetResourceQuotaLimits(t *testing.T) {
+	newBinding := func(name string, state placementv1beta1.BindingState, deleting bool) *placementv1beta1.ResourceBinding {
+		binding := &placementv1beta1.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: quotaNamespace,
+				Name:      name,
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State: state,
+			},
+		}
+		if deleting {
+			binding.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			binding.Finalizers = []string{placementv1beta1.SchedulerBindingCleanupFinalizer}
+		}
+		return binding
+	}
+
+	testCases := []struct {
+		name       string
+		disabled   bool
+		namespace  string
+		objs       []client.Object
+		wantLimits *fleetResourceQuotaLimits
+	}{
+		{
+			name:      "quotas not enabled",
+			disabled:  true,
+			namespace: quotaNamespace,
+		},
+		{
+			name: "cluster-scoped placement",
+		},
+		{
+			name:      "no quota in the namespace",
+			namespace: quotaNamespace,
+		},
+		{
+			name:      "most restrictive limits across quotas",
+			namespace: quotaNamespace,
+			objs: []client.Object{
+				&placementv1beta1.FleetResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: quotaNamespace, Name: quotaName},
+					Spec: placementv1beta1.FleetResourceQuotaSpec{
+						MaxClustersPerPlacement: ptr.To(int32(5)),
+						MaxBindings:             ptr.To(int32(10)),
+					},
+				},
+				&placementv1beta1.FleetResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: quotaNamespace, Name: altQuotaName},
+					Spec: placementv1beta1.FleetResourceQuotaSpec{
+						MaxClustersPerPlacement: ptr.To(int32(3)),
+						MaxBindings:             ptr.To(int32(4)),
+					},
+				},
+				newBinding(bindingName, placementv1beta1.BindingStateBound, false),
+				newBinding(altBindingName, placementv1beta1.BindingStateScheduled, false),
+				newBinding(anotherBindingName, placementv1beta1.BindingStateUnscheduled, false),
+				newBinding("deleting", placementv1beta1.BindingStateBound, true),
+			},
+			wantLimits: &fleetResourceQuotaLimits{
+				maxClustersPerPlacement: 3,
+				remainingBindings:       2,
+			},
+		},
+		{
+			name:      "binding limit exceeded",
+			namespace: quotaNamespace,
+			objs: []client.Object{
+				&placementv1beta1.FleetResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: quotaNamespace, Name: quotaName},
+					Spec: placementv1beta1.FleetResourceQuotaSpec{
+						MaxBindings: ptr.To(int32(1)),
+					},
+				},
+				newBinding(bindingName, placementv1beta1.BindingStateBound, false),
+				newBinding(altBindingName, placementv1beta1.BindingStateScheduled, false),
+			},
+			wantLimits: &fleetResourceQuotaLimits{
+				maxClustersPerPlacement: -1,
+				remainingBindings:       0,
+			},
+		},
+		{
+			name:      "invalid allowed cluster selector",
+			namespace: quotaNamespace,
+			objs: []client.Object{
+				&placementv1beta1.FleetResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: quotaNamespace, Name: quotaName},
+					Spec: placementv1beta1.FleetResourceQuotaSpec{
+						AllowedClusterSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "env", Operator: metav1.LabelSelectorOpIn},
+							},
+						},
+					},
+				},
+			},
+			wantLimits: &fleetResourceQuotaLimits{
+				maxClustersPerPlacement: -1,
+				remainingBindings:       -1,
+				invalidSelectorQuotas:   []string{quotaName},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(tc.objs...).Build()
+			// Construct framework manually instead of using NewFramework() to avoid mocking the controller manager.
+			f := &framework{
+				uncachedReader:            fakeClient,
+				enableFleetResourceQuotas: !tc.disabled,
+			}
+			limits, err := f.collectFleetResourceQuotaLimits(context.Background(), tc.namespace)
+			if err != nil {
+				t.Fatalf("collectFleetResourceQuotaLimits() = %v, want no error", err)
+			}
+			if tc.wantLimits == nil {
+				if limits != nil {
+					t.Fatalf("collectFleetResourceQuotaLimits() = %+v, want nil", limits)
+				}
+				return
+			}
+			if limits == nil {
+				t.Fatalf("collectFleetResourceQuotaLimits() = nil, want %+v", tc.wantLimits)
+			}
+			if limits.maxClustersPerPlacement != tc.wantLimits.maxClustersPerPlacement || limits.remainingBindings != tc.wantLimits.remainingBindings {
+				t.Errorf("collectFleetResourceQuotaLimits() = (maxClustersPerPlacement: %d, remainingBindings: %d), want (%d, %d)",
+					limits.maxClustersPerPlacement, limits.remainingBindings, tc.wantLimits.maxClustersPerPlacement, tc.wantLimits.remainingBindings)
+			}
+			if diff := cmp.Diff(tc.wantLimits.invalidSelectorQuotas, limits.invalidSelectorQuotas); diff != "" {
+				t.Errorf("collectFleetResourceQuotaLimits() invalid selector quotas mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestFleetResourceQuotaLimitsClusterSelection tests the allowedClusters and partitionValidTargets methods.
+func TestFleetResourceQuotaLimitsClusterSelection(t *testing.T) {
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Labels: map[string]string{"env": "prod", "region": "east"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: altClusterName, Labels: map[string]string{"env": "prod", "region": "west"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: anotherClusterName, Labels: map[string]string{"env": "dev", "region": "east"}}},
+	}
+	prodSelector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}})
+	if err != nil {
+		t.Fatalf("failed to build selector: %v", err)
+	}
+	eastSelector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"region": "east"}})
+	if err != nil {
+		t.Fatalf("failed to build selector: %v", err)
+	}
+
+	var nilLimits *fleetResourceQuotaLimits
+	if got := nilLimits.allowedClusters(clusters); len(got) != len(clusters) {
+		t.Errorf("allowedClusters() on nil limits returned %d clusters, want %d", len(got), len(clusters))
+	}
+
+	limits := &fleetResourceQuotaLimits{
+		maxClustersPerPlacement: -1,
+		remainingBindings:       -1,
+		clusterSelectors: map[string]labels.Selector{
+			quotaName:    prodSelector,
+			altQuotaName: eastSelector,
+		},
+	}
+	allowed := limits.allowedClusters(clusters)
+	if len(allowed) != 1 || allowed[0].Name != clusterName {
+		t.Errorf("allowedClusters() = %v, want only cluster %s", allowed, clusterName)
+	}
+	wantFiltered := map[string]string{
+		altClusterName:     "cluster is not allowed by fleet resource quota alt-quota",
+		anotherClusterName: "cluster is not allowed by fleet resource quota quota",
+	}
+	gotFiltered := make(map[string]string)
+	for _, filtered := range limits.filteredClusters() {
+		gotFiltered[filtered.cluster.Name] = filtered.status.Reasons()[0]
+		if !filtered.status.IsClusterUnschedulable() {
+			t.Errorf("filtered cluster %s status = %v, want ClusterUnschedulable", filtered.cluster.Name, filtered.status)
+		}
+	}
+	if diff := cmp.Diff(wantFiltered, gotFiltered); diff != "" {
+		t.Errorf("filteredClusters() mismatch (-want, +got):\n%s", diff)
+	}
+
+	valid := []*clusterv1beta1.MemberCluster{&clusters[0], &clusters[1]}
+	gotValid, gotInvalid := limits.partitionValidTargets(valid, nil)
+	if len(gotValid) != 1 || gotValid[0].Name != clusterName {
+		t.Errorf("partitionValidTargets() valid = %v, want only cluster %s", gotValid, clusterName)
+	}
+	if len(gotInvalid) != 1 || gotInvalid[0].cluster.Name != altClusterName || gotInvalid[0].reason != wantFiltered[altClusterName] {
+		t.Errorf("partitionValidTargets() invalid = %v, want only cluster %s", gotInvalid, altClusterName)
+	}
+
+	// A quota with an invalid selector allows no cluster.
+	invalidLimits := &fleetResourceQuotaLimits{
+		maxClustersPerPlacement: -1,
+		remainingBindings:       -1,
+		clusterSelectors:        map[string]labels.Selector{quotaName: labels.Nothing()},
+		invalidSelectorQuotas:   []string{quotaName},
+	}
+	if allowed := invalidLimits.allowedClusters(clusters); len(allowed) != 0 {
+		t.Errorf("allowedClusters() with an invalid selector = %v, want no cluster", allowed)
+	}
+	for _, filtered := range invalidLimits.filteredClusters() {
+		if got, want := filtered.status.Reasons()[0], fmt.Sprintf(invalidQuotaSelectorReasonTemplate, quotaName); got != want {
+			t.Errorf("filtered cluster %s reason = %q, want %q", filtered.cluster.Name, got, want)
+		}
+	}
+}
+
+// TestCapNewBindings tests the capNewBindings method.
+func TestCapNewBindings(t *testing.T) {
+	toCreate := []placementv1beta1.BindingObj{
+		&placementv1beta1.ResourceBinding{ObjectMeta: metav1.ObjectMeta{Name: bindingName}},
+		&placementv1beta1.ResourceBinding{ObjectMeta: metav1.ObjectMeta{Name: altBindingName}},
+		&placementv1beta1.ResourceBinding{ObjectMeta: metav1.ObjectMeta{Name: anotherBindingName}},
+	}
+
+	testCases := []struct {
+		name      string
+		limits    *fleetResourceQuotaLimits
+		kept      int
+		wantCount int
+	}{
+		{
+			name:      "no limits",
+			kept:      10,
+			wantCount: 3,
+		},
+		{
+			name:      "unlimited",
+			limits:    &fleetResourceQuotaLimits{maxClustersPerPlacement: -1, remainingBindings: -1},
+			kept:      10,
+			wantCount: 3,
+		},
+		{
+			name:      "capped by clusters per placement",
+			limits:    &fleetResourceQuotaLimits{maxClustersPerPlacement: 3, remainingBindings: -1},
+			kept:      1,
+			wantCount: 2,
+		},
+		{
+			name:      "kept bindings already exceed clusters per placement",
+			limits:    &fleetResourceQuotaLimits{maxClustersPerPlacement: 3, remainingBindings: -1},
+			kept:      4,
+			wantCount: 0,
+		},
+		{
+			name:      "capped by remaining bindings",
+			limits:    &fleetResourceQuotaLimits{maxClustersPerPlacement: 5, remainingBindings: 1},
+			kept:      1,
+			wantCount: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.limits.capNewBindings(toCreate, tc.kept)
+			if len(got) != tc.wantCount {
+				t.Fatalf("capNewBindings() returned %d bindings, want %d", len(got), tc.wantCount)
+			}
+			for idx := range got {
+				if got[idx].GetName() != toCreate[idx].GetName() {
+					t.Errorf("capNewBindings()[%d] = %s, want %s", idx, got[idx].GetName(), toCreate[idx].GetName())
+				}
+			}
+		})
+	}
+}
+
+// TestMaxSelectedClusters tests the maxSelectedClusters method.
+func TestMaxSelectedClusters(t *testing.T) {
+	testCases := []struct {
+		name   string
+		limits *fleetResourceQuotaLimits
+		kept   int
+		want   int
+	}{
+		{
+			name: "no limits",
+			kept: 2,
+			want: -1,
+		},
+		{
+			name:   "unlimited",
+			limits: &fleetResourceQuotaLimits{maxClustersPerPlacement: -1, remainingBindings: -1},
+			kept:   2,
+			want:   -1,
+		},
+		{
+			name:   "limited by clusters per placement",
+			limits: &fleetResourceQuotaLimits{maxClustersPerPlacement: 3, remainingBindings: 5},
+			kept:   2,
+			want:   3,
+		},
+		{
+			name:   "limited by remaining bindings",
+			limits: &fleetResourceQuotaLimits{maxClustersPerPlacement: 5, remainingBindings: 1},
+			kept:   2,
+			want:   3,
+		},
+		{
+			name:   "limited by remaining bindings only",
+			limits: &fleetResourceQuotaLimits{maxClustersPerPlacement: -1, remainingBindings: 0},
+			want:   0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.limits.maxSelectedClusters(tc.kept); got != tc.want {
+				t.Errorf("maxSelectedClusters(%d) = %d, want %d", tc.kept, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// divideReplicas divides the total number of replicas specified in the replica scheduling policy
+// across the given target clusters, in accordance with the division preference.
+//
+// It returns nil if the policy does not divide replicas (or does not identify the workload whose
+// replicas are divided). The division is deterministic: replicas are first assigned in proportion
+// to the weights (rounding down), and the remaining replicas go to the clusters with the largest
+// remainders, with ties broken by cluster names.
+func divideReplicas(rs *placementv1beta1.ReplicaScheduling, clusters []clusterv1beta1.MemberCluster, targets []string) map[string]int32 {
+	if !dividesReplicas(rs) {
+		return nil
+	}
+
+	sortedTargets := make([]string, len(targets))
+	copy(sortedTargets, targets)
+	sort.Strings(sortedTargets)
+
+	weights := replicaWeightsOf(rs, clusters, sortedTargets)
+	totalWeight := new(big.Int)
+	for _, w := range weights {
+		totalWeight.Add(totalWeight, w)
+	}
+	if totalWeight.Sign() == 0 {
+		// No cluster has a positive weight; divide the replicas evenly.
+		for i := range weights {
+			weights[i] = big.NewInt(1)
+		}
+		totalWeight.SetInt64(int64(len(weights)))
+	}
+
+	shares := make(map[string]int32, len(sortedTargets))
+	if len(sortedTargets) == 0 {
+		return shares
+	}
+
+	total := big.NewInt(int64(*rs.Replicas))
+	remainders := make([]*big.Int, len(sortedTargets))
+	assigned := int32(0)
+	for i, name := range sortedTargets {
+		quotient, remainder := new(big.Int).QuoRem(new(big.Int).Mul(total, weights[i]), totalWeight, new(big.Int))
+		shares[name] = int32(quotient.Int64())
+		remainders[i] = remainder
+		assigned += shares[name]
+	}
+
+	// Hand out the remaining replicas to the clusters with the largest remainders.
+	order := make([]int, len(sortedTargets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return remainders[order[i]].Cmp(remainders[order[j]]) > 0
+	})
+	for i := 0; assigned < *rs.Replicas; i++ {
+		shares[sortedTargets[order[i%len(order)]]]++
+		assigned++
+	}
+	return shares
+}
+
+// replicaWeightsOf returns the weights of the (sorted) target clusters per the division preference.
+func replicaWeightsOf(rs *placementv1beta1.ReplicaScheduling, clusters []clusterv1beta1.MemberCluster, targets []string) []*big.Int {
+	weights := make([]*big.Int, len(targets))
+	switch rs.DivisionPreference {
+	case placementv1beta1.ReplicaDivisionPreferenceAvailableCapacity:
+		resourceName := rs.CapacityResourceName
+		if resourceName == "" {
+			resourceName = corev1.ResourceCPU
+		}
+		clusterMap := make(map[string]*clusterv1beta1.MemberCluster, len(clusters))
+		for i := range clusters {
+			clusterMap[clusters[i].Name] = &clusters[i]
+		}
+		for i, name := range targets {
+			weights[i] = new(big.Int)
+			cluster, ok := clusterMap[name]
+			if !ok {
+				continue
+			}
+			if available, ok := cluster.Status.ResourceUsage.Available[resourceName]; ok && available.Sign() > 0 {
+				weights[i].SetInt64(available.MilliValue())
+			}
+		}
+	default:
+		staticWeights := make(map[string]int32, len(rs.StaticWeights))
+		for _, sw := range rs.StaticWeights {
+			staticWeights[sw.ClusterName] = sw.Weight
+		}
+		for i, name := range targets {
+			weights[i] = big.NewInt(int64(staticWeights[name]))
+		}
+	}
+	return weights
+}
+
+// assignReplicas records the share of replicas of each selected cluster on its binding, if the
+// scheduling policy divides replicas across clusters; otherwise, it clears any share left on
+// the bindings.
+//
+// Shares are set directly on the bindings to create and the bindings to patch, which are written
+// by the caller; bindings that are kept as they are in the current scheduling cycle (i.e., scheduled
+// and bound bindings) are updated here if their shares change.
+//
+// To avoid shuffling replicas around on every scheduling cycle (e.g., when clusters report
+// slightly different available capacities), the shares are re-computed only when the set of
+// bindings changes, or when the shares recorded on the bindings are inconsistent with the policy.
+func (f *framework) assignReplicas(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []clusterv1beta1.MemberCluster,
+	toCreate []placementv1beta1.BindingObj,
+	toPatch []*bindingWithPatch,
+	kept ...[]placementv1beta1.BindingObj,
+) error {
+	var rs *placementv1beta1.ReplicaScheduling
+	if p := policy.GetPolicySnapshotSpec().Policy; p != nil {
+		rs = p.ReplicaScheduling
+	}
+
+	all := make([]placementv1beta1.BindingObj, 0, len(toCreate)+len(toPatch))
+	all = append(all, toCreate...)
+	for _, bp := range toPatch {
+		all = append(all, bp.updated)
+	}
+	keptCount := 0
+	for _, bindings := range kept {
+		all = append(all, bindings...)
+		keptCount += len(bindings)
+	}
+	if len(toCreate) == 0 && len(toPatch) == 0 && areReplicaSharesConsistent(rs, all) {
+		return nil
+	}
+
+	targets := make([]string, 0, len(all))
+	for _, binding := range all {
+		targets = append(targets, binding.GetBindingSpec().TargetCluster)
+	}
+	shares := divideReplicas(rs, clusters, targets)
+	shareOf := func(binding placementv1beta1.BindingObj) *int32 {
+		if shares == nil {
+			return nil
+		}
+		return ptr.To(shares[binding.GetBindingSpec().TargetCluster])
+	}
+	var replicaTarget *placementv1beta1.WorkloadReference
+	if shares != nil {
+		replicaTarget = rs.TargetWorkload
+	}
+	setShare := func(binding placementv1beta1.BindingObj) {
+		spec := binding.GetBindingSpec()
+		spec.Replicas = shareOf(binding)
+		spec.ReplicaTarget = replicaTarget.DeepCopy()
+	}
+
+	for _, binding := range all[:len(all)-keptCount] {
+		setShare(binding)
+	}
+
+	stale := make([]placementv1beta1.BindingObj, 0, keptCount)
+	for _, binding := range all[len(all)-keptCount:] {
+		spec := binding.GetBindingSpec()
+		if !ptr.Equal(spec.Replicas, shareOf(binding)) || !equality.Semantic.DeepEqual(spec.ReplicaTarget, replicaTarget) {
+			stale = append(stale, binding)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+	klog.V(2).InfoS("Updating the share of replicas on bindings", "policySnapshot", klog.KObj(policy), "bindingCount", len(stale))
+	return f.updateBindings(ctx, stale, func(ctx context.Context, hubClient client.Client, binding placementv1beta1.BindingObj) error {
+		setShare(binding)
+		return hubClient.Update(ctx, binding, &client.UpdateOptions{})
+	})
+}
+
+// areReplicaSharesConsistent returns whether the shares of replicas recorded on the bindings agree
+// with the replica scheduling policy, i.e., every binding has a share for the target workload and the
+// shares add up to the total number of replicas if the policy divides replicas, or no binding has a
+// share otherwise.
+func areReplicaSharesConsistent(rs *placementv1beta1.ReplicaScheduling, bindings []placementv1beta1.BindingObj) bool {
+	divided := dividesReplicas(rs)
+	var target *placementv1beta1.WorkloadReference
+	if divided {
+		target = rs.TargetWorkload
+	}
+	sum := int64(0)
+	for _, binding := range bindings {
+		replicas := binding.GetBindingSpec().Replicas
+		if (replicas != nil) != divided || !equality.Semantic.DeepEqual(binding.GetBindingSpec().ReplicaTarget, target) {
+			return false
+		}
+		if replicas != nil {
+			sum += int64(*replicas)
+		}
+	}
+	return !divided || len(bindings) == 0 || sum == int64(*rs.Replicas)
+}
+
+// dividesReplicas returns whether a replica scheduling policy divides the replicas of a workload
+// across clusters.
+func dividesReplicas(rs *placementv1beta1.ReplicaScheduling) bool {
+	return rs != nil && rs.Type == placementv1beta1.ReplicaSchedulingTypeDivided && rs.Replicas != nil && rs.TargetWorkload != nil
+}
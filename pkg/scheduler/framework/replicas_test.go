@@ -0,0 +1,367 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+var targetWorkload = &placementv1beta1.WorkloadReference{
+	Group:   "apps",
+	Version: "v1",
+	Kind:    "Deployment",
+	Name:    "app",
+}
+
+// TestDivideReplicas tests the divideReplicas function.
+func TestDivideReplicas(t *testing.T) {
+	clusters := []clusterv1beta1.MemberCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				ResourceUsage: clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("3"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: altClusterName,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				ResourceUsage: clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("1"),
+						corev1.ResourceMemory: resource.MustParse("3Gi"),
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: anotherClusterName,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name    string
+		rs      *placementv1beta1.ReplicaScheduling
+		targets []string
+		want    map[string]int32
+	}{
+		{
+			name:    "no replica scheduling",
+			targets: []string{clusterName, altClusterName},
+		},
+		{
+			name: "duplicated",
+			rs: &placementv1beta1.ReplicaScheduling{
+				Type: placementv1beta1.ReplicaSchedulingTypeDuplicated,
+			},
+			targets: []string{clusterName, altClusterName},
+		},
+		{
+			name: "divided, no targets",
+			rs: &placementv1beta1.ReplicaScheduling{
+				Type:           placementv1beta1.ReplicaSchedulingTypeDivided,
+				TargetWorkload: targetWorkload,
+				Replicas:       ptr.To(int32(3)),
+			},
+			want: map[string]int32{},
+		},
+		{
+			name: "divided, no weights, divide evenly",
+			rs: &placementv1beta1.ReplicaScheduling{
+				Type:           placementv1beta1.ReplicaSchedulingTypeDivided,
+				TargetWorkload: targetWorkload,
+				Replicas:       ptr.To(int32(7)),
+			},
+			targets: []string{clusterName, altClusterName, anotherClusterName},
+			want: map[string]int32{
+				// Ties in remainders are broken by cluster names.
+				clusterName:        3,
+				anotherClusterName: 2,
+				altClusterName:     2,
+			},
+		},
+		{
+			name: "divided, static weights",
+			rs: &placementv1beta1.ReplicaScheduling{
+				Type:               placementv1beta1.ReplicaSchedulingTypeDivided,
+				TargetWorkload:     targetWorkload,
+				Replicas:           ptr.To(int32(10)),
+				DivisionPreference: placementv1beta1.ReplicaDivisionPreferenceWeighted,
+				StaticWeights: []placementv1beta1.StaticClusterWeight{
+					{
+						ClusterName: clusterName,
+						Weight:      2,
+					},
+					{
+						ClusterName: altClusterName,
+						Weight:      1,
+					},
+				},
+			},
+			targets: []string{clusterName, altClusterName, anotherClusterName},
+			want: map[string]int32{
+				clusterName:        7,
+				altClusterName:     3,
+				anotherClusterName: 0,
+			},
+		},
+		{
+			name: "divided, available capacity (cpu by default)",
+			rs: &placementv1beta1.ReplicaScheduling{
+				Type:               placementv1beta1.ReplicaSchedulingTypeDivided,
+				TargetWorkload:     targetWorkload,
+				Replicas:           ptr.To(int32(8)),
+				DivisionPreference: placementv1beta1.ReplicaDivisionPreferenceAvailableCapacity,
+			},
+			targets: []string{clusterName, altClusterName, anotherClusterName},
+			want: map[string]int32{
+				clusterName:        6,
+				altClusterName:     2,
+				anotherClusterName: 0,
+			},
+		},
+		{
+			name: "divided, available capacity (memory)",
+			rs: &placementv1beta1.ReplicaScheduling{
+				Type:                 placementv1beta1.ReplicaSchedulingTypeDivided,
+				TargetWorkload:       targetWorkload,
+				Replicas:             ptr.To(int32(4)),
+				DivisionPreference:   placementv1beta1.ReplicaDivisionPreferenceAvailableCapacity,
+				CapacityResourceName: corev1.ResourceMemory,
+			},
+			targets: []string{clusterName, altClusterName},
+			want: map[string]int32{
+				clusterName:    1,
+				altClusterName: 3,
+			},
+		},
+		{
+			name: "divided, available capacity, no capacity reported",
+			rs: &placementv1beta1.ReplicaScheduling{
+				Type:               placementv1beta1.ReplicaSchedulingTypeDivided,
+				TargetWorkload:     targetWorkload,
+				Replicas:           ptr.To(int32(3)),
+				DivisionPreference: placementv1beta1.ReplicaDivisionPreferenceAvailableCapacity,
+			},
+			targets: []string{anotherClusterName, "unknown-cluster"},
+			want: map[string]int32{
+				anotherClusterName: 2,
+				"unknown-cluster":  1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := divideReplicas(tc.rs, clusters, tc.targets)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("divideReplicas() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestAreReplicaSharesConsistent tests the areReplicaSharesConsistent function.
+func TestAreReplicaSharesConsistent(t *testing.T) {
+	divided := &placementv1beta1.ReplicaScheduling{
+		Type:           placementv1beta1.ReplicaSchedulingTypeDivided,
+		TargetWorkload: targetWorkload,
+		Replicas:       ptr.To(int32(3)),
+	}
+	bindingWithReplicas := func(replicas *int32) placementv1beta1.BindingObj {
+		binding := &placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{
+				Replicas: replicas,
+			},
+		}
+		if replicas != nil {
+			binding.Spec.ReplicaTarget = targetWorkload
+		}
+		return binding
+	}
+	bindingForWorkload := func(replicas int32, name string) placementv1beta1.BindingObj {
+		target := targetWorkload.DeepCopy()
+		target.Name = name
+		return &placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{
+				Replicas:      ptr.To(replicas),
+				ReplicaTarget: target,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		rs       *placementv1beta1.ReplicaScheduling
+		bindings []placementv1beta1.BindingObj
+		want     bool
+	}{
+		{
+			name:     "not divided, no shares",
+			bindings: []placementv1beta1.BindingObj{bindingWithReplicas(nil)},
+			want:     true,
+		},
+		{
+			name:     "not divided, leftover share",
+			bindings: []placementv1beta1.BindingObj{bindingWithReplicas(ptr.To(int32(1)))},
+			want:     false,
+		},
+		{
+			name:     "divided, missing share",
+			rs:       divided,
+			bindings: []placementv1beta1.BindingObj{bindingWithReplicas(ptr.To(int32(3))), bindingWithReplicas(nil)},
+			want:     false,
+		},
+		{
+			name:     "divided, shares do not add up",
+			rs:       divided,
+			bindings: []placementv1beta1.BindingObj{bindingWithReplicas(ptr.To(int32(1))), bindingWithReplicas(ptr.To(int32(1)))},
+			want:     false,
+		},
+		{
+			name:     "divided, shares for another workload",
+			rs:       divided,
+			bindings: []placementv1beta1.BindingObj{bindingWithReplicas(ptr.To(int32(1))), bindingForWorkload(2, "other-app")},
+			want:     false,
+		},
+		{
+			name: "divided, no target workload",
+			rs: &placementv1beta1.ReplicaScheduling{
+				Type:     placementv1beta1.ReplicaSchedulingTypeDivided,
+				Replicas: ptr.To(int32(3)),
+			},
+			bindings: []placementv1beta1.BindingObj{bindingWithReplicas(ptr.To(int32(1))), bindingWithReplicas(ptr.To(int32(2)))},
+			want:     false,
+		},
+		{
+			name:     "divided, shares add up",
+			rs:       divided,
+			bindings: []placementv1beta1.BindingObj{bindingWithReplicas(ptr.To(int32(1))), bindingWithReplicas(ptr.To(int32(2)))},
+			want:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := areReplicaSharesConsistent(tc.rs, tc.bindings); got != tc.want {
+				t.Errorf("areReplicaSharesConsistent() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestAssignReplicas tests the assignReplicas method.
+func TestAssignReplicas(t *testing.T) {
+	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaScheduling{
+					Type:           placementv1beta1.ReplicaSchedulingTypeDivided,
+					TargetWorkload: targetWorkload,
+					Replicas:       ptr.To(int32(5)),
+				},
+			},
+		},
+	}
+	boundBinding := &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bindingName,
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: clusterName,
+			Replicas:      ptr.To(int32(5)),
+		},
+	}
+	toCreate := &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: altBindingName,
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateScheduled,
+			TargetCluster: altClusterName,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(boundBinding).
+		Build()
+	// Construct framework manually instead of using NewFramework() to avoid mocking the controller manager.
+	f := &framework{
+		client: fakeClient,
+	}
+
+	ctx := context.Background()
+	if err := f.assignReplicas(ctx, policy, nil, []placementv1beta1.BindingObj{toCreate}, nil, []placementv1beta1.BindingObj{boundBinding}); err != nil {
+		t.Fatalf("assignReplicas() = %v, want no error", err)
+	}
+
+	// The share of the binding to create is set in place.
+	if got, want := toCreate.Spec.Replicas, ptr.To(int32(2)); !ptr.Equal(got, want) {
+		t.Errorf("binding to create replicas = %v, want %v", ptr.Deref(got, -1), *want)
+	}
+	// The share of the bound binding is updated via the API server.
+	updated := &placementv1beta1.ClusterResourceBinding{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: bindingName}, updated); err != nil {
+		t.Fatalf("Get binding %s = %v, want no error", bindingName, err)
+	}
+	if got, want := updated.Spec.Replicas, ptr.To(int32(3)); !ptr.Equal(got, want) {
+		t.Errorf("bound binding replicas = %v, want %v", ptr.Deref(got, -1), *want)
+	}
+	for _, binding := range []*placementv1beta1.ClusterResourceBinding{toCreate, updated} {
+		if diff := cmp.Diff(binding.Spec.ReplicaTarget, targetWorkload); diff != "" {
+			t.Errorf("binding %s replica target diff (-got, +want): %s", binding.Name, diff)
+		}
+	}
+
+	// Shares are kept as they are when no binding is created or patched and the shares are consistent;
+	// the framework is set up with an empty client so that any update attempt would fail.
+	f.client = fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		Build()
+	kept := []placementv1beta1.BindingObj{updated, toCreate}
+	if err := f.assignReplicas(ctx, policy, nil, nil, nil, kept); err != nil {
+		t.Fatalf("assignReplicas() = %v, want no error", err)
+	}
+}
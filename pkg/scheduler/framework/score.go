@@ -23,7 +23,20 @@ import (
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 )
 
+const (
+	// MinNormalizedScore and MaxNormalizedScore are the boundaries (inclusive) of the normalized
+	// score a score plugin gives to a cluster.
+	MinNormalizedScore int64 = 0
+	MaxNormalizedScore int64 = 100
+)
+
 // ClusterScore is the scores the scheduler assigns to a cluster.
+//
+// A score plugin reports its raw score in one of the user-facing scores (the topology spread
+// score or the affinity score); the framework then multiplies the scores each plugin gives (which
+// might have been normalized across all the candidate clusters, if the plugin or the profile opts
+// in) by the weight of the plugin, and sums them up as the weighted score, which decides how the
+// clusters are ranked.
 type ClusterScore struct {
 	// TopologySpreadScore determines how much a binding would satisfy the topology spread
 	// constraints specified by the user.
@@ -39,6 +52,17 @@ type ClusterScore struct {
 	// a preference for already selected clusters when all the other conditions are the same,
 	// so as to minimize interruption between different scheduling runs.
 	ObsoletePlacementAffinityScore int
+	// WeightedScore is the sum of the scores score plugins (and scheduler extenders) give to the
+	// cluster, each normalized if applicable and multiplied by the weight of its source.
+	//
+	// Note that this score is set by the framework only; score plugins should leave it unset.
+	WeightedScore int64
+}
+
+// rawScore returns the raw score a score plugin reports in a ClusterScore, i.e., the sum of its
+// user-facing scores.
+func (s1 *ClusterScore) rawScore() int64 {
+	return int64(s1.TopologySpreadScore) + int64(s1.AffinityScore)
 }
 
 // Add adds a ClusterScore to another ClusterScore.
@@ -48,6 +72,22 @@ func (s1 *ClusterScore) Add(s2 *ClusterScore) {
 	s1.TopologySpreadScore += s2.TopologySpreadScore
 	s1.AffinityScore += s2.AffinityScore
 	s1.ObsoletePlacementAffinityScore += s2.ObsoletePlacementAffinityScore
+	s1.WeightedScore += s2.WeightedScore
+}
+
+// Multiply returns a new ClusterScore, with its user-facing scores (the topology spread score
+// and the affinity score) multiplied by the given weight.
+//
+// Note that the obsolete placement affinity score is not weighted, as it serves as an internal
+// tie-breaker only, and neither is the weighted score, which has been weighted already; also
+// note that this will panic if the score is nil.
+func (s1 *ClusterScore) Multiply(weight int32) *ClusterScore {
+	return &ClusterScore{
+		TopologySpreadScore:            s1.TopologySpreadScore * weight,
+		AffinityScore:                  s1.AffinityScore * weight,
+		ObsoletePlacementAffinityScore: s1.ObsoletePlacementAffinityScore,
+		WeightedScore:                  s1.WeightedScore,
+	}
 }
 
 // Equal returns true if a ClusterScore is equal to another.
@@ -61,7 +101,8 @@ func (s1 *ClusterScore) Equal(s2 *ClusterScore) bool {
 		return false
 	default:
 		// Both are not nils.
-		return s1.TopologySpreadScore == s2.TopologySpreadScore &&
+		return s1.WeightedScore == s2.WeightedScore &&
+			s1.TopologySpreadScore == s2.TopologySpreadScore &&
 			s1.AffinityScore == s2.AffinityScore &&
 			s1.ObsoletePlacementAffinityScore == s2.ObsoletePlacementAffinityScore
 	}
@@ -69,8 +110,16 @@ func (s1 *ClusterScore) Equal(s2 *ClusterScore) bool {
 
 // Less returns true if a ClusterScore is less than another.
 //
+// The weighted scores are compared first; if they are the same, the user-facing scores (the
+// topology spread score first, and then the affinity score) and the obsolete placement affinity
+// score are compared in order, as tie-breakers.
+//
 // Note that this will panic if either score is nil.
 func (s1 *ClusterScore) Less(s2 *ClusterScore) bool {
+	if s1.WeightedScore != s2.WeightedScore {
+		return s1.WeightedScore < s2.WeightedScore
+	}
+
 	if s1.TopologySpreadScore != s2.TopologySpreadScore {
 		return s1.TopologySpreadScore < s2.TopologySpreadScore
 	}
@@ -86,6 +135,18 @@ func (s1 *ClusterScore) Less(s2 *ClusterScore) bool {
 type ScoredCluster struct {
 	Cluster *clusterv1beta1.MemberCluster
 	Score   *ClusterScore
+
+	// PluginScores are the scores each score plugin has given to the cluster, keyed by the
+	// plugin names; the user-facing scores are the raw scores multiplied by the weight of the
+	// plugin, and the weighted score is the (normalized, if applicable) score multiplied by the
+	// weight. They have been summed up in the score of the cluster already and are kept for
+	// reporting purposes only.
+	PluginScores map[string]*ClusterScore
+
+	// ExtenderScores are the scores scheduler extenders have given to the cluster, keyed by
+	// the extender names; they have been added to the affinity score and the weighted score
+	// already and are kept for reporting purposes only.
+	ExtenderScores map[string]int32
 }
 
 // ScoredClusters is a list of ScoredClusters; this type implements the sort.Interface.
@@ -126,3 +187,36 @@ func (sc ScoredClusters) String() string {
 	}
 	return fmt.Sprintf("ScoredClusters{%s}", strings.Join(names, ", "))
 }
+
+// normalizeScores normalizes the raw scores a score plugin gives to clusters, keyed by the cluster
+// names, into the range [MinNormalizedScore, MaxNormalizedScore] with min-max scaling, in place,
+// i.e., the cluster with the lowest raw score is given MinNormalizedScore and the cluster with the
+// highest raw score is given MaxNormalizedScore.
+//
+// If all the clusters are given the same raw score, the plugin has no preference among them, and
+// all the clusters are given MinNormalizedScore.
+func normalizeScores(scores map[string]int64) {
+	if len(scores) == 0 {
+		return
+	}
+
+	first := true
+	var minScore, maxScore int64
+	for _, score := range scores {
+		if first || score < minScore {
+			minScore = score
+		}
+		if first || score > maxScore {
+			maxScore = score
+		}
+		first = false
+	}
+
+	for name, score := range scores {
+		if maxScore == minScore {
+			scores[name] = MinNormalizedScore
+			continue
+		}
+		scores[name] = MinNormalizedScore + (score-minScore)*(MaxNormalizedScore-MinNormalizedScore)/(maxScore-minScore)
+	}
+}
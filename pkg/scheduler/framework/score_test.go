@@ -40,6 +40,7 @@ func TestClusterScoreAdd(t *testing.T) {
 		TopologySpreadScore:            1,
 		AffinityScore:                  5,
 		ObsoletePlacementAffinityScore: 1,
+		WeightedScore:                  50,
 	}
 
 	s1.Add(s2)
@@ -47,12 +48,34 @@ func TestClusterScoreAdd(t *testing.T) {
 		TopologySpreadScore:            1,
 		AffinityScore:                  5,
 		ObsoletePlacementAffinityScore: 1,
+		WeightedScore:                  50,
 	}
 	if diff := cmp.Diff(s1, want); diff != "" {
 		t.Fatalf("Add() diff (-got, +want): %s", diff)
 	}
 }
 
+// TestClusterScoreMultiply tests the Multiply() method of ClusterScore.
+func TestClusterScoreMultiply(t *testing.T) {
+	s := &ClusterScore{
+		TopologySpreadScore:            -1,
+		AffinityScore:                  5,
+		ObsoletePlacementAffinityScore: 1,
+		WeightedScore:                  100,
+	}
+
+	got := s.Multiply(3)
+	want := &ClusterScore{
+		TopologySpreadScore:            -3,
+		AffinityScore:                  15,
+		ObsoletePlacementAffinityScore: 1,
+		WeightedScore:                  100,
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Multiply() diff (-got, +want): %s", diff)
+	}
+}
+
 // TestClusterScoreEqual tests the Equal() method of ClusterScore.
 func TestClusterScoreEqual(t *testing.T) {
 	testCases := []struct {
@@ -88,6 +111,19 @@ func TestClusterScoreEqual(t *testing.T) {
 				ObsoletePlacementAffinityScore: 0,
 			},
 		},
+		{
+			name: "s1 is not equal to s2 in weighted score",
+			s1: &ClusterScore{
+				TopologySpreadScore: 1,
+				AffinityScore:       5,
+				WeightedScore:       100,
+			},
+			s2: &ClusterScore{
+				TopologySpreadScore: 1,
+				AffinityScore:       5,
+				WeightedScore:       50,
+			},
+		},
 		{
 			name: "s1 is nil",
 			s2: &ClusterScore{
@@ -126,6 +162,20 @@ func TestClusterScoreLess(t *testing.T) {
 		s2   *ClusterScore
 		want bool
 	}{
+		{
+			name: "s1 is less than s2 in weighted score",
+			s1: &ClusterScore{
+				TopologySpreadScore: 1,
+				AffinityScore:       20,
+				WeightedScore:       50,
+			},
+			s2: &ClusterScore{
+				TopologySpreadScore: 0,
+				AffinityScore:       10,
+				WeightedScore:       100,
+			},
+			want: true,
+		},
 		{
 			name: "s1 is less than s2 in topology spread score",
 			s1: &ClusterScore{
@@ -568,7 +618,7 @@ func TestScoredClustersString(t *testing.T) {
 					},
 				},
 			},
-			expected: "ScoredClusters{Cluster{Name: cluster-a, Score: &{1 2 0}}}",
+			expected: "ScoredClusters{Cluster{Name: cluster-a, Score: &{1 2 0 0}}}",
 		},
 		{
 			name: "multiple clusters",
@@ -598,7 +648,7 @@ func TestScoredClustersString(t *testing.T) {
 					},
 				},
 			},
-			expected: "ScoredClusters{Cluster{Name: cluster-a, Score: &{100 50 1}}, Cluster{Name: cluster-b, Score: &{0 0 0}}, Cluster{Name: cluster-c, Score: &{-10 -5 0}}}",
+			expected: "ScoredClusters{Cluster{Name: cluster-a, Score: &{100 50 1 0}}, Cluster{Name: cluster-b, Score: &{0 0 0 0}}, Cluster{Name: cluster-c, Score: &{-10 -5 0 0}}}",
 		},
 	}
 
@@ -654,3 +704,75 @@ func TestScoredClustersStringTruncation(t *testing.T) {
 		t.Fatalf("String() should not contain overflow cluster: %q", output)
 	}
 }
+
+// TestNormalizeScores tests the normalizeScores function.
+func TestNormalizeScores(t *testing.T) {
+	testCases := []struct {
+		name   string
+		scores map[string]int64
+		want   map[string]int64
+	}{
+		{
+			name:   "no scores",
+			scores: map[string]int64{},
+			want:   map[string]int64{},
+		},
+		{
+			name: "single cluster",
+			scores: map[string]int64{
+				"cluster-a": 10,
+			},
+			want: map[string]int64{
+				"cluster-a": MinNormalizedScore,
+			},
+		},
+		{
+			name: "same scores",
+			scores: map[string]int64{
+				"cluster-a": -5,
+				"cluster-b": -5,
+			},
+			want: map[string]int64{
+				"cluster-a": MinNormalizedScore,
+				"cluster-b": MinNormalizedScore,
+			},
+		},
+		{
+			name: "positive scores",
+			scores: map[string]int64{
+				"cluster-a": 10,
+				"cluster-b": 20,
+				"cluster-c": 50,
+			},
+			want: map[string]int64{
+				"cluster-a": 0,
+				"cluster-b": 25,
+				"cluster-c": 100,
+			},
+		},
+		{
+			name: "mixed scores",
+			scores: map[string]int64{
+				"cluster-a": -2,
+				"cluster-b": -1,
+				"cluster-c": 0,
+				"cluster-d": 1,
+			},
+			want: map[string]int64{
+				"cluster-a": 0,
+				"cluster-b": 33,
+				"cluster-c": 66,
+				"cluster-d": 100,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			normalizeScores(tc.scores)
+			if diff := cmp.Diff(tc.scores, tc.want); diff != "" {
+				t.Errorf("normalizeScores() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/annotations"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// SimulatedClusterDecision is the outcome of a simulated scheduling cycle for a single cluster.
+type SimulatedClusterDecision struct {
+	// ClusterName is the name of the cluster.
+	ClusterName string
+	// Selected is true if the cluster would have been picked.
+	Selected bool
+	// Score is the total score the cluster receives; it is nil if the cluster has not been scored,
+	// e.g., it has been filtered out, or the placement is not of the PickN placement type.
+	Score *ClusterScore
+	// PluginScores are the weighted scores each score plugin gives to the cluster, keyed by the
+	// plugin names.
+	PluginScores map[string]*ClusterScore
+	// ExtenderScores are the scores scheduler extenders give to the cluster, keyed by the extender
+	// names.
+	ExtenderScores map[string]int32
+	// Reason explains why the cluster is or is not picked.
+	Reason string
+}
+
+// RunSimulationCycleFor runs a simulated scheduling cycle for a resource placement with a
+// (possibly proposed) scheduling policy, against the given list of (possibly hypothetical)
+// clusters.
+//
+// The placement key is optional; if specified, the existing bindings of the placement are
+// taken into account, in the same way as they would be if the policy were to replace the
+// current one, i.e., they are considered obsolete.
+//
+// For policies of the PickN placement type, the simulation keeps running the plugins until
+// enough clusters have been picked or no more cluster can be picked, so that any batch size
+// limit imposed by the post-batch plugins is honored as it would be across multiple
+// scheduling cycles.
+//
+// Fleet resource quotas (if enabled) in the namespace of the placement are honored as well: clusters
+// that the quotas do not allow the placement to target are filtered out, and no more clusters are
+// picked than the quotas allow; as the simulation starts from scratch, the bindings the placement
+// currently has are considered kept when counting the bindings in the namespace. Note that when the
+// quotas limit the number of clusters a placement of the PickAll or PickFixed placement type can
+// select, the clusters the simulation leaves out might not be the ones the scheduler would.
+//
+// The simulation never creates, updates, or deletes any object.
+func (f *framework) RunSimulationCycleFor(
+	ctx context.Context,
+	placementKey queue.PlacementKey,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []clusterv1beta1.MemberCluster,
+) ([]*SimulatedClusterDecision, error) {
+	policyRef := klog.KObj(policy)
+
+	namespace := policy.GetNamespace()
+	var obsolete []placementv1beta1.BindingObj
+	kept := 0
+	if len(placementKey) > 0 {
+		placementNamespace, name, err := controller.ExtractNamespaceNameFromKey(placementKey)
+		if err != nil {
+			klog.ErrorS(err, "Failed to extract namespace and name from placement key", "policySnapshot", policyRef)
+			return nil, err
+		}
+		namespace = placementNamespace
+		// Bindings are listed from the cache, as the simulation is read-only.
+		bindings, err := controller.ListBindingsFromKey(ctx, f.client, types.NamespacedName{Namespace: namespace, Name: name}, true)
+		if err != nil {
+			klog.ErrorS(err, "Failed to collect bindings", "policySnapshot", policyRef)
+			return nil, err
+		}
+		bound, scheduled, obsoleteBindings, _, _, _ := classifyBindings(policy, bindings, clusters)
+		// Bindings that are associated with a different policy snapshot are already obsolete; in
+		// case that the simulated policy is associated with the current one, treat the bound and
+		// scheduled bindings as obsolete as well, since the simulation starts from scratch.
+		obsolete = append(obsolete, obsoleteBindings...)
+		obsolete = append(obsolete, bound...)
+		obsolete = append(obsolete, scheduled...)
+		kept = len(bound) + len(scheduled)
+	}
+
+	// Collect the limits that fleet resource quotas (if any) impose on the placement.
+	quotaLimits, err := f.collectFleetResourceQuotaLimits(ctx, namespace)
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect fleet resource quota limits", "policySnapshot", policyRef)
+		return nil, err
+	}
+
+	spec := policy.GetPolicySnapshotSpec()
+	switch {
+	case spec.Policy == nil || spec.Policy.PlacementType == placementv1beta1.PickAllPlacementType:
+		return f.runSimulationForPickAllPlacementType(ctx, policy, quotaLimits.allowedClusters(clusters), obsolete, quotaLimits, kept)
+	case spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType:
+		return f.runSimulationForPickFixedPlacementType(spec.Policy.ClusterNames, clusters, quotaLimits, kept), nil
+	case spec.Policy.PlacementType == placementv1beta1.PickNPlacementType:
+		return f.runSimulationForPickNPlacementType(ctx, policy, quotaLimits.allowedClusters(clusters), obsolete, quotaLimits, kept)
+	default:
+		err := fmt.Errorf("the placement type %s is unknown", spec.Policy.PlacementType)
+		klog.ErrorS(err, "Failed to run simulation", "policySnapshot", policyRef)
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+}
+
+// runSimulationForPickAllPlacementType simulates a scheduling cycle for policies of the PickAll
+// placement type.
+func (f *framework) runSimulationForPickAllPlacementType(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []clusterv1beta1.MemberCluster,
+	obsolete []placementv1beta1.BindingObj,
+	quotaLimits *fleetResourceQuotaLimits,
+	kept int,
+) ([]*SimulatedClusterDecision, error) {
+	state := NewCycleState(clusters, obsolete)
+	scored, filtered, err := f.runAllPluginsForPickAllPlacementType(ctx, state, policy, clusters)
+	if err != nil {
+		return nil, err
+	}
+	filtered = append(filtered, quotaLimits.filteredClusters()...)
+
+	// Sort the clusters by their names, as the Filter stage runs in parallel.
+	sort.Slice(scored, func(i, j int) bool {
+		return scored[i].Cluster.Name < scored[j].Cluster.Name
+	})
+	maxSelected := quotaLimits.maxSelectedClusters(kept)
+	decisions := make([]*SimulatedClusterDecision, 0, len(scored)+len(filtered))
+	for idx, sc := range scored {
+		if maxSelected >= 0 && idx >= maxSelected {
+			decisions = append(decisions, &SimulatedClusterDecision{
+				ClusterName: sc.Cluster.Name,
+				Reason:      fmt.Sprintf(notPickedByQuotaReasonTemplate, sc.Cluster.Name, maxSelected),
+			})
+			continue
+		}
+		decisions = append(decisions, &SimulatedClusterDecision{
+			ClusterName: sc.Cluster.Name,
+			Selected:    true,
+			Reason:      fmt.Sprintf(resourceScheduleSucceededMessageFormat, sc.Cluster.Name),
+		})
+	}
+	return append(decisions, newSimulatedDecisionsFromFilteredClusters(filtered)...), nil
+}
+
+// runSimulationForPickFixedPlacementType simulates a scheduling cycle for policies of the PickFixed
+// placement type.
+func (f *framework) runSimulationForPickFixedPlacementType(
+	target []string,
+	clusters []clusterv1beta1.MemberCluster,
+	quotaLimits *fleetResourceQuotaLimits,
+	kept int,
+) []*SimulatedClusterDecision {
+	valid, invalid, notFound := f.crossReferenceClustersWithTargetNames(clusters, target)
+	// Report valid targets that the fleet resource quotas do not allow the placement to target
+	// as invalid ones.
+	valid, invalid = quotaLimits.partitionValidTargets(valid, invalid)
+
+	maxSelected := quotaLimits.maxSelectedClusters(kept)
+	decisions := make([]*SimulatedClusterDecision, 0, len(valid)+len(invalid)+len(notFound))
+	for idx, cluster := range valid {
+		if maxSelected >= 0 && idx >= maxSelected {
+			decisions = append(decisions, &SimulatedClusterDecision{
+				ClusterName: cluster.Name,
+				Reason:      fmt.Sprintf(notPickedByQuotaReasonTemplate, cluster.Name, maxSelected),
+			})
+			continue
+		}
+		decisions = append(decisions, &SimulatedClusterDecision{
+			ClusterName: cluster.Name,
+			Selected:    true,
+			Reason:      fmt.Sprintf(resourceScheduleSucceededMessageFormat, cluster.Name),
+		})
+	}
+	for _, clusterWithReason := range invalid {
+		decisions = append(decisions, &SimulatedClusterDecision{
+			ClusterName: clusterWithReason.cluster.Name,
+			Reason:      fmt.Sprintf(pickFixedInvalidClusterReasonTemplate, clusterWithReason.cluster.Name, clusterWithReason.reason),
+		})
+	}
+	for _, clusterName := range notFound {
+		decisions = append(decisions, &SimulatedClusterDecision{
+			ClusterName: clusterName,
+			Reason:      fmt.Sprintf(pickFixedNotFoundClusterReasonTemplate, clusterName),
+		})
+	}
+	return decisions
+}
+
+// runSimulationForPickNPlacementType simulates a scheduling cycle for policies of the PickN
+// placement type.
+func (f *framework) runSimulationForPickNPlacementType(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	clusters []clusterv1beta1.MemberCluster,
+	obsolete []placementv1beta1.BindingObj,
+	quotaLimits *fleetResourceQuotaLimits,
+	kept int,
+) ([]*SimulatedClusterDecision, error) {
+	policyRef := klog.KObj(policy)
+
+	numOfClusters, err := annotations.ExtractNumOfClustersFromPolicySnapshot(policy)
+	if err != nil {
+		klog.ErrorS(err, "Failed to extract number of clusters required from policy snapshot", "policySnapshot", policyRef)
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+
+	// Clusters picked in earlier rounds are tracked with in-memory bindings, which are never
+	// written to the API server.
+	picked := make([]placementv1beta1.BindingObj, 0, numOfClusters)
+	decisions := make([]*SimulatedClusterDecision, 0, len(clusters))
+	var notPicked ScoredClusters
+	var filtered filteredClusterWithStatusList
+	var state *CycleState
+	candidates := clusters
+	maxSelected := quotaLimits.maxSelectedClusters(kept)
+	for len(picked) < numOfClusters {
+		state = NewCycleState(clusters, obsolete, picked)
+		var scored ScoredClusters
+		scored, filtered, err = f.runAllPluginsForPickNPlacementType(ctx, state, policy, numOfClusters, len(picked), candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		numOfClustersToPick := calcNumOfClustersToSelect(state.desiredBatchSize, state.batchSizeLimit, len(scored))
+		if maxSelected >= 0 {
+			// Pick no more clusters than the fleet resource quotas allow.
+			numOfClustersToPick = min(numOfClustersToPick, max(maxSelected-len(picked), 0))
+		}
+		var newlyPicked ScoredClusters
+		newlyPicked, notPicked = pickTopNScoredClusters(scored, numOfClustersToPick)
+		for _, sc := range newlyPicked {
+			decision := newSimulatedDecisionFromScoredCluster(sc)
+			decision.Selected = true
+			decision.Reason = fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, sc.Cluster.Name, sc.Score.AffinityScore, sc.Score.TopologySpreadScore) + extenderScoresReasonSuffix(sc.ExtenderScores)
+			decisions = append(decisions, decision)
+			picked = append(picked, &placementv1beta1.ClusterResourceBinding{
+				Spec: placementv1beta1.ResourceBindingSpec{
+					State:         placementv1beta1.BindingStateScheduled,
+					TargetCluster: sc.Cluster.Name,
+				},
+			})
+		}
+
+		if len(newlyPicked) == 0 {
+			// No more cluster can be picked.
+			break
+		}
+
+		// Exclude the picked clusters from the candidates of the next round.
+		remaining := make([]clusterv1beta1.MemberCluster, 0, len(candidates))
+		for idx := range candidates {
+			if !isPicked(newlyPicked, candidates[idx].Name) {
+				remaining = append(remaining, candidates[idx])
+			}
+		}
+		candidates = remaining
+	}
+
+	// Report the clusters that are not picked, as seen in the last round.
+	limitedByQuotas := maxSelected >= 0 && len(picked) >= maxSelected && len(picked) < numOfClusters
+	for _, sc := range notPicked {
+		decision := newSimulatedDecisionFromScoredCluster(sc)
+		decision.Reason = fmt.Sprintf(notPickedByScoreReasonTemplate, sc.Cluster.Name, sc.Score.AffinityScore, sc.Score.TopologySpreadScore) + extenderScoresReasonSuffix(sc.ExtenderScores)
+		if limitedByQuotas {
+			decision.Reason = fmt.Sprintf(notPickedByQuotaReasonTemplate, sc.Cluster.Name, maxSelected)
+		}
+		decisions = append(decisions, decision)
+	}
+	filtered = append(filtered, quotaLimits.filteredClusters()...)
+	return append(decisions, newSimulatedDecisionsFromFilteredClusters(filtered)...), nil
+}
+
+// newSimulatedDecisionFromScoredCluster returns a simulated decision for a scored cluster, with
+// the weighted scores of each score plugin.
+func newSimulatedDecisionFromScoredCluster(sc *ScoredCluster) *SimulatedClusterDecision {
+	return &SimulatedClusterDecision{
+		ClusterName:    sc.Cluster.Name,
+		Score:          sc.Score,
+		PluginScores:   sc.PluginScores,
+		ExtenderScores: sc.ExtenderScores,
+	}
+}
+
+// newSimulatedDecisionsFromFilteredClusters returns simulated decisions for clusters that have
+// been filtered out.
+func newSimulatedDecisionsFromFilteredClusters(filtered filteredClusterWithStatusList) []*SimulatedClusterDecision {
+	sort.Sort(filtered)
+	decisions := make([]*SimulatedClusterDecision, 0, len(filtered))
+	for _, fc := range filtered {
+		decisions = append(decisions, &SimulatedClusterDecision{
+			ClusterName: fc.cluster.Name,
+			Reason:      fc.status.String(),
+		})
+	}
+	return decisions
+}
+
+// isPicked returns true if a cluster is among the given scored clusters.
+func isPicked(scored ScoredClusters, clusterName string) bool {
+	for _, sc := range scored {
+		if sc.Cluster.Name == clusterName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+)
+
+// TestRunSimulationCycleFor tests the RunSimulationCycleFor method.
+func TestRunSimulationCycleFor(t *testing.T) {
+	filterPluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
+	scorePluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 1)
+	postBatchPluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 2)
+	unhealthyClusterName := "unhealthy"
+
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: anotherClusterName, Labels: map[string]string{"env": "canary"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: unhealthyClusterName}},
+	}
+	scores := map[string]int32{
+		clusterName:        1,
+		altClusterName:     5,
+		anotherClusterName: 3,
+	}
+
+	newPolicy := func(placementType placementv1beta1.PlacementType, numOfClusters int, clusterNames ...string) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+		return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: policyName,
+				Annotations: map[string]string{
+					placementv1beta1.NumberOfClustersAnnotation: fmt.Sprintf("%d", numOfClusters),
+				},
+			},
+			Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType: placementType,
+					ClusterNames:  clusterNames,
+				},
+			},
+		}
+	}
+
+	// simulatedResult is a simplified view of a simulated decision; -1 denotes the absence
+	// of a score.
+	type simulatedResult struct {
+		Selected          bool
+		AffinityScore     int32
+		PluginScores      map[string]int32
+		ObsoleteAffinity  int
+		HasFilteredReason bool
+		HasQuotaReason    bool
+	}
+
+	testCases := []struct {
+		name          string
+		policy        *placementv1beta1.ClusterSchedulingPolicySnapshot
+		batchLimit    int
+		bindings      []client.Object
+		quotas        []client.Object
+		placementKey  queue.PlacementKey
+		wantDecisions map[string]simulatedResult
+	}{
+		{
+			name:   "PickAll",
+			policy: newPolicy(placementv1beta1.PickAllPlacementType, 0),
+			wantDecisions: map[string]simulatedResult{
+				clusterName:          {Selected: true, AffinityScore: -1},
+				altClusterName:       {Selected: true, AffinityScore: -1},
+				anotherClusterName:   {Selected: true, AffinityScore: -1},
+				unhealthyClusterName: {AffinityScore: -1, HasFilteredReason: true},
+			},
+		},
+		{
+			name:   "PickFixed",
+			policy: newPolicy(placementv1beta1.PickFixedPlacementType, 0, clusterName, "unknown"),
+			// The cluster is not eligible for resource placement as it never joins the fleet.
+			wantDecisions: map[string]simulatedResult{
+				clusterName: {AffinityScore: -1},
+				"unknown":   {AffinityScore: -1},
+			},
+		},
+		{
+			name:   "PickN",
+			policy: newPolicy(placementv1beta1.PickNPlacementType, 2),
+			wantDecisions: map[string]simulatedResult{
+				altClusterName:       {Selected: true, AffinityScore: 5, PluginScores: map[string]int32{scorePluginName: 5}},
+				anotherClusterName:   {Selected: true, AffinityScore: 3, PluginScores: map[string]int32{scorePluginName: 3}},
+				clusterName:          {AffinityScore: 1, PluginScores: map[string]int32{scorePluginName: 1}},
+				unhealthyClusterName: {AffinityScore: -1, HasFilteredReason: true},
+			},
+		},
+		{
+			name:       "PickN, with batch size limit",
+			policy:     newPolicy(placementv1beta1.PickNPlacementType, 3),
+			batchLimit: 1,
+			wantDecisions: map[string]simulatedResult{
+				altClusterName:       {Selected: true, AffinityScore: 5, PluginScores: map[string]int32{scorePluginName: 5}},
+				anotherClusterName:   {Selected: true, AffinityScore: 3, PluginScores: map[string]int32{scorePluginName: 3}},
+				clusterName:          {Selected: true, AffinityScore: 1, PluginScores: map[string]int32{scorePluginName: 1}},
+				unhealthyClusterName: {AffinityScore: -1, HasFilteredReason: true},
+			},
+		},
+		{
+			name:         "PickN, with existing bindings",
+			policy:       newPolicy(placementv1beta1.PickNPlacementType, 1),
+			placementKey: queue.PlacementKey(crpName),
+			bindings: []client.Object{
+				&placementv1beta1.ClusterResourceBinding{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "binding-1",
+						Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						State:                        placementv1beta1.BindingStateBound,
+						TargetCluster:                clusterName,
+						SchedulingPolicySnapshotName: policyName,
+					},
+				},
+			},
+			// The existing binding is considered obsolete; it does not prevent the cluster from
+			// being picked, nor does it count towards the number of clusters to pick.
+			wantDecisions: map[string]simulatedResult{
+				altClusterName:       {Selected: true, AffinityScore: 5, PluginScores: map[string]int32{scorePluginName: 5}},
+				anotherClusterName:   {AffinityScore: 3, PluginScores: map[string]int32{scorePluginName: 3}},
+				clusterName:          {AffinityScore: 1, PluginScores: map[string]int32{scorePluginName: 1}, ObsoleteAffinity: 1},
+				unhealthyClusterName: {AffinityScore: -1, HasFilteredReason: true},
+			},
+		},
+		{
+			name:         "PickAll, with fleet resource quotas",
+			policy:       newPolicy(placementv1beta1.PickAllPlacementType, 0),
+			placementKey: queue.PlacementKey(fmt.Sprintf("%s/%s", quotaNamespace, crpName)),
+			bindings: []client.Object{
+				// A binding of another placement in the same namespace.
+				&placementv1beta1.ResourceBinding{
+					ObjectMeta: metav1.ObjectMeta{Namespace: quotaNamespace, Name: "binding-1"},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						State:         placementv1beta1.BindingStateBound,
+						TargetCluster: clusterName,
+					},
+				},
+			},
+			quotas: []client.Object{
+				&placementv1beta1.FleetResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: quotaNamespace, Name: quotaName},
+					Spec: placementv1beta1.FleetResourceQuotaSpec{
+						MaxBindings: ptr.To(int32(2)),
+					},
+				},
+			},
+			// Only one more binding can be created in the namespace.
+			wantDecisions: map[string]simulatedResult{
+				clusterName:          {Selected: true, AffinityScore: -1},
+				anotherClusterName:   {AffinityScore: -1, HasQuotaReason: true},
+				altClusterName:       {AffinityScore: -1, HasQuotaReason: true},
+				unhealthyClusterName: {AffinityScore: -1, HasFilteredReason: true},
+			},
+		},
+		{
+			name:         "PickN, with fleet resource quotas",
+			policy:       newPolicy(placementv1beta1.PickNPlacementType, 3),
+			placementKey: queue.PlacementKey(fmt.Sprintf("%s/%s", quotaNamespace, crpName)),
+			quotas: []client.Object{
+				&placementv1beta1.FleetResourceQuota{
+					ObjectMeta: metav1.ObjectMeta{Namespace: quotaNamespace, Name: quotaName},
+					Spec: placementv1beta1.FleetResourceQuotaSpec{
+						MaxClustersPerPlacement: ptr.To(int32(1)),
+						AllowedClusterSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"canary"}},
+							},
+						},
+					},
+				},
+			},
+			wantDecisions: map[string]simulatedResult{
+				altClusterName:       {Selected: true, AffinityScore: 5, PluginScores: map[string]int32{scorePluginName: 5}},
+				clusterName:          {AffinityScore: 1, PluginScores: map[string]int32{scorePluginName: 1}, HasQuotaReason: true},
+				anotherClusterName:   {AffinityScore: -1, HasQuotaReason: true},
+				unhealthyClusterName: {AffinityScore: -1, HasFilteredReason: true},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(tc.bindings...).
+				WithObjects(tc.quotas...).
+				Build()
+
+			profile := NewProfile(dummyProfileName)
+			profile.WithFilterPlugin(&DummyAllPurposePlugin{
+				name: filterPluginName,
+				filterRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (status *Status) {
+					if cluster.Name == unhealthyClusterName {
+						return NewNonErrorStatus(ClusterUnschedulable, filterPluginName, "cluster is unhealthy")
+					}
+					return nil
+				},
+			})
+			profile.WithScorePlugin(&DummyAllPurposePlugin{
+				name: scorePluginName,
+				scoreRunner: func(_ context.Context, state CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (*ClusterScore, *Status) {
+					score := &ClusterScore{AffinityScore: scores[cluster.Name]}
+					if state.HasObsoleteBindingFor(cluster.Name) {
+						score.ObsoletePlacementAffinityScore = 1
+					}
+					return score, nil
+				},
+			})
+			if tc.batchLimit > 0 {
+				profile.WithPostBatchPlugin(&DummyAllPurposePlugin{
+					name: postBatchPluginName,
+					postBatchRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj) (int, *Status) {
+						return tc.batchLimit, nil
+					},
+				})
+			}
+			f := &framework{
+				profile:                   profile,
+				client:                    fakeClient,
+				uncachedReader:            fakeClient,
+				parallelizer:              parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
+				clusterEligibilityChecker: clustereligibilitychecker.New(),
+				enableFleetResourceQuotas: true,
+			}
+
+			decisions, err := f.RunSimulationCycleFor(context.Background(), tc.placementKey, tc.policy, clusters)
+			if err != nil {
+				t.Fatalf("RunSimulationCycleFor() = %v, want no error", err)
+			}
+
+			gotDecisions := make(map[string]simulatedResult, len(decisions))
+			for _, d := range decisions {
+				got := simulatedResult{
+					Selected:          d.Selected,
+					AffinityScore:     -1,
+					HasFilteredReason: d.Score == nil && strings.Contains(d.Reason, "cluster is unhealthy"),
+					HasQuotaReason:    strings.Contains(d.Reason, "fleet resource quota"),
+				}
+				if d.Score != nil {
+					got.AffinityScore = d.Score.AffinityScore
+					got.ObsoleteAffinity = d.Score.ObsoletePlacementAffinityScore
+				}
+				for pluginName, score := range d.PluginScores {
+					if got.PluginScores == nil {
+						got.PluginScores = map[string]int32{}
+					}
+					got.PluginScores[pluginName] = score.AffinityScore
+				}
+				gotDecisions[d.ClusterName] = got
+			}
+			if diff := cmp.Diff(gotDecisions, tc.wantDecisions); diff != "" {
+				t.Errorf("RunSimulationCycleFor() decisions diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,248 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/extender"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	// ConfigurationAPIVersion is the API version of the scheduler configuration.
+	ConfigurationAPIVersion = "scheduler.kubernetes-fleet.io/v1alpha1"
+	// ConfigurationKind is the kind of the scheduler configuration.
+	ConfigurationKind = "SchedulerConfiguration"
+
+	// allPlugins is the wildcard name which, when used in a disabled plugin list, disables all
+	// the default plugins at an extension point.
+	allPlugins = "*"
+
+	// minScorePluginWeight and maxScorePluginWeight are the boundaries (inclusive) of the weights
+	// a score plugin can have.
+	minScorePluginWeight = 1
+	maxScorePluginWeight = 100
+)
+
+// Configuration is the versioned configuration of the Fleet scheduler, which the hub agent reads
+// from a file (usually mounted from a ConfigMap) at startup.
+//
+// An example configuration:
+//
+//	apiVersion: scheduler.kubernetes-fleet.io/v1alpha1
+//	kind: SchedulerConfiguration
+//	profiles:
+//	- name: NoTopologySpread
+//	  plugins:
+//	    postBatch:
+//	      disabled:
+//	      - name: TopologySpreadConstraints
+//	    ...
+//	    score:
+//	      enabled:
+//	      - name: ClusterAffinity
+//	        weight: 2
+//	  scoreNormalization: MinMax
+//	extenders:
+//	- name: QuotaChecker
+//	  urlPrefix: https://quota-checker.example.com/fleet
+//	  filterVerb: filter
+//	  httpTimeout: 3s
+//	  ignorable: true
+type Configuration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Profiles is the list of scheduling profiles the scheduler supports. A placement picks a
+	// profile by its name; placements that do not specify a profile use the default one.
+	//
+	// Each profile is built on top of the default plugin set. The default profile
+	// (DefaultProfile) is always available; it can be customized by adding a profile of the
+	// same name to the list.
+	Profiles []ProfileConfiguration `json:"profiles,omitempty"`
+
+	// Extenders is the list of HTTP scheduler extenders the scheduler consults with, in order, in
+	// all profiles; extenders run after the Filter and Score plugins.
+	Extenders []extender.Configuration `json:"extenders,omitempty"`
+}
+
+// ProfileConfiguration is the configuration of a scheduling profile.
+type ProfileConfiguration struct {
+	// Name is the name of the profile.
+	Name string `json:"name"`
+
+	// Plugins specifies the plugins to enable or disable at each extension point, on top of
+	// the default plugin set.
+	Plugins *Plugins `json:"plugins,omitempty"`
+
+	// ScoreNormalization specifies how the raw scores of score plugins are normalized before they
+	// are weighted and summed up; it can be None (the raw scores are used as they are) or MinMax
+	// (the raw scores each plugin gives are scaled into the range [0, 100] across all the
+	// candidate clusters). Defaults to None.
+	//
+	// Note that MinMax normalization might change how clusters are ranked, as it amplifies small
+	// differences between the raw scores of a plugin.
+	ScoreNormalization framework.ScoreNormalization `json:"scoreNormalization,omitempty"`
+}
+
+// Plugins specifies the plugins to enable or disable at each extension point.
+type Plugins struct {
+	// PostBatch is the plugin set for the PostBatch extension point.
+	PostBatch PluginSet `json:"postBatch,omitempty"`
+	// PreFilter is the plugin set for the PreFilter extension point.
+	PreFilter PluginSet `json:"preFilter,omitempty"`
+	// Filter is the plugin set for the Filter extension point.
+	Filter PluginSet `json:"filter,omitempty"`
+	// PreScore is the plugin set for the PreScore extension point.
+	PreScore PluginSet `json:"preScore,omitempty"`
+	// Score is the plugin set for the Score extension point.
+	Score PluginSet `json:"score,omitempty"`
+}
+
+// PluginSet specifies the plugins to enable or disable at an extension point.
+//
+// Default plugins that are not disabled run first, in their default order; enabled plugins
+// that are not in the default set run afterwards, in the order they are listed.
+type PluginSet struct {
+	// Enabled is the list of plugins to enable in addition to the default ones.
+	Enabled []Plugin `json:"enabled,omitempty"`
+	// Disabled is the list of default plugins to disable; use "*" to disable all of them.
+	Disabled []Plugin `json:"disabled,omitempty"`
+}
+
+// Plugin specifies a plugin by its name.
+type Plugin struct {
+	// Name is the name of the plugin.
+	Name string `json:"name"`
+
+	// Weight is the weight of the plugin; the scores the plugin reports (normalized, if
+	// applicable) are multiplied by its weight before they are summed up with the scores of
+	// other plugins. It is only applicable to plugins enabled at the Score extension point, and
+	// must be in the range of [1, 100]. Defaults to 1.
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// LoadConfiguration reads and validates the scheduler configuration from a file.
+func LoadConfiguration(path string) (*Configuration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scheduler configuration file %s: %w", path, err)
+	}
+
+	cfg := &Configuration{}
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to decode scheduler configuration file %s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid scheduler configuration in file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate checks if the scheduler configuration is valid.
+func (cfg *Configuration) Validate() error {
+	if cfg.APIVersion != ConfigurationAPIVersion || cfg.Kind != ConfigurationKind {
+		return fmt.Errorf("unsupported scheduler configuration type %s/%s, want %s/%s", cfg.APIVersion, cfg.Kind, ConfigurationAPIVersion, ConfigurationKind)
+	}
+
+	knownPlugins := sets.New(registeredPluginNames()...)
+	profileNames := sets.New[string]()
+	for idx := range cfg.Profiles {
+		profileCfg := &cfg.Profiles[idx]
+		if len(profileCfg.Name) == 0 {
+			return fmt.Errorf("profile %d has no name", idx)
+		}
+		if profileNames.Has(profileCfg.Name) {
+			return fmt.Errorf("profile %s is defined more than once", profileCfg.Name)
+		}
+		profileNames.Insert(profileCfg.Name)
+
+		switch profileCfg.ScoreNormalization {
+		case "", framework.ScoreNormalizationNone, framework.ScoreNormalizationMinMax:
+		default:
+			return fmt.Errorf("profile %s: unknown score normalization %q, want %s or %s", profileCfg.Name, profileCfg.ScoreNormalization, framework.ScoreNormalizationNone, framework.ScoreNormalizationMinMax)
+		}
+
+		if profileCfg.Plugins == nil {
+			continue
+		}
+		pluginSets := map[string]PluginSet{
+			postBatchExtensionPoint: profileCfg.Plugins.PostBatch,
+			preFilterExtensionPoint: profileCfg.Plugins.PreFilter,
+			filterExtensionPoint:    profileCfg.Plugins.Filter,
+			preScoreExtensionPoint:  profileCfg.Plugins.PreScore,
+			scoreExtensionPoint:     profileCfg.Plugins.Score,
+		}
+		for extensionPoint, pluginSet := range pluginSets {
+			if err := validatePluginSet(extensionPoint, pluginSet, knownPlugins); err != nil {
+				return fmt.Errorf("profile %s: %w", profileCfg.Name, err)
+			}
+		}
+	}
+
+	extenderNames := sets.New[string]()
+	for idx := range cfg.Extenders {
+		extenderCfg := &cfg.Extenders[idx]
+		if err := extenderCfg.Validate(); err != nil {
+			return err
+		}
+		if extenderNames.Has(extenderCfg.Name) {
+			return fmt.Errorf("extender %s is defined more than once", extenderCfg.Name)
+		}
+		extenderNames.Insert(extenderCfg.Name)
+	}
+	return nil
+}
+
+// validatePluginSet checks if a plugin set is valid.
+func validatePluginSet(extensionPoint string, pluginSet PluginSet, knownPlugins sets.Set[string]) error {
+	for _, pl := range pluginSet.Disabled {
+		if pl.Name != allPlugins && !knownPlugins.Has(pl.Name) {
+			return fmt.Errorf("unknown plugin %s disabled at extension point %s", pl.Name, extensionPoint)
+		}
+		if pl.Weight != nil {
+			return fmt.Errorf("weight is set for plugin %s disabled at extension point %s", pl.Name, extensionPoint)
+		}
+	}
+
+	enabled := sets.New[string]()
+	for _, pl := range pluginSet.Enabled {
+		if !knownPlugins.Has(pl.Name) {
+			return fmt.Errorf("unknown plugin %s enabled at extension point %s", pl.Name, extensionPoint)
+		}
+		if enabled.Has(pl.Name) {
+			return fmt.Errorf("plugin %s is enabled more than once at extension point %s", pl.Name, extensionPoint)
+		}
+		enabled.Insert(pl.Name)
+
+		if pl.Weight == nil {
+			continue
+		}
+		if extensionPoint != scoreExtensionPoint {
+			return fmt.Errorf("weight is set for plugin %s at extension point %s; weights are only applicable to the %s extension point", pl.Name, extensionPoint, scoreExtensionPoint)
+		}
+		if *pl.Weight < minScorePluginWeight || *pl.Weight > maxScorePluginWeight {
+			return fmt.Errorf("weight %d of plugin %s is out of range [%d, %d]", *pl.Weight, pl.Name, minScorePluginWeight, maxScorePluginWeight)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/extender"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+var (
+	configurationTypeMeta = metav1.TypeMeta{
+		APIVersion: ConfigurationAPIVersion,
+		Kind:       ConfigurationKind,
+	}
+)
+
+// TestLoadConfiguration tests the LoadConfiguration function.
+func TestLoadConfiguration(t *testing.T) {
+	testCases := []struct {
+		name       string
+		content    string
+		wantConfig *Configuration
+		wantErrMsg string
+	}{
+		{
+			name: "valid configuration",
+			content: `
+apiVersion: scheduler.kubernetes-fleet.io/v1alpha1
+kind: SchedulerConfiguration
+profiles:
+- name: NoTopologySpread
+  scoreNormalization: MinMax
+  plugins:
+    postBatch:
+      disabled:
+      - name: TopologySpreadConstraints
+    score:
+      enabled:
+      - name: ClusterAffinity
+        weight: 2
+`,
+			wantConfig: &Configuration{
+				TypeMeta: configurationTypeMeta,
+				Profiles: []ProfileConfiguration{
+					{
+						Name:               "NoTopologySpread",
+						ScoreNormalization: framework.ScoreNormalizationMinMax,
+						Plugins: &Plugins{
+							PostBatch: PluginSet{
+								Disabled: []Plugin{{Name: "TopologySpreadConstraints"}},
+							},
+							Score: PluginSet{
+								Enabled: []Plugin{{Name: "ClusterAffinity", Weight: ptr.To(int32(2))}},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown field",
+			content: `
+apiVersion: scheduler.kubernetes-fleet.io/v1alpha1
+kind: SchedulerConfiguration
+profile:
+- name: NoTopologySpread
+`,
+			wantErrMsg: "failed to decode",
+		},
+		{
+			name: "wrong kind",
+			content: `
+apiVersion: scheduler.kubernetes-fleet.io/v1alpha1
+kind: KubeSchedulerConfiguration
+`,
+			wantErrMsg: "unsupported scheduler configuration type",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			if err := os.WriteFile(path, []byte(tc.content), 0600); err != nil {
+				t.Fatalf("failed to write configuration file: %v", err)
+			}
+
+			cfg, err := LoadConfiguration(path)
+			if tc.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsg) {
+					t.Fatalf("LoadConfiguration() error = %v, want error containing %s", err, tc.wantErrMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadConfiguration() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(cfg, tc.wantConfig); diff != "" {
+				t.Errorf("LoadConfiguration() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestValidate tests the Validate method of Configuration.
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		profiles   []ProfileConfiguration
+		extenders  []extender.Configuration
+		wantErrMsg string
+	}{
+		{
+			name: "no profiles",
+		},
+		{
+			name: "valid profiles",
+			profiles: []ProfileConfiguration{
+				{
+					Name: DefaultProfileName,
+					Plugins: &Plugins{
+						Score: PluginSet{
+							Enabled: []Plugin{{Name: "TopologySpreadConstraints", Weight: ptr.To(int32(100))}},
+						},
+					},
+				},
+				{
+					Name: "FilterOnly",
+					Plugins: &Plugins{
+						PreScore: PluginSet{
+							Disabled: []Plugin{{Name: allPlugins}},
+						},
+						Score: PluginSet{
+							Disabled: []Plugin{{Name: allPlugins}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "valid extenders",
+			extenders: []extender.Configuration{
+				{
+					Name:       "QuotaChecker",
+					URLPrefix:  "https://quota-checker.example.com",
+					FilterVerb: "filter",
+				},
+				{
+					Name:      "CostScorer",
+					URLPrefix: "http://cost-scorer.example.com",
+					ScoreVerb: "score",
+				},
+			},
+		},
+		{
+			name: "invalid extender",
+			extenders: []extender.Configuration{
+				{
+					Name:      "QuotaChecker",
+					URLPrefix: "https://quota-checker.example.com",
+				},
+			},
+			wantErrMsg: "neither a filter verb nor a score verb",
+		},
+		{
+			name: "duplicate extenders",
+			extenders: []extender.Configuration{
+				{
+					Name:       "QuotaChecker",
+					URLPrefix:  "https://quota-checker.example.com",
+					FilterVerb: "filter",
+				},
+				{
+					Name:       "QuotaChecker",
+					URLPrefix:  "https://quota-checker.example.com",
+					FilterVerb: "filter",
+				},
+			},
+			wantErrMsg: "extender QuotaChecker is defined more than once",
+		},
+		{
+			name:       "profile without a name",
+			profiles:   []ProfileConfiguration{{}},
+			wantErrMsg: "has no name",
+		},
+		{
+			name: "duplicate profiles",
+			profiles: []ProfileConfiguration{
+				{Name: "Profile"},
+				{Name: "Profile"},
+			},
+			wantErrMsg: "defined more than once",
+		},
+		{
+			name: "unknown plugin enabled",
+			profiles: []ProfileConfiguration{
+				{
+					Name: "Profile",
+					Plugins: &Plugins{
+						Filter: PluginSet{
+							Enabled: []Plugin{{Name: "Unknown"}},
+						},
+					},
+				},
+			},
+			wantErrMsg: "unknown plugin Unknown enabled",
+		},
+		{
+			name: "unknown plugin disabled",
+			profiles: []ProfileConfiguration{
+				{
+					Name: "Profile",
+					Plugins: &Plugins{
+						Filter: PluginSet{
+							Disabled: []Plugin{{Name: "Unknown"}},
+						},
+					},
+				},
+			},
+			wantErrMsg: "unknown plugin Unknown disabled",
+		},
+		{
+			name: "plugin enabled twice",
+			profiles: []ProfileConfiguration{
+				{
+					Name: "Profile",
+					Plugins: &Plugins{
+						Score: PluginSet{
+							Enabled: []Plugin{{Name: "ClusterAffinity"}, {Name: "ClusterAffinity"}},
+						},
+					},
+				},
+			},
+			wantErrMsg: "enabled more than once",
+		},
+		{
+			name: "weight set at non-score extension point",
+			profiles: []ProfileConfiguration{
+				{
+					Name: "Profile",
+					Plugins: &Plugins{
+						Filter: PluginSet{
+							Enabled: []Plugin{{Name: "ClusterAffinity", Weight: ptr.To(int32(2))}},
+						},
+					},
+				},
+			},
+			wantErrMsg: "weights are only applicable",
+		},
+		{
+			name: "weight out of range",
+			profiles: []ProfileConfiguration{
+				{
+					Name: "Profile",
+					Plugins: &Plugins{
+						Score: PluginSet{
+							Enabled: []Plugin{{Name: "ClusterAffinity", Weight: ptr.To(int32(0))}},
+						},
+					},
+				},
+			},
+			wantErrMsg: "out of range",
+		},
+		{
+			name: "unknown score normalization",
+			profiles: []ProfileConfiguration{
+				{
+					Name:               "Profile",
+					ScoreNormalization: "ZScore",
+				},
+			},
+			wantErrMsg: "unknown score normalization",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &Configuration{
+				TypeMeta:  configurationTypeMeta,
+				Profiles:  tc.profiles,
+				Extenders: tc.extenders,
+			}
+			err := cfg.Validate()
+			if tc.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsg) {
+					t.Fatalf("Validate() = %v, want error containing %s", err, tc.wantErrMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() = %v, want no error", err)
+			}
+		})
+	}
+}
@@ -18,17 +18,30 @@ limitations under the License.
 package profile
 
 import (
+	"fmt"
+
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusteraffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clustereligibility"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/placementaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/resourcefit"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/sameplacementaffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/topologyspreadconstraints"
 )
 
 const (
-	// defaultProfileName is the default name for the scheduling profile.
-	defaultProfileName = "DefaultProfile"
+	// DefaultProfileName is the name of the default scheduling profile.
+	DefaultProfileName = "DefaultProfile"
+)
+
+const (
+	// The names of the extension points, as used in the scheduler configuration.
+	postBatchExtensionPoint = "postBatch"
+	preFilterExtensionPoint = "preFilter"
+	filterExtensionPoint    = "filter"
+	preScoreExtensionPoint  = "preScore"
+	scoreExtensionPoint     = "score"
 )
 
 // Options holds the configuration options for creating a scheduling profile.
@@ -43,22 +56,181 @@ func NewDefaultProfile() *framework.Profile {
 
 // NewProfile creates a scheduling profile with the given options.
 func NewProfile(opts Options) *framework.Profile {
-	p := framework.NewProfile(defaultProfileName)
+	// The default plugin set is always valid; an error should never occur here.
+	p, err := newProfileFromConfiguration(&ProfileConfiguration{Name: DefaultProfileName}, opts)
+	if err != nil {
+		panic(fmt.Sprintf("failed to build the default scheduling profile: %v", err))
+	}
+	return p
+}
+
+// NewProfilesFromConfiguration creates the scheduling profiles specified in the scheduler configuration.
+//
+// The default profile is always included (as the first item in the returned list), even if the
+// configuration does not specify it; a nil configuration yields the default profile only.
+func NewProfilesFromConfiguration(cfg *Configuration, opts Options) ([]*framework.Profile, error) {
+	profileCfgs := []ProfileConfiguration{{Name: DefaultProfileName}}
+	if cfg != nil {
+		for idx := range cfg.Profiles {
+			if cfg.Profiles[idx].Name == DefaultProfileName {
+				// The default profile has been customized.
+				profileCfgs[0] = cfg.Profiles[idx]
+				continue
+			}
+			profileCfgs = append(profileCfgs, cfg.Profiles[idx])
+		}
+	}
 
-	// default plugin list
+	profiles := make([]*framework.Profile, 0, len(profileCfgs))
+	for idx := range profileCfgs {
+		p, err := newProfileFromConfiguration(&profileCfgs[idx], opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build scheduling profile %s: %w", profileCfgs[idx].Name, err)
+		}
+		profiles = append(profiles, p)
+	}
+	return profiles, nil
+}
+
+// newPluginRegistry instantiates all the plugins that can be used in a scheduling profile, keyed
+// by their names; it also returns the names of the default plugins at each extension point,
+// in the order they run.
+//
+// Note that plugins are instantiated per profile, as each plugin is set up with the framework
+// its profile is associated with.
+func newPluginRegistry(opts Options) (registry map[string]framework.Plugin, defaults map[string][]string) {
 	clusterAffinityPlugin := clusteraffinity.New()
 	if opts.ClusterAffinityPlugin != nil {
 		clusterAffinityPlugin = *opts.ClusterAffinityPlugin
 	}
 	clusterEligibilityPlugin := clustereligibility.New()
+	placementAffinityPlugin := placementaffinity.New()
 	samePlacementAffinityPlugin := sameplacementaffinity.New()
+	resourceFitPlugin := resourcefit.New()
 	topologySpreadConstraintsPlugin := topologyspreadconstraints.New()
 	taintTolerationPlugin := tainttoleration.New()
 
-	p.WithPostBatchPlugin(&topologySpreadConstraintsPlugin).
-		WithPreFilterPlugin(&clusterAffinityPlugin).WithPreFilterPlugin(&topologySpreadConstraintsPlugin).
-		WithFilterPlugin(&clusterAffinityPlugin).WithFilterPlugin(&clusterEligibilityPlugin).WithFilterPlugin(&taintTolerationPlugin).WithFilterPlugin(&samePlacementAffinityPlugin).WithFilterPlugin(&topologySpreadConstraintsPlugin).
-		WithPreScorePlugin(&clusterAffinityPlugin).WithPreScorePlugin(&topologySpreadConstraintsPlugin).
-		WithScorePlugin(&clusterAffinityPlugin).WithScorePlugin(&samePlacementAffinityPlugin).WithScorePlugin(&topologySpreadConstraintsPlugin)
-	return p
+	registry = map[string]framework.Plugin{}
+	for _, pl := range []framework.Plugin{
+		&clusterAffinityPlugin,
+		&clusterEligibilityPlugin,
+		&placementAffinityPlugin,
+		&samePlacementAffinityPlugin,
+		&resourceFitPlugin,
+		&topologySpreadConstraintsPlugin,
+		&taintTolerationPlugin,
+	} {
+		registry[pl.Name()] = pl
+	}
+
+	defaults = map[string][]string{
+		postBatchExtensionPoint: {topologySpreadConstraintsPlugin.Name()},
+		preFilterExtensionPoint: {
+			clusterAffinityPlugin.Name(), placementAffinityPlugin.Name(), resourceFitPlugin.Name(),
+			topologySpreadConstraintsPlugin.Name(),
+		},
+		filterExtensionPoint: {
+			clusterAffinityPlugin.Name(), clusterEligibilityPlugin.Name(), taintTolerationPlugin.Name(),
+			placementAffinityPlugin.Name(), samePlacementAffinityPlugin.Name(), resourceFitPlugin.Name(),
+			topologySpreadConstraintsPlugin.Name(),
+		},
+		preScoreExtensionPoint: {clusterAffinityPlugin.Name(), placementAffinityPlugin.Name(), topologySpreadConstraintsPlugin.Name()},
+		scoreExtensionPoint: {
+			clusterAffinityPlugin.Name(), placementAffinityPlugin.Name(), samePlacementAffinityPlugin.Name(),
+			topologySpreadConstraintsPlugin.Name(),
+		},
+	}
+	return registry, defaults
+}
+
+// registeredPluginNames returns the names of all the plugins that can be used in a scheduling profile.
+func registeredPluginNames() []string {
+	registry, _ := newPluginRegistry(Options{})
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	return names
+}
+
+// newProfileFromConfiguration creates a scheduling profile from its configuration.
+func newProfileFromConfiguration(profileCfg *ProfileConfiguration, opts Options) (*framework.Profile, error) {
+	registry, defaults := newPluginRegistry(opts)
+	plugins := Plugins{}
+	if profileCfg.Plugins != nil {
+		plugins = *profileCfg.Plugins
+	}
+
+	p := framework.NewProfile(profileCfg.Name)
+	for _, name := range mergePluginSet(defaults[postBatchExtensionPoint], plugins.PostBatch) {
+		pl, ok := registry[name].(framework.PostBatchPlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %s does not support extension point %s", name, postBatchExtensionPoint)
+		}
+		p.WithPostBatchPlugin(pl)
+	}
+	for _, name := range mergePluginSet(defaults[preFilterExtensionPoint], plugins.PreFilter) {
+		pl, ok := registry[name].(framework.PreFilterPlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %s does not support extension point %s", name, preFilterExtensionPoint)
+		}
+		p.WithPreFilterPlugin(pl)
+	}
+	for _, name := range mergePluginSet(defaults[filterExtensionPoint], plugins.Filter) {
+		pl, ok := registry[name].(framework.FilterPlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %s does not support extension point %s", name, filterExtensionPoint)
+		}
+		p.WithFilterPlugin(pl)
+	}
+	for _, name := range mergePluginSet(defaults[preScoreExtensionPoint], plugins.PreScore) {
+		pl, ok := registry[name].(framework.PreScorePlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %s does not support extension point %s", name, preScoreExtensionPoint)
+		}
+		p.WithPreScorePlugin(pl)
+	}
+	for _, name := range mergePluginSet(defaults[scoreExtensionPoint], plugins.Score) {
+		pl, ok := registry[name].(framework.ScorePlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %s does not support extension point %s", name, scoreExtensionPoint)
+		}
+		p.WithScorePlugin(pl)
+	}
+	for _, pl := range plugins.Score.Enabled {
+		if pl.Weight != nil {
+			p.WithScorePluginWeight(pl.Name, *pl.Weight)
+		}
+	}
+	if len(profileCfg.ScoreNormalization) > 0 {
+		p.WithScoreNormalization(profileCfg.ScoreNormalization)
+	}
+	return p, nil
+}
+
+// mergePluginSet returns the names of the plugins to run at an extension point, in order, given
+// the default plugins at the extension point and the plugin set in the configuration.
+func mergePluginSet(defaults []string, pluginSet PluginSet) []string {
+	disabled := map[string]bool{}
+	for _, pl := range pluginSet.Disabled {
+		disabled[pl.Name] = true
+	}
+
+	merged := make([]string, 0, len(defaults)+len(pluginSet.Enabled))
+	included := map[string]bool{}
+	if !disabled[allPlugins] {
+		for _, name := range defaults {
+			if !disabled[name] {
+				merged = append(merged, name)
+				included[name] = true
+			}
+		}
+	}
+	for _, pl := range pluginSet.Enabled {
+		if !included[pl.Name] {
+			merged = append(merged, pl.Name)
+			included[pl.Name] = true
+		}
+	}
+	return merged
 }
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestNewProfilesFromConfiguration tests the NewProfilesFromConfiguration function.
+func TestNewProfilesFromConfiguration(t *testing.T) {
+	testCases := []struct {
+		name             string
+		cfg              *Configuration
+		wantProfileNames []string
+		wantErr          bool
+	}{
+		{
+			name:             "nil configuration",
+			wantProfileNames: []string{DefaultProfileName},
+		},
+		{
+			name: "multiple profiles",
+			cfg: &Configuration{
+				TypeMeta: configurationTypeMeta,
+				Profiles: []ProfileConfiguration{
+					{Name: "ProfileA"},
+					{
+						Name: DefaultProfileName,
+						Plugins: &Plugins{
+							Score: PluginSet{
+								Disabled: []Plugin{{Name: allPlugins}},
+							},
+						},
+					},
+					{Name: "ProfileB"},
+				},
+			},
+			wantProfileNames: []string{DefaultProfileName, "ProfileA", "ProfileB"},
+		},
+		{
+			name: "plugin enabled at an unsupported extension point",
+			cfg: &Configuration{
+				TypeMeta: configurationTypeMeta,
+				Profiles: []ProfileConfiguration{
+					{
+						Name: "Profile",
+						Plugins: &Plugins{
+							PostBatch: PluginSet{
+								Enabled: []Plugin{{Name: "ClusterEligibility"}},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			profiles, err := NewProfilesFromConfiguration(tc.cfg, Options{})
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("NewProfilesFromConfiguration() = %v, want error", profiles)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewProfilesFromConfiguration() = %v, want no error", err)
+			}
+
+			profileNames := make([]string, 0, len(profiles))
+			for _, p := range profiles {
+				profileNames = append(profileNames, p.Name())
+			}
+			if diff := cmp.Diff(profileNames, tc.wantProfileNames); diff != "" {
+				t.Errorf("NewProfilesFromConfiguration() profile names diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestMergePluginSet tests the mergePluginSet function.
+func TestMergePluginSet(t *testing.T) {
+	defaults := []string{"A", "B", "C"}
+
+	testCases := []struct {
+		name      string
+		pluginSet PluginSet
+		want      []string
+	}{
+		{
+			name: "no changes",
+			want: []string{"A", "B", "C"},
+		},
+		{
+			name: "disable one default plugin",
+			pluginSet: PluginSet{
+				Disabled: []Plugin{{Name: "B"}},
+			},
+			want: []string{"A", "C"},
+		},
+		{
+			name: "disable all default plugins and enable some",
+			pluginSet: PluginSet{
+				Enabled:  []Plugin{{Name: "C"}, {Name: "D"}, {Name: "A"}},
+				Disabled: []Plugin{{Name: allPlugins}},
+			},
+			want: []string{"C", "D", "A"},
+		},
+		{
+			name: "enable plugins already in the default set",
+			pluginSet: PluginSet{
+				Enabled: []Plugin{{Name: "D"}, {Name: "A"}},
+			},
+			want: []string{"A", "B", "C", "D"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(mergePluginSet(defaults, tc.pluginSet), tc.want); diff != "" {
+				t.Errorf("mergePluginSet() diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+
+	hubmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/hub"
+)
+
+// WeightFunc returns the weight of a namespace in the scheduling queue; a namespace of weight N
+// may have up to N of its placements dequeued in one round before other namespaces take their turns.
+//
+// Cluster-scoped placements (i.e., ClusterResourcePlacements) are considered to be in a namespace
+// of an empty name.
+type WeightFunc func(namespace string) int
+
+// fairAttributes are the attributes of a placement that decide its position in the fair queue.
+type fairAttributes struct {
+	priority int32
+	// weight is the weight of the namespace of the placement.
+	weight int
+}
+
+// fairItem is an item kept in the fair queue.
+type fairItem struct {
+	key       any
+	namespace string
+	fairAttributes
+	// addedAt is the time the item has been added to the queue, which is used to report the
+	// time the item has waited in the queue.
+	addedAt time.Time
+}
+
+// fairBucket keeps the items of one namespace at one priority level, in FIFO order.
+type fairBucket struct {
+	namespace string
+	items     []*fairItem
+	// weight is the last evaluated weight of the namespace.
+	weight int
+	// credit is the number of items the bucket may still have dequeued in the current round.
+	credit int
+}
+
+// fairLevel keeps the items of one priority level, bucketed by their namespaces; the buckets take
+// turns to have their items dequeued, in weighted round robin order.
+type fairLevel struct {
+	buckets map[string]*fairBucket
+	// ring is the list of buckets with items, in the order they take turns.
+	ring []*fairBucket
+	// cursor is the position of the bucket whose turn it is in the ring.
+	cursor int
+}
+
+// fairQueue is a storage for a work queue which orders items by their priority and, among items of
+// the same priority, shares the queue fairly between namespaces; it implements the workqueue.Queue
+// interface.
+//
+// Items of a higher priority are always dequeued first; among items of the same priority, each
+// namespace takes turns to have up to as many items dequeued as its weight, in FIFO order, so that a
+// burst of placements in one namespace cannot starve the placements in other namespaces.
+//
+// Note that the work queue guards all calls to its storage with its own lock, and never pushes
+// an item that is already in the storage; consequently, the levels do not need a lock of their own,
+// and each item appears in the storage at most once.
+//
+// The priority of a placement and the weight of its namespace are evaluated right before the
+// placement is added to the work queue (see evaluateAttributes), as the evaluation might involve
+// API calls, which should not be made while the work queue holds its lock; delayed and rate limited
+// additions have the attributes evaluated when their delays have passed. The weight of a namespace
+// is kept with the bucket of the namespace, and is dropped once the namespace has no item left.
+type fairQueue struct {
+	levels map[int32]*fairLevel
+	// priorities are the priorities of the levels with items, in descending order.
+	priorities []int32
+	itemByKey  map[any]*fairItem
+	priorityOf PriorityFunc
+	weightOf   WeightFunc
+	// now returns the current time; it is replaced in tests.
+	now func() time.Time
+
+	// attrsByKey keeps the evaluated attributes of the placements that are being added, until the
+	// work queue pushes (or touches) them; it is guarded by its own lock, as evaluations run
+	// outside of the lock of the work queue.
+	//
+	// Note that a placement that is added while it is being processed is pushed only when it is
+	// marked as done; its evaluated attributes are kept until then.
+	attrsMu    sync.Mutex
+	attrsByKey map[any]fairAttributes
+}
+
+// Verify that fairQueue implements workqueue.Queue at compile time.
+var _ workqueue.Queue[any] = &fairQueue{}
+
+// namespaceOf returns the namespace of a placement from its key.
+func namespaceOf(key any) string {
+	namespace, _, found := strings.Cut(string(key.(PlacementKey)), "/")
+	if !found {
+		return ""
+	}
+	return namespace
+}
+
+// evaluateAttributes evaluates the priority of a placement and the weight of its namespace, for a
+// placement that is about to be added to the work queue; it must be called without holding the
+// lock of the work queue.
+func (fq *fairQueue) evaluateAttributes(placementKey PlacementKey) {
+	namespace := namespaceOf(placementKey)
+	var priority int32
+	if fq.priorityOf != nil {
+		priority = fq.priorityOf(placementKey)
+	}
+	weight := 1
+	if fq.weightOf != nil {
+		weight = max(fq.weightOf(namespace), 1)
+	}
+
+	fq.attrsMu.Lock()
+	defer fq.attrsMu.Unlock()
+	fq.attrsByKey[placementKey] = fairAttributes{priority: priority, weight: weight}
+}
+
+// takeAttributesOf returns (and forgets) the last evaluated attributes of a placement, if any.
+func (fq *fairQueue) takeAttributesOf(key any) (fairAttributes, bool) {
+	fq.attrsMu.Lock()
+	defer fq.attrsMu.Unlock()
+	attrs, ok := fq.attrsByKey[key]
+	delete(fq.attrsByKey, key)
+	return attrs, ok
+}
+
+// push adds an item to the bucket of its namespace at its priority level.
+func (fq *fairQueue) push(item *fairItem) {
+	level, ok := fq.levels[item.priority]
+	if !ok {
+		level = &fairLevel{buckets: make(map[string]*fairBucket)}
+		fq.levels[item.priority] = level
+		idx := sort.Search(len(fq.priorities), func(i int) bool { return fq.priorities[i] < item.priority })
+		fq.priorities = append(fq.priorities, 0)
+		copy(fq.priorities[idx+1:], fq.priorities[idx:])
+		fq.priorities[idx] = item.priority
+	}
+	bucket, ok := level.buckets[item.namespace]
+	if !ok {
+		// The namespace joins the end of the ring, with its latest weight.
+		bucket = &fairBucket{namespace: item.namespace, credit: item.weight}
+		level.buckets[item.namespace] = bucket
+		level.ring = append(level.ring, bucket)
+	}
+	// The bucket takes the latest weight of the namespace for its next rounds.
+	bucket.weight = item.weight
+	bucket.items = append(bucket.items, item)
+	fq.itemByKey[item.key] = item
+}
+
+// remove removes an item from the bucket of its namespace at its priority level.
+func (fq *fairQueue) remove(item *fairItem) {
+	level := fq.levels[item.priority]
+	bucket := level.buckets[item.namespace]
+	for idx := range bucket.items {
+		if bucket.items[idx] == item {
+			bucket.items = append(bucket.items[:idx], bucket.items[idx+1:]...)
+			break
+		}
+	}
+	delete(fq.itemByKey, item.key)
+	if len(bucket.items) == 0 {
+		fq.removeBucket(item.priority, level, bucket)
+	}
+}
+
+// removeBucket removes an empty bucket from its priority level, and the level itself if the level
+// has no bucket left.
+func (fq *fairQueue) removeBucket(priority int32, level *fairLevel, bucket *fairBucket) {
+	delete(level.buckets, bucket.namespace)
+	for idx := range level.ring {
+		if level.ring[idx] != bucket {
+			continue
+		}
+		level.ring = append(level.ring[:idx], level.ring[idx+1:]...)
+		if idx < level.cursor {
+			level.cursor--
+		}
+		break
+	}
+	if level.cursor >= len(level.ring) {
+		level.cursor = 0
+	}
+
+	if len(level.ring) > 0 {
+		return
+	}
+	delete(fq.levels, priority)
+	for idx := range fq.priorities {
+		if fq.priorities[idx] == priority {
+			fq.priorities = append(fq.priorities[:idx], fq.priorities[idx+1:]...)
+			break
+		}
+	}
+}
+
+// Touch updates the attributes of an item that is added again while still in the queue, as the
+// priority of the placement, or the weight of its namespace, might have changed since it was pushed.
+func (fq *fairQueue) Touch(key any) {
+	item, ok := fq.itemByKey[key]
+	if !ok {
+		return
+	}
+	attrs, ok := fq.takeAttributesOf(key)
+	if !ok {
+		return
+	}
+	if attrs.priority != item.priority {
+		fq.remove(item)
+		item.fairAttributes = attrs
+		fq.push(item)
+		return
+	}
+	item.weight = attrs.weight
+	fq.levels[item.priority].buckets[item.namespace].weight = attrs.weight
+}
+
+// Push adds a new item.
+func (fq *fairQueue) Push(key any) {
+	// Every push follows the evaluation of the attributes of the placement, which are kept until
+	// the placement is pushed or touched; the defaults (a priority of zero and a weight of one) are
+	// used only as a fallback.
+	attrs, ok := fq.takeAttributesOf(key)
+	if !ok {
+		attrs = fairAttributes{weight: 1}
+	}
+	item := &fairItem{
+		key:            key,
+		namespace:      namespaceOf(key),
+		fairAttributes: attrs,
+		addedAt:        fq.now(),
+	}
+	fq.push(item)
+	hubmetrics.SchedulingQueueDepth.WithLabelValues(item.namespace).Inc()
+}
+
+// Len returns the total number of items.
+func (fq *fairQueue) Len() int {
+	return len(fq.itemByKey)
+}
+
+// Pop retrieves the next item, from the namespace whose turn it is at the highest priority level.
+func (fq *fairQueue) Pop() any {
+	priority := fq.priorities[0]
+	level := fq.levels[priority]
+	bucket := level.ring[level.cursor]
+
+	item := bucket.items[0]
+	bucket.items = bucket.items[1:]
+	delete(fq.itemByKey, item.key)
+	bucket.credit--
+
+	switch {
+	case len(bucket.items) == 0:
+		// The namespace leaves the ring; the next namespace takes its turn.
+		fq.removeBucket(priority, level, bucket)
+	case bucket.credit <= 0:
+		// The namespace has used up its turn; refill its credit for the next round.
+		bucket.credit = bucket.weight
+		level.cursor = (level.cursor + 1) % len(level.ring)
+	}
+
+	hubmetrics.SchedulingQueueDepth.WithLabelValues(item.namespace).Dec()
+	hubmetrics.SchedulingQueueWaitDurationSeconds.WithLabelValues(item.namespace).Observe(fq.now().Sub(item.addedAt).Seconds())
+	return item.key
+}
+
+// NewFairPlacementSchedulingQueue returns a PlacementSchedulingQueue which dequeues PlacementKeys
+// in the order of their priority (as reported by the given priority function), and, for
+// PlacementKeys of the same priority, shares the queue between namespaces in weighted round robin
+// order (with the weights reported by the given weight function); PlacementKeys of the same
+// namespace and priority are dequeued in the order they are added.
+//
+// Either function can be nil, in which case all PlacementKeys have a priority of zero, or all
+// namespaces have a weight of one, respectively.
+//
+// Rate limiting and delays work the same way as they do with the simple scheduling queue; a
+// PlacementKey enters the fair ordering, with its attributes evaluated, only after its rate limit or
+// delay has passed. The queue
+// reports its depth, and the time PlacementKeys wait in the fair ordering, per namespace.
+func NewFairPlacementSchedulingQueue(name string, rateLimiter workqueue.TypedRateLimiter[any], priorityOf PriorityFunc, weightOf WeightFunc) PlacementSchedulingQueue {
+	if len(name) == 0 {
+		name = defaultSimplePlacementSchedulingQueueOptions.name
+	}
+	if rateLimiter == nil {
+		rateLimiter = defaultSimplePlacementSchedulingQueueOptions.rateLimiter
+	}
+
+	storage := &fairQueue{
+		levels:     make(map[int32]*fairLevel),
+		itemByKey:  make(map[any]*fairItem),
+		priorityOf: priorityOf,
+		weightOf:   weightOf,
+		now:        time.Now,
+		attrsByKey: make(map[any]fairAttributes),
+	}
+	return &simplePlacementSchedulingQueue{
+		active: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
+			Name: name,
+			DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[any]{
+				Name: name,
+				Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[any]{
+					Name:  name,
+					Queue: storage,
+				}),
+			}),
+		}),
+		rateLimiter: rateLimiter,
+		prepare:     storage.evaluateAttributes,
+	}
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestFairQueue tests the ordering of the fair queue storage.
+func TestFairQueue(t *testing.T) {
+	priorities := map[PlacementKey]int32{
+		"crp-high": 10,
+		"b/2":      5,
+	}
+	weights := map[string]int{
+		"a": 2,
+	}
+
+	testCases := []struct {
+		name     string
+		pushes   []PlacementKey
+		touch    func()
+		wantKeys []PlacementKey
+	}{
+		{
+			name:     "weighted round robin across namespaces",
+			pushes:   []PlacementKey{"a/1", "a/2", "a/3", "a/4", "b/1", "crp", "c/1", "c/2"},
+			wantKeys: []PlacementKey{"a/1", "a/2", "b/1", "crp", "c/1", "a/3", "a/4", "c/2"},
+		},
+		{
+			name:     "higher priority first",
+			pushes:   []PlacementKey{"a/1", "a/2", "b/1", "crp-high", "b/2"},
+			wantKeys: []PlacementKey{"crp-high", "b/2", "a/1", "a/2", "b/1"},
+		},
+		{
+			name:   "priority changes while in the queue",
+			pushes: []PlacementKey{"a/1", "a/2", "b/1", "c/1"},
+			touch: func() {
+				priorities["c/1"] = 20
+			},
+			wantKeys: []PlacementKey{"c/1", "a/1", "a/2", "b/1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			current := time.Now()
+			fq := &fairQueue{
+				levels:     make(map[int32]*fairLevel),
+				itemByKey:  make(map[any]*fairItem),
+				priorityOf: func(key PlacementKey) int32 { return priorities[key] },
+				weightOf:   func(namespace string) int { return weights[namespace] },
+				now:        func() time.Time { return current },
+				attrsByKey: make(map[any]fairAttributes),
+			}
+			// As with the work queue, evaluate the attributes of each placement before it is
+			// pushed (or touched).
+			for _, key := range tc.pushes {
+				fq.evaluateAttributes(key)
+				fq.Push(key)
+			}
+			if tc.touch != nil {
+				tc.touch()
+				for _, key := range tc.pushes {
+					fq.evaluateAttributes(key)
+					fq.Touch(key)
+				}
+			}
+			if got, want := fq.Len(), len(tc.pushes); got != want {
+				t.Fatalf("Len() = %d, want %d", got, want)
+			}
+
+			gotKeys := make([]PlacementKey, 0, len(tc.wantKeys))
+			for fq.Len() > 0 {
+				gotKeys = append(gotKeys, fq.Pop().(PlacementKey))
+			}
+			if diff := cmp.Diff(gotKeys, tc.wantKeys); diff != "" {
+				t.Errorf("Popped keys diff (-got, +want): %s", diff)
+			}
+			if len(fq.levels) != 0 || len(fq.priorities) != 0 || len(fq.attrsByKey) != 0 {
+				t.Errorf("Queue is not cleaned up after all items are popped: levels %v, priorities %v, attributes %v", fq.levels, fq.priorities, fq.attrsByKey)
+			}
+		})
+	}
+}
+
+// TestFairPlacementSchedulingQueue_BasicOps tests the basic ops
+// (Add, Next, Done) of a fair scheduling queue.
+func TestFairPlacementSchedulingQueue_BasicOps(t *testing.T) {
+	mu := sync.Mutex{}
+	priorities := map[PlacementKey]int32{
+		"crp": 10,
+	}
+	priorityOf := func(key PlacementKey) int32 {
+		mu.Lock()
+		defer mu.Unlock()
+		return priorities[key]
+	}
+	fq := NewFairPlacementSchedulingQueue("", nil, priorityOf, nil)
+	fq.Run()
+
+	keysToAdd := []PlacementKey{"a/1", "a/2", "a/3", "b/1", "crp"}
+	for _, key := range keysToAdd {
+		fq.Add(key)
+	}
+
+	wantKeys := []PlacementKey{"crp", "a/1", "b/1", "a/2", "a/3"}
+	keysRecved := []PlacementKey{}
+	for i := 0; i < len(wantKeys); i++ {
+		key, closed := fq.NextPlacementKey()
+		if closed {
+			t.Fatalf("Queue closed unexpected")
+		}
+		keysRecved = append(keysRecved, key)
+		fq.Done(key)
+		fq.Forget(key)
+	}
+
+	if diff := cmp.Diff(keysRecved, wantKeys); diff != "" {
+		t.Fatalf("Received keys diff (-got, +want): %s", diff)
+	}
+
+	fq.Close()
+}
+
+// TestFairPlacementSchedulingQueue_AttributesEvaluatedOutsideLock tests that a fair scheduling
+// queue evaluates the priorities of placements and the weights of namespaces without holding the
+// lock of the work queue.
+func TestFairPlacementSchedulingQueue_AttributesEvaluatedOutsideLock(t *testing.T) {
+	var fq PlacementSchedulingQueue
+	// Len acquires the lock of the work queue; it would block forever if the functions were
+	// called with the lock held.
+	priorityOf := func(_ PlacementKey) int32 {
+		_ = fq.(*simplePlacementSchedulingQueue).active.Len()
+		return 0
+	}
+	weightOf := func(_ string) int {
+		_ = fq.(*simplePlacementSchedulingQueue).active.Len()
+		return 1
+	}
+	fq = NewFairPlacementSchedulingQueue("", nil, priorityOf, weightOf)
+	fq.Run()
+
+	processed := make(chan struct{})
+	go func() {
+		defer close(processed)
+		fq.Add("a/1")
+		fq.Add("a/1")
+		fq.AddBatched("b/1")
+		for i := 0; i < 2; i++ {
+			key, _ := fq.NextPlacementKey()
+			fq.Done(key)
+		}
+	}()
+	select {
+	case <-processed:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("queue ops are blocked; the priority or weight function is called with the lock of the work queue held")
+	}
+
+	fq.Close()
+}
+
+// TestFairPlacementSchedulingQueue_DelayedAdds tests that a fair scheduling queue evaluates the
+// priorities of placements added with delays when the delays have passed.
+func TestFairPlacementSchedulingQueue_DelayedAdds(t *testing.T) {
+	priorities := map[PlacementKey]int32{
+		"a/1": 10,
+		"b/1": 5,
+	}
+	priorityOf := func(key PlacementKey) int32 {
+		return priorities[key]
+	}
+	fq := NewFairPlacementSchedulingQueue("", nil, priorityOf, nil)
+	fq.Run()
+
+	fq.Add("b/1")
+	fq.Add("a/1")
+	// Re-add a/1 with a delay while it is still in the queue.
+	fq.AddAfter("a/1", time.Millisecond*100)
+
+	key, closed := fq.NextPlacementKey()
+	if closed {
+		t.Fatalf("Queue closed unexpected")
+	}
+	if key != "a/1" {
+		t.Fatalf("NextPlacementKey() = %v, want a/1", key)
+	}
+	fq.Done(key)
+	fq.Forget(key)
+
+	// Wait for the delayed addition of a/1.
+	time.Sleep(time.Millisecond * 500)
+
+	wantKeys := []PlacementKey{"a/1", "b/1"}
+	keysRecved := []PlacementKey{}
+	for i := 0; i < len(wantKeys); i++ {
+		key, closed := fq.NextPlacementKey()
+		if closed {
+			t.Fatalf("Queue closed unexpected")
+		}
+		keysRecved = append(keysRecved, key)
+		fq.Done(key)
+		fq.Forget(key)
+	}
+
+	if diff := cmp.Diff(keysRecved, wantKeys); diff != "" {
+		t.Fatalf("Received keys diff (-got, +want): %s", diff)
+	}
+
+	fq.Close()
+}
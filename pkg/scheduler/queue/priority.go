@@ -0,0 +1,203 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"container/heap"
+	"sync"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// PriorityFunc returns the priority of a placement; placements of a higher priority are
+// dequeued first.
+type PriorityFunc func(placementKey PlacementKey) int32
+
+// priorityItem is an item kept in the priority heap.
+type priorityItem struct {
+	key      any
+	priority int32
+	// seq is the order in which the item has been pushed; items of the same priority are
+	// dequeued in FIFO order.
+	seq uint64
+	// index is the position of the item in the heap.
+	index int
+}
+
+// priorityHeap is a heap of priority items, which implements heap.Interface.
+type priorityHeap []*priorityItem
+
+// Len implements heap.Interface.
+func (h priorityHeap) Len() int { return len(h) }
+
+// Less implements heap.Interface.
+func (h priorityHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+// Swap implements heap.Interface.
+func (h priorityHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+// Push implements heap.Interface.
+func (h *priorityHeap) Push(x any) {
+	item := x.(*priorityItem)
+	item.index = len(*h)
+	*h = append(*h, item)
+}
+
+// Pop implements heap.Interface.
+func (h *priorityHeap) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	item.index = -1
+	*h = old[:n-1]
+	return item
+}
+
+// priorityQueue is a priority-based storage for a work queue, which implements the
+// workqueue.Queue interface.
+//
+// Note that the work queue guards all calls to its storage with its own lock, and never pushes
+// an item that is already in the storage; consequently, the heap does not need a lock of its own,
+// and each item appears in the storage at most once.
+//
+// The priority of a placement is evaluated right before the placement is added to the work queue
+// (see evaluatePriority), as the evaluation might involve API calls, which should not be made
+// while the work queue holds its lock; delayed and rate limited additions have the priority
+// evaluated when their delays have passed.
+type priorityQueue struct {
+	items      priorityHeap
+	itemByKey  map[any]*priorityItem
+	priorityOf PriorityFunc
+	nextSeq    uint64
+
+	// priorityByKey keeps the evaluated priorities of the placements that are being added, until
+	// the work queue pushes (or touches) them; it is guarded by its own lock, as evaluations run
+	// outside of the lock of the work queue.
+	//
+	// Note that a placement that is added while it is being processed is pushed only when it is
+	// marked as done; its evaluated priority is kept until then.
+	priorityByKeyMu sync.Mutex
+	priorityByKey   map[any]int32
+}
+
+// Verify that priorityQueue implements workqueue.Queue at compile time.
+var _ workqueue.Queue[any] = &priorityQueue{}
+
+// evaluatePriority evaluates the priority of a placement that is about to be added to the work
+// queue; it must be called without holding the lock of the work queue.
+func (pq *priorityQueue) evaluatePriority(placementKey PlacementKey) {
+	priority := pq.priorityOf(placementKey)
+
+	pq.priorityByKeyMu.Lock()
+	defer pq.priorityByKeyMu.Unlock()
+	pq.priorityByKey[placementKey] = priority
+}
+
+// takeEvaluatedPriorityOf returns (and forgets) the last evaluated priority of a placement, if any.
+func (pq *priorityQueue) takeEvaluatedPriorityOf(key any) (int32, bool) {
+	pq.priorityByKeyMu.Lock()
+	defer pq.priorityByKeyMu.Unlock()
+	priority, ok := pq.priorityByKey[key]
+	delete(pq.priorityByKey, key)
+	return priority, ok
+}
+
+// Touch updates the priority of an item that is added again while still in the queue, as the
+// priority of the placement might have changed since it was pushed.
+func (pq *priorityQueue) Touch(key any) {
+	item, ok := pq.itemByKey[key]
+	if !ok {
+		return
+	}
+	if priority, ok := pq.takeEvaluatedPriorityOf(key); ok && priority != item.priority {
+		item.priority = priority
+		heap.Fix(&pq.items, item.index)
+	}
+}
+
+// Push adds a new item.
+func (pq *priorityQueue) Push(key any) {
+	// Every push follows the evaluation of the priority of the placement, which is kept until
+	// the placement is pushed or touched; the default priority (zero) is used only as a fallback.
+	priority, _ := pq.takeEvaluatedPriorityOf(key)
+	item := &priorityItem{
+		key:      key,
+		priority: priority,
+		seq:      pq.nextSeq,
+	}
+	pq.nextSeq++
+	heap.Push(&pq.items, item)
+	pq.itemByKey[key] = item
+}
+
+// Len returns the total number of items.
+func (pq *priorityQueue) Len() int {
+	return pq.items.Len()
+}
+
+// Pop retrieves the item of the highest priority.
+func (pq *priorityQueue) Pop() any {
+	item := heap.Pop(&pq.items).(*priorityItem)
+	delete(pq.itemByKey, item.key)
+	return item.key
+}
+
+// NewPriorityPlacementSchedulingQueue returns a PlacementSchedulingQueue which dequeues
+// PlacementKeys in the order of their priority (as reported by the given priority function),
+// and, for PlacementKeys of the same priority, in the order they are added.
+//
+// Rate limiting and delays work the same way as they do with the simple scheduling queue; a
+// PlacementKey enters the priority ordering, with its priority evaluated, only after its rate
+// limit or delay has passed.
+func NewPriorityPlacementSchedulingQueue(name string, rateLimiter workqueue.TypedRateLimiter[any], priorityOf PriorityFunc) PlacementSchedulingQueue {
+	if len(name) == 0 {
+		name = defaultSimplePlacementSchedulingQueueOptions.name
+	}
+	if rateLimiter == nil {
+		rateLimiter = defaultSimplePlacementSchedulingQueueOptions.rateLimiter
+	}
+
+	storage := &priorityQueue{
+		itemByKey:     make(map[any]*priorityItem),
+		priorityOf:    priorityOf,
+		priorityByKey: make(map[any]int32),
+	}
+	return &simplePlacementSchedulingQueue{
+		active: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
+			Name: name,
+			DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[any]{
+				Name: name,
+				Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[any]{
+					Name:  name,
+					Queue: storage,
+				}),
+			}),
+		}),
+		rateLimiter: rateLimiter,
+		prepare:     storage.evaluatePriority,
+	}
+}
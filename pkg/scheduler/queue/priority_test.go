@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestPriorityPlacementSchedulingQueue_BasicOps tests the basic ops
+// (Add, Next, Done) of a priority-based scheduling queue.
+func TestPriorityPlacementSchedulingQueue_BasicOps(t *testing.T) {
+	mu := sync.Mutex{}
+	priorities := map[PlacementKey]int32{
+		"A": 0,
+		"B": 10,
+		"C": -5,
+		"D": 10,
+		"E": 0,
+	}
+	priorityOf := func(key PlacementKey) int32 {
+		mu.Lock()
+		defer mu.Unlock()
+		return priorities[key]
+	}
+	pq := NewPriorityPlacementSchedulingQueue("", nil, priorityOf)
+	pq.Run()
+
+	keysToAdd := []PlacementKey{"A", "B", "C", "D", "E"}
+	for _, key := range keysToAdd {
+		pq.Add(key)
+	}
+
+	// Raise the priority of E, and re-add it while it is still in the queue.
+	mu.Lock()
+	priorities["E"] = 20
+	mu.Unlock()
+	pq.Add("E")
+
+	wantKeys := []PlacementKey{"E", "B", "D", "A", "C"}
+	keysRecved := []PlacementKey{}
+	for i := 0; i < len(wantKeys); i++ {
+		key, closed := pq.NextPlacementKey()
+		if closed {
+			t.Fatalf("Queue closed unexpected")
+		}
+		keysRecved = append(keysRecved, key)
+		pq.Done(key)
+		pq.Forget(key)
+	}
+
+	if diff := cmp.Diff(keysRecved, wantKeys); diff != "" {
+		t.Fatalf("Received keys diff (-got, +want): %s", diff)
+	}
+
+	pq.Close()
+}
+
+// TestPriorityPlacementSchedulingQueue_PriorityEvaluatedOutsideLock tests that a priority-based
+// scheduling queue evaluates the priorities of placements without holding the lock of the work queue.
+func TestPriorityPlacementSchedulingQueue_PriorityEvaluatedOutsideLock(t *testing.T) {
+	var pq PlacementSchedulingQueue
+	priorityOf := func(_ PlacementKey) int32 {
+		// Len acquires the lock of the work queue; it would block forever if the priority
+		// function were called with the lock held.
+		_ = pq.(*simplePlacementSchedulingQueue).active.Len()
+		return 0
+	}
+	pq = NewPriorityPlacementSchedulingQueue("", nil, priorityOf)
+	pq.Run()
+
+	added := make(chan struct{})
+	go func() {
+		defer close(added)
+		pq.Add("A")
+		pq.Add("A")
+		pq.AddBatched("B")
+	}()
+	select {
+	case <-added:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Add() is blocked; the priority function is called with the lock of the work queue held")
+	}
+
+	pq.Close()
+}
+
+// TestPriorityPlacementSchedulingQueue_DelayedAdds tests that a priority-based scheduling queue
+// evaluates the priorities of placements added with delays when the delays have passed.
+func TestPriorityPlacementSchedulingQueue_DelayedAdds(t *testing.T) {
+	priorities := map[PlacementKey]int32{
+		"A": 10,
+		"B": 5,
+	}
+	priorityOf := func(key PlacementKey) int32 {
+		return priorities[key]
+	}
+	pq := NewPriorityPlacementSchedulingQueue("", nil, priorityOf)
+	pq.Run()
+
+	pq.Add("B")
+	pq.Add("A")
+	// Re-add A with a delay while it is still in the queue.
+	pq.AddAfter("A", time.Millisecond*100)
+
+	key, closed := pq.NextPlacementKey()
+	if closed {
+		t.Fatalf("Queue closed unexpected")
+	}
+	if key != "A" {
+		t.Fatalf("NextPlacementKey() = %v, want A", key)
+	}
+	pq.Done(key)
+	pq.Forget(key)
+
+	// Wait for the delayed addition of A.
+	time.Sleep(time.Millisecond * 500)
+
+	wantKeys := []PlacementKey{"A", "B"}
+	keysRecved := []PlacementKey{}
+	for i := 0; i < len(wantKeys); i++ {
+		key, closed := pq.NextPlacementKey()
+		if closed {
+			t.Fatalf("Queue closed unexpected")
+		}
+		keysRecved = append(keysRecved, key)
+		pq.Done(key)
+		pq.Forget(key)
+	}
+
+	if diff := cmp.Diff(keysRecved, wantKeys); diff != "" {
+		t.Fatalf("Received keys diff (-got, +want): %s", diff)
+	}
+
+	pq.Close()
+}
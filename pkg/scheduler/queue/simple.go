@@ -29,6 +29,15 @@ import (
 // workqueue, which queues all placement keys indiscriminately for processing.
 type simplePlacementSchedulingQueue struct {
 	active workqueue.TypedRateLimitingInterface[any]
+	// rateLimiter is the rate limiter set up with the work queue.
+	rateLimiter workqueue.TypedRateLimiter[any]
+
+	// prepare, if set, is called with each PlacementKey right before it is added to the work queue.
+	//
+	// The work queue guards all calls to its storage with its own lock; storages that need to
+	// look up the attributes of a placement (e.g., its priority), which might involve API calls,
+	// use this hook to do so without holding the lock.
+	prepare func(placementKey PlacementKey)
 }
 
 // Verify that simplePlacementSchedulingQueue implements
@@ -88,29 +97,64 @@ func (sq *simplePlacementSchedulingQueue) Done(placementKey PlacementKey) {
 //
 // Note that this bypasses the rate limiter (if any).
 func (sq *simplePlacementSchedulingQueue) Add(placementKey PlacementKey) {
+	sq.prepareKey(placementKey)
 	sq.active.Add(placementKey)
 }
 
 // AddRateLimited adds a PlacementKey to the work queue after the rate limiter (if any)
 // says that it is OK.
 func (sq *simplePlacementSchedulingQueue) AddRateLimited(placementKey PlacementKey) {
-	sq.active.AddRateLimited(placementKey)
+	if sq.prepare == nil {
+		sq.active.AddRateLimited(placementKey)
+		return
+	}
+	sq.addAfterPrepared(placementKey, sq.rateLimiter.When(placementKey))
 }
 
 // AddAfter adds a PlacementKey to the work queue after a set duration.
 //
 // Note that this bypasses the rate limiter (if any).
 func (sq *simplePlacementSchedulingQueue) AddAfter(placementKey PlacementKey, duration time.Duration) {
-	sq.active.AddAfter(placementKey, duration)
+	if sq.prepare == nil {
+		sq.active.AddAfter(placementKey, duration)
+		return
+	}
+	sq.addAfterPrepared(placementKey, duration)
+}
+
+// addAfterPrepared adds a PlacementKey to the work queue after a set duration, with the
+// preparation hook run when the duration has passed rather than when the call is made; this
+// way the PlacementKey always enters the work queue with up-to-date attributes, even if it has
+// been added (and processed) again in the meantime.
+func (sq *simplePlacementSchedulingQueue) addAfterPrepared(placementKey PlacementKey, duration time.Duration) {
+	if duration <= 0 {
+		sq.Add(placementKey)
+		return
+	}
+	time.AfterFunc(duration, func() {
+		if sq.active.ShuttingDown() {
+			return
+		}
+		sq.Add(placementKey)
+	})
 }
 
 // AddBatched tracks a PlacementKey and adds such keys in batch later to the work queue when appropriate.
 //
 // For the simple queue implementation, this is equivalent to Add.
 func (sq *simplePlacementSchedulingQueue) AddBatched(placementKey PlacementKey) {
+	sq.prepareKey(placementKey)
 	sq.active.Add(placementKey)
 }
 
+// prepareKey runs the preparation hook (if any) on a PlacementKey that is about to be added to
+// the work queue.
+func (sq *simplePlacementSchedulingQueue) prepareKey(placementKey PlacementKey) {
+	if sq.prepare != nil {
+		sq.prepare(placementKey)
+	}
+}
+
 // Forget untracks a PlacementKey from rate limiter(s) (if any) set up with the queue.
 func (sq *simplePlacementSchedulingQueue) Forget(placementKey PlacementKey) {
 	sq.active.Forget(placementKey)
@@ -129,5 +173,6 @@ func NewSimplePlacementSchedulingQueue(name string, rateLimiter workqueue.TypedR
 		active: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
 			Name: name,
 		}),
+		rateLimiter: rateLimiter,
 	}
 }
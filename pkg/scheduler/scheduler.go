@@ -25,6 +25,7 @@ import (
 	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -42,18 +43,31 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 )
 
+const (
+	// schedulingProfileNotFoundReason is the reason of the event the scheduler emits when a placement
+	// specifies a scheduling profile that does not exist.
+	schedulingProfileNotFoundReason = "SchedulingProfileNotFound"
+)
+
 // Scheduler is the scheduler for Fleet workloads.
 type Scheduler struct {
 	// name is the name of the scheduler.
 	name string
 
-	// framework is the scheduling framework in use by the scheduler.
-	//
-	// At this stage, a scheduler is always associated with one scheduling framework; in the long
-	// run, multiple frameworks may be supported, to allow the usage of varying scheduling configurations
-	// for different types of workloads.
+	// schedulerName is the name placements specify to be scheduled by the scheduler; the scheduler
+	// ignores placements that specify other scheduler names. An empty name stands for the default
+	// scheduler.
+	schedulerName string
+
+	// framework is the default scheduling framework in use by the scheduler; it is used for
+	// placements that do not specify a scheduling profile.
 	framework framework.Framework
 
+	// profileFrameworks are the scheduling frameworks in use by the scheduler, keyed by the names
+	// of their scheduling profiles; this allows the usage of varying scheduling configurations
+	// for different types of workloads.
+	profileFrameworks map[string]framework.Framework
+
 	// queue is the work queue in use by the scheduler; the scheduler pulls items from the queue and
 	// performs scheduling in accordance with them.
 	queue queue.PlacementSchedulingQueue
@@ -78,6 +92,27 @@ type Scheduler struct {
 	eventRecorder record.EventRecorder
 }
 
+// Option is the function for configuring a scheduler.
+type Option func(*Scheduler)
+
+// WithProfileFramework registers a scheduling framework with the scheduler, which is used for
+// placements that specify the scheduling profile of the given name.
+func WithProfileFramework(profileName string, fw framework.Framework) Option {
+	return func(s *Scheduler) {
+		if s.profileFrameworks == nil {
+			s.profileFrameworks = map[string]framework.Framework{}
+		}
+		s.profileFrameworks[profileName] = fw
+	}
+}
+
+// WithSchedulerName sets the name placements specify to be scheduled by the scheduler.
+func WithSchedulerName(schedulerName string) Option {
+	return func(s *Scheduler) {
+		s.schedulerName = schedulerName
+	}
+}
+
 // NewScheduler creates a scheduler.
 func NewScheduler(
 	name string,
@@ -85,8 +120,9 @@ func NewScheduler(
 	queue queue.PlacementSchedulingQueue,
 	manager ctrl.Manager,
 	workerNumber int,
+	opts ...Option,
 ) *Scheduler {
-	return &Scheduler{
+	s := &Scheduler{
 		name:           name,
 		framework:      framework,
 		queue:          queue,
@@ -96,6 +132,10 @@ func NewScheduler(
 		workerNumber:   workerNumber,
 		eventRecorder:  manager.GetEventRecorderFor(name),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // ScheduleOnce performs scheduling for one single item pulled from the work queue.
@@ -160,6 +200,17 @@ func (s *Scheduler) scheduleOnce(ctx context.Context, worker int) {
 		return
 	}
 
+	// Check if the placement is scheduled by this scheduler.
+	//
+	// Normally this would not happen as sources only enqueue placements that are scheduled by this
+	// scheduler; the check guards against different schedulers processing the same placement.
+	if !controller.IsPlacementScheduledBy(placement, s.schedulerName) {
+		klog.V(2).InfoS("Placement is scheduled by another scheduler; skip it", "placement", placementKey, "schedulerName", placement.GetPlacementSpec().Scheduler())
+		// Untrack the key from the rate limiter.
+		s.queue.Forget(placementKey)
+		return
+	}
+
 	// Check if the placement has been marked for deletion, and if it has the scheduler cleanup finalizer.
 	if placement.GetDeletionTimestamp() != nil {
 		// Use SchedulerCleanupFinalizer consistently for all placement types
@@ -185,6 +236,18 @@ func (s *Scheduler) scheduleOnce(ctx context.Context, worker int) {
 
 	// The placement has not been marked for deletion; run the scheduling cycle for it.
 
+	// Skip the placement if its scheduling is blocked by any scheduling gates.
+	//
+	// Note that removing a scheduling gate bumps the generation of the placement, which the
+	// placement controller reflects on the latest policy snapshot; the placement will then be
+	// enqueued again by the policy snapshot watcher.
+	if placement.GetPlacementSpec().IsSchedulingGated() {
+		klog.V(2).InfoS("Placement is gated from scheduling; skip it", "placement", placementKey, "schedulingGates", placement.GetPlacementSpec().SchedulingGates)
+		// Untrack the key from the rate limiter.
+		s.queue.Forget(placementKey)
+		return
+	}
+
 	// Verify that it has an active policy snapshot.
 	latestPolicySnapshot, err := s.lookupLatestPolicySnapshot(ctx, placement)
 	if err != nil {
@@ -205,12 +268,26 @@ func (s *Scheduler) scheduleOnce(ctx context.Context, worker int) {
 		return
 	}
 
+	// Find the scheduling framework to use, per the scheduling profile the placement specifies.
+	fw, err := s.frameworkFor(latestPolicySnapshot)
+	if err != nil {
+		klog.ErrorS(err, "Failed to find the scheduling framework for placement", "placement", placementKey)
+		s.eventRecorder.Event(placement, corev1.EventTypeWarning, schedulingProfileNotFoundReason, err.Error())
+		// No requeue is needed; scheduling profiles can only be added by restarting the scheduler
+		// with a new configuration, and the placement will be processed again when the scheduler
+		// restarts, or when the placement picks a different profile.
+
+		// Untrack the key from the rate limiter.
+		s.queue.Forget(placementKey)
+		return
+	}
+
 	// Run the scheduling cycle.
 	//
 	// Note that the scheduler will enter this cycle as long as the placement is active and an active
 	// policy snapshot has been produced.
 	cycleStartTime := time.Now()
-	res, err := s.framework.RunSchedulingCycleFor(ctx, placementKey, latestPolicySnapshot)
+	res, err := fw.RunSchedulingCycleFor(ctx, placementKey, latestPolicySnapshot)
 	if err != nil {
 		if errors.Is(err, controller.ErrUnexpectedBehavior) {
 			// The placement is in an unexpected state; this is a scheduler-side error, and
@@ -400,6 +477,21 @@ func (s *Scheduler) lookupLatestPolicySnapshot(ctx context.Context, placement fl
 	}
 }
 
+// frameworkFor returns the scheduling framework to use for a policy snapshot, per the scheduling
+// profile specified in the policy.
+func (s *Scheduler) frameworkFor(policySnapshot fleetv1beta1.PolicySnapshotObj) (framework.Framework, error) {
+	policy := policySnapshot.GetPolicySnapshotSpec().Policy
+	if policy == nil || len(policy.SchedulingProfile) == 0 {
+		return s.framework, nil
+	}
+
+	fw, ok := s.profileFrameworks[policy.SchedulingProfile]
+	if !ok {
+		return nil, fmt.Errorf("scheduling profile %q is not found", policy.SchedulingProfile)
+	}
+	return fw, nil
+}
+
 // addSchedulerCleanupFinalizer adds the scheduler cleanup finalizer to a placement (if it does not
 // have it yet).
 func (s *Scheduler) addSchedulerCleanUpFinalizer(ctx context.Context, placement fleetv1beta1.PlacementObj) error {
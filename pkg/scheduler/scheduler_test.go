@@ -34,6 +34,7 @@ import (
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	hubmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/hub"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 )
 
 const (
@@ -45,12 +46,21 @@ const (
 	policySnapshotName        = "test-policy-snapshot"
 	altPolicySnapshotName     = "another-test-policy-snapshot"
 	anotherPolicySnapshotName = "yet-another-test-policy-snapshot"
+
+	altProfileName = "alt-profile"
 )
 
 var (
 	ignoreObjectMetaResourceVersionField = cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion")
 )
 
+// dummyFramework is a no-op scheduling framework for testing purposes.
+type dummyFramework struct {
+	framework.Framework
+
+	name string
+}
+
 // TestMain sets up the test environment.
 func TestMain(m *testing.M) {
 	// Add custom APIs to the runtime scheme.
@@ -637,3 +647,71 @@ func TestObserveSchedulingCycleMetrics(t *testing.T) {
 		})
 	}
 }
+
+// TestFrameworkFor tests the frameworkFor method.
+func TestFrameworkFor(t *testing.T) {
+	defaultFramework := &dummyFramework{name: "default"}
+	altFramework := &dummyFramework{name: "alt"}
+
+	testCases := []struct {
+		name          string
+		policy        *fleetv1beta1.PlacementPolicy
+		wantFramework *dummyFramework
+		wantErr       bool
+	}{
+		{
+			name:          "no policy",
+			wantFramework: defaultFramework,
+		},
+		{
+			name: "no scheduling profile",
+			policy: &fleetv1beta1.PlacementPolicy{
+				PlacementType: fleetv1beta1.PickAllPlacementType,
+			},
+			wantFramework: defaultFramework,
+		},
+		{
+			name: "registered scheduling profile",
+			policy: &fleetv1beta1.PlacementPolicy{
+				SchedulingProfile: altProfileName,
+			},
+			wantFramework: altFramework,
+		},
+		{
+			name: "unknown scheduling profile",
+			policy: &fleetv1beta1.PlacementPolicy{
+				SchedulingProfile: "unknown",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Scheduler{framework: defaultFramework}
+			WithProfileFramework(altProfileName, altFramework)(s)
+
+			policySnapshot := &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policySnapshotName,
+				},
+				Spec: fleetv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: tc.policy,
+				},
+			}
+			fw, err := s.frameworkFor(policySnapshot)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("frameworkFor() = %v, want error", fw)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("frameworkFor() = %v, want no error", err)
+			}
+			if fw != tc.wantFramework {
+				t.Errorf("frameworkFor() = %v, want %v", fw, tc.wantFramework)
+			}
+		})
+	}
+}
@@ -34,12 +34,14 @@ const (
 	consistentlyDuration = time.Second
 	consistentlyInterval = time.Millisecond * 200
 
-	crbName       = "test-crb"
-	rbName        = "test-rb"
-	crpName       = "test-crp"
-	rpName        = "test-rp"
-	clusterName   = "test-cluster"
-	testNamespace = "test-ns"
+	crbName          = "test-crb"
+	dependentCRPName = "test-dependent-crp"
+	referencedCRB    = "test-referenced-crb"
+	rbName           = "test-rb"
+	crpName          = "test-crp"
+	rpName           = "test-rp"
+	clusterName      = "test-cluster"
+	testNamespace    = "test-ns"
 )
 
 var (
@@ -54,6 +56,10 @@ var (
 		return isKeyPresent(crpName)
 	}
 
+	expectedDependentCRPKeySetEnqueuedActual = func() error {
+		return isKeyPresent(dependentCRPName)
+	}
+
 	expectedRPKeySetEnqueuedActual = func() error {
 		expectedKey := fmt.Sprintf("%s/%s", testNamespace, rpName)
 		return isKeyPresent(expectedKey)
@@ -207,4 +213,110 @@ var _ = Describe("scheduler - cluster resource binding and resource binding watc
 			keyCollector.Reset()
 		})
 	})
+	Context("bindings of a placement selected by placement affinity terms", func() {
+		BeforeAll(func() {
+			resourceSelectors := []fleetv1beta1.ResourceSelectorTerm{
+				{
+					Group:   "",
+					Kind:    "Namespace",
+					Version: "v1",
+					Name:    testNamespace,
+				},
+			}
+			referencedCRP := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: crpName,
+					Labels: map[string]string{
+						"app": "web",
+					},
+				},
+				Spec: fleetv1beta1.PlacementSpec{
+					ResourceSelectors: resourceSelectors,
+				},
+			}
+			Expect(hubClient.Create(ctx, referencedCRP)).Should(Succeed(), "Failed to create the referenced CRP")
+			dependentCRP := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: dependentCRPName,
+				},
+				Spec: fleetv1beta1.PlacementSpec{
+					ResourceSelectors: resourceSelectors,
+					Policy: &fleetv1beta1.PlacementPolicy{
+						PlacementType: fleetv1beta1.PickAllPlacementType,
+						Affinity: &fleetv1beta1.Affinity{
+							PlacementAffinity: &fleetv1beta1.PlacementAffinity{
+								RequiredDuringSchedulingIgnoredDuringExecution: []fleetv1beta1.PlacementAffinityTerm{
+									{
+										LabelSelector: &metav1.LabelSelector{
+											MatchLabels: map[string]string{
+												"app": "web",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(hubClient.Create(ctx, dependentCRP)).Should(Succeed(), "Failed to create the dependent CRP")
+			Consistently(noKeyEnqueuedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Workqueue is not empty")
+
+			crb := fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: referencedCRB,
+					Labels: map[string]string{
+						fleetv1beta1.PlacementTrackingLabel: crpName,
+					},
+				},
+				Spec: fleetv1beta1.ResourceBindingSpec{
+					State:                        fleetv1beta1.BindingStateScheduled,
+					SchedulingPolicySnapshotName: "test-policy",
+					TargetCluster:                clusterName,
+					ClusterDecision: fleetv1beta1.ClusterDecision{
+						ClusterName: clusterName,
+						Selected:    true,
+						Reason:      "test-reason",
+					},
+				},
+			}
+			Expect(hubClient.Create(ctx, &crb)).Should(Succeed(), "Failed to create cluster resource binding")
+		})
+
+		It("should enqueue the dependent CRP when a cluster is picked by the referenced CRP", func() {
+			Eventually(expectedDependentCRPKeySetEnqueuedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Workqueue is either empty or it contains more than one element")
+			Consistently(expectedDependentCRPKeySetEnqueuedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Workqueue is either empty or it contains more than one element")
+		})
+
+		It("bind the cluster", func() {
+			keyCollector.Reset()
+			var crb fleetv1beta1.ClusterResourceBinding
+			Expect(hubClient.Get(ctx, client.ObjectKey{Name: referencedCRB}, &crb)).Should(Succeed())
+			crb.Spec.State = fleetv1beta1.BindingStateBound
+			Expect(hubClient.Update(ctx, &crb)).Should(Succeed())
+		})
+
+		It("should not enqueue the dependent CRP when the picked cluster stays the same", func() {
+			Consistently(noKeyEnqueuedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Workqueue is not empty")
+		})
+
+		It("unschedule the cluster", func() {
+			var crb fleetv1beta1.ClusterResourceBinding
+			Expect(hubClient.Get(ctx, client.ObjectKey{Name: referencedCRB}, &crb)).Should(Succeed())
+			crb.Spec.State = fleetv1beta1.BindingStateUnscheduled
+			Expect(hubClient.Update(ctx, &crb)).Should(Succeed())
+		})
+
+		It("should enqueue the dependent CRP when the cluster is no longer picked by the referenced CRP", func() {
+			Eventually(expectedDependentCRPKeySetEnqueuedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Workqueue is either empty or it contains more than one element")
+			Consistently(expectedDependentCRPKeySetEnqueuedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Workqueue is either empty or it contains more than one element")
+		})
+
+		AfterAll(func() {
+			Expect(hubClient.Delete(ctx, &fleetv1beta1.ClusterResourceBinding{ObjectMeta: metav1.ObjectMeta{Name: referencedCRB}})).Should(Succeed())
+			Expect(hubClient.Delete(ctx, &fleetv1beta1.ClusterResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: dependentCRPName}})).Should(Succeed())
+			Expect(hubClient.Delete(ctx, &fleetv1beta1.ClusterResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: crpName}})).Should(Succeed())
+			keyCollector.Reset()
+		})
+	})
 })
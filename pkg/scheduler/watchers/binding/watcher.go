@@ -21,6 +21,9 @@ import (
 	"fmt"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,12 +36,16 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 )
 
-// Reconciler reconciles the deletion of a binding.
+// Reconciler reconciles the deletion of a binding, and the changes of the clusters picked through a
+// binding.
 type Reconciler struct {
 	// Client is the client the controller uses to access the hub cluster.
 	client.Client
 	// SchedulerWorkQueue is the workqueue in use by the scheduler.
 	SchedulerWorkQueue queue.PlacementSchedulingQueueWriter
+	// SchedulerName is the name of the scheduler; only placements scheduled by the scheduler are
+	// enqueued. An empty name stands for the default scheduler.
+	SchedulerName string
 }
 
 // Reconcile reconciles the binding.
@@ -82,19 +89,126 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			// error cannot be retried.
 			return ctrl.Result{}, nil
 		}
-		r.SchedulerWorkQueue.AddRateLimited(queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(binding.GetNamespace(), placementName)))
+		placementKey := queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(binding.GetNamespace(), placementName))
+		isScheduledBy, err := controller.IsPlacementKeyScheduledBy(ctx, r.Client, placementKey, r.SchedulerName)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get placement for binding", "binding", bindingRef, "placement", placementKey)
+			return ctrl.Result{}, controller.NewAPIServerError(true, err)
+		}
+		if !isScheduledBy {
+			// The placement is scheduled by another scheduler; ignore the binding.
+			return ctrl.Result{}, nil
+		}
+		r.SchedulerWorkQueue.AddRateLimited(placementKey)
+	}
+
+	// The clusters picked by the placement of the binding might have changed; enqueue the placements
+	// whose placement affinity (or anti-affinity) terms select the placement.
+	if err := r.enqueueDependentPlacements(ctx, binding); err != nil {
+		klog.ErrorS(err, "Failed to enqueue placements with placement affinity to the placement of binding", "binding", bindingRef)
+		return ctrl.Result{}, err
 	}
 
 	// No action is needed for the scheduler to take in other cases.
 	return ctrl.Result{}, nil
 }
 
-// buildCustomPredicate creates a predicate that only triggers on deletion timestamp changes.
+// enqueueDependentPlacements enqueues the placements (scheduled by the scheduler) that have placement
+// affinity or anti-affinity terms selecting the placement of a binding.
+//
+// A ClusterResourcePlacement can only refer to other ClusterResourcePlacements, and a
+// ResourcePlacement can only refer to other ResourcePlacements in the same namespace.
+func (r *Reconciler) enqueueDependentPlacements(ctx context.Context, binding fleetv1beta1.BindingObj) error {
+	placementName, exist := binding.GetLabels()[fleetv1beta1.PlacementTrackingLabel]
+	if !exist {
+		return nil
+	}
+	placementKey := queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(binding.GetNamespace(), placementName))
+	placement, err := controller.FetchPlacementFromKey(ctx, r.Client, placementKey)
+	switch {
+	case apierrors.IsNotFound(err):
+		// No placement can select a placement that is gone.
+		return nil
+	case err != nil:
+		return controller.NewAPIServerError(true, err)
+	}
+
+	var placementList fleetv1beta1.PlacementObjList
+	var listOptions []client.ListOption
+	if binding.GetNamespace() == "" {
+		placementList = &fleetv1beta1.ClusterResourcePlacementList{}
+	} else {
+		placementList = &fleetv1beta1.ResourcePlacementList{}
+		listOptions = append(listOptions, client.InNamespace(binding.GetNamespace()))
+	}
+	if err := r.Client.List(ctx, placementList, listOptions...); err != nil {
+		return controller.NewAPIServerError(true, err)
+	}
+
+	placementLabels := labels.Set(placement.GetLabels())
+	for _, dependent := range placementList.GetPlacementObjs() {
+		if dependent.GetName() == placementName || !controller.IsPlacementScheduledBy(dependent, r.SchedulerName) {
+			continue
+		}
+		if !hasPlacementAffinityTermSelecting(dependent, placementLabels) {
+			continue
+		}
+		klog.V(2).InfoS("Enqueueing placement with placement affinity to the placement of binding",
+			"placement", klog.KObj(dependent), "binding", klog.KObj(binding))
+		r.SchedulerWorkQueue.Add(controller.GetObjectKeyFromObj(dependent))
+	}
+	return nil
+}
+
+// hasPlacementAffinityTermSelecting returns whether a placement has any placement affinity or
+// anti-affinity term (required or preferred) that selects a placement with the given labels.
+func hasPlacementAffinityTermSelecting(placement fleetv1beta1.PlacementObj, placementLabels labels.Set) bool {
+	policy := placement.GetPlacementSpec().Policy
+	if policy == nil || policy.Affinity == nil {
+		return false
+	}
+
+	var terms []fleetv1beta1.PlacementAffinityTerm
+	if pa := policy.Affinity.PlacementAffinity; pa != nil {
+		terms = append(terms, pa.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for idx := range pa.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, pa.PreferredDuringSchedulingIgnoredDuringExecution[idx].PlacementAffinityTerm)
+		}
+	}
+	if paa := policy.Affinity.PlacementAntiAffinity; paa != nil {
+		terms = append(terms, paa.RequiredDuringSchedulingIgnoredDuringExecution...)
+		for idx := range paa.PreferredDuringSchedulingIgnoredDuringExecution {
+			terms = append(terms, paa.PreferredDuringSchedulingIgnoredDuringExecution[idx].PlacementAffinityTerm)
+		}
+	}
+	for idx := range terms {
+		selector, err := metav1.LabelSelectorAsSelector(terms[idx].LabelSelector)
+		if err != nil {
+			// Invalid selectors are rejected by the webhook; the scheduler reports them when the
+			// placement is scheduled.
+			continue
+		}
+		if selector.Matches(placementLabels) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPicked returns whether a binding has its target cluster picked by its placement.
+func isPicked(binding fleetv1beta1.BindingObj) bool {
+	state := binding.GetBindingSpec().State
+	return binding.GetDeletionTimestamp() == nil && (state == fleetv1beta1.BindingStateScheduled || state == fleetv1beta1.BindingStateBound)
+}
+
+// buildCustomPredicate creates a predicate that only triggers on deletion timestamp changes, and on
+// changes of the clusters picked through bindings.
 func buildCustomPredicate() predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
-			// Ignore creation events.
-			return false
+			// Only bindings that pick clusters might concern placements with placement affinity.
+			binding, ok := e.Object.(fleetv1beta1.BindingObj)
+			return ok && isPicked(binding)
 		},
 		DeleteFunc: func(e event.DeleteEvent) bool {
 			// Ignore deletion events (events emitted when the object is actually removed
@@ -116,7 +230,14 @@ func buildCustomPredicate() predicate.Predicate {
 				return true
 			}
 
-			return false
+			// Check if the cluster picked through the binding has changed.
+			oldBinding, oldOK := e.ObjectOld.(fleetv1beta1.BindingObj)
+			newBinding, newOK := e.ObjectNew.(fleetv1beta1.BindingObj)
+			if !oldOK || !newOK {
+				return false
+			}
+			return isPicked(oldBinding) != isPicked(newBinding) ||
+				oldBinding.GetBindingSpec().TargetCluster != newBinding.GetBindingSpec().TargetCluster
 		},
 	}
 }
@@ -22,6 +22,7 @@ import (
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 )
 
 // isPlacementFullyScheduled returns whether a placement is fully scheduled.
@@ -80,6 +81,18 @@ func classifyPlacements(placements []fleetv1beta1.PlacementObj) (toProcess []fle
 	return toProcess
 }
 
+// filterPlacementsBySchedulerName returns the placements that are scheduled by the scheduler of the
+// given name.
+func filterPlacementsBySchedulerName(placements []fleetv1beta1.PlacementObj, schedulerName string) []fleetv1beta1.PlacementObj {
+	filtered := make([]fleetv1beta1.PlacementObj, 0, len(placements))
+	for idx := range placements {
+		if controller.IsPlacementScheduledBy(placements[idx], schedulerName) {
+			filtered = append(filtered, placements[idx])
+		}
+	}
+	return filtered
+}
+
 // convertCRPArrayToPlacementObjs converts a slice of ClusterResourcePlacement items to PlacementObj array.
 func convertCRPArrayToPlacementObjs(crps []fleetv1beta1.ClusterResourcePlacement) []fleetv1beta1.PlacementObj {
 	placements := make([]fleetv1beta1.PlacementObj, len(crps))
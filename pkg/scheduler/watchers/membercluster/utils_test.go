@@ -43,6 +43,8 @@ const (
 	rpName5       = "rp-5"
 	rpName6       = "rp-6"
 	testNamespace = "test-namespace"
+
+	customSchedulerName = "custom-scheduler"
 )
 
 var (
@@ -861,6 +863,68 @@ func TestClassifyPlacements(t *testing.T) {
 	}
 }
 
+// TestFilterPlacementsBySchedulerName tests the filterPlacementsBySchedulerName function.
+func TestFilterPlacementsBySchedulerName(t *testing.T) {
+	defaultCRP := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName1,
+		},
+	}
+	explicitDefaultRP := &placementv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rpName1,
+			Namespace: testNamespace,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			SchedulerName: placementv1beta1.DefaultSchedulerName,
+		},
+	}
+	customCRP := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName2,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			SchedulerName: customSchedulerName,
+		},
+	}
+	placements := []placementv1beta1.PlacementObj{defaultCRP, explicitDefaultRP, customCRP}
+
+	testCases := []struct {
+		name           string
+		schedulerName  string
+		wantPlacements []placementv1beta1.PlacementObj
+	}{
+		{
+			name:           "empty scheduler name",
+			wantPlacements: []placementv1beta1.PlacementObj{defaultCRP, explicitDefaultRP},
+		},
+		{
+			name:           "default scheduler",
+			schedulerName:  placementv1beta1.DefaultSchedulerName,
+			wantPlacements: []placementv1beta1.PlacementObj{defaultCRP, explicitDefaultRP},
+		},
+		{
+			name:           "custom scheduler",
+			schedulerName:  customSchedulerName,
+			wantPlacements: []placementv1beta1.PlacementObj{customCRP},
+		},
+		{
+			name:           "unknown scheduler",
+			schedulerName:  "unknown-scheduler",
+			wantPlacements: []placementv1beta1.PlacementObj{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := filterPlacementsBySchedulerName(placements, tc.schedulerName)
+			if diff := cmp.Diff(got, tc.wantPlacements); diff != "" {
+				t.Errorf("filterPlacementsBySchedulerName() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 // TestConvertCRPArrayToPlacementObjs tests the convertCRPArrayToPlacementObjs function.
 func TestConvertCRPArrayToPlacementObjs(t *testing.T) {
 	testCases := []struct {
@@ -52,6 +52,10 @@ type Reconciler struct {
 
 	// enableResourcePlacement indicates whether the resource placement controller is enabled.
 	EnableResourcePlacement bool
+
+	// SchedulerName is the name of the scheduler; only placements scheduled by the scheduler are
+	// enqueued. An empty name stands for the default scheduler.
+	SchedulerName string
 }
 
 // Reconcile reconciles a member cluster.
@@ -153,6 +157,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	placements := append(convertCRPArrayToPlacementObjs(crpList.Items), convertRPArrayToPlacementObjs(rpList.Items)...)
+	// Only process placements that are scheduled by the scheduler.
+	placements = filterPlacementsBySchedulerName(placements, r.SchedulerName)
 	if !isMemberClusterMissing && memberCluster.GetDeletionTimestamp().IsZero() {
 		// If the member cluster is set to the left state, the scheduler needs to process all
 		// placements (case 2c)); otherwise, only placements of the PickAll type + placements of the PickN type,
@@ -183,7 +189,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 // SetupWithManager builds a controller with Reconciler and sets it up with a controller manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
-	customPredicate := predicate.Funcs{
+	return ctrl.NewControllerManagedBy(mgr).Named("membercluster-scheduler-watcher").
+		For(&clusterv1beta1.MemberCluster{}).
+		WithEventFilter(NewSchedulingRelevantChangePredicate(r.ClusterEligibilityChecker)).
+		Complete(r)
+}
+
+// NewSchedulingRelevantChangePredicate returns a predicate that filters in only the member cluster
+// events that may require the scheduler's attention, as explained in the Reconcile method.
+func NewSchedulingRelevantChangePredicate(clusterEligibilityChecker *clustereligibilitychecker.ClusterEligibilityChecker) predicate.Predicate {
+	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
 			// Normally it is safe to ignore newly created cluster objects, as they are not yet
 			// ready for scheduling; when the clusters do become ready, the controller will catch
@@ -273,8 +288,8 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			}
 
 			// Check the resource placement eligibility for the old and new cluster object.
-			oldEligible, _ := r.ClusterEligibilityChecker.IsEligible(oldCluster)
-			newEligible, _ := r.ClusterEligibilityChecker.IsEligible(newCluster)
+			oldEligible, _ := clusterEligibilityChecker.IsEligible(oldCluster)
+			newEligible, _ := clusterEligibilityChecker.IsEligible(newCluster)
 
 			if !oldEligible && newEligible {
 				// The cluster becomes eligible for resource placement, i.e., match for case 1b).
@@ -289,20 +304,16 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			return false
 		},
 	}
-
-	return ctrl.NewControllerManagedBy(mgr).Named("membercluster-scheduler-watcher").
-		For(&clusterv1beta1.MemberCluster{}).
-		WithEventFilter(customPredicate).
-		Complete(r)
 }
 
 func isTaintsUpdatedOrDeleted(oldTaints []clusterv1beta1.Taint, newTaints []clusterv1beta1.Taint) bool {
+	// Taints are compared by key, value and effect; the time a taint is added does not affect scheduling.
 	newTaintsMap := make(map[clusterv1beta1.Taint]bool)
 	for _, newTaint := range newTaints {
-		newTaintsMap[newTaint] = true
+		newTaintsMap[clusterv1beta1.Taint{Key: newTaint.Key, Value: newTaint.Value, Effect: newTaint.Effect}] = true
 	}
 	for _, oldTaint := range oldTaints {
-		if !newTaintsMap[oldTaint] {
+		if !newTaintsMap[clusterv1beta1.Taint{Key: oldTaint.Key, Value: oldTaint.Value, Effect: oldTaint.Effect}] {
 			return true
 		}
 	}
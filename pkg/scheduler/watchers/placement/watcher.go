@@ -41,6 +41,9 @@ type Reconciler struct {
 	client.Client
 	// SchedulerWorkQueue is the workqueue in use by the scheduler.
 	SchedulerWorkQueue queue.PlacementSchedulingQueueWriter
+	// SchedulerName is the name of the scheduler; only placements scheduled by the scheduler are
+	// enqueued. An empty name stands for the default scheduler.
+	SchedulerName string
 }
 
 // Reconcile reconciles the placement object.
@@ -61,6 +64,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, controller.NewAPIServerError(true, client.IgnoreNotFound(err))
 	}
 
+	// Check if the placement is scheduled by the scheduler.
+	if !controller.IsPlacementScheduledBy(placement, r.SchedulerName) {
+		klog.V(2).InfoS("Placement is scheduled by another scheduler; ignore it", "placement", placementRef, "schedulerName", placement.GetPlacementSpec().Scheduler())
+		return ctrl.Result{}, nil
+	}
+
 	// Check if the placement has been deleted and has the scheduler finalizer.
 	if placement.GetDeletionTimestamp() != nil && controllerutil.ContainsFinalizer(placement, fleetv1beta1.SchedulerCleanupFinalizer) {
 		// The placement has been deleted and still has the scheduler finalizer;
@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementgroup features a controller that enqueues placement objects for the
+// scheduler to process where there is a change in the placement groups they belong to.
+package placementgroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// Reconciler reconciles the change in placement groups.
+//
+// Note that the reconciler is triggered with requests of the member placements (rather than
+// of the placement groups themselves), so that the members of a deleted placement group (or
+// placements that have been removed from a group) can still be enqueued.
+type Reconciler struct {
+	// Client is the client the controller uses to access the hub cluster.
+	client.Client
+	// SchedulerWorkQueue is the workqueue in use by the scheduler.
+	SchedulerWorkQueue queue.PlacementSchedulingQueueWriter
+	// SchedulerName is the name of the scheduler; only placements scheduled by the scheduler are
+	// enqueued. An empty name stands for the default scheduler.
+	SchedulerName string
+}
+
+// Reconcile reconciles a member placement of a placement group.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	placementRef := klog.KRef(req.Namespace, req.Name)
+	startTime := time.Now()
+	klog.V(2).InfoS("Scheduler source reconciliation starts", "placement", placementRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Scheduler source reconciliation ends", "placement", placementRef, "latency", latency)
+	}()
+
+	placementKey := controller.GetObjectKeyFromRequest(req)
+	isScheduledBy, err := controller.IsPlacementKeyScheduledBy(ctx, r.Client, placementKey, r.SchedulerName)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get placement", "placement", placementRef)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if !isScheduledBy {
+		// The placement is scheduled by another scheduler; ignore it.
+		return ctrl.Result{}, nil
+	}
+	r.SchedulerWorkQueue.Add(placementKey)
+
+	// The reconciliation loop ends.
+	return ctrl.Result{}, nil
+}
+
+// mapPlacementGroupToMembers maps a placement group to requests of its member placements.
+func mapPlacementGroupToMembers(_ context.Context, obj client.Object) []reconcile.Request {
+	group, ok := obj.(*fleetv1beta1.PlacementGroup)
+	if !ok {
+		err := controller.NewUnexpectedBehaviorError(fmt.Errorf("received an object of type %T, want a placement group", obj))
+		klog.ErrorS(err, "Failed to map placement group to its members")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(group.Spec.Members))
+	for _, member := range group.Spec.Members {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: member.Namespace, Name: member.Name},
+		})
+	}
+	return requests
+}
+
+func buildCustomPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			// Always process newly created placement groups.
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			// The members of a deleted placement group are no longer placed as a whole.
+			return true
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Check if the update event is valid.
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				err := controller.NewUnexpectedBehaviorError(fmt.Errorf("update event is invalid"))
+				klog.ErrorS(err, "Failed to process update event")
+				return false
+			}
+
+			// The members of the group have changed.
+			if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() {
+				return true
+			}
+
+			// The scheduler has committed a new decision for the group (or found that the group can
+			// no longer be satisfied); the members need to follow.
+			oldGroup, oldOK := e.ObjectOld.(*fleetv1beta1.PlacementGroup)
+			newGroup, newOK := e.ObjectNew.(*fleetv1beta1.PlacementGroup)
+			if !oldOK || !newOK {
+				err := controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to cast objects in update event to placement groups"))
+				klog.ErrorS(err, "Failed to process update event")
+				return false
+			}
+			return !equality.Semantic.DeepEqual(oldGroup.Status, newGroup.Status)
+		},
+	}
+}
+
+// SetupWithManager sets up the controller with the manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("placementgroup-scheduler-watcher").
+		Watches(&fleetv1beta1.PlacementGroup{},
+			handler.EnqueueRequestsFromMapFunc(mapPlacementGroupToMembers),
+			builder.WithPredicates(buildCustomPredicate())).
+		Complete(r)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementgroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	groupName = "test-group"
+)
+
+func TestMapPlacementGroupToMembers(t *testing.T) {
+	group := &fleetv1beta1.PlacementGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: groupName},
+		Spec: fleetv1beta1.PlacementGroupSpec{
+			Members: []fleetv1beta1.PlacementGroupMember{
+				{Name: "frontend"},
+				{Namespace: "app", Name: "backend"},
+			},
+		},
+	}
+	want := []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Name: "frontend"}},
+		{NamespacedName: types.NamespacedName{Namespace: "app", Name: "backend"}},
+	}
+	if diff := cmp.Diff(want, mapPlacementGroupToMembers(context.Background(), group)); diff != "" {
+		t.Errorf("mapPlacementGroupToMembers() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestBuildCustomPredicateForUpdate(t *testing.T) {
+	oldGroup := &fleetv1beta1.PlacementGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: groupName, Generation: 1},
+		Status: fleetv1beta1.PlacementGroupStatus{
+			SelectedClusters: []string{"member-1"},
+		},
+	}
+	tests := map[string]struct {
+		newGroup *fleetv1beta1.PlacementGroup
+		want     bool
+	}{
+		"members changed": {
+			newGroup: &fleetv1beta1.PlacementGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: groupName, Generation: 2},
+				Status:     oldGroup.Status,
+			},
+			want: true,
+		},
+		"selected clusters changed": {
+			newGroup: &fleetv1beta1.PlacementGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: groupName, Generation: 1},
+				Status: fleetv1beta1.PlacementGroupStatus{
+					SelectedClusters: []string{"member-2"},
+				},
+			},
+			want: true,
+		},
+		"no change": {
+			newGroup: &fleetv1beta1.PlacementGroup{
+				ObjectMeta: metav1.ObjectMeta{Name: groupName, Generation: 1, Labels: map[string]string{"foo": "bar"}},
+				Status:     oldGroup.Status,
+			},
+			want: false,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := event.UpdateEvent{ObjectOld: oldGroup, ObjectNew: tt.newGroup}
+			if got := buildCustomPredicate().Update(e); got != tt.want {
+				t.Errorf("Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
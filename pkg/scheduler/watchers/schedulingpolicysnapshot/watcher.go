@@ -41,6 +41,9 @@ type Reconciler struct {
 	client.Client
 	// SchedulerWorkQueue is the workqueue in use by the scheduler.
 	SchedulerWorkQueue queue.PlacementSchedulingQueueWriter
+	// SchedulerName is the name of the scheduler; only placements scheduled by the scheduler are
+	// enqueued. An empty name stands for the default scheduler.
+	SchedulerName string
 }
 
 // Reconcile reconciles the policy snapshot (either ClusterSchedulingPolicySnapshot or SchedulingPolicySnapshot).
@@ -114,7 +117,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		// value be corrected, the controller will be triggered again.
 		return ctrl.Result{}, nil
 	}
-	r.SchedulerWorkQueue.Add(queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(policySnapshot.GetNamespace(), placementName)))
+	placementKey := queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(policySnapshot.GetNamespace(), placementName))
+	isScheduledBy, err := controller.IsPlacementKeyScheduledBy(ctx, r.Client, placementKey, r.SchedulerName)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get placement for policy snapshot", "policySnapshot", policySnapshotRef, "placement", placementKey)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if !isScheduledBy {
+		// The placement is scheduled by another scheduler; ignore the policy snapshot.
+		return ctrl.Result{}, nil
+	}
+	r.SchedulerWorkQueue.Add(placementKey)
 
 	// The reconciliation loop ends.
 	return ctrl.Result{}, nil
@@ -126,11 +126,46 @@ var (
 		Kind:  placementv1beta1.ClusterResourcePlacementEvictionKind,
 	}
 
+	ResourcePlacementEvictionGK = schema.GroupKind{
+		Group: placementv1beta1.GroupVersion.Group,
+		Kind:  placementv1beta1.ResourcePlacementEvictionKind,
+	}
+
 	ClusterResourcePlacementDisruptionBudgetGK = schema.GroupKind{
 		Group: placementv1beta1.GroupVersion.Group,
 		Kind:  placementv1beta1.ClusterResourcePlacementDisruptionBudgetKind,
 	}
 
+	PlacementSimulationGK = schema.GroupKind{
+		Group: placementv1beta1.GroupVersion.Group,
+		Kind:  placementv1beta1.PlacementSimulationKind,
+	}
+
+	PlacementPriorityClassGK = schema.GroupKind{
+		Group: placementv1beta1.GroupVersion.Group,
+		Kind:  placementv1beta1.PlacementPriorityClassKind,
+	}
+
+	PlacementGroupGK = schema.GroupKind{
+		Group: placementv1beta1.GroupVersion.Group,
+		Kind:  placementv1beta1.PlacementGroupKind,
+	}
+
+	ClusterSchedulingExplanationGK = schema.GroupKind{
+		Group: placementv1beta1.GroupVersion.Group,
+		Kind:  placementv1beta1.ClusterSchedulingExplanationKind,
+	}
+
+	SchedulingExplanationGK = schema.GroupKind{
+		Group: placementv1beta1.GroupVersion.Group,
+		Kind:  placementv1beta1.SchedulingExplanationKind,
+	}
+
+	FleetResourceQuotaGK = schema.GroupKind{
+		Group: placementv1beta1.GroupVersion.Group,
+		Kind:  placementv1beta1.FleetResourceQuotaKind,
+	}
+
 	ClusterResourceOverrideGK = schema.GroupKind{
 		Group: placementv1beta1.GroupVersion.Group,
 		Kind:  placementv1beta1.ClusterResourceOverrideKind,
@@ -211,7 +246,14 @@ func NewResourceConfig(isAllowList bool) *ResourceConfig {
 	r.AddGroupKind(ClusterStagedUpdateStrategyGK)
 	r.AddGroupKind(ClusterApprovalRequestGK)
 	r.AddGroupKind(ClusterResourcePlacementEvictionGK)
+	r.AddGroupKind(ResourcePlacementEvictionGK)
 	r.AddGroupKind(ClusterResourcePlacementDisruptionBudgetGK)
+	r.AddGroupKind(PlacementSimulationGK)
+	r.AddGroupKind(PlacementPriorityClassGK)
+	r.AddGroupKind(PlacementGroupGK)
+	r.AddGroupKind(ClusterSchedulingExplanationGK)
+	r.AddGroupKind(SchedulingExplanationGK)
+	r.AddGroupKind(FleetResourceQuotaGK)
 	r.AddGroupKind(ClusterResourceOverrideGK)
 	r.AddGroupKind(ClusterResourceOverrideSnapshotGK)
 	r.AddGroupKind(ResourceOverrideGK)
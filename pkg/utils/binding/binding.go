@@ -40,3 +40,13 @@ func IsBindingDiffReported(binding placementv1beta1.BindingObj) bool {
 	diffReportCondition := binding.GetCondition(string(placementv1beta1.ResourceBindingDiffReported))
 	return diffReportCondition != nil && diffReportCondition.ObservedGeneration == binding.GetGeneration()
 }
+
+// IsBindingDryRunCompleted checks if the binding uses the DryRun apply strategy and all of its
+// resources have been accepted by the member cluster API server in dry-run mode.
+func IsBindingDryRunCompleted(binding placementv1beta1.BindingObj) bool {
+	applyStrategy := binding.GetBindingSpec().ApplyStrategy
+	if applyStrategy == nil || applyStrategy.Type != placementv1beta1.ApplyStrategyTypeDryRun {
+		return false
+	}
+	return condition.IsConditionStatusTrue(binding.GetCondition(string(placementv1beta1.ResourceBindingApplied)), binding.GetGeneration())
+}
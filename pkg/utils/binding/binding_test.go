@@ -419,3 +419,121 @@ func TestIsBindingReportDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestIsBindingDryRunCompleted(t *testing.T) {
+	tests := []struct {
+		name    string
+		binding *placementv1beta1.ClusterResourceBinding
+		want    bool
+	}{
+		{
+			name: "binding with a non-DryRun apply strategy should not be considered as dry-run completed",
+			binding: &placementv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-binding",
+					Generation: 1,
+				},
+				Spec: placementv1beta1.ResourceBindingSpec{
+					ApplyStrategy: &placementv1beta1.ApplyStrategy{
+						Type: placementv1beta1.ApplyStrategyTypeServerSideApply,
+					},
+				},
+				Status: placementv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(placementv1beta1.ResourceBindingApplied),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							Reason:             "applied",
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "binding with the DryRun apply strategy should be considered as dry-run completed if applied condition is true",
+			binding: &placementv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-binding",
+					Generation: 1,
+				},
+				Spec: placementv1beta1.ResourceBindingSpec{
+					ApplyStrategy: &placementv1beta1.ApplyStrategy{
+						Type: placementv1beta1.ApplyStrategyTypeDryRun,
+					},
+				},
+				Status: placementv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(placementv1beta1.ResourceBindingApplied),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							Reason:             "applied",
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "binding with the DryRun apply strategy should not be considered as dry-run completed if applied condition is false",
+			binding: &placementv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-binding",
+					Generation: 1,
+				},
+				Spec: placementv1beta1.ResourceBindingSpec{
+					ApplyStrategy: &placementv1beta1.ApplyStrategy{
+						Type: placementv1beta1.ApplyStrategyTypeDryRun,
+					},
+				},
+				Status: placementv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(placementv1beta1.ResourceBindingApplied),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 1,
+							Reason:             "notApplied",
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "binding with the DryRun apply strategy should not be considered as dry-run completed if applied condition is not current",
+			binding: &placementv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-binding",
+					Generation: 2,
+				},
+				Spec: placementv1beta1.ResourceBindingSpec{
+					ApplyStrategy: &placementv1beta1.ApplyStrategy{
+						Type: placementv1beta1.ApplyStrategyTypeDryRun,
+					},
+				},
+				Status: placementv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(placementv1beta1.ResourceBindingApplied),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 1,
+							Reason:             "applied",
+						},
+					},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsBindingDryRunCompleted(tc.binding)
+			if got != tc.want {
+				t.Errorf("IsBindingDryRunCompleted test `%s` failed got: %v, want: %v", tc.name, got, tc.want)
+			}
+		})
+	}
+}
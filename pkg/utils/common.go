@@ -294,6 +294,12 @@ var (
 		Kind:    "Pod",
 	}
 
+	PodGVR = schema.GroupVersionResource{
+		Group:    corev1.GroupName,
+		Version:  corev1.SchemeGroupVersion.Version,
+		Resource: "pods",
+	}
+
 	PodDisruptionBudgetGVR = schema.GroupVersionResource{
 		Group:    policyv1.GroupName,
 		Version:  policyv1.SchemeGroupVersion.Version,
@@ -103,6 +103,13 @@ var (
 		WorkSynchronizedCondition,
 		DiffReportedCondition,
 	}
+
+	CondTypesForDryRunApplyStrategy = []ResourceCondition{
+		RolloutStartedCondition,
+		OverriddenCondition,
+		WorkSynchronizedCondition,
+		AppliedCondition,
+	}
 )
 
 func (c ResourceCondition) EventReasonForTrue() string {
@@ -26,6 +26,10 @@ const (
 	// SchedulingUnknownReason is the reason string of placement condition when the schedule status is unknown.
 	SchedulingUnknownReason = "SchedulePending"
 
+	// SchedulingGatedReason is the reason string of placement condition when the scheduling is blocked by
+	// scheduling gates.
+	SchedulingGatedReason = "SchedulingGated"
+
 	// ResourceScheduleSucceededReason is the reason string of placement condition when the selected resources are scheduled.
 	ResourceScheduleSucceededReason = "ScheduleSucceeded"
 
@@ -220,6 +224,10 @@ const (
 
 	// UpdateRunWaitingMessageFmt is the message format string of condition if the staged update run is waiting for stage tasks in a stage to complete.
 	UpdateRunWaitingMessageFmt = "The updateRun is waiting for %s tasks in stage %s to complete"
+
+	// UpdateRunWaitingForMaintenanceMessageFmt is the message format string of condition if the staged update run is waiting for
+	// the maintenance windows of clusters in a stage to end.
+	UpdateRunWaitingForMaintenanceMessageFmt = "The updateRun is waiting for the maintenance window of cluster(s) %s in stage %s to end"
 )
 
 // A group of condition reason & message string which is used to populate the ClusterResourcePlacementEviction condition.
@@ -245,6 +253,15 @@ const (
 	// EvictionInvalidPickFixedCRPMessage is the message string of invalid eviction condition when CRP placement type is PickFixed.
 	EvictionInvalidPickFixedCRPMessage = "Found ClusterResourcePlacement with PickFixed placement type targeted by eviction"
 
+	// EvictionInvalidMissingRPMessage is the message string of invalid eviction condition when RP is missing.
+	EvictionInvalidMissingRPMessage = "Failed to find ResourcePlacement targeted by eviction"
+
+	// EvictionInvalidDeletingRPMessage is the message string of invalid eviction condition when RP is deleting.
+	EvictionInvalidDeletingRPMessage = "Found deleting ResourcePlacement targeted by eviction"
+
+	// EvictionInvalidPickFixedRPMessage is the message string of invalid eviction condition when RP placement type is PickFixed.
+	EvictionInvalidPickFixedRPMessage = "Found ResourcePlacement with PickFixed placement type targeted by eviction"
+
 	// EvictionInvalidMissingCRBMessage is the message string of invalid eviction condition when CRB is missing.
 	EvictionInvalidMissingCRBMessage = "Failed to find scheduler decision for placement in cluster targeted by eviction"
 
@@ -257,6 +274,9 @@ const (
 	// EvictionAllowedNoPDBMessage is the message string for executed condition when no PDB is specified.
 	EvictionAllowedNoPDBMessage = "Eviction is allowed, no ClusterResourcePlacementDisruptionBudget specified"
 
+	// EvictionAllowedNoDisruptionBudgetForRPMessage is the message string for executed condition when the eviction targets a RP.
+	EvictionAllowedNoDisruptionBudgetForRPMessage = "Eviction is allowed, ResourcePlacements are not subject to disruption budgets"
+
 	// EvictionAllowedPlacementRemovedMessage is the message string for executed condition when CRB targeted by eviction is being deleted.
 	EvictionAllowedPlacementRemovedMessage = "Eviction is allowed, resources propagated by placement is currently being removed from cluster targeted by eviction"
 
@@ -349,3 +369,24 @@ const (
 	NotAllAppliedObjectsAvailableMessage = "Some manifests are not available (%d of %d manifests are available)"
 	NotAllManifestsHaveReportedDiff      = "Failed to report diff on all manifests (%d of %d manifests have reported diff)"
 )
+
+// A group of condition reason & message string which is used to populate the PlacementSimulation condition.
+const (
+	// PlacementSimulationCompletedReason is the reason string of condition if the simulation has been completed.
+	PlacementSimulationCompletedReason = "PlacementSimulationCompleted"
+
+	// PlacementSimulationInvalidReason is the reason string of condition if the simulation is invalid.
+	PlacementSimulationInvalidReason = "PlacementSimulationInvalid"
+
+	// PlacementSimulationCompletedMessageFmt is the message format string of condition if the simulation has been completed.
+	PlacementSimulationCompletedMessageFmt = "Simulation has been completed, %d cluster(s) would be selected"
+
+	// PlacementSimulationInvalidMissingCRPMessage is the message string of invalid simulation condition when the CRP is missing.
+	PlacementSimulationInvalidMissingCRPMessage = "Failed to find ClusterResourcePlacement targeted by simulation"
+
+	// PlacementSimulationInvalidMissingClusterMessageFmt is the message format string of invalid simulation condition when a cluster to change is missing.
+	PlacementSimulationInvalidMissingClusterMessageFmt = "Failed to find member cluster %s targeted by simulation"
+
+	// PlacementSimulationInvalidMissingProfileMessageFmt is the message format string of invalid simulation condition when the scheduling profile is missing.
+	PlacementSimulationInvalidMissingProfileMessageFmt = "Failed to find scheduling profile %s"
+)
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+const (
+	// defaultSchedulingQueueWeight is the weight of a namespace in the fair scheduling queue, if
+	// the namespace does not specify one.
+	defaultSchedulingQueueWeight = 1
+	// maxSchedulingQueueWeight is the maximum weight of a namespace in the fair scheduling queue.
+	maxSchedulingQueueWeight = 100
+)
+
+// NewNamespaceWeightFunc returns a function which looks up the weight of a namespace in the fair
+// scheduling queue, as set by the SchedulingQueueWeightAnnotation annotation on the namespace.
+//
+// Namespaces that cannot be found, or that have an invalid weight, have the default weight; so do
+// cluster-scoped placements, which are considered to be in a namespace of an empty name.
+func NewNamespaceWeightFunc(c client.Reader) queue.WeightFunc {
+	return func(namespace string) int {
+		if len(namespace) == 0 {
+			return defaultSchedulingQueueWeight
+		}
+		ns := &corev1.Namespace{}
+		if err := c.Get(context.Background(), types.NamespacedName{Name: namespace}, ns); err != nil {
+			if !apierrors.IsNotFound(err) {
+				klog.ErrorS(err, "Failed to look up the namespace for its scheduling queue weight", "namespace", namespace)
+			}
+			return defaultSchedulingQueueWeight
+		}
+		return SchedulingQueueWeightOf(ns)
+	}
+}
+
+// SchedulingQueueWeightOf returns the weight of a namespace in the fair scheduling queue.
+func SchedulingQueueWeightOf(ns *corev1.Namespace) int {
+	val, ok := ns.GetAnnotations()[fleetv1beta1.SchedulingQueueWeightAnnotation]
+	if !ok {
+		return defaultSchedulingQueueWeight
+	}
+	weight, err := strconv.Atoi(val)
+	if err != nil || weight < 1 || weight > maxSchedulingQueueWeight {
+		klog.V(2).InfoS("Ignoring invalid scheduling queue weight", "namespace", klog.KObj(ns), "weight", val)
+		return defaultSchedulingQueueWeight
+	}
+	return weight
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestNewNamespaceWeightFunc(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	newNamespace := func(name, weight string) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if len(weight) > 0 {
+			ns.Annotations = map[string]string{fleetv1beta1.SchedulingQueueWeightAnnotation: weight}
+		}
+		return ns
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			newNamespace("no-weight", ""),
+			newNamespace("heavy", "5"),
+			newNamespace("invalid", "five"),
+			newNamespace("too-heavy", "1000"),
+			newNamespace("zero", "0"),
+		).
+		Build()
+	weightOf := NewNamespaceWeightFunc(fakeClient)
+
+	tests := []struct {
+		name      string
+		namespace string
+		want      int
+	}{
+		{
+			name:      "cluster-scoped placements",
+			namespace: "",
+			want:      1,
+		},
+		{
+			name:      "namespace not found",
+			namespace: "missing",
+			want:      1,
+		},
+		{
+			name:      "no weight",
+			namespace: "no-weight",
+			want:      1,
+		},
+		{
+			name:      "valid weight",
+			namespace: "heavy",
+			want:      5,
+		},
+		{
+			name:      "non-integer weight",
+			namespace: "invalid",
+			want:      1,
+		},
+		{
+			name:      "weight too large",
+			namespace: "too-heavy",
+			want:      1,
+		},
+		{
+			name:      "weight too small",
+			namespace: "zero",
+			want:      1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := weightOf(tc.namespace); got != tc.want {
+				t.Errorf("weightOf(%q) = %d, want %d", tc.namespace, got, tc.want)
+			}
+		})
+	}
+}
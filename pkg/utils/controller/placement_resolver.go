@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -63,6 +64,32 @@ func FetchPlacementFromNamespacedName(ctx context.Context, c client.Reader, nn t
 	return placement, nil
 }
 
+// IsPlacementScheduledBy returns if a placement is scheduled by the scheduler of the given name.
+//
+// An empty scheduler name stands for the default scheduler.
+func IsPlacementScheduledBy(placement fleetv1beta1.PlacementObj, schedulerName string) bool {
+	if len(schedulerName) == 0 {
+		schedulerName = fleetv1beta1.DefaultSchedulerName
+	}
+	return placement.GetPlacementSpec().Scheduler() == schedulerName
+}
+
+// IsPlacementKeyScheduledBy returns if the placement of the given key is scheduled by the scheduler
+// of the given name.
+//
+// If the placement is not found, it is considered to be scheduled by the scheduler, as the scheduler
+// can always tell that no further processing is needed for a placement that is gone.
+func IsPlacementKeyScheduledBy(ctx context.Context, c client.Reader, placementKey queue.PlacementKey, schedulerName string) (bool, error) {
+	placement, err := FetchPlacementFromKey(ctx, c, placementKey)
+	switch {
+	case apierrors.IsNotFound(err):
+		return true, nil
+	case err != nil:
+		return false, err
+	}
+	return IsPlacementScheduledBy(placement, schedulerName), nil
+}
+
 // GetObjectKeyFromObj generates a object Key from a meta object.
 func GetObjectKeyFromObj(obj metav1.Object) queue.PlacementKey {
 	if obj.GetNamespace() == "" {
@@ -347,6 +347,98 @@ func TestFetchPlacementFromKey(t *testing.T) {
 	}
 }
 
+func TestIsPlacementKeyScheduledBy(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	objects := []client.Object{
+		&fleetv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-crp",
+			},
+		},
+		&fleetv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-rp",
+				Namespace: "test-ns",
+			},
+			Spec: fleetv1beta1.PlacementSpec{
+				SchedulerName: "custom-scheduler",
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		placementKey  queue.PlacementKey
+		schedulerName string
+		want          bool
+		wantErr       bool
+	}{
+		{
+			name:         "placement without scheduler name, empty scheduler name",
+			placementKey: queue.PlacementKey("test-crp"),
+			want:         true,
+		},
+		{
+			name:          "placement without scheduler name, default scheduler",
+			placementKey:  queue.PlacementKey("test-crp"),
+			schedulerName: fleetv1beta1.DefaultSchedulerName,
+			want:          true,
+		},
+		{
+			name:          "placement without scheduler name, custom scheduler",
+			placementKey:  queue.PlacementKey("test-crp"),
+			schedulerName: "custom-scheduler",
+			want:          false,
+		},
+		{
+			name:          "placement with scheduler name, custom scheduler",
+			placementKey:  queue.PlacementKey("test-ns/test-rp"),
+			schedulerName: "custom-scheduler",
+			want:          true,
+		},
+		{
+			name:          "placement with scheduler name, default scheduler",
+			placementKey:  queue.PlacementKey("test-ns/test-rp"),
+			schedulerName: fleetv1beta1.DefaultSchedulerName,
+			want:          false,
+		},
+		{
+			name:          "placement not found",
+			placementKey:  queue.PlacementKey("non-existent-crp"),
+			schedulerName: "custom-scheduler",
+			want:          true,
+		},
+		{
+			name:         "invalid placement key",
+			placementKey: queue.PlacementKey("a/b/c"),
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				Build()
+
+			got, err := IsPlacementKeyScheduledBy(context.Background(), fakeClient, tt.placementKey, tt.schedulerName)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("IsPlacementKeyScheduledBy() = %v, want error", got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("IsPlacementKeyScheduledBy() = %v, %v, want %v, nil", got, err, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetPlacementKeyFromObj(t *testing.T) {
 	tests := []struct {
 		name      string
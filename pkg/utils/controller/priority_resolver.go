@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+// FetchPlacementPriorityClass returns the PlacementPriorityClass that a placement refers to.
+// It returns nil if the placement does not refer to any priority class, or if the priority class
+// it refers to does not exist.
+func FetchPlacementPriorityClass(ctx context.Context, c client.Reader, placement fleetv1beta1.PlacementObj) (*fleetv1beta1.PlacementPriorityClass, error) {
+	priorityClassName := placement.GetPlacementSpec().PriorityClassName
+	if len(priorityClassName) == 0 {
+		return nil, nil
+	}
+	priorityClass := &fleetv1beta1.PlacementPriorityClass{}
+	if err := c.Get(ctx, types.NamespacedName{Name: priorityClassName}, priorityClass); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, NewAPIServerError(true, err)
+	}
+	return priorityClass, nil
+}
+
+// PlacementPriorityOf returns the priority value a PlacementPriorityClass assigns; placements
+// without a priority class have a priority value of zero.
+func PlacementPriorityOf(priorityClass *fleetv1beta1.PlacementPriorityClass) int32 {
+	if priorityClass == nil {
+		return 0
+	}
+	return priorityClass.Value
+}
+
+// NewPlacementPriorityFunc returns a function which looks up the priority of a placement by its
+// key, for the scheduling queue to order placements; placements that cannot be found (e.g., they
+// have been deleted) have a priority value of zero.
+func NewPlacementPriorityFunc(c client.Reader) queue.PriorityFunc {
+	return func(placementKey queue.PlacementKey) int32 {
+		ctx := context.Background()
+		placement, err := FetchPlacementFromKey(ctx, c, placementKey)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				klog.ErrorS(err, "Failed to look up the placement for its priority", "placement", placementKey)
+			}
+			return 0
+		}
+		priorityClass, err := FetchPlacementPriorityClass(ctx, c, placement)
+		if err != nil {
+			klog.ErrorS(err, "Failed to look up the priority class of the placement", "placement", placementKey)
+			return 0
+		}
+		return PlacementPriorityOf(priorityClass)
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+func TestFetchPlacementPriorityClass(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+
+	priorityClass := &fleetv1beta1.PlacementPriorityClass{
+		ObjectMeta:       metav1.ObjectMeta{Name: "high"},
+		Value:            1000,
+		PreemptionPolicy: fleetv1beta1.PreemptLowerPriority,
+	}
+
+	tests := []struct {
+		name              string
+		priorityClassName string
+		objects           []client.Object
+		wantFound         bool
+		wantPriority      int32
+	}{
+		{
+			name:         "no priority class",
+			objects:      []client.Object{priorityClass},
+			wantPriority: 0,
+		},
+		{
+			name:              "priority class not found",
+			priorityClassName: "low",
+			objects:           []client.Object{priorityClass},
+			wantPriority:      0,
+		},
+		{
+			name:              "priority class found",
+			priorityClassName: "high",
+			objects:           []client.Object{priorityClass},
+			wantFound:         true,
+			wantPriority:      1000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+			placement := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-crp"},
+				Spec: fleetv1beta1.PlacementSpec{
+					PriorityClassName: tt.priorityClassName,
+				},
+			}
+			got, err := FetchPlacementPriorityClass(context.Background(), fakeClient, placement)
+			if err != nil {
+				t.Fatalf("FetchPlacementPriorityClass() = %v, want no error", err)
+			}
+			if (got != nil) != tt.wantFound {
+				t.Errorf("FetchPlacementPriorityClass() = %v, want found %v", got, tt.wantFound)
+			}
+			if gotPriority := PlacementPriorityOf(got); gotPriority != tt.wantPriority {
+				t.Errorf("PlacementPriorityOf() = %d, want %d", gotPriority, tt.wantPriority)
+			}
+		})
+	}
+}
+
+func TestNewPlacementPriorityFunc(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add scheme: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&fleetv1beta1.PlacementPriorityClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "high"},
+			Value:      1000,
+		},
+		&fleetv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-crp"},
+			Spec:       fleetv1beta1.PlacementSpec{PriorityClassName: "high"},
+		},
+		&fleetv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-rp", Namespace: "test-ns"},
+			Spec:       fleetv1beta1.PlacementSpec{PriorityClassName: "high"},
+		},
+	).Build()
+	priorityOf := NewPlacementPriorityFunc(fakeClient)
+
+	tests := []struct {
+		name         string
+		placementKey queue.PlacementKey
+		want         int32
+	}{
+		{
+			name:         "cluster resource placement",
+			placementKey: queue.PlacementKey("test-crp"),
+			want:         1000,
+		},
+		{
+			name:         "resource placement",
+			placementKey: queue.PlacementKey("test-ns/test-rp"),
+			want:         1000,
+		},
+		{
+			name:         "placement not found",
+			placementKey: queue.PlacementKey("missing-crp"),
+			want:         0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := priorityOf(tt.placementKey); got != tt.want {
+				t.Errorf("priorityOf(%q) = %d, want %d", tt.placementKey, got, tt.want)
+			}
+		})
+	}
+}
@@ -13,25 +13,26 @@ import (
 )
 
 // IsEvictionInTerminalState checks to see if eviction is in a terminal state.
-func IsEvictionInTerminalState(eviction *placementv1beta1.ClusterResourcePlacementEviction) bool {
+func IsEvictionInTerminalState(eviction placementv1beta1.PlacementEvictionObj) bool {
 	if validCondition := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeValid)); condition.IsConditionStatusFalse(validCondition, eviction.GetGeneration()) {
-		klog.V(2).InfoS("Invalid eviction, no need to reconcile", "clusterResourcePlacementEviction", eviction.Name)
+		klog.V(2).InfoS("Invalid eviction, no need to reconcile", "placementEviction", klog.KObj(eviction))
 		return true
 	}
 
 	if executedCondition := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeExecuted)); executedCondition != nil {
-		klog.V(2).InfoS("Eviction has executed condition specified, no need to reconcile", "clusterResourcePlacementEviction", eviction.Name)
+		klog.V(2).InfoS("Eviction has executed condition specified, no need to reconcile", "placementEviction", klog.KObj(eviction))
 		return true
 	}
 	return false
 }
 
 // IsPlacementPresent checks to see if placement on target cluster could be present.
-func IsPlacementPresent(binding *placementv1beta1.ClusterResourceBinding) bool {
-	if binding.Spec.State == placementv1beta1.BindingStateBound {
+func IsPlacementPresent(binding placementv1beta1.BindingObj) bool {
+	state := binding.GetBindingSpec().State
+	if state == placementv1beta1.BindingStateBound {
 		return true
 	}
-	if binding.Spec.State == placementv1beta1.BindingStateUnscheduled {
+	if state == placementv1beta1.BindingStateUnscheduled {
 		currentAnnotation := binding.GetAnnotations()
 		previousState, exist := currentAnnotation[placementv1beta1.PreviousBindingStateAnnotation]
 		if exist && placementv1beta1.BindingState(previousState) == placementv1beta1.BindingStateBound {
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package maintenancewindow features utilities for evaluating the maintenance windows of member clusters.
+package maintenancewindow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+// Validate returns an error if the maintenance window cannot be evaluated.
+func Validate(window *clusterv1beta1.MaintenanceWindow) error {
+	if window.Schedule == "" {
+		if window.Start == nil || window.End == nil {
+			return fmt.Errorf("maintenance window %q must specify either a schedule or both start and end", window.Name)
+		}
+		if !window.End.After(window.Start.Time) {
+			return fmt.Errorf("maintenance window %q must end after it starts", window.Name)
+		}
+		return nil
+	}
+	if _, _, err := parseSchedule(window); err != nil {
+		return err
+	}
+	if window.Duration == nil || window.Duration.Duration <= 0 {
+		return fmt.Errorf("maintenance window %q must specify a positive duration with its schedule", window.Name)
+	}
+	return nil
+}
+
+// Current returns the occurrence of the given maintenance windows that is active at the given
+// time, or nil if none is active. If several occurrences are active, the one ending last is returned.
+//
+// Windows that cannot be evaluated are ignored.
+func Current(windows []clusterv1beta1.MaintenanceWindow, now time.Time) *clusterv1beta1.MaintenanceWindowOccurrence {
+	var current *clusterv1beta1.MaintenanceWindowOccurrence
+	for idx := range windows {
+		occ := activeOccurrence(&windows[idx], now)
+		if occ != nil && (current == nil || occ.End.After(current.End.Time)) {
+			current = occ
+		}
+	}
+	return current
+}
+
+// Next returns the earliest occurrence of the given maintenance windows that starts after the
+// given time, or nil if there is none.
+//
+// Windows that cannot be evaluated are ignored.
+func Next(windows []clusterv1beta1.MaintenanceWindow, now time.Time) *clusterv1beta1.MaintenanceWindowOccurrence {
+	var next *clusterv1beta1.MaintenanceWindowOccurrence
+	for idx := range windows {
+		occ := nextOccurrence(&windows[idx], now)
+		if occ != nil && (next == nil || occ.Start.Before(&next.Start)) {
+			next = occ
+		}
+	}
+	return next
+}
+
+// InMaintenance returns if the member cluster has an active maintenance window at the given time,
+// and if so, the time at which the window ends.
+func InMaintenance(cluster *clusterv1beta1.MemberCluster, now time.Time) (time.Time, bool) {
+	current := Current(cluster.Spec.MaintenanceWindows, now)
+	if current == nil {
+		return time.Time{}, false
+	}
+	return current.End.Time, true
+}
+
+func activeOccurrence(window *clusterv1beta1.MaintenanceWindow, now time.Time) *clusterv1beta1.MaintenanceWindowOccurrence {
+	if Validate(window) != nil {
+		return nil
+	}
+	if window.Schedule == "" {
+		if window.Start.After(now) || !window.End.After(now) {
+			return nil
+		}
+		return newOccurrence(window.Name, window.Start.Time, window.End.Time)
+	}
+	schedule, loc, _ := parseSchedule(window)
+	// The earliest occurrence that starts after (now - duration) is the earliest one that has
+	// not ended yet; it is active if it has already started.
+	start := schedule.Next(now.Add(-window.Duration.Duration).In(loc))
+	if start.IsZero() || start.After(now) {
+		return nil
+	}
+	return newOccurrence(window.Name, start, start.Add(window.Duration.Duration))
+}
+
+func nextOccurrence(window *clusterv1beta1.MaintenanceWindow, now time.Time) *clusterv1beta1.MaintenanceWindowOccurrence {
+	if Validate(window) != nil {
+		return nil
+	}
+	if window.Schedule == "" {
+		if !window.Start.After(now) {
+			return nil
+		}
+		return newOccurrence(window.Name, window.Start.Time, window.End.Time)
+	}
+	schedule, loc, _ := parseSchedule(window)
+	start := schedule.Next(now.In(loc))
+	if start.IsZero() {
+		// The schedule never fires (e.g., "0 0 30 2 *").
+		return nil
+	}
+	return newOccurrence(window.Name, start, start.Add(window.Duration.Duration))
+}
+
+func parseSchedule(window *clusterv1beta1.MaintenanceWindow) (cron.Schedule, *time.Location, error) {
+	loc := time.UTC
+	if window.TimeZone != "" {
+		var err error
+		if loc, err = time.LoadLocation(window.TimeZone); err != nil {
+			return nil, nil, fmt.Errorf("maintenance window %q has an invalid time zone %q: %w", window.Name, window.TimeZone, err)
+		}
+	}
+	schedule, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("maintenance window %q has an invalid schedule %q: %w", window.Name, window.Schedule, err)
+	}
+	return schedule, loc, nil
+}
+
+func newOccurrence(name string, start, end time.Time) *clusterv1beta1.MaintenanceWindowOccurrence {
+	return &clusterv1beta1.MaintenanceWindowOccurrence{
+		Name:  name,
+		Start: metav1.NewTime(start.UTC()),
+		End:   metav1.NewTime(end.UTC()),
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package maintenancewindow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+func TestValidate(t *testing.T) {
+	now := metav1.NewTime(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(now.Add(time.Hour))
+	tests := []struct {
+		name    string
+		window  clusterv1beta1.MaintenanceWindow
+		wantErr bool
+	}{
+		{
+			name:   "valid recurring window",
+			window: clusterv1beta1.MaintenanceWindow{Name: "w", Schedule: "0 2 * * SAT", Duration: &metav1.Duration{Duration: time.Hour}, TimeZone: "Europe/Berlin"},
+		},
+		{
+			name:   "valid absolute window",
+			window: clusterv1beta1.MaintenanceWindow{Name: "w", Start: &now, End: &later},
+		},
+		{
+			name:    "invalid schedule",
+			window:  clusterv1beta1.MaintenanceWindow{Name: "w", Schedule: "not a cron", Duration: &metav1.Duration{Duration: time.Hour}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid time zone",
+			window:  clusterv1beta1.MaintenanceWindow{Name: "w", Schedule: "0 2 * * *", Duration: &metav1.Duration{Duration: time.Hour}, TimeZone: "Mars/Olympus"},
+			wantErr: true,
+		},
+		{
+			name:    "missing duration",
+			window:  clusterv1beta1.MaintenanceWindow{Name: "w", Schedule: "0 2 * * *"},
+			wantErr: true,
+		},
+		{
+			name:    "end before start",
+			window:  clusterv1beta1.MaintenanceWindow{Name: "w", Start: &later, End: &now},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(&tt.window); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCurrentAndNext(t *testing.T) {
+	// Saturday, June 7th 2025, 02:30 UTC.
+	now := time.Date(2025, 6, 7, 2, 30, 0, 0, time.UTC)
+	occurrence := func(name string, start, end time.Time) *clusterv1beta1.MaintenanceWindowOccurrence {
+		return &clusterv1beta1.MaintenanceWindowOccurrence{Name: name, Start: metav1.NewTime(start), End: metav1.NewTime(end)}
+	}
+	absStart := metav1.NewTime(time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC))
+	absEnd := metav1.NewTime(time.Date(2025, 6, 11, 0, 0, 0, 0, time.UTC))
+	pastStart := metav1.NewTime(time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC))
+	pastEnd := metav1.NewTime(time.Date(2025, 6, 7, 6, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name        string
+		windows     []clusterv1beta1.MaintenanceWindow
+		wantCurrent *clusterv1beta1.MaintenanceWindowOccurrence
+		wantNext    *clusterv1beta1.MaintenanceWindowOccurrence
+	}{
+		{
+			name: "no windows",
+		},
+		{
+			name: "active recurring window",
+			windows: []clusterv1beta1.MaintenanceWindow{
+				{Name: "weekly", Schedule: "0 2 * * SAT", Duration: &metav1.Duration{Duration: time.Hour}},
+			},
+			wantCurrent: occurrence("weekly", time.Date(2025, 6, 7, 2, 0, 0, 0, time.UTC), time.Date(2025, 6, 7, 3, 0, 0, 0, time.UTC)),
+			wantNext:    occurrence("weekly", time.Date(2025, 6, 14, 2, 0, 0, 0, time.UTC), time.Date(2025, 6, 14, 3, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "recurring window evaluated in a time zone",
+			windows: []clusterv1beta1.MaintenanceWindow{
+				// 02:00 in Berlin (CEST) is 00:00 UTC; the occurrence has ended by 02:30 UTC.
+				{Name: "weekly", Schedule: "0 2 * * SAT", Duration: &metav1.Duration{Duration: 2 * time.Hour}, TimeZone: "Europe/Berlin"},
+			},
+			wantNext: occurrence("weekly", time.Date(2025, 6, 14, 0, 0, 0, 0, time.UTC), time.Date(2025, 6, 14, 2, 0, 0, 0, time.UTC)),
+		},
+		{
+			name: "absolute windows",
+			windows: []clusterv1beta1.MaintenanceWindow{
+				{Name: "future", Start: &absStart, End: &absEnd},
+				{Name: "now", Start: &pastStart, End: &pastEnd},
+			},
+			wantCurrent: occurrence("now", pastStart.Time, pastEnd.Time),
+			wantNext:    occurrence("future", absStart.Time, absEnd.Time),
+		},
+		{
+			name: "overlapping windows pick the latest end and earliest start",
+			windows: []clusterv1beta1.MaintenanceWindow{
+				{Name: "daily", Schedule: "0 * * * *", Duration: &metav1.Duration{Duration: 45 * time.Minute}},
+				{Name: "now", Start: &pastStart, End: &pastEnd},
+			},
+			wantCurrent: occurrence("now", pastStart.Time, pastEnd.Time),
+			wantNext:    occurrence("daily", time.Date(2025, 6, 7, 3, 0, 0, 0, time.UTC), time.Date(2025, 6, 7, 3, 45, 0, 0, time.UTC)),
+		},
+		{
+			name: "invalid windows are ignored",
+			windows: []clusterv1beta1.MaintenanceWindow{
+				{Name: "bad", Schedule: "bad", Duration: &metav1.Duration{Duration: time.Hour}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.wantCurrent, Current(tt.windows, now)); diff != "" {
+				t.Errorf("Current() mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantNext, Next(tt.windows, now)); diff != "" {
+				t.Errorf("Next() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package propertyexpression provides utils to compile and evaluate the CEL property expressions
+// in cluster selector terms.
+package propertyexpression
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+const (
+	// The names of the variables available in a property expression.
+	labelsVarName           = "labels"
+	propertiesVarName       = "properties"
+	stringPropertiesVarName = "stringProperties"
+	resourceUsageVarName    = "resourceUsage"
+
+	// compareVersionsFuncName is the name of the function for comparing version strings in a
+	// property expression.
+	compareVersionsFuncName = "compareVersions"
+
+	// The keys of the resource usage variable.
+	capacityKey    = "capacity"
+	allocatableKey = "allocatable"
+	availableKey   = "available"
+
+	// costLimit is the maximum cost the evaluation of a property expression may incur, which
+	// guards the scheduler against expressions that are too expensive to evaluate.
+	costLimit uint64 = 1000000
+
+	// maxCachedPrograms is the maximum number of compiled programs to keep in the cache.
+	maxCachedPrograms = 1024
+)
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	// programs is a cache of compiled programs, keyed by the expressions.
+	programs   = map[string]cel.Program{}
+	programsMu sync.Mutex
+)
+
+// celEnv returns the CEL environment in which property expressions are compiled.
+func celEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Variable(labelsVarName, cel.MapType(cel.StringType, cel.StringType)),
+			cel.Variable(propertiesVarName, cel.MapType(cel.StringType, cel.DoubleType)),
+			cel.Variable(stringPropertiesVarName, cel.MapType(cel.StringType, cel.StringType)),
+			cel.Variable(resourceUsageVarName, cel.MapType(cel.StringType, cel.MapType(cel.StringType, cel.DoubleType))),
+			cel.Function(compareVersionsFuncName,
+				cel.Overload("compare_versions_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.IntType,
+					cel.BinaryBinding(compareVersions),
+				),
+			),
+			cel.CrossTypeNumericComparisons(true),
+		)
+	})
+	return env, envErr
+}
+
+// compareVersions compares two version strings (e.g., `v1.30.2`), and returns -1, 0, or 1 if
+// the first version is lower than, equal to, or higher than the second one respectively.
+//
+// A leading `v` and trailing build information (e.g., `-eks-1234`) are allowed; missing minor and
+// patch numbers are considered to be zeros. Version strings that cannot be parsed yield an error,
+// which fails the evaluation.
+func compareVersions(lhs, rhs ref.Val) ref.Val {
+	lhsStr, ok := lhs.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(lhs)
+	}
+	rhsStr, ok := rhs.(types.String)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(rhs)
+	}
+	lhsVer, err := version.ParseGeneric(string(lhsStr))
+	if err != nil {
+		return types.NewErr("failed to parse version %q: %v", string(lhsStr), err)
+	}
+	cmp, err := lhsVer.Compare(string(rhsStr))
+	if err != nil {
+		return types.NewErr("failed to parse version %q: %v", string(rhsStr), err)
+	}
+	return types.Int(cmp)
+}
+
+// Compile type-checks a property expression, which must evaluate to a boolean value, and returns
+// a program for evaluating it.
+//
+// Compiled programs are cached; the cache is reset when it grows too large.
+func Compile(expression string) (cel.Program, error) {
+	programsMu.Lock()
+	prg, ok := programs[expression]
+	programsMu.Unlock()
+	if ok {
+		return prg, nil
+	}
+
+	e, err := celEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the CEL environment: %w", err)
+	}
+	ast, iss := e.Compile(expression)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("failed to compile property expression %q: %w", expression, iss.Err())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("property expression %q must evaluate to a boolean value, got %s", expression, ast.OutputType())
+	}
+	prg, err = e.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build program for property expression %q: %w", expression, err)
+	}
+
+	programsMu.Lock()
+	defer programsMu.Unlock()
+	if len(programs) >= maxCachedPrograms {
+		programs = map[string]cel.Program{}
+	}
+	programs[expression] = prg
+	return prg, nil
+}
+
+// Matches evaluates a property expression against a member cluster.
+//
+// Note that if the expression cannot be evaluated for the cluster, e.g., it refers to a property
+// the cluster does not report, the cluster is considered not matched, and no error is returned;
+// an error is returned only if the expression is invalid.
+func Matches(expression string, cluster *clusterv1beta1.MemberCluster) (bool, error) {
+	prg, err := Compile(expression)
+	if err != nil {
+		return false, err
+	}
+
+	out, _, err := prg.Eval(activationFor(cluster))
+	if err != nil {
+		klog.V(2).InfoS("Failed to evaluate property expression; the cluster is not matched", "expression", expression, "memberCluster", klog.KObj(cluster), "err", err)
+		return false, nil
+	}
+	matched, ok := out.Value().(bool)
+	return ok && matched, nil
+}
+
+// activationFor returns the variables for evaluating property expressions against a member cluster.
+func activationFor(cluster *clusterv1beta1.MemberCluster) map[string]any {
+	labels := cluster.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	properties := make(map[string]float64, len(cluster.Status.Properties))
+	stringProperties := make(map[string]string, len(cluster.Status.Properties))
+	for name, v := range cluster.Status.Properties {
+		stringProperties[string(name)] = v.Value
+		q, err := resource.ParseQuantity(v.Value)
+		if err != nil {
+			// Properties whose values are not valid quantities are not available in property
+			// expressions.
+			continue
+		}
+		properties[string(name)] = q.AsApproximateFloat64()
+	}
+
+	usage := cluster.Status.ResourceUsage
+	resourceUsage := map[string]map[string]float64{
+		capacityKey:    toFloats(usage.Capacity),
+		allocatableKey: toFloats(usage.Allocatable),
+		availableKey:   toFloats(usage.Available),
+	}
+
+	return map[string]any{
+		labelsVarName:           labels,
+		propertiesVarName:       properties,
+		stringPropertiesVarName: stringProperties,
+		resourceUsageVarName:    resourceUsage,
+	}
+}
+
+// toFloats converts a resource list to a map of floats, keyed by the resource names.
+func toFloats(rl corev1.ResourceList) map[string]float64 {
+	floats := make(map[string]float64, len(rl))
+	for name, q := range rl {
+		floats[string(name)] = q.AsApproximateFloat64()
+	}
+	return floats
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propertyexpression
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+const (
+	clusterName = "bravelion"
+
+	k8sVersionPropertyName    = "k8s-version"
+	k8sGitVersionPropertyName = "k8s.io/k8s-version"
+	nodeCountPropertyName     = "kubernetes-fleet.io/node-count"
+	invalidPropertyName       = "invalid-property"
+)
+
+// TestCompile tests the Compile function.
+func TestCompile(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		wantErrMsg string
+	}{
+		{
+			name:       "valid expression",
+			expression: `resourceUsage.available.cpu > 0.2 * resourceUsage.capacity.cpu && properties["k8s-version"] >= 1.30`,
+		},
+		{
+			name:       "valid expression with labels",
+			expression: `labels["env"] == "prod" || "region" in labels`,
+		},
+		{
+			name:       "valid expression with version comparison",
+			expression: `compareVersions(stringProperties["k8s.io/k8s-version"], "1.30") >= 0`,
+		},
+		{
+			name:       "syntax error",
+			expression: `properties["k8s-version"] >=`,
+			wantErrMsg: "failed to compile property expression",
+		},
+		{
+			name:       "undeclared variable",
+			expression: `nodes > 1`,
+			wantErrMsg: "failed to compile property expression",
+		},
+		{
+			name:       "non-boolean expression",
+			expression: `properties["k8s-version"] * 2.0`,
+			wantErrMsg: "must evaluate to a boolean value",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prg, err := Compile(tc.expression)
+			if tc.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsg) {
+					t.Errorf("Compile() = %v, want error containing %q", err, tc.wantErrMsg)
+				}
+				return
+			}
+			if err != nil || prg == nil {
+				t.Errorf("Compile() = %v, %v, want a program, nil", prg, err)
+			}
+		})
+	}
+}
+
+// TestMatches tests the Matches function.
+func TestMatches(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+			Labels: map[string]string{
+				"env": "prod",
+			},
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				k8sVersionPropertyName: {
+					Value: "1.31",
+				},
+				k8sGitVersionPropertyName: {
+					Value: "v1.30.2",
+				},
+				nodeCountPropertyName: {
+					Value: "4",
+				},
+				invalidPropertyName: {
+					Value: "invalid",
+				},
+			},
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10"),
+					corev1.ResourceMemory: resource.MustParse("40Gi"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: resource.MustParse("36Gi"),
+				},
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2500m"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		expression     string
+		want           bool
+		expectedToFail bool
+	}{
+		{
+			name:       "labels, matched",
+			expression: `labels["env"] == "prod"`,
+			want:       true,
+		},
+		{
+			name:       "labels, not matched",
+			expression: `labels["env"] == "dev"`,
+		},
+		{
+			name:       "properties, matched",
+			expression: `properties["k8s-version"] >= 1.30 && properties["kubernetes-fleet.io/node-count"] == 4.0`,
+			want:       true,
+		},
+		{
+			name:       "properties, not matched",
+			expression: `properties["kubernetes-fleet.io/node-count"] > 4`,
+		},
+		{
+			name:       "string properties, matched",
+			expression: `stringProperties["k8s.io/k8s-version"] == "v1.30.2" && stringProperties["invalid-property"] == "invalid"`,
+			want:       true,
+		},
+		{
+			name:       "version comparison, matched",
+			expression: `compareVersions(stringProperties["k8s.io/k8s-version"], "1.30") >= 0 && compareVersions(stringProperties["k8s.io/k8s-version"], "v1.30.2") == 0`,
+			want:       true,
+		},
+		{
+			name:       "version comparison, not matched",
+			expression: `compareVersions(stringProperties["k8s.io/k8s-version"], "1.30.10") >= 0`,
+		},
+		{
+			name:       "version comparison with an unparsable version",
+			expression: `compareVersions(stringProperties["invalid-property"], "1.30") >= 0`,
+		},
+		{
+			name:       "resource usage, matched",
+			expression: `resourceUsage.available.cpu > 0.2 * resourceUsage.capacity.cpu`,
+			want:       true,
+		},
+		{
+			name:       "resource usage, not matched",
+			expression: `resourceUsage.available.memory > 0.2 * resourceUsage.allocatable.memory`,
+		},
+		{
+			name:       "missing label",
+			expression: `labels["region"] == "east"`,
+		},
+		{
+			name:       "property with an invalid value",
+			expression: `properties["invalid-property"] > 0`,
+		},
+		{
+			name:       "presence check",
+			expression: `!("invalid-property" in properties) && "k8s-version" in properties`,
+			want:       true,
+		},
+		{
+			name:           "invalid expression",
+			expression:     `labels["env"]`,
+			expectedToFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matched, err := Matches(tc.expression, cluster)
+			if tc.expectedToFail {
+				if err == nil {
+					t.Errorf("Matches(), want error, got nil")
+				}
+				return
+			}
+
+			if err != nil || matched != tc.want {
+				t.Errorf("Matches() = %v, %v, want %v, nil", matched, err, tc.want)
+			}
+		})
+	}
+}
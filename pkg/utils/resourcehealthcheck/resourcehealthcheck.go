@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourcehealthcheck provides utils to evaluate ResourceHealthChecks against resources.
+package resourcehealthcheck
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// objectVarName is the name of the variable that holds the resource in an expression.
+	objectVarName = "object"
+
+	// costLimit is the maximum cost the evaluation of an expression may incur, which guards the
+	// member agent against expressions that are too expensive to evaluate.
+	costLimit uint64 = 1000000
+
+	// maxCachedPrograms is the maximum number of compiled programs to keep in the cache.
+	maxCachedPrograms = 1024
+)
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	// programs is a cache of compiled programs, keyed by the expressions.
+	programs   = map[string]cel.Program{}
+	programsMu sync.Mutex
+)
+
+// celEnv returns the CEL environment in which health check expressions are compiled.
+func celEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Variable(objectVarName, cel.DynType),
+			cel.CrossTypeNumericComparisons(true),
+		)
+	})
+	return env, envErr
+}
+
+// Compile type-checks a health check expression, which must evaluate to a boolean value, and
+// returns a program for evaluating it.
+//
+// Compiled programs are cached; the cache is reset when it grows too large.
+func Compile(expression string) (cel.Program, error) {
+	programsMu.Lock()
+	prg, ok := programs[expression]
+	programsMu.Unlock()
+	if ok {
+		return prg, nil
+	}
+
+	e, err := celEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the CEL environment: %w", err)
+	}
+	ast, iss := e.Compile(expression)
+	if iss.Err() != nil {
+		return nil, fmt.Errorf("failed to compile health check expression %q: %w", expression, iss.Err())
+	}
+	// The object is dynamically typed, so expressions over its fields are only known to be
+	// boolean at evaluation time.
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("health check expression %q must evaluate to a boolean value, got %s", expression, ast.OutputType())
+	}
+	prg, err = e.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build program for health check expression %q: %w", expression, err)
+	}
+
+	programsMu.Lock()
+	defer programsMu.Unlock()
+	if len(programs) >= maxCachedPrograms {
+		programs = map[string]cel.Program{}
+	}
+	programs[expression] = prg
+	return prg, nil
+}
+
+// Targets returns if a health check applies to resources of the given GVK.
+func Targets(hc *placementv1beta1.ResourceHealthCheck, gvk schema.GroupVersionKind) bool {
+	target := hc.Spec.Target
+	return target.Group == gvk.Group && target.Kind == gvk.Kind && (target.Version == "" || target.Version == gvk.Version)
+}
+
+// IsAvailable evaluates the rules of a health check against a resource, and returns if the
+// resource satisfies all of them.
+//
+// An error is returned only if a rule is invalid; rules that cannot be evaluated for the resource,
+// e.g., an expression that refers to a field the resource does not have yet, are not satisfied.
+func IsAvailable(hc *placementv1beta1.ResourceHealthCheck, obj *unstructured.Unstructured) (bool, error) {
+	for idx := range hc.Spec.Rules {
+		rule := &hc.Spec.Rules[idx]
+		var satisfied bool
+		switch {
+		case rule.Expression != "":
+			prg, err := Compile(rule.Expression)
+			if err != nil {
+				return false, fmt.Errorf("invalid rule %d of resource health check %s: %w", idx, hc.Name, err)
+			}
+			out, _, err := prg.Eval(map[string]any{objectVarName: obj.Object})
+			if err != nil {
+				klog.V(2).InfoS("Failed to evaluate health check expression; the rule is not satisfied",
+					"resourceHealthCheck", klog.KObj(hc), "expression", rule.Expression, "resource", klog.KObj(obj), "err", err)
+				return false, nil
+			}
+			matched, ok := out.Value().(bool)
+			satisfied = ok && matched
+		case rule.Condition != nil:
+			satisfied = hasCondition(obj, rule.Condition)
+		default:
+			return false, fmt.Errorf("invalid rule %d of resource health check %s: neither expression nor condition is specified", idx, hc.Name)
+		}
+		if !satisfied {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// hasCondition returns if the resource reports a status condition of the expected type and status.
+//
+// Conditions that report an observed generation older than the generation of the resource are
+// stale and are ignored.
+func hasCondition(obj *unstructured.Unstructured, expected *placementv1beta1.ResourceHealthCheckCondition) bool {
+	conditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return false
+	}
+	for _, c := range conditions {
+		cond, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		if condType, _ := cond["type"].(string); condType != expected.Type {
+			continue
+		}
+		if observedGeneration, found, _ := unstructured.NestedInt64(cond, "observedGeneration"); found && observedGeneration < obj.GetGeneration() {
+			return false
+		}
+		status, _ := cond["status"].(string)
+		return metav1.ConditionStatus(status) == expected.Status
+	}
+	return false
+}
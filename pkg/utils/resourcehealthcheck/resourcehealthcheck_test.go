@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcehealthcheck
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    bool
+	}{
+		{
+			name:       "boolean expression over the object",
+			expression: "object.status.readyReplicas >= object.spec.replicas",
+		},
+		{
+			name:       "constant boolean expression",
+			expression: "true",
+		},
+		{
+			name:       "non-boolean expression",
+			expression: "'ready'",
+			wantErr:    true,
+		},
+		{
+			name:       "unknown variable",
+			expression: "cluster.status.ready",
+			wantErr:    true,
+		},
+		{
+			name:       "syntax error",
+			expression: "object.status.ready ==",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Compile(tt.expression); (err != nil) != tt.wantErr {
+				t.Errorf("Compile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTargets(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+	tests := []struct {
+		name   string
+		target placementv1beta1.ResourceHealthCheckTarget
+		want   bool
+	}{
+		{
+			name:   "any version",
+			target: placementv1beta1.ResourceHealthCheckTarget{Group: "example.com", Kind: "Widget"},
+			want:   true,
+		},
+		{
+			name:   "matching version",
+			target: placementv1beta1.ResourceHealthCheckTarget{Group: "example.com", Version: "v1", Kind: "Widget"},
+			want:   true,
+		},
+		{
+			name:   "different version",
+			target: placementv1beta1.ResourceHealthCheckTarget{Group: "example.com", Version: "v2", Kind: "Widget"},
+			want:   false,
+		},
+		{
+			name:   "different group",
+			target: placementv1beta1.ResourceHealthCheckTarget{Kind: "Widget"},
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hc := &placementv1beta1.ResourceHealthCheck{Spec: placementv1beta1.ResourceHealthCheckSpec{Target: tt.target}}
+			if got := Targets(hc, gvk); got != tt.want {
+				t.Errorf("Targets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
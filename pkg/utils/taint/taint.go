@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package taint features utilities for matching taints on member clusters with tolerations on placements.
+package taint
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// ToleratesTaint returns if a toleration tolerates a taint.
+func ToleratesTaint(toleration *placementv1beta1.Toleration, taint *clusterv1beta1.Taint) bool {
+	if toleration.Effect != "" && toleration.Effect != taint.Effect {
+		return false
+	}
+	switch toleration.Operator {
+	case corev1.TolerationOpExists:
+		return toleration.Key == "" || toleration.Key == taint.Key
+	case corev1.TolerationOpEqual:
+		return toleration.Key == taint.Key && toleration.Value == taint.Value
+	}
+	return false
+}
+
+// TolerationsTolerateTaint returns if any of the tolerations tolerates a taint.
+func TolerationsTolerateTaint(tolerations []placementv1beta1.Toleration, taint *clusterv1beta1.Taint) bool {
+	for idx := range tolerations {
+		if ToleratesTaint(&tolerations[idx], taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// NoExecuteEvictionTime returns the time at which a placement with the given tolerations should be
+// evicted from a cluster with the given taints; it returns false if the placement should not be
+// evicted at all, i.e., the tolerations tolerate all the NoExecute taints forever.
+//
+// Taints that have not been stamped with the time they were added are considered to be added now.
+func NoExecuteEvictionTime(taints []clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration, now time.Time) (time.Time, bool) {
+	var evictAt time.Time
+	shouldEvict := false
+	for idx := range taints {
+		taint := &taints[idx]
+		if taint.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+
+		addedAt := now
+		if taint.TimeAdded != nil {
+			addedAt = taint.TimeAdded.Time
+		}
+		// As with Kubernetes, the shortest toleration period among the matching tolerations wins;
+		// the taint is tolerated forever only if none of the matching tolerations has a period.
+		tolerated, bounded := false, false
+		var minSeconds int64
+		for tidx := range tolerations {
+			toleration := &tolerations[tidx]
+			if !ToleratesTaint(toleration, taint) {
+				continue
+			}
+			tolerated = true
+			if toleration.TolerationSeconds == nil {
+				continue
+			}
+			seconds := max(*toleration.TolerationSeconds, 0)
+			if !bounded || seconds < minSeconds {
+				minSeconds = seconds
+			}
+			bounded = true
+		}
+
+		var taintEvictAt time.Time
+		switch {
+		case !tolerated:
+			taintEvictAt = addedAt
+		case bounded:
+			taintEvictAt = addedAt.Add(time.Duration(minSeconds) * time.Second)
+		default:
+			continue
+		}
+		if !shouldEvict || taintEvictAt.Before(evictAt) {
+			evictAt = taintEvictAt
+		}
+		shouldEvict = true
+	}
+	return evictAt, shouldEvict
+}
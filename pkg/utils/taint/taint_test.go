@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package taint
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestToleratesTaint(t *testing.T) {
+	taint := clusterv1beta1.Taint{Key: "key1", Value: "value1", Effect: corev1.TaintEffectNoExecute}
+	tests := []struct {
+		name       string
+		toleration placementv1beta1.Toleration
+		want       bool
+	}{
+		{
+			name:       "exists operator with empty key matches all taints",
+			toleration: placementv1beta1.Toleration{Operator: corev1.TolerationOpExists},
+			want:       true,
+		},
+		{
+			name:       "exists operator with matching key",
+			toleration: placementv1beta1.Toleration{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+			want:       true,
+		},
+		{
+			name:       "equal operator with matching key and value",
+			toleration: placementv1beta1.Toleration{Key: "key1", Value: "value1", Operator: corev1.TolerationOpEqual},
+			want:       true,
+		},
+		{
+			name:       "equal operator with different value",
+			toleration: placementv1beta1.Toleration{Key: "key1", Value: "value2", Operator: corev1.TolerationOpEqual},
+			want:       false,
+		},
+		{
+			name:       "different effect",
+			toleration: placementv1beta1.Toleration{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToleratesTaint(&tt.toleration, &taint); got != tt.want {
+				t.Errorf("ToleratesTaint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNoExecuteEvictionTime(t *testing.T) {
+	now := time.Now()
+	addedAt := now.Add(-time.Hour)
+	noExecuteTaint := clusterv1beta1.Taint{Key: "key1", Effect: corev1.TaintEffectNoExecute, TimeAdded: &metav1.Time{Time: addedAt}}
+	tolerationFor := func(seconds *int64) placementv1beta1.Toleration {
+		return placementv1beta1.Toleration{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: seconds}
+	}
+
+	tests := []struct {
+		name            string
+		taints          []clusterv1beta1.Taint
+		tolerations     []placementv1beta1.Toleration
+		wantShouldEvict bool
+		wantEvictAt     time.Time
+	}{
+		{
+			name:   "NoSchedule taints only",
+			taints: []clusterv1beta1.Taint{{Key: "key1", Effect: corev1.TaintEffectNoSchedule}},
+		},
+		{
+			name:            "NoExecute taint not tolerated",
+			taints:          []clusterv1beta1.Taint{noExecuteTaint},
+			wantShouldEvict: true,
+			wantEvictAt:     addedAt,
+		},
+		{
+			name:            "NoExecute taint not stamped yet",
+			taints:          []clusterv1beta1.Taint{{Key: "key1", Effect: corev1.TaintEffectNoExecute}},
+			wantShouldEvict: true,
+			wantEvictAt:     now,
+		},
+		{
+			name:        "NoExecute taint tolerated forever",
+			taints:      []clusterv1beta1.Taint{noExecuteTaint},
+			tolerations: []placementv1beta1.Toleration{tolerationFor(nil)},
+		},
+		{
+			name:            "shortest toleration period wins",
+			taints:          []clusterv1beta1.Taint{noExecuteTaint},
+			tolerations:     []placementv1beta1.Toleration{tolerationFor(nil), tolerationFor(ptr.To(int64(600))), tolerationFor(ptr.To(int64(60)))},
+			wantShouldEvict: true,
+			wantEvictAt:     addedAt.Add(time.Minute),
+		},
+		{
+			name:            "negative toleration period",
+			taints:          []clusterv1beta1.Taint{noExecuteTaint},
+			tolerations:     []placementv1beta1.Toleration{tolerationFor(ptr.To(int64(-1)))},
+			wantShouldEvict: true,
+			wantEvictAt:     addedAt,
+		},
+		{
+			name: "earliest eviction time across taints",
+			taints: []clusterv1beta1.Taint{
+				noExecuteTaint,
+				{Key: "key2", Effect: corev1.TaintEffectNoExecute, TimeAdded: &metav1.Time{Time: now}},
+			},
+			tolerations: []placementv1beta1.Toleration{
+				tolerationFor(ptr.To(int64(7200))),
+				{Key: "key2", Operator: corev1.TolerationOpExists, TolerationSeconds: ptr.To(int64(60))},
+			},
+			wantShouldEvict: true,
+			wantEvictAt:     now.Add(time.Minute),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotEvictAt, gotShouldEvict := NoExecuteEvictionTime(tt.taints, tt.tolerations, now)
+			if gotShouldEvict != tt.wantShouldEvict {
+				t.Fatalf("NoExecuteEvictionTime() shouldEvict = %v, want %v", gotShouldEvict, tt.wantShouldEvict)
+			}
+			if !gotEvictAt.Equal(tt.wantEvictAt) {
+				t.Errorf("NoExecuteEvictionTime() evictAt = %v, want %v", gotEvictAt, tt.wantEvictAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package validator provides utils to validate FleetResourceQuota resources.
+package validator
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// ValidateFleetResourceQuota validates fleet resource quota fields and returns error.
+//
+// The numeric limits are validated by the CRD schema; only the allowed cluster selector is
+// checked here.
+func ValidateFleetResourceQuota(quota *placementv1beta1.FleetResourceQuota) error {
+	if quota.Spec.AllowedClusterSelector == nil {
+		return nil
+	}
+	if _, err := metav1.LabelSelectorAsSelector(quota.Spec.AllowedClusterSelector); err != nil {
+		return fmt.Errorf("the allowed cluster selector %+v is invalid: %w", quota.Spec.AllowedClusterSelector, err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestValidateFleetResourceQuota(t *testing.T) {
+	tests := map[string]struct {
+		selector   *metav1.LabelSelector
+		wantErrMsg string
+	}{
+		"no allowed cluster selector": {},
+		"valid allowed cluster selector": {
+			selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"env": "prod"},
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "region", Operator: metav1.LabelSelectorOpIn, Values: []string{"east", "west"}},
+				},
+			},
+		},
+		"invalid label value": {
+			selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"env": "not a valid value"}},
+			wantErrMsg: "the allowed cluster selector",
+		},
+		"invalid operator": {
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "region", Operator: "Near", Values: []string{"east"}},
+				},
+			},
+			wantErrMsg: "is not a valid label selector operator",
+		},
+		"values with the exists operator": {
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "region", Operator: metav1.LabelSelectorOpExists, Values: []string{"east"}},
+				},
+			},
+			wantErrMsg: "the allowed cluster selector",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			quota := &placementv1beta1.FleetResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "app"},
+				Spec:       placementv1beta1.FleetResourceQuotaSpec{AllowedClusterSelector: tt.selector},
+			}
+			err := ValidateFleetResourceQuota(quota)
+			if tt.wantErrMsg == "" {
+				if err != nil {
+					t.Errorf("ValidateFleetResourceQuota() = %v, want no error", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("ValidateFleetResourceQuota() = %v, want error containing %q", err, tt.wantErrMsg)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/maintenancewindow"
 )
 
 var (
@@ -17,7 +18,17 @@ var (
 
 // ValidateMemberCluster validates member cluster fields and returns error.
 func ValidateMemberCluster(mc clusterv1beta1.MemberCluster) error {
-	return validateTaints(mc.Spec.Taints)
+	return apiErrors.NewAggregate([]error{validateTaints(mc.Spec.Taints), validateMaintenanceWindows(mc.Spec.MaintenanceWindows)})
+}
+
+func validateMaintenanceWindows(windows []clusterv1beta1.MaintenanceWindow) error {
+	allErr := make([]error, 0)
+	for idx := range windows {
+		if err := maintenancewindow.Validate(&windows[idx]); err != nil {
+			allErr = append(allErr, err)
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
 }
 
 func validateTaints(taints []clusterv1beta1.Taint) error {
@@ -32,10 +43,12 @@ func validateTaints(taints []clusterv1beta1.Taint) error {
 				allErr = append(allErr, fmt.Errorf(invalidTaintValueErrFmt, taint, msg))
 			}
 		}
-		if taintMap[taint] {
-			allErr = append(allErr, fmt.Errorf(uniqueTaintErrFmt, taint))
+		// Taints are unique by key, value and effect; the time a taint is added is left out.
+		taintKey := clusterv1beta1.Taint{Key: taint.Key, Value: taint.Value, Effect: taint.Effect}
+		if taintMap[taintKey] {
+			allErr = append(allErr, fmt.Errorf(uniqueTaintErrFmt, taintKey))
 		}
-		taintMap[taint] = true
+		taintMap[taintKey] = true
 	}
 	return apiErrors.NewAggregate(allErr)
 }
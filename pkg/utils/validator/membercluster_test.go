@@ -3,6 +3,9 @@ package validator
 import (
 	"strings"
 	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 )
@@ -66,6 +69,21 @@ func TestValidateTaints(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "taints must be unique",
 		},
+		"invalid taint, non-unique NoExecute taint added at different times": {
+			taints: []clusterv1beta1.Taint{
+				{
+					Key:       "key1",
+					Effect:    "NoExecute",
+					TimeAdded: &metav1.Time{Time: time.Now()},
+				},
+				{
+					Key:    "key1",
+					Effect: "NoExecute",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "taints must be unique",
+		},
 		"valid taints": {
 			taints: []clusterv1beta1.Taint{
 				{
@@ -82,6 +100,11 @@ func TestValidateTaints(t *testing.T) {
 					Key:    "key3",
 					Effect: "NoSchedule",
 				},
+				{
+					Key:       "key3",
+					Effect:    "NoExecute",
+					TimeAdded: &metav1.Time{Time: time.Now()},
+				},
 			},
 			wantErr: false,
 		},
@@ -98,3 +121,43 @@ func TestValidateTaints(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMaintenanceWindows(t *testing.T) {
+	tests := map[string]struct {
+		windows    []clusterv1beta1.MaintenanceWindow
+		wantErr    bool
+		wantErrMsg string
+	}{
+		"valid windows": {
+			windows: []clusterv1beta1.MaintenanceWindow{
+				{Name: "weekly", Schedule: "0 2 * * SAT", Duration: &metav1.Duration{Duration: time.Hour}, TimeZone: "UTC"},
+			},
+			wantErr: false,
+		},
+		"invalid schedule": {
+			windows: []clusterv1beta1.MaintenanceWindow{
+				{Name: "weekly", Schedule: "0 2 * *", Duration: &metav1.Duration{Duration: time.Hour}},
+			},
+			wantErr:    true,
+			wantErrMsg: "invalid schedule",
+		},
+		"invalid time zone": {
+			windows: []clusterv1beta1.MaintenanceWindow{
+				{Name: "weekly", Schedule: "0 2 * * SAT", Duration: &metav1.Duration{Duration: time.Hour}, TimeZone: "Nowhere/Land"},
+			},
+			wantErr:    true,
+			wantErrMsg: "invalid time zone",
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			gotErr := validateMaintenanceWindows(testCase.windows)
+			if (gotErr != nil) != testCase.wantErr {
+				t.Errorf("validateMaintenanceWindows() error = %v, wantErr %v", gotErr, testCase.wantErr)
+			}
+			if testCase.wantErr && !strings.Contains(gotErr.Error(), testCase.wantErrMsg) {
+				t.Errorf("validateMaintenanceWindows() got %v, should contain want %s", gotErr, testCase.wantErrMsg)
+			}
+		})
+	}
+}
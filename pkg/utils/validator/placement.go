@@ -22,11 +22,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"path"
+	"slices"
 	"sort"
 	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,6 +38,7 @@ import (
 	apiErrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -43,6 +47,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/propertyexpression"
 )
 
 var ResourceInformer informer.Manager
@@ -179,6 +184,9 @@ func validatePlacementPolicy(policy *placementv1beta1.PlacementPolicy) error {
 			allErr = append(allErr, err)
 		}
 	}
+	if policy.ReplicaScheduling != nil {
+		allErr = append(allErr, validateReplicaScheduling(policy.ReplicaScheduling))
+	}
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -215,6 +223,9 @@ func validatePolicyForPickFixedPlacementType(policy *placementv1beta1.PlacementP
 	if policy.Tolerations != nil {
 		allErr = append(allErr, fmt.Errorf("tolerations needs to be empty for policy type %s, only valid for PickAll/PickN", placementv1beta1.PickFixedPlacementType))
 	}
+	if len(policy.ResourceRequests) > 0 {
+		allErr = append(allErr, fmt.Errorf("resource requests needs to be empty for policy type %s, only valid for PickAll/PickN", placementv1beta1.PickFixedPlacementType))
+	}
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -231,10 +242,14 @@ func validatePolicyForPickAllPlacementType(policy *placementv1beta1.PlacementPol
 	if policy.Affinity != nil && policy.Affinity.ClusterAffinity != nil {
 		allErr = append(allErr, validateClusterAffinity(policy.Affinity.ClusterAffinity, policy.PlacementType))
 	}
+	if policy.Affinity != nil {
+		allErr = append(allErr, validatePlacementAffinity(policy.Affinity, policy.PlacementType))
+	}
 	if len(policy.TopologySpreadConstraints) > 0 {
 		allErr = append(allErr, fmt.Errorf("topology spread constraints needs to be empty for policy type %s, only valid for PickN policy type", placementv1beta1.PickAllPlacementType))
 	}
 	allErr = append(allErr, validateTolerations(policy.Tolerations))
+	allErr = append(allErr, validateResourceRequests(policy.ResourceRequests))
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -255,10 +270,14 @@ func validatePolicyForPickNPolicyType(policy *placementv1beta1.PlacementPolicy)
 	if policy.Affinity != nil && policy.Affinity.ClusterAffinity != nil {
 		allErr = append(allErr, validateClusterAffinity(policy.Affinity.ClusterAffinity, policy.PlacementType))
 	}
+	if policy.Affinity != nil {
+		allErr = append(allErr, validatePlacementAffinity(policy.Affinity, policy.PlacementType))
+	}
 	if len(policy.TopologySpreadConstraints) > 0 {
 		allErr = append(allErr, validateTopologySpreadConstraints(policy.TopologySpreadConstraints))
 	}
 	allErr = append(allErr, validateTolerations(policy.Tolerations))
+	allErr = append(allErr, validateResourceRequests(policy.ResourceRequests))
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -285,10 +304,84 @@ func validateClusterAffinity(clusterAffinity *placementv1beta1.ClusterAffinity,
 	return apiErrors.NewAggregate(allErr)
 }
 
+func validatePlacementAffinity(affinity *placementv1beta1.Affinity, placementType placementv1beta1.PlacementType) error {
+	allErr := make([]error, 0)
+	var required []placementv1beta1.PlacementAffinityTerm
+	var preferred []placementv1beta1.WeightedPlacementAffinityTerm
+	if affinity.PlacementAffinity != nil {
+		required = append(required, affinity.PlacementAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		preferred = append(preferred, affinity.PlacementAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+	if affinity.PlacementAntiAffinity != nil {
+		required = append(required, affinity.PlacementAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution...)
+		preferred = append(preferred, affinity.PlacementAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution...)
+	}
+
+	for _, term := range required {
+		allErr = append(allErr, validatePlacementAffinityTerm(term))
+	}
+	if placementType == placementv1beta1.PickAllPlacementType && len(preferred) > 0 {
+		allErr = append(allErr, fmt.Errorf("preferred placement (anti-)affinity terms will be ignored for placement policy type %s", placementType))
+	}
+	for _, term := range preferred {
+		allErr = append(allErr, validatePlacementAffinityTerm(term.PlacementAffinityTerm))
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
+func validatePlacementAffinityTerm(term placementv1beta1.PlacementAffinityTerm) error {
+	if term.LabelSelector == nil {
+		return fmt.Errorf("the labelSelector in placement affinity term cannot be nil")
+	}
+	return validateLabelSelector(term.LabelSelector, "placement affinity term")
+}
+
+func validateResourceRequests(requests corev1.ResourceList) error {
+	allErr := make([]error, 0)
+	for name, quantity := range requests {
+		if quantity.Sign() < 0 {
+			allErr = append(allErr, fmt.Errorf("resource request for %s cannot be negative: %s", name, quantity.String()))
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
+func validateReplicaScheduling(rs *placementv1beta1.ReplicaScheduling) error {
+	allErr := make([]error, 0)
+	if rs.Type != placementv1beta1.ReplicaSchedulingTypeDivided {
+		if rs.Replicas != nil || rs.TargetWorkload != nil || len(rs.StaticWeights) > 0 || rs.CapacityResourceName != "" {
+			allErr = append(allErr, fmt.Errorf("replicas, target workload, static weights, and capacity resource name can only be specified when the replica scheduling type is %s", placementv1beta1.ReplicaSchedulingTypeDivided))
+		}
+		return apiErrors.NewAggregate(allErr)
+	}
+	if rs.Replicas == nil {
+		allErr = append(allErr, fmt.Errorf("replicas must be specified when the replica scheduling type is %s", placementv1beta1.ReplicaSchedulingTypeDivided))
+	}
+	switch target := rs.TargetWorkload; {
+	case target == nil:
+		allErr = append(allErr, fmt.Errorf("target workload must be specified when the replica scheduling type is %s", placementv1beta1.ReplicaSchedulingTypeDivided))
+	case target.Version == "" || target.Kind == "" || target.Name == "":
+		allErr = append(allErr, fmt.Errorf("the version, kind, and name of the target workload must be specified"))
+	}
+	if rs.DivisionPreference == placementv1beta1.ReplicaDivisionPreferenceAvailableCapacity && len(rs.StaticWeights) > 0 {
+		allErr = append(allErr, fmt.Errorf("static weights cannot be specified when the division preference is %s", placementv1beta1.ReplicaDivisionPreferenceAvailableCapacity))
+	}
+	if rs.DivisionPreference != placementv1beta1.ReplicaDivisionPreferenceAvailableCapacity && rs.CapacityResourceName != "" {
+		allErr = append(allErr, fmt.Errorf("capacity resource name can only be specified when the division preference is %s", placementv1beta1.ReplicaDivisionPreferenceAvailableCapacity))
+	}
+	uniqueClusterNames := make(map[string]bool)
+	for _, sw := range rs.StaticWeights {
+		if uniqueClusterNames[sw.ClusterName] {
+			allErr = append(allErr, fmt.Errorf("static weight for cluster %s is specified more than once", sw.ClusterName))
+		}
+		uniqueClusterNames[sw.ClusterName] = true
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
 func validateTolerations(tolerations []placementv1beta1.Toleration) error {
 	allErr := make([]error, 0)
-	tolerationMap := make(map[placementv1beta1.Toleration]bool)
-	for _, toleration := range tolerations {
+	for idx, toleration := range tolerations {
 		if toleration.Key != "" {
 			for _, msg := range validation.IsQualifiedName(toleration.Key) {
 				allErr = append(allErr, fmt.Errorf(invalidTolerationKeyErrFmt, toleration, msg))
@@ -307,21 +400,20 @@ func validateTolerations(tolerations []placementv1beta1.Toleration) error {
 				allErr = append(allErr, fmt.Errorf(invalidTolerationValueErrFmt, toleration, msg))
 			}
 		}
-		if tolerationMap[toleration] {
+		if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+			allErr = append(allErr, fmt.Errorf(invalidTolerationErrFmt, toleration, "toleration seconds can only be specified, when effect is NoExecute"))
+		}
+		// Tolerations are compared semantically, as the toleration seconds field is a pointer.
+		if slices.ContainsFunc(tolerations[:idx], func(t placementv1beta1.Toleration) bool { return equality.Semantic.DeepEqual(t, toleration) }) {
 			allErr = append(allErr, fmt.Errorf(uniqueTolerationErrFmt, toleration))
 		}
-		tolerationMap[toleration] = true
 	}
 	return apiErrors.NewAggregate(allErr)
 }
 
 func IsTolerationsUpdatedOrDeleted(oldTolerations []placementv1beta1.Toleration, newTolerations []placementv1beta1.Toleration) bool {
-	newTolerationsMap := make(map[placementv1beta1.Toleration]bool)
-	for _, newToleration := range newTolerations {
-		newTolerationsMap[newToleration] = true
-	}
 	for _, oldToleration := range oldTolerations {
-		if !newTolerationsMap[oldToleration] {
+		if !slices.ContainsFunc(newTolerations, func(t placementv1beta1.Toleration) bool { return equality.Semantic.DeepEqual(t, oldToleration) }) {
 			return true
 		}
 	}
@@ -334,6 +426,17 @@ func validateTopologySpreadConstraints(topologyConstraints []placementv1beta1.To
 		if len(tc.WhenUnsatisfiable) > 0 && tc.WhenUnsatisfiable != placementv1beta1.DoNotSchedule && tc.WhenUnsatisfiable != placementv1beta1.ScheduleAnyway {
 			allErr = append(allErr, fmt.Errorf("unknown unsatisfiable type %s", tc.WhenUnsatisfiable))
 		}
+		if tc.MinDomains != nil && tc.WhenUnsatisfiable == placementv1beta1.ScheduleAnyway {
+			allErr = append(allErr, fmt.Errorf("minDomains can only be set when whenUnsatisfiable is %s", placementv1beta1.DoNotSchedule))
+		}
+		// The topology key, the nested topology keys, and the match label keys must all be distinct.
+		seenKeys := map[string]bool{tc.TopologyKey: true}
+		for _, key := range append(append([]string{}, tc.NestedTopologyKeys...), tc.MatchLabelKeys...) {
+			if seenKeys[key] {
+				allErr = append(allErr, fmt.Errorf("label key %s is used more than once in topology spread constraint with topology key %s", key, tc.TopologyKey))
+			}
+			seenKeys[key] = true
+		}
 	}
 	return apiErrors.NewAggregate(allErr)
 }
@@ -353,6 +456,10 @@ func validateClusterSelector(clusterSelector *placementv1beta1.ClusterSelector)
 		if clusterSelectorTerm.PropertySelector != nil {
 			allErr = append(allErr, validatePropertySelector(clusterSelectorTerm.PropertySelector))
 		}
+
+		if len(clusterSelectorTerm.PropertyExpression) > 0 {
+			allErr = append(allErr, validatePropertyExpression(clusterSelectorTerm.PropertyExpression))
+		}
 	}
 	return apiErrors.NewAggregate(allErr)
 }
@@ -363,14 +470,17 @@ func validatePreferredClusterSelectors(preferredClusterSelectors []placementv1be
 		// API server validation on object occurs before webhook is triggered hence not validating weight.
 		allErr = append(allErr, validateLabelSelector(preferredClusterSelector.Preference.LabelSelector, "preferred cluster selector"))
 
-		// Affinity is PreferredDuringSchedulingIgnoredDuringExecution, so check that PropertySelector is nil.
 		if preferredClusterSelector.Preference.PropertySelector != nil {
-			allErr = append(allErr, fmt.Errorf("PropertySelector is not allowed for PreferredDuringSchedulingIgnoredDuringExecution affinity"))
+			allErr = append(allErr, validatePropertySelector(preferredClusterSelector.Preference.PropertySelector))
 		}
 
 		if preferredClusterSelector.Preference.PropertySorter != nil {
 			allErr = append(allErr, validatePropertySorter(preferredClusterSelector.Preference.PropertySorter))
 		}
+
+		if len(preferredClusterSelector.Preference.PropertyExpression) > 0 {
+			allErr = append(allErr, validatePropertyExpression(preferredClusterSelector.Preference.PropertyExpression))
+		}
 	}
 	return apiErrors.NewAggregate(allErr)
 }
@@ -422,6 +532,16 @@ func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy) e
 		if rolloutStrategy.ApplyStrategy.Type != placementv1beta1.ApplyStrategyTypeServerSideApply && rolloutStrategy.ApplyStrategy.ServerSideApplyConfig != nil {
 			allErr = append(allErr, errors.New("serverSideApplyConfig is only valid for ServerSideApply strategy type"))
 		}
+		for idx, rule := range rolloutStrategy.ApplyStrategy.IgnoreDifferences {
+			if _, err := path.Match(rule.Name, ""); err != nil {
+				allErr = append(allErr, fmt.Errorf("ignoreDifferences[%d] has an invalid name pattern `%s`: %w", idx, rule.Name, err))
+			}
+			for _, ptr := range rule.JSONPointers {
+				if !strings.HasPrefix(ptr, "/") {
+					allErr = append(allErr, fmt.Errorf("ignoreDifferences[%d] has an invalid JSON pointer `%s`: must start with `/`", idx, ptr))
+				}
+			}
+		}
 	}
 
 	return apiErrors.NewAggregate(allErr)
@@ -441,7 +561,7 @@ func validatePropertySelectorRequirements(propertySelectorRequirements []placeme
 		if err := validateOperator(req.Operator, req.Values); err != nil {
 			allErr = append(allErr, err)
 		}
-		if err := validateValues(req.Values); err != nil {
+		if err := validateValues(req.Operator, req.Values); err != nil {
 			allErr = append(allErr, fmt.Errorf("invalid values for property %s: %w", req.Name, err))
 		}
 		// TODO: Check for logical contradictions
@@ -449,6 +569,14 @@ func validatePropertySelectorRequirements(propertySelectorRequirements []placeme
 	return apiErrors.NewAggregate(allErr)
 }
 
+// validatePropertyExpression type-checks the CEL property expression.
+func validatePropertyExpression(expression string) error {
+	if _, err := propertyexpression.Compile(expression); err != nil {
+		return fmt.Errorf("invalid property expression: %w", err)
+	}
+	return nil
+}
+
 func validatePropertySorter(propertySorter *placementv1beta1.PropertySorter) error {
 	var allErr []error
 	if err := validateName(propertySorter.Name); err != nil {
@@ -515,25 +643,47 @@ func validateName(name string) error {
 }
 
 func validateOperator(op placementv1beta1.PropertySelectorOperator, values []string) error {
-	// TODO: Restructure for Eq (bundle operator and value validation logic)
-	validOperators := map[placementv1beta1.PropertySelectorOperator]bool{
-		placementv1beta1.PropertySelectorGreaterThan:          true,
-		placementv1beta1.PropertySelectorGreaterThanOrEqualTo: true,
-		placementv1beta1.PropertySelectorLessThan:             true,
-		placementv1beta1.PropertySelectorLessThanOrEqualTo:    true,
-		placementv1beta1.PropertySelectorEqualTo:              true,
-		placementv1beta1.PropertySelectorNotEqualTo:           true,
-	}
-	if validOperators[op] && len(values) != 1 {
-		return fmt.Errorf("operator %s requires exactly one value, got %d", op, len(values))
+	switch op {
+	case placementv1beta1.PropertySelectorGreaterThan,
+		placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+		placementv1beta1.PropertySelectorLessThan,
+		placementv1beta1.PropertySelectorLessThanOrEqualTo,
+		placementv1beta1.PropertySelectorEqualTo,
+		placementv1beta1.PropertySelectorNotEqualTo,
+		placementv1beta1.PropertySelectorVersionGreaterThan,
+		placementv1beta1.PropertySelectorVersionLessThan:
+		if len(values) != 1 {
+			return fmt.Errorf("operator %s requires exactly one value, got %d", op, len(values))
+		}
+	case placementv1beta1.PropertySelectorIn, placementv1beta1.PropertySelectorNotIn:
+		if len(values) == 0 {
+			return fmt.Errorf("operator %s requires at least one value", op)
+		}
+	case placementv1beta1.PropertySelectorExists, placementv1beta1.PropertySelectorDoesNotExist:
+		if len(values) != 0 {
+			return fmt.Errorf("operator %s requires no values, got %d", op, len(values))
+		}
+	default:
+		return fmt.Errorf("invalid property selector operator %s", op)
 	}
 	return nil
 }
 
-func validateValues(values []string) error {
-	for _, value := range values {
-		if _, err := resource.ParseQuantity(value); err != nil {
-			return fmt.Errorf("value %s is not a valid resource.Quantity: %w", value, err)
+func validateValues(op placementv1beta1.PropertySelectorOperator, values []string) error {
+	switch op {
+	case placementv1beta1.PropertySelectorIn, placementv1beta1.PropertySelectorNotIn:
+		// The values are compared as strings; any value is valid.
+	case placementv1beta1.PropertySelectorVersionGreaterThan, placementv1beta1.PropertySelectorVersionLessThan:
+		for _, value := range values {
+			if _, err := version.ParseGeneric(value); err != nil {
+				return fmt.Errorf("value %s is not a valid version: %w", value, err)
+			}
+		}
+	default:
+		for _, value := range values {
+			if _, err := resource.ParseQuantity(value); err != nil {
+				return fmt.Errorf("value %s is not a valid resource.Quantity: %w", value, err)
+			}
 		}
 	}
 	return nil
@@ -21,9 +21,11 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
@@ -327,6 +329,58 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "serverSideApplyConfig is only valid for ServerSideApply strategy type",
 		},
+		"valid rollout strategy - ignoreDifferences": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					Type: placementv1beta1.ApplyStrategyTypeServerSideApply,
+					IgnoreDifferences: []placementv1beta1.IgnoreDifference{
+						{
+							Group:        "apps",
+							Kind:         "Deployment",
+							Name:         "web-*",
+							JSONPointers: []string{"/spec/replicas"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - ignoreDifferences with invalid name pattern": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					Type: placementv1beta1.ApplyStrategyTypeServerSideApply,
+					IgnoreDifferences: []placementv1beta1.IgnoreDifference{
+						{
+							Group:        "apps",
+							Kind:         "Deployment",
+							Name:         "web-[",
+							JSONPointers: []string{"/spec/replicas"},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "ignoreDifferences[0] has an invalid name pattern",
+		},
+		"invalid rollout strategy - ignoreDifferences with invalid JSON pointer": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					Type: placementv1beta1.ApplyStrategyTypeServerSideApply,
+					IgnoreDifferences: []placementv1beta1.IgnoreDifference{
+						{
+							Group:        "apps",
+							Kind:         "Deployment",
+							JSONPointers: []string{"spec.replicas"},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "ignoreDifferences[0] has an invalid JSON pointer `spec.replicas`",
+		},
 	}
 
 	for testName, testCase := range tests {
@@ -459,6 +513,17 @@ func TestValidateClusterResourcePlacement_PickFixedPlacementPolicy(t *testing.T)
 			wantErr:    true,
 			wantErrMsg: "tolerations needs to be empty for policy type PickFixed, only valid for PickAll/PickN",
 		},
+		"invalid placement policy - PickFixed with non-empty resource requests": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  []string{"test-cluster"},
+				ResourceRequests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("1"),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "resource requests needs to be empty for policy type PickFixed, only valid for PickAll/PickN",
+		},
 	}
 
 	for testName, testCase := range tests {
@@ -542,6 +607,90 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "PreferredDuringSchedulingIgnoredDuringExecution will be ignored for placement policy type PickAll",
 		},
+		"invalid placement policy - PickAll with invalid label selector in required placement affinity term": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAffinity: &placementv1beta1.PlacementAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+							{
+								LabelSelector: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{
+											Key:      "test-key",
+											Operator: metav1.LabelSelectorOpIn,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "for 'in', 'notin' operators, values set can't be empty",
+		},
+		"invalid placement policy - PickAll with nil label selector in required placement anti-affinity term": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+							{},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "the labelSelector in placement affinity term cannot be nil",
+		},
+		"invalid placement policy - PickAll with preferred placement anti-affinity terms": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.WeightedPlacementAffinityTerm{
+							{
+								Weight: 10,
+								PlacementAffinityTerm: placementv1beta1.PlacementAffinityTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "backend"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "preferred placement (anti-)affinity terms will be ignored for placement policy type PickAll",
+		},
+		"valid placement policy - PickAll with required placement affinity and anti-affinity terms": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAffinity: &placementv1beta1.PlacementAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+							{
+								LabelSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": "database"},
+								},
+							},
+						},
+					},
+					PlacementAntiAffinity: &placementv1beta1.PlacementAntiAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PlacementAffinityTerm{
+							{
+								LabelSelector: &metav1.LabelSelector{
+									MatchLabels: map[string]string{"app": "batch"},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		"invalid placement policy - PickAll with non empty topology constraints": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType: placementv1beta1.PickAllPlacementType,
@@ -927,7 +1076,7 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "for 'in', 'notin' operators, values set can't be empty",
 		},
-		"invalid placement policy - PickN with non-nil property selector in PreferredDuringSchedulingIgnoredDuringExecution affinity": {
+		"valid placement policy - PickN with property selector in PreferredDuringSchedulingIgnoredDuringExecution affinity": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType:    placementv1beta1.PickNPlacementType,
 				NumberOfClusters: &positiveNumberOfClusters,
@@ -954,8 +1103,244 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 					},
 				},
 			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickN with invalid property selector in PreferredDuringSchedulingIgnoredDuringExecution affinity": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+							{
+								Preference: placementv1beta1.ClusterSelectorTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "version",
+												Operator: placementv1beta1.PropertySelectorExists,
+												Values:   []string{"1"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "operator Exists requires no values, got 1",
+		},
+		"valid placement policy - PickN with In operator in property selector": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "version",
+												Operator: placementv1beta1.PropertySelectorIn,
+												Values:   []string{"1.30", "1.31-preview"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"valid placement policy - PickN with DoesNotExist operator in property selector": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "version",
+												Operator: placementv1beta1.PropertySelectorDoesNotExist,
+												Values:   nil,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"valid placement policy - PickN with VersionGt operator in property selector": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "version",
+												Operator: placementv1beta1.PropertySelectorVersionGreaterThan,
+												Values:   []string{"v1.30.2"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickN with NotIn operator and no values in property selector": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "version",
+												Operator: placementv1beta1.PropertySelectorNotIn,
+												Values:   nil,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "operator NotIn requires at least one value",
+		},
+		"invalid placement policy - PickN with VersionLt operator and multiple values in property selector": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "version",
+												Operator: placementv1beta1.PropertySelectorVersionLessThan,
+												Values:   []string{"1.30", "1.31"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "operator VersionLt requires exactly one value, got 2",
+		},
+		"invalid placement policy - PickN with VersionLt operator and invalid version in property selector": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "version",
+												Operator: placementv1beta1.PropertySelectorVersionLessThan,
+												Values:   []string{"latest"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "value latest is not a valid version",
+		},
+		"invalid placement policy - PickN with unknown operator in property selector": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "version",
+												Operator: placementv1beta1.PropertySelectorOperator("Like"),
+												Values:   []string{"1"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			wantErr:    true,
-			wantErrMsg: "PropertySelector is not allowed for PreferredDuringSchedulingIgnoredDuringExecution affinity",
+			wantErrMsg: "invalid property selector operator Like",
 		},
 		"invalid placement policy - PickN with invalid property sorter in PreferredDuringSchedulingIgnoredDuringExecution affinity": {
 			policy: &placementv1beta1.PlacementPolicy{
@@ -982,6 +1367,71 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "invalid property sort order random-order",
 		},
+		"valid placement policy - PickN with property expressions": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertyExpression: `resourceUsage.available.cpu > 0.2 * resourceUsage.capacity.cpu && properties["k8s-version"] >= 1.30`,
+								},
+							},
+						},
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+							{
+								Weight: 10,
+								Preference: placementv1beta1.ClusterSelectorTerm{
+									PropertyExpression: `labels["region"] == "east"`,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickN with invalid property expression in RequiredDuringSchedulingIgnoredDuringExecution affinity": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertyExpression: `resourceUsage.available.cpu >`,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "invalid property expression",
+		},
+		"invalid placement policy - PickN with non-boolean property expression in PreferredDuringSchedulingIgnoredDuringExecution affinity": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+							{
+								Weight: 10,
+								Preference: placementv1beta1.ClusterSelectorTerm{
+									PropertyExpression: `resourceUsage.available.cpu * 2.0`,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "must evaluate to a boolean value",
+		},
 		"invalid placement policy - PickN with invalid topology constraint with unknown unsatisfiable type": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType:    placementv1beta1.PickNPlacementType,
@@ -996,6 +1446,162 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "unknown unsatisfiable type random-type",
 		},
+		"invalid placement policy - PickN with topology constraint with duplicate nested topology key": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				TopologySpreadConstraints: []placementv1beta1.TopologySpreadConstraint{
+					{
+						TopologyKey:        "test-key",
+						NestedTopologyKeys: []string{"test-key"},
+						WhenUnsatisfiable:  placementv1beta1.DoNotSchedule,
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "label key test-key is used more than once",
+		},
+		"invalid placement policy - PickN with topology constraint with min domains and scheduleAnyway": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				TopologySpreadConstraints: []placementv1beta1.TopologySpreadConstraint{
+					{
+						TopologyKey:       "test-key",
+						MinDomains:        ptr.To(int32(2)),
+						WhenUnsatisfiable: placementv1beta1.ScheduleAnyway,
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "minDomains can only be set when whenUnsatisfiable is DoNotSchedule",
+		},
+		"invalid placement policy - divided replica scheduling without replicas": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaScheduling{
+					Type: placementv1beta1.ReplicaSchedulingTypeDivided,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "replicas must be specified when the replica scheduling type is Divided",
+		},
+		"invalid placement policy - divided replica scheduling without target workload": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaScheduling{
+					Type:     placementv1beta1.ReplicaSchedulingTypeDivided,
+					Replicas: ptr.To(int32(3)),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "target workload must be specified when the replica scheduling type is Divided",
+		},
+		"invalid placement policy - divided replica scheduling with incomplete target workload": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaScheduling{
+					Type:     placementv1beta1.ReplicaSchedulingTypeDivided,
+					Replicas: ptr.To(int32(3)),
+					TargetWorkload: &placementv1beta1.WorkloadReference{
+						Group: "apps",
+						Kind:  "Deployment",
+						Name:  "test-deployment",
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "the version, kind, and name of the target workload must be specified",
+		},
+		"invalid placement policy - duplicated replica scheduling with target workload": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaScheduling{
+					Type: placementv1beta1.ReplicaSchedulingTypeDuplicated,
+					TargetWorkload: &placementv1beta1.WorkloadReference{
+						Group:   "apps",
+						Version: "v1",
+						Kind:    "Deployment",
+						Name:    "test-deployment",
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "can only be specified when the replica scheduling type is Divided",
+		},
+		"invalid placement policy - duplicated replica scheduling with static weights": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				ReplicaScheduling: &placementv1beta1.ReplicaScheduling{
+					Type: placementv1beta1.ReplicaSchedulingTypeDuplicated,
+					StaticWeights: []placementv1beta1.StaticClusterWeight{
+						{ClusterName: "test-cluster", Weight: 1},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "can only be specified when the replica scheduling type is Divided",
+		},
+		"invalid placement policy - divided replica scheduling by available capacity with static weights": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				ReplicaScheduling: &placementv1beta1.ReplicaScheduling{
+					Type:     placementv1beta1.ReplicaSchedulingTypeDivided,
+					Replicas: ptr.To(int32(3)),
+					TargetWorkload: &placementv1beta1.WorkloadReference{
+						Group:   "apps",
+						Version: "v1",
+						Kind:    "Deployment",
+						Name:    "test-deployment",
+					},
+					DivisionPreference: placementv1beta1.ReplicaDivisionPreferenceAvailableCapacity,
+					StaticWeights: []placementv1beta1.StaticClusterWeight{
+						{ClusterName: "test-cluster", Weight: 1},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "static weights cannot be specified when the division preference is AvailableCapacity",
+		},
+		"valid placement policy - divided replica scheduling with static weights": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  []string{"test-cluster-1", "test-cluster-2"},
+				ReplicaScheduling: &placementv1beta1.ReplicaScheduling{
+					Type:     placementv1beta1.ReplicaSchedulingTypeDivided,
+					Replicas: ptr.To(int32(3)),
+					TargetWorkload: &placementv1beta1.WorkloadReference{
+						Group:   "apps",
+						Version: "v1",
+						Kind:    "Deployment",
+						Name:    "test-deployment",
+					},
+					DivisionPreference: placementv1beta1.ReplicaDivisionPreferenceWeighted,
+					StaticWeights: []placementv1beta1.StaticClusterWeight{
+						{ClusterName: "test-cluster-1", Weight: 2},
+						{ClusterName: "test-cluster-2", Weight: 1},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"valid placement policy - PickN with topology constraint with min domains, nested topology keys and match label keys": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				TopologySpreadConstraints: []placementv1beta1.TopologySpreadConstraint{
+					{
+						TopologyKey:        "test-region",
+						MinDomains:         ptr.To(int32(2)),
+						NestedTopologyKeys: []string{"test-zone"},
+						MatchLabelKeys:     []string{"test-env"},
+						WhenUnsatisfiable:  placementv1beta1.DoNotSchedule,
+					},
+				},
+			},
+			wantErr: false,
+		},
 		"valid placement policy - PickN with non nil affinity, non empty topology constraints": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType:    placementv1beta1.PickNPlacementType,
@@ -1265,6 +1871,29 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "property name segment $ is not valid",
 		},
+		"valid placement policy - PickN with resource requests": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				ResourceRequests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+					"nvidia.com/gpu":      resource.MustParse("1"),
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickN with negative resource requests": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				ResourceRequests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("-1"),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "resource request for cpu cannot be negative: -1",
+		},
 	}
 
 	for testName, testCase := range tests {
@@ -1544,6 +2173,47 @@ func TestValidateTolerations(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "toleration value needs to be empty, when operator is Exists",
 		},
+		"valid toleration, toleration seconds with NoExecute effect": {
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			wantErr: false,
+		},
+		"invalid toleration, toleration seconds with NoSchedule effect": {
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoSchedule,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "toleration seconds can only be specified, when effect is NoExecute",
+		},
+		"invalid toleration, non-unique toleration with toleration seconds": {
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "tolerations must be unique",
+		},
 		"invalid toleration, non-unique toleration": {
 			tolerations: []placementv1beta1.Toleration{
 				{
@@ -1619,6 +2289,44 @@ func TestIsTolerationsUpdatedOrDeleted(t *testing.T) {
 			},
 			want: true,
 		},
+		"toleration with toleration seconds is unchanged": {
+			oldTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			newTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			want: false,
+		},
+		"toleration seconds was updated in new tolerations": {
+			oldTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(300)),
+				},
+			},
+			newTolerations: []placementv1beta1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: ptr.To(int64(600)),
+				},
+			},
+			want: true,
+		},
 		"one toleration was updated in new tolerations": {
 			oldTolerations: []placementv1beta1.Toleration{
 				{
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"fmt"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	apiErrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// ValidatePlacementSimulation validates a PlacementSimulation object.
+func ValidatePlacementSimulation(sim *placementv1beta1.PlacementSimulation) error {
+	allErr := make([]error, 0)
+	if sim.Spec.Policy != nil {
+		if err := validatePlacementPolicy(sim.Spec.Policy); err != nil {
+			allErr = append(allErr, fmt.Errorf("the placement policy field is invalid: %w", err))
+		}
+	}
+
+	seenClusters := make(map[string]bool)
+	for _, change := range sim.Spec.ClusterChanges {
+		if seenClusters[change.ClusterName] {
+			allErr = append(allErr, fmt.Errorf("cluster %s has more than one cluster change", change.ClusterName))
+		}
+		seenClusters[change.ClusterName] = true
+
+		if errs := metav1validation.ValidateLabels(change.Labels, field.NewPath("labels")); len(errs) > 0 {
+			allErr = append(allErr, fmt.Errorf("the labels of cluster %s are invalid: %w", change.ClusterName, errs.ToAggregate()))
+		}
+		if err := validateTaints(change.Taints); err != nil {
+			allErr = append(allErr, fmt.Errorf("the taints of cluster %s are invalid: %w", change.ClusterName, err))
+		}
+		for name := range change.Properties {
+			for _, msg := range validation.IsQualifiedName(string(name)) {
+				allErr = append(allErr, fmt.Errorf("invalid property name %s of cluster %s: %s", name, change.ClusterName, msg))
+			}
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/utils/ptr"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestValidatePlacementSimulation(t *testing.T) {
+	tests := map[string]struct {
+		spec       placementv1beta1.PlacementSimulationSpec
+		wantErr    bool
+		wantErrMsg string
+	}{
+		"valid simulation, placement name only": {
+			spec: placementv1beta1.PlacementSimulationSpec{
+				PlacementName: "test-crp",
+			},
+		},
+		"valid simulation, with policy and cluster changes": {
+			spec: placementv1beta1.PlacementSimulationSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType:    placementv1beta1.PickNPlacementType,
+					NumberOfClusters: ptr.To(int32(2)),
+				},
+				ClusterChanges: []placementv1beta1.SimulatedClusterChange{
+					{
+						ClusterName: "cluster-1",
+						Labels:      map[string]string{"region": "east"},
+						Taints: []clusterv1beta1.Taint{
+							{Key: "key1", Value: "value1", Effect: "NoSchedule"},
+						},
+						Properties: map[clusterv1beta1.PropertyName]string{"kubernetes-fleet.io/node-count": "3"},
+					},
+				},
+			},
+		},
+		"invalid policy": {
+			spec: placementv1beta1.PlacementSimulationSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType: placementv1beta1.PickFixedPlacementType,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "cluster names cannot be empty for policy type PickFixed",
+		},
+		"duplicate cluster changes": {
+			spec: placementv1beta1.PlacementSimulationSpec{
+				PlacementName: "test-crp",
+				ClusterChanges: []placementv1beta1.SimulatedClusterChange{
+					{ClusterName: "cluster-1"},
+					{ClusterName: "cluster-1"},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "cluster cluster-1 has more than one cluster change",
+		},
+		"invalid label": {
+			spec: placementv1beta1.PlacementSimulationSpec{
+				PlacementName: "test-crp",
+				ClusterChanges: []placementv1beta1.SimulatedClusterChange{
+					{ClusterName: "cluster-1", Labels: map[string]string{"region": "east@"}},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "the labels of cluster cluster-1 are invalid",
+		},
+		"invalid taint": {
+			spec: placementv1beta1.PlacementSimulationSpec{
+				PlacementName: "test-crp",
+				ClusterChanges: []placementv1beta1.SimulatedClusterChange{
+					{
+						ClusterName: "cluster-1",
+						Taints: []clusterv1beta1.Taint{
+							{Key: "key@123:", Effect: "NoSchedule"},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "the taints of cluster cluster-1 are invalid",
+		},
+		"invalid property name": {
+			spec: placementv1beta1.PlacementSimulationSpec{
+				PlacementName: "test-crp",
+				ClusterChanges: []placementv1beta1.SimulatedClusterChange{
+					{
+						ClusterName: "cluster-1",
+						Properties:  map[clusterv1beta1.PropertyName]string{"node count": "3"},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "invalid property name node count of cluster cluster-1",
+		},
+	}
+	for testName, tt := range tests {
+		t.Run(testName, func(t *testing.T) {
+			sim := &placementv1beta1.PlacementSimulation{Spec: tt.spec}
+			err := ValidatePlacementSimulation(sim)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("ValidatePlacementSimulation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("ValidatePlacementSimulation() error = %v, want error message containing %q", err, tt.wantErrMsg)
+			}
+		})
+	}
+}
@@ -102,7 +102,7 @@ func validateOverridePolicy(policy *placementv1beta1.OverridePolicy) error {
 		if rule.ClusterSelector != nil {
 			for _, selector := range rule.ClusterSelector.ClusterSelectorTerms {
 				// Check that only label selector is supported
-				if selector.PropertySelector != nil || selector.PropertySorter != nil {
+				if selector.PropertySelector != nil || selector.PropertySorter != nil || len(selector.PropertyExpression) > 0 {
 					allErr = append(allErr, fmt.Errorf("invalid clusterSelector %+v: only labelSelector is supported", selector))
 					continue
 				}
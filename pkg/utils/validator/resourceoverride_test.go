@@ -648,6 +648,22 @@ func TestValidateOverridePolicy(t *testing.T) {
 			},
 			wantErrMsg: fmt.Errorf("only labelSelector is supported"),
 		},
+		"unsupported selector type - property expression": {
+			policy: &placementv1beta1.OverridePolicy{
+				OverrideRules: []placementv1beta1.OverrideRule{
+					{
+						ClusterSelector: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertyExpression: `labels["env"] == "prod"`,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrMsg: fmt.Errorf("only labelSelector is supported"),
+		},
 		"no cluster selector": {
 			policy: &placementv1beta1.OverridePolicy{
 				OverrideRules: []placementv1beta1.OverrideRule{
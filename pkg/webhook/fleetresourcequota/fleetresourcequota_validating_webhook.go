@@ -0,0 +1,203 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fleetresourcequota provides validating webhooks that validate fleet resource quotas, and
+// enforce them on ResourcePlacements.
+package fleetresourcequota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+var (
+	// ValidationPath is the webhook service path which admission requests are routed to for enforcing fleet resource quotas on RP resources.
+	ValidationPath = fmt.Sprintf(utils.ValidationPathFmt, placementv1beta1.GroupVersion.Group, placementv1beta1.GroupVersion.Version, "fleetresourcequota")
+)
+
+type fleetResourceQuotaValidator struct {
+	// Note: we have to use the uncached client here to avoid getting stale data
+	// since we need to guarantee that the number of placements in a namespace stays within the quotas.
+	client  client.Reader
+	decoder webhook.AdmissionDecoder
+}
+
+// Add registers the webhooks that validate FleetResourceQuotas and enforce them on ResourcePlacements.
+func Add(mgr manager.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &fleetResourceQuotaValidator{mgr.GetAPIReader(), admission.NewDecoder(mgr.GetScheme())}})
+	hookServer.Register(QuotaObjectValidationPath, &webhook.Admission{Handler: &fleetResourceQuotaObjectValidator{admission.NewDecoder(mgr.GetScheme())}})
+	return nil
+}
+
+// Handle fleetResourceQuotaValidator checks to see if a resource placement stays within the fleet resource quotas in its namespace.
+func (v *fleetResourceQuotaValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	var rp placementv1beta1.ResourcePlacement
+	klog.V(2).InfoS("Validating webhook handling fleet resource quotas", "operation", req.Operation, "namespacedName", req.Namespace+"/"+req.Name)
+	if err := v.decoder.Decode(req, &rp); err != nil {
+		klog.ErrorS(err, "Failed to decode resource placement object for enforcing fleet resource quotas", "userName", req.UserInfo.Username, "groups", req.UserInfo.Groups)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if req.Operation == admissionv1.Update {
+		var oldRP placementv1beta1.ResourcePlacement
+		if err := v.decoder.DecodeRaw(req.OldObject, &oldRP); err != nil {
+			klog.ErrorS(err, "Failed to decode old resource placement object for enforcing fleet resource quotas", "userName", req.UserInfo.Username, "groups", req.UserInfo.Groups)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		// Allow updates that do not change the placement policy, so that a placement created
+		// before a quota is tightened can still be updated (e.g., deleted with finalizers removed).
+		if equality.Semantic.DeepEqual(rp.Spec.Policy, oldRP.Spec.Policy) {
+			return admission.Allowed("resource placement policy is not changed")
+		}
+	}
+
+	quotaList := &placementv1beta1.FleetResourceQuotaList{}
+	if err := v.client.List(ctx, quotaList, client.InNamespace(rp.Namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			// The fleet resource quota API is not installed.
+			return admission.Allowed("fleet resource quota API is not installed")
+		}
+		klog.ErrorS(err, "Failed to list fleet resource quotas when validating")
+		return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to list fleet resource quotas, please retry the request: %w", err))
+	}
+	if len(quotaList.Items) == 0 {
+		return admission.Allowed("no fleet resource quota applies to the resource placement")
+	}
+
+	if req.Operation == admissionv1.Create {
+		rpList := &placementv1beta1.ResourcePlacementList{}
+		if err := v.client.List(ctx, rpList, client.InNamespace(rp.Namespace)); err != nil {
+			klog.ErrorS(err, "Failed to list resource placements when validating")
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to list resource placements, please retry the request: %w", err))
+		}
+		if err := validatePlacementCount(quotaList, rpList); err != nil {
+			klog.V(2).ErrorS(err, "Resource placement exceeds fleet resource quotas, request is denied", "operation", req.Operation)
+			return admission.Denied(err.Error())
+		}
+	}
+
+	if err := validateClusterCount(quotaList, &rp); err != nil {
+		klog.V(2).ErrorS(err, "Resource placement exceeds fleet resource quotas, request is denied", "operation", req.Operation)
+		return admission.Denied(err.Error())
+	}
+
+	if rp.Spec.Policy != nil && rp.Spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType {
+		clusterList := &clusterv1beta1.MemberClusterList{}
+		if err := v.client.List(ctx, clusterList); err != nil {
+			klog.ErrorS(err, "Failed to list member clusters when validating")
+			return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to list member clusters, please retry the request: %w", err))
+		}
+		if err := validateTargetClusters(quotaList, &rp, clusterList); err != nil {
+			klog.V(2).ErrorS(err, "Resource placement targets clusters not allowed by fleet resource quotas, request is denied", "operation", req.Operation)
+			return admission.Denied(err.Error())
+		}
+	}
+
+	return admission.Allowed("resource placement stays within the fleet resource quotas")
+}
+
+// validatePlacementCount checks that creating one more resource placement does not exceed the
+// maximum number of placements of any quota.
+func validatePlacementCount(quotaList *placementv1beta1.FleetResourceQuotaList, rpList *placementv1beta1.ResourcePlacementList) error {
+	count := 0
+	for idx := range rpList.Items {
+		if rpList.Items[idx].DeletionTimestamp == nil {
+			count++
+		}
+	}
+	for idx := range quotaList.Items {
+		quota := &quotaList.Items[idx]
+		if quota.Spec.MaxPlacements != nil && count >= int(*quota.Spec.MaxPlacements) {
+			return fmt.Errorf("fleet resource quota %s allows at most %d resource placements in namespace %s", quota.Name, *quota.Spec.MaxPlacements, quota.Namespace)
+		}
+	}
+	return nil
+}
+
+// validateClusterCount checks that a resource placement does not request more clusters than the
+// maximum number of clusters per placement of any quota.
+func validateClusterCount(quotaList *placementv1beta1.FleetResourceQuotaList, rp *placementv1beta1.ResourcePlacement) error {
+	policy := rp.Spec.Policy
+	if policy == nil {
+		return nil
+	}
+	var requested int
+	switch policy.PlacementType {
+	case placementv1beta1.PickNPlacementType:
+		if policy.NumberOfClusters == nil {
+			return nil
+		}
+		requested = int(*policy.NumberOfClusters)
+	case placementv1beta1.PickFixedPlacementType:
+		requested = len(policy.ClusterNames)
+	default:
+		// Placements of the PickAll placement type are capped by the scheduler instead.
+		return nil
+	}
+	for idx := range quotaList.Items {
+		quota := &quotaList.Items[idx]
+		if quota.Spec.MaxClustersPerPlacement != nil && requested > int(*quota.Spec.MaxClustersPerPlacement) {
+			return fmt.Errorf("resource placement requests %d clusters, but fleet resource quota %s allows at most %d clusters per placement", requested, quota.Name, *quota.Spec.MaxClustersPerPlacement)
+		}
+	}
+	return nil
+}
+
+// validateTargetClusters checks that the clusters a resource placement of the PickFixed placement
+// type targets are all allowed by the quotas.
+//
+// Clusters that have not joined the fleet yet are not checked here; the scheduler reports them as
+// not found.
+func validateTargetClusters(quotaList *placementv1beta1.FleetResourceQuotaList, rp *placementv1beta1.ResourcePlacement, clusterList *clusterv1beta1.MemberClusterList) error {
+	clusterLabels := make(map[string]labels.Set, len(clusterList.Items))
+	for idx := range clusterList.Items {
+		clusterLabels[clusterList.Items[idx].Name] = clusterList.Items[idx].Labels
+	}
+	for idx := range quotaList.Items {
+		quota := &quotaList.Items[idx]
+		if quota.Spec.AllowedClusterSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(quota.Spec.AllowedClusterSelector)
+		if err != nil {
+			return fmt.Errorf("fleet resource quota %s has an invalid allowed cluster selector: %w", quota.Name, err)
+		}
+		for _, name := range rp.Spec.Policy.ClusterNames {
+			set, found := clusterLabels[name]
+			if found && !selector.Matches(set) {
+				return fmt.Errorf("cluster %s is not allowed by fleet resource quota %s", name, quota.Name)
+			}
+		}
+	}
+	return nil
+}
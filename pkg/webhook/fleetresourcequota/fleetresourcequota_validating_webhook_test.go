@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleetresourcequota
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	quotaNamespace   = "test-ns"
+	noQuotaNamespace = "other-ns"
+	quotaName        = "test-quota"
+	rpName           = "test-rp"
+	newRPName        = "new-rp"
+	prodClusterName  = "prod-cluster"
+	devClusterName   = "dev-cluster"
+)
+
+func newResourcePlacement(namespace, name string, policy *placementv1beta1.PlacementPolicy) *placementv1beta1.ResourcePlacement {
+	return &placementv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: policy,
+		},
+	}
+}
+
+func newRequest(t *testing.T, op admissionv1.Operation, rp, oldRP *placementv1beta1.ResourcePlacement) admission.Request {
+	rpBytes, err := json.Marshal(rp)
+	if err != nil {
+		t.Fatalf("failed to marshal resource placement: %v", err)
+	}
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Namespace: rp.Namespace,
+			Name:      rp.Name,
+			Operation: op,
+			Object:    runtime.RawExtension{Raw: rpBytes},
+		},
+	}
+	if oldRP != nil {
+		oldRPBytes, err := json.Marshal(oldRP)
+		if err != nil {
+			t.Fatalf("failed to marshal old resource placement: %v", err)
+		}
+		req.OldObject = runtime.RawExtension{Raw: oldRPBytes}
+	}
+	return req
+}
+
+func TestHandle(t *testing.T) {
+	quota := &placementv1beta1.FleetResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: quotaNamespace,
+			Name:      quotaName,
+		},
+		Spec: placementv1beta1.FleetResourceQuotaSpec{
+			MaxPlacements:           ptr.To(int32(1)),
+			MaxClustersPerPlacement: ptr.To(int32(2)),
+			AllowedClusterSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"env": "prod"},
+			},
+		},
+	}
+	existingRP := newResourcePlacement(quotaNamespace, rpName, nil)
+	prodCluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   prodClusterName,
+			Labels: map[string]string{"env": "prod"},
+		},
+	}
+	devCluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   devClusterName,
+			Labels: map[string]string{"env": "dev"},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add cluster v1beta1 scheme: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects([]client.Object{quota, existingRP, prodCluster, devCluster}...).
+		Build()
+	validator := fleetResourceQuotaValidator{
+		client:  fakeClient,
+		decoder: admission.NewDecoder(scheme),
+	}
+
+	pickN := func(n int32) *placementv1beta1.PlacementPolicy {
+		return &placementv1beta1.PlacementPolicy{
+			PlacementType:    placementv1beta1.PickNPlacementType,
+			NumberOfClusters: ptr.To(n),
+		}
+	}
+	pickFixed := func(names ...string) *placementv1beta1.PlacementPolicy {
+		return &placementv1beta1.PlacementPolicy{
+			PlacementType: placementv1beta1.PickFixedPlacementType,
+			ClusterNames:  names,
+		}
+	}
+
+	testCases := map[string]struct {
+		req          admission.Request
+		wantResponse admission.Response
+	}{
+		"allow RP create in a namespace without quotas": {
+			req:          newRequest(t, admissionv1.Create, newResourcePlacement(noQuotaNamespace, newRPName, pickN(5)), nil),
+			wantResponse: admission.Allowed("no fleet resource quota applies to the resource placement"),
+		},
+		"deny RP create when the placement count limit is reached": {
+			req:          newRequest(t, admissionv1.Create, newResourcePlacement(quotaNamespace, newRPName, nil), nil),
+			wantResponse: admission.Denied("fleet resource quota test-quota allows at most 1 resource placements in namespace test-ns"),
+		},
+		"allow RP update that does not change the policy": {
+			req:          newRequest(t, admissionv1.Update, newResourcePlacement(quotaNamespace, rpName, pickN(3)), newResourcePlacement(quotaNamespace, rpName, pickN(3))),
+			wantResponse: admission.Allowed("resource placement policy is not changed"),
+		},
+		"deny RP update that requests too many clusters (PickN)": {
+			req:          newRequest(t, admissionv1.Update, newResourcePlacement(quotaNamespace, rpName, pickN(3)), existingRP),
+			wantResponse: admission.Denied("resource placement requests 3 clusters, but fleet resource quota test-quota allows at most 2 clusters per placement"),
+		},
+		"deny RP update that requests too many clusters (PickFixed)": {
+			req:          newRequest(t, admissionv1.Update, newResourcePlacement(quotaNamespace, rpName, pickFixed(prodClusterName, "a", "b")), existingRP),
+			wantResponse: admission.Denied("resource placement requests 3 clusters, but fleet resource quota test-quota allows at most 2 clusters per placement"),
+		},
+		"deny RP update that targets a cluster not allowed": {
+			req:          newRequest(t, admissionv1.Update, newResourcePlacement(quotaNamespace, rpName, pickFixed(prodClusterName, devClusterName)), existingRP),
+			wantResponse: admission.Denied("cluster dev-cluster is not allowed by fleet resource quota test-quota"),
+		},
+		"allow RP update that targets allowed or unknown clusters": {
+			req:          newRequest(t, admissionv1.Update, newResourcePlacement(quotaNamespace, rpName, pickFixed(prodClusterName, "unknown-cluster")), existingRP),
+			wantResponse: admission.Allowed("resource placement stays within the fleet resource quotas"),
+		},
+	}
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			gotResult := validator.Handle(context.Background(), testCase.req)
+			if diff := cmp.Diff(testCase.wantResponse, gotResult); diff != "" {
+				t.Errorf("fleetResourceQuotaValidator Handle() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleetresourcequota
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/validator"
+)
+
+var (
+	// QuotaObjectValidationPath is the webhook service path which admission requests are routed to for validating FleetResourceQuota resources.
+	QuotaObjectValidationPath = fmt.Sprintf(utils.ValidationPathFmt, placementv1beta1.GroupVersion.Group, placementv1beta1.GroupVersion.Version, "fleetresourcequotaobject")
+)
+
+type fleetResourceQuotaObjectValidator struct {
+	decoder webhook.AdmissionDecoder
+}
+
+// Handle fleetResourceQuotaObjectValidator checks to see if a fleet resource quota is valid.
+func (v *fleetResourceQuotaObjectValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	var quota placementv1beta1.FleetResourceQuota
+	klog.V(2).InfoS("Validating webhook handling fleet resource quota", "operation", req.Operation, "fleetResourceQuota", req.Namespace+"/"+req.Name)
+	if err := v.decoder.Decode(req, &quota); err != nil {
+		klog.ErrorS(err, "Failed to decode fleet resource quota object for validating fields", "userName", req.UserInfo.Username, "groups", req.UserInfo.Groups, "fleetResourceQuota", req.Namespace+"/"+req.Name)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if err := validator.ValidateFleetResourceQuota(&quota); err != nil {
+		klog.V(2).ErrorS(err, "FleetResourceQuota has invalid fields, request is denied", "operation", req.Operation, "fleetResourceQuota", klog.KObj(&quota))
+		return admission.Denied(err.Error())
+	}
+
+	klog.V(2).InfoS("FleetResourceQuota has valid fields", "fleetResourceQuota", klog.KObj(&quota))
+	return admission.Allowed("fleetResourceQuota has valid fields")
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleetresourcequota
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestHandleQuotaObject(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement v1beta1 scheme: %v", err)
+	}
+	validator := fleetResourceQuotaObjectValidator{decoder: admission.NewDecoder(scheme)}
+
+	testCases := map[string]struct {
+		selector    *metav1.LabelSelector
+		wantAllowed bool
+	}{
+		"allow quota without an allowed cluster selector": {
+			wantAllowed: true,
+		},
+		"allow quota with a valid allowed cluster selector": {
+			selector:    &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			wantAllowed: true,
+		},
+		"deny quota with an invalid allowed cluster selector": {
+			selector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "env", Operator: metav1.LabelSelectorOpIn},
+				},
+			},
+		},
+	}
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			quota := &placementv1beta1.FleetResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Namespace: quotaNamespace, Name: quotaName},
+				Spec:       placementv1beta1.FleetResourceQuotaSpec{AllowedClusterSelector: testCase.selector},
+			}
+			quotaBytes, err := json.Marshal(quota)
+			if err != nil {
+				t.Fatalf("failed to marshal fleet resource quota: %v", err)
+			}
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Namespace: quota.Namespace,
+					Name:      quota.Name,
+					Operation: admissionv1.Create,
+					Object:    runtime.RawExtension{Raw: quotaBytes},
+				},
+			}
+			gotResult := validator.Handle(context.Background(), req)
+			if gotResult.Allowed != testCase.wantAllowed {
+				t.Errorf("fleetResourceQuotaObjectValidator Handle() allowed = %v, want %v (result: %+v)", gotResult.Allowed, testCase.wantAllowed, gotResult.Result)
+			}
+		})
+	}
+}
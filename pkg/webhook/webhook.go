@@ -59,6 +59,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceplacementdisruptionbudget"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceplacementeviction"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/fleetresourcehandler"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/fleetresourcequota"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/membercluster"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/pod"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/replicaset"
@@ -115,6 +116,7 @@ const (
 	resourceOverrideName                 = "resourceoverrides"
 	evictionName                         = "clusterresourceplacementevictions"
 	disruptionBudgetName                 = "clusterresourceplacementdisruptionbudgets"
+	fleetResourceQuotaName               = "fleetresourcequotas"
 )
 
 var (
@@ -393,6 +395,30 @@ func (w *Config) buildFleetValidatingWebhooks() []admv1.ValidatingWebhook {
 			}},
 			TimeoutSeconds: longWebhookTimeout,
 		},
+		admv1.ValidatingWebhook{
+			Name:                    "fleet.fleetresourcequota.validating",
+			ClientConfig:            w.createClientConfig(fleetresourcequota.ValidationPath),
+			FailurePolicy:           &failFailurePolicy,
+			SideEffects:             &sideEffortsNone,
+			AdmissionReviewVersions: admissionReviewVersions,
+			Rules: []admv1.RuleWithOperations{{
+				Operations: []admv1.OperationType{admv1.Create, admv1.Update},
+				Rule:       createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{placementv1beta1.ResourcePlacementResource}, &namespacedScope),
+			}},
+			TimeoutSeconds: longWebhookTimeout,
+		},
+		admv1.ValidatingWebhook{
+			Name:                    "fleet.fleetresourcequotaobject.validating",
+			ClientConfig:            w.createClientConfig(fleetresourcequota.QuotaObjectValidationPath),
+			FailurePolicy:           &failFailurePolicy,
+			SideEffects:             &sideEffortsNone,
+			AdmissionReviewVersions: admissionReviewVersions,
+			Rules: []admv1.RuleWithOperations{{
+				Operations: []admv1.OperationType{admv1.Create, admv1.Update},
+				Rule:       createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{fleetResourceQuotaName}, &namespacedScope),
+			}},
+			TimeoutSeconds: longWebhookTimeout,
+		},
 		admv1.ValidatingWebhook{
 			Name:                    "fleet.clusterresourceplacementeviction.validating",
 			ClientConfig:            w.createClientConfig(clusterresourceplacementeviction.ValidationPath),
@@ -52,7 +52,7 @@ func TestBuildFleetValidatingWebhooks(t *testing.T) {
 				serviceURL:           "test-url",
 				clientConnectionType: &url,
 			},
-			wantLength: 8,
+			wantLength: 10,
 		},
 		"enable workload": {
 			config: Config{
@@ -62,7 +62,7 @@ func TestBuildFleetValidatingWebhooks(t *testing.T) {
 				clientConnectionType: &url,
 				enableWorkload:       true,
 			},
-			wantLength: 6,
+			wantLength: 8,
 		},
 	}
 
@@ -103,6 +103,13 @@ func scheduledBindingsCreatedOrUpdatedForClustersActual(clusters []string, score
 		if err != nil {
 			return fmt.Errorf("failed to list bindings for placement %s: %w", placementKey, err)
 		}
+		// The expected cluster decisions depend on the scheduling policy in use.
+		policySnapshotKey := types.NamespacedName{Namespace: placementKey.Namespace, Name: policySnapshotName}
+		policySnapshot, err := getSchedulingPolicySnapshot(policySnapshotKey)
+		if err != nil {
+			return fmt.Errorf("failed to get policy snapshot %s: %w", policySnapshotKey, err)
+		}
+		policy := policySnapshot.GetPolicySnapshotSpec().Policy
 		// Find all the scheduled bindings.
 		scheduled := []placementv1beta1.BindingObj{}
 		clusterMap := make(map[string]bool)
@@ -134,11 +141,7 @@ func scheduledBindingsCreatedOrUpdatedForClustersActual(clusters []string, score
 						State:                        placementv1beta1.BindingStateScheduled,
 						SchedulingPolicySnapshotName: policySnapshotName,
 						TargetCluster:                name,
-						ClusterDecision: placementv1beta1.ClusterDecision{
-							ClusterName:  name,
-							Selected:     true,
-							ClusterScore: score,
-						},
+						ClusterDecision:              wantClusterDecision(name, true, score, policy),
 					},
 				}
 			} else {
@@ -156,11 +159,7 @@ func scheduledBindingsCreatedOrUpdatedForClustersActual(clusters []string, score
 						State:                        placementv1beta1.BindingStateScheduled,
 						SchedulingPolicySnapshotName: policySnapshotName,
 						TargetCluster:                name,
-						ClusterDecision: placementv1beta1.ClusterDecision{
-							ClusterName:  name,
-							Selected:     true,
-							ClusterScore: score,
-						},
+						ClusterDecision:              wantClusterDecision(name, true, score, policy),
 					},
 				}
 			}
@@ -189,6 +188,13 @@ func boundBindingsCreatedOrUpdatedForClustersActual(clusters []string, scoreByCl
 		if err != nil {
 			return fmt.Errorf("failed to list bindings for placement %s: %w", placementKey, err)
 		}
+		// The expected cluster decisions depend on the scheduling policy in use.
+		policySnapshotKey := types.NamespacedName{Namespace: placementKey.Namespace, Name: policySnapshotName}
+		policySnapshot, err := getSchedulingPolicySnapshot(policySnapshotKey)
+		if err != nil {
+			return fmt.Errorf("failed to get policy snapshot %s: %w", policySnapshotKey, err)
+		}
+		policy := policySnapshot.GetPolicySnapshotSpec().Policy
 
 		bound := []placementv1beta1.BindingObj{}
 		clusterMap := make(map[string]bool)
@@ -217,11 +223,7 @@ func boundBindingsCreatedOrUpdatedForClustersActual(clusters []string, scoreByCl
 						State:                        placementv1beta1.BindingStateBound,
 						SchedulingPolicySnapshotName: policySnapshotName,
 						TargetCluster:                name,
-						ClusterDecision: placementv1beta1.ClusterDecision{
-							ClusterName:  name,
-							Selected:     true,
-							ClusterScore: score,
-						},
+						ClusterDecision:              wantClusterDecision(name, true, score, policy),
 					},
 				}
 				wantBound = append(wantBound, binding)
@@ -242,11 +244,7 @@ func boundBindingsCreatedOrUpdatedForClustersActual(clusters []string, scoreByCl
 						State:                        placementv1beta1.BindingStateBound,
 						SchedulingPolicySnapshotName: policySnapshotName,
 						TargetCluster:                name,
-						ClusterDecision: placementv1beta1.ClusterDecision{
-							ClusterName:  name,
-							Selected:     true,
-							ClusterScore: score,
-						},
+						ClusterDecision:              wantClusterDecision(name, true, score, policy),
 					},
 				}
 				wantBound = append(wantBound, binding)
@@ -275,6 +273,13 @@ func unscheduledBindingsCreatedOrUpdatedForClustersActual(clusters []string, sco
 		if err != nil {
 			return fmt.Errorf("failed to list bindings for placement %s: %w", placementKey, err)
 		}
+		// The expected cluster decisions depend on the scheduling policy in use.
+		policySnapshotKey := types.NamespacedName{Namespace: placementKey.Namespace, Name: policySnapshotName}
+		policySnapshot, err := getSchedulingPolicySnapshot(policySnapshotKey)
+		if err != nil {
+			return fmt.Errorf("failed to get policy snapshot %s: %w", policySnapshotKey, err)
+		}
+		policy := policySnapshot.GetPolicySnapshotSpec().Policy
 
 		unscheduled := []placementv1beta1.BindingObj{}
 		clusterMap := make(map[string]bool)
@@ -303,11 +308,7 @@ func unscheduledBindingsCreatedOrUpdatedForClustersActual(clusters []string, sco
 						State:                        placementv1beta1.BindingStateUnscheduled,
 						SchedulingPolicySnapshotName: policySnapshotName,
 						TargetCluster:                name,
-						ClusterDecision: placementv1beta1.ClusterDecision{
-							ClusterName:  name,
-							Selected:     true,
-							ClusterScore: score,
-						},
+						ClusterDecision:              wantClusterDecision(name, true, score, policy),
 					},
 				}
 				wantUnscheduled = append(wantUnscheduled, binding)
@@ -328,11 +329,7 @@ func unscheduledBindingsCreatedOrUpdatedForClustersActual(clusters []string, sco
 						State:                        placementv1beta1.BindingStateUnscheduled,
 						SchedulingPolicySnapshotName: policySnapshotName,
 						TargetCluster:                name,
-						ClusterDecision: placementv1beta1.ClusterDecision{
-							ClusterName:  name,
-							Selected:     true,
-							ClusterScore: score,
-						},
+						ClusterDecision:              wantClusterDecision(name, true, score, policy),
 					},
 				}
 				wantUnscheduled = append(wantUnscheduled, binding)
@@ -453,11 +450,7 @@ func pickAllPolicySnapshotStatusUpdatedActual(scored, filtered []string, policyS
 		// Verify that cluster decisions are populated correctly.
 		wantClusterDecisions := []placementv1beta1.ClusterDecision{}
 		for _, clusterName := range scored {
-			wantClusterDecisions = append(wantClusterDecisions, placementv1beta1.ClusterDecision{
-				ClusterName:  clusterName,
-				Selected:     true,
-				ClusterScore: &zeroScore,
-			})
+			wantClusterDecisions = append(wantClusterDecisions, wantClusterDecision(clusterName, true, &zeroScore, policySnapshot.GetPolicySnapshotSpec().Policy))
 		}
 		for _, clusterName := range filtered {
 			wantClusterDecisions = append(wantClusterDecisions, placementv1beta1.ClusterDecision{
@@ -542,18 +535,10 @@ func pickNPolicySnapshotStatusUpdatedActual(
 		// Verify that cluster decisions are populated correctly.
 		wantClusterDecisions := []placementv1beta1.ClusterDecision{}
 		for _, clusterName := range picked {
-			wantClusterDecisions = append(wantClusterDecisions, placementv1beta1.ClusterDecision{
-				ClusterName:  clusterName,
-				Selected:     true,
-				ClusterScore: scoreByCluster[clusterName],
-			})
+			wantClusterDecisions = append(wantClusterDecisions, wantClusterDecision(clusterName, true, scoreByCluster[clusterName], policySnapshot.GetPolicySnapshotSpec().Policy))
 		}
 		for _, clusterName := range notPicked {
-			wantClusterDecisions = append(wantClusterDecisions, placementv1beta1.ClusterDecision{
-				ClusterName:  clusterName,
-				Selected:     false,
-				ClusterScore: scoreByCluster[clusterName],
-			})
+			wantClusterDecisions = append(wantClusterDecisions, wantClusterDecision(clusterName, false, scoreByCluster[clusterName], policySnapshot.GetPolicySnapshotSpec().Policy))
 		}
 		for _, clusterName := range filtered {
 			wantClusterDecisions = append(wantClusterDecisions, placementv1beta1.ClusterDecision{
@@ -63,6 +63,11 @@ const (
 	bindingNamePlaceholder = "binding"
 
 	testNamespace = "test-namespace"
+
+	// The names of the score plugins registered in the scheduler framework.
+	clusterAffinityPluginName           = "ClusterAffinity"
+	samePlacementAntiAffinityPluginName = "SamePlacementAntiAffinity"
+	topologySpreadConstraintsPluginName = "TopologySpreadConstraints"
 )
 
 var (
@@ -161,6 +166,69 @@ func buildSchedulerFramework(ctrlMgr manager.Manager, clusterEligibilityChecker
 	return framework.NewFramework(profile, ctrlMgr, framework.WithClusterEligibilityChecker(clusterEligibilityChecker))
 }
 
+// wantClusterDecision returns the cluster decision the scheduler is expected to make for a cluster
+// with the given expected score under the given scheduling policy.
+//
+// The expected affinity score and topology spread score are those the cluster affinity plugin and
+// the topology spread constraints plugin give respectively. As all score plugins are of the default
+// weight (1) and scores are not normalized by default, the weighted score is their sum; and for
+// placements of the PickN placement type, each score plugin that is not skipped reports its score.
+func wantClusterDecision(clusterName string, selected bool, score *placementv1beta1.ClusterScore, policy *placementv1beta1.PlacementPolicy) placementv1beta1.ClusterDecision {
+	decision := placementv1beta1.ClusterDecision{
+		ClusterName: clusterName,
+		Selected:    selected,
+	}
+	if score == nil {
+		// The cluster is not scored, e.g., for placements of the PickFixed placement type.
+		return decision
+	}
+
+	affinityScore := ptr.Deref(score.AffinityScore, 0)
+	topologySpreadScore := ptr.Deref(score.TopologySpreadScore, 0)
+	decision.ClusterScore = &placementv1beta1.ClusterScore{
+		AffinityScore:       ptr.To(affinityScore),
+		TopologySpreadScore: ptr.To(topologySpreadScore),
+		WeightedScore:       ptr.To(int64(affinityScore) + int64(topologySpreadScore)),
+	}
+	if policy == nil || policy.PlacementType != placementv1beta1.PickNPlacementType {
+		// The Score stage does not run for placements of the PickAll placement type.
+		return decision
+	}
+
+	// The plugin scores are listed in the order of the plugin names.
+	if policy.Affinity != nil && policy.Affinity.ClusterAffinity != nil && len(policy.Affinity.ClusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
+		decision.PluginScores = append(decision.PluginScores, placementv1beta1.PluginScore{
+			Name: clusterAffinityPluginName,
+			ClusterScore: placementv1beta1.ClusterScore{
+				AffinityScore:       ptr.To(affinityScore),
+				TopologySpreadScore: ptr.To(int32(0)),
+				WeightedScore:       ptr.To(int64(affinityScore)),
+			},
+		})
+	}
+	// The same placement anti-affinity plugin is never skipped; it reports the obsolete placement
+	// affinity score only, which is for internal usage and does not count in the weighted score.
+	decision.PluginScores = append(decision.PluginScores, placementv1beta1.PluginScore{
+		Name: samePlacementAntiAffinityPluginName,
+		ClusterScore: placementv1beta1.ClusterScore{
+			AffinityScore:       ptr.To(int32(0)),
+			TopologySpreadScore: ptr.To(int32(0)),
+			WeightedScore:       ptr.To(int64(0)),
+		},
+	})
+	if len(policy.TopologySpreadConstraints) > 0 {
+		decision.PluginScores = append(decision.PluginScores, placementv1beta1.PluginScore{
+			Name: topologySpreadConstraintsPluginName,
+			ClusterScore: placementv1beta1.ClusterScore{
+				AffinityScore:       ptr.To(int32(0)),
+				TopologySpreadScore: ptr.To(topologySpreadScore),
+				WeightedScore:       ptr.To(int64(topologySpreadScore)),
+			},
+		})
+	}
+	return decision
+}
+
 func buildK8sAPIConfigFrom(restCfg *rest.Config) []byte {
 	clusterName := "default-cluster"
 	contextName := "default-context"
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package explain
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	toolsutils "github.com/kubefleet-dev/kubefleet/tools/utils"
+)
+
+// explainOptions wraps the parameters of the explain command.
+type explainOptions struct {
+	hubClusterContext string
+	namespace         string
+	placementName     string
+
+	hubClient client.Client
+	out       io.Writer
+}
+
+// NewCmdExplain creates a new explain command.
+func NewCmdExplain() *cobra.Command {
+	o := &explainOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "explain <placement>",
+		Short: "Explain the scheduling decisions of a placement",
+		Long: `Explain, cluster by cluster, the latest scheduling cycle the scheduler has run for a placement.
+
+For each cluster the scheduler has considered, the command shows whether the cluster has been
+selected, which scheduler plugin (or scheduler extender) has filtered it out, the score each
+scheduler plugin has given to it, and the reason behind the decision.
+
+The placement is a ClusterResourcePlacement by default; specify a namespace to explain a
+ResourcePlacement instead.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.placementName = args[0]
+			o.out = cmd.OutOrStdout()
+			if err := o.setupClient(); err != nil {
+				return err
+			}
+			return o.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.hubClusterContext, "hubClusterContext", "", "The name of the kubeconfig context to use for the hub cluster")
+	cmd.Flags().StringVarP(&o.namespace, "namespace", "n", "", "The namespace of the ResourcePlacement to explain; leave empty for a ClusterResourcePlacement")
+
+	// Mark required flags.
+	_ = cmd.MarkFlagRequired("hubClusterContext")
+
+	return cmd
+}
+
+func (o *explainOptions) run(ctx context.Context) error {
+	if o.placementName == "" {
+		return fmt.Errorf("placement name is required")
+	}
+
+	var explanation placementv1beta1.SchedulingExplanationObj
+	kind := placementv1beta1.ClusterResourcePlacementKind
+	if o.namespace == "" {
+		explanation = &placementv1beta1.ClusterSchedulingExplanation{}
+	} else {
+		explanation = &placementv1beta1.SchedulingExplanation{}
+		kind = placementv1beta1.ResourcePlacementKind
+	}
+	if err := o.hubClient.Get(ctx, types.NamespacedName{Namespace: o.namespace, Name: o.placementName}, explanation); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("no scheduling explanation found for %s %q; the placement may not exist or may not have been scheduled yet", kind, o.placementName)
+		}
+		return fmt.Errorf("failed to get the scheduling explanation for %s %q: %w", kind, o.placementName, err)
+	}
+
+	return printExplanation(o.out, kind, explanation)
+}
+
+// printExplanation renders a scheduling explanation in a human-readable form.
+func printExplanation(out io.Writer, kind string, explanation placementv1beta1.SchedulingExplanationObj) error {
+	status := explanation.GetSchedulingExplanationStatus()
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	placementName := explanation.GetName()
+	if ns := explanation.GetNamespace(); ns != "" {
+		placementName = ns + "/" + placementName
+	}
+	fmt.Fprintf(w, "%s:\t%s\n", kind, placementName)
+	fmt.Fprintf(w, "Policy Snapshot:\t%s\n", status.PolicySnapshotName)
+	fmt.Fprintf(w, "Placement Generation:\t%d\n", status.ObservedCRPGeneration)
+	lastUpdated := "<unknown>"
+	if status.LastUpdatedTime != nil {
+		lastUpdated = status.LastUpdatedTime.UTC().Format("2006-01-02T15:04:05Z")
+	}
+	fmt.Fprintf(w, "Last Updated:\t%s\n", lastUpdated)
+	fmt.Fprintln(w)
+
+	if len(status.Clusters) == 0 {
+		fmt.Fprintln(w, "No cluster has been considered by the scheduler.")
+		return w.Flush()
+	}
+
+	fmt.Fprintln(w, "CLUSTER\tSELECTED\tFILTERED BY\tSCORE\tPLUGIN SCORES\tREASON")
+	for _, c := range status.Clusters {
+		filteredBy := c.FilteredBy
+		if filteredBy == "" {
+			filteredBy = "-"
+		}
+		fmt.Fprintf(w, "%s\t%t\t%s\t%s\t%s\t%s\n", c.ClusterName, c.Selected, filteredBy, formatScore(c.ClusterScore), formatPluginScores(c.PluginScores), c.Reason)
+	}
+	return w.Flush()
+}
+
+// formatScore returns the weighted score of a cluster score, or "-" if the cluster has not
+// been scored.
+func formatScore(score *placementv1beta1.ClusterScore) string {
+	if score == nil || score.WeightedScore == nil {
+		return "-"
+	}
+	return strconv.FormatInt(*score.WeightedScore, 10)
+}
+
+// formatPluginScores returns the weighted scores of each plugin in the form of
+// name=score, separated by commas.
+func formatPluginScores(pluginScores []placementv1beta1.PluginScore) string {
+	if len(pluginScores) == 0 {
+		return "-"
+	}
+	scores := make([]string, 0, len(pluginScores))
+	for _, ps := range pluginScores {
+		scores = append(scores, fmt.Sprintf("%s=%s", ps.Name, formatScore(&ps.ClusterScore)))
+	}
+	return strings.Join(scores, ",")
+}
+
+// setupClient creates and configures the Kubernetes client
+func (o *explainOptions) setupClient() error {
+	scheme := runtime.NewScheme()
+
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		return fmt.Errorf("failed to add custom APIs (placement) to the runtime scheme: %w", err)
+	}
+
+	hubClient, err := toolsutils.GetClusterClientFromClusterContext(o.hubClusterContext, scheme)
+	if err != nil {
+		return fmt.Errorf("failed to create hub cluster client: %w", err)
+	}
+
+	o.hubClient = hubClient
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestRun(t *testing.T) {
+	lastUpdatedTime := metav1.NewTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	status := placementv1beta1.SchedulingExplanationStatus{
+		PolicySnapshotName:    "test-placement-0",
+		ObservedCRPGeneration: 2,
+		LastUpdatedTime:       &lastUpdatedTime,
+		Clusters: []placementv1beta1.ClusterSchedulingExplanationEntry{
+			{
+				ClusterName: "member-1",
+				Selected:    true,
+				ClusterScore: &placementv1beta1.ClusterScore{
+					WeightedScore: ptr.To(int64(150)),
+				},
+				PluginScores: []placementv1beta1.PluginScore{
+					{Name: "ClusterAffinity", ClusterScore: placementv1beta1.ClusterScore{WeightedScore: ptr.To(int64(100))}},
+					{Name: "TopologySpread", ClusterScore: placementv1beta1.ClusterScore{WeightedScore: ptr.To(int64(50))}},
+				},
+				Reason: "picked",
+			},
+			{
+				ClusterName: "member-2",
+				FilteredBy:  "ClusterAffinity",
+				Reason:      "label mismatch",
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		namespace     string
+		placementName string
+		existing      []client.Object
+		wantOutput    string
+		wantErrMsg    string
+	}{
+		{
+			name:       "empty placement name should fail",
+			wantErrMsg: "placement name is required",
+		},
+		{
+			name:          "explanation not found",
+			placementName: "test-placement",
+			wantErrMsg:    `no scheduling explanation found for ClusterResourcePlacement "test-placement"`,
+		},
+		{
+			name:          "explain a ClusterResourcePlacement",
+			placementName: "test-placement",
+			existing: []client.Object{
+				&placementv1beta1.ClusterSchedulingExplanation{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-placement"},
+					Status:     status,
+				},
+			},
+			wantOutput: `ClusterResourcePlacement:   test-placement
+Policy Snapshot:            test-placement-0
+Placement Generation:       2
+Last Updated:               2025-01-02T03:04:05Z
+
+CLUSTER    SELECTED   FILTERED BY       SCORE   PLUGIN SCORES                           REASON
+member-1   true       -                 150     ClusterAffinity=100,TopologySpread=50   picked
+member-2   false      ClusterAffinity   -       -                                       label mismatch
+`,
+		},
+		{
+			name:          "explain a ResourcePlacement with no cluster considered",
+			namespace:     "test-ns",
+			placementName: "test-placement",
+			existing: []client.Object{
+				&placementv1beta1.SchedulingExplanation{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-placement"},
+					Status: placementv1beta1.SchedulingExplanationStatus{
+						PolicySnapshotName:    "test-placement-0",
+						ObservedCRPGeneration: 1,
+					},
+				},
+			},
+			wantOutput: `ResourcePlacement:      test-ns/test-placement
+Policy Snapshot:        test-placement-0
+Placement Generation:   1
+Last Updated:           <unknown>
+
+No cluster has been considered by the scheduler.
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := placementv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("failed to add placement APIs to the scheme: %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.existing...).
+				Build()
+
+			out := &bytes.Buffer{}
+			o := &explainOptions{
+				namespace:     tc.namespace,
+				placementName: tc.placementName,
+				hubClient:     fakeClient,
+				out:           out,
+			}
+			err := o.run(context.Background())
+			if tc.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsg) {
+					t.Fatalf("run() = %v, want error containing %q", err, tc.wantErrMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("run() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(out.String(), tc.wantOutput); diff != "" {
+				t.Errorf("output diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/approve"
 	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/draincluster"
+	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/explain"
 	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/uncordoncluster"
 )
 
@@ -36,6 +37,7 @@ func main() {
 	// Add subcommands
 	rootCmd.AddCommand(approve.NewCmdApprove())
 	rootCmd.AddCommand(draincluster.NewCmdDrainCluster())
+	rootCmd.AddCommand(explain.NewCmdExplain())
 	rootCmd.AddCommand(uncordoncluster.NewCmdUncordonCluster())
 
 	if err := rootCmd.Execute(); err != nil {